@@ -5,23 +5,50 @@ package internal // import "github.com/open-telemetry/opentelemetry-collector-co
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/plogtest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/ptracetest"
+)
+
+// The signals the expected data can be made of.
+const (
+	SignalMetrics = "metrics"
+	SignalTraces  = "traces"
+	SignalLogs    = "logs"
 )
 
 type Config struct {
-	ExpectedFile    string
-	WriteExpected   bool
-	CompareOptions  []pmetrictest.CompareMetricsOption
-	OTLPEndpoint    string
-	OTLPHTTPEndoint string
-	Timeout         time.Duration
+	Signal               string
+	ExpectedFile         string
+	WriteExpected        bool
+	DiffFile             string
+	CompareOptions       []pmetrictest.CompareMetricsOption
+	TracesCompareOptions []ptracetest.CompareTracesOption
+	LogsCompareOptions   []plogtest.CompareLogsOption
+	OTLPEndpoint         string
+	OTLPHTTPEndoint      string
+	Timeout              time.Duration
 }
 
 func ReadConfig(args []string) (*Config, error) {
 	opts := []pmetrictest.CompareMetricsOption{}
+	// spans and log records are compared regardless of their order
+	tracesOpts := []ptracetest.CompareTracesOption{
+		ptracetest.IgnoreResourceSpansOrder(),
+		ptracetest.IgnoreScopeSpansOrder(),
+		ptracetest.IgnoreSpansOrder(),
+	}
+	logsOpts := []plogtest.CompareLogsOption{
+		plogtest.IgnoreResourceLogsOrder(),
+		plogtest.IgnoreScopeLogsOrder(),
+		plogtest.IgnoreLogRecordsOrder(),
+	}
+	signal := SignalMetrics
+	var diffFile string
 	writeExpected := false
 	var expectedFile string
 	var otlpEndpoint string
@@ -40,6 +67,15 @@ func ReadConfig(args []string) (*Config, error) {
 			if err != nil {
 				return nil, err
 			}
+		case "--signal":
+			i++
+			if i == len(args) {
+				return nil, errors.New("--signal requires an argument")
+			}
+			signal = args[i]
+			if signal != SignalMetrics && signal != SignalTraces && signal != SignalLogs {
+				return nil, fmt.Errorf("unsupported signal %q, must be one of metrics, traces or logs", signal)
+			}
 		case "--expected":
 			i++
 			if i == len(args) {
@@ -60,10 +96,38 @@ func ReadConfig(args []string) (*Config, error) {
 			otlpHTTPEndpoint = args[i]
 		case "--write-expected":
 			writeExpected = true
+		case "--diff-file":
+			i++
+			if i == len(args) {
+				return nil, errors.New("--diff-file requires an argument")
+			}
+			diffFile = args[i]
 		case "--ignore-start-timestamp":
 			opts = append(opts, pmetrictest.IgnoreStartTimestamp())
+			tracesOpts = append(tracesOpts, ptracetest.IgnoreStartTimestamp())
 		case "--ignore-timestamp":
 			opts = append(opts, pmetrictest.IgnoreTimestamp())
+			logsOpts = append(logsOpts, plogtest.IgnoreTimestamp())
+		case "--ignore-end-timestamp":
+			tracesOpts = append(tracesOpts, ptracetest.IgnoreEndTimestamp())
+		case "--ignore-observed-timestamp":
+			logsOpts = append(logsOpts, plogtest.IgnoreObservedTimestamp())
+		case "--ignore-trace-id":
+			tracesOpts = append(tracesOpts, ptracetest.IgnoreTraceID())
+		case "--ignore-span-id":
+			tracesOpts = append(tracesOpts, ptracetest.IgnoreSpanID())
+		case "--ignore-span-attribute-value":
+			i++
+			if i == len(args) {
+				return nil, errors.New("--ignore-span-attribute-value requires an argument")
+			}
+			tracesOpts = append(tracesOpts, ptracetest.IgnoreSpanAttributeValue(args[i]))
+		case "--ignore-log-record-attribute-value":
+			i++
+			if i == len(args) {
+				return nil, errors.New("--ignore-log-record-attribute-value requires an argument")
+			}
+			logsOpts = append(logsOpts, plogtest.IgnoreLogRecordAttributeValue(args[i]))
 		case "--ignore-metrics-data-points-order":
 			opts = append(opts, pmetrictest.IgnoreMetricDataPointsOrder())
 		case "--ignore-metrics-order":
@@ -78,6 +142,8 @@ func ReadConfig(args []string) (*Config, error) {
 			opts = append(opts, pmetrictest.IgnoreExemplarSlice())
 		case "--ignore-scope-version":
 			opts = append(opts, pmetrictest.IgnoreScopeVersion())
+			tracesOpts = append(tracesOpts, ptracetest.IgnoreScopeSpanInstrumentationScopeVersion())
+			logsOpts = append(logsOpts, plogtest.IgnoreScopeLogsVersion())
 		case "--ignore-data-points-attributes-order":
 			opts = append(opts, pmetrictest.IgnoreDatapointAttributesOrder())
 		case "--ignore-resource-attribute-value":
@@ -86,6 +152,8 @@ func ReadConfig(args []string) (*Config, error) {
 				return nil, errors.New("--ignore-resource-attribute-value requires an argument")
 			}
 			opts = append(opts, pmetrictest.IgnoreResourceAttributeValue(args[i]))
+			tracesOpts = append(tracesOpts, ptracetest.IgnoreResourceAttributeValue(args[i]))
+			logsOpts = append(logsOpts, plogtest.IgnoreResourceAttributeValue(args[i]))
 		case "--ignore-metric-attribute-value":
 			i++
 			if i == len(args) {
@@ -102,11 +170,15 @@ func ReadConfig(args []string) (*Config, error) {
 		}
 	}
 	return &Config{
-		WriteExpected:   writeExpected,
-		CompareOptions:  opts,
-		ExpectedFile:    expectedFile,
-		OTLPEndpoint:    otlpEndpoint,
-		OTLPHTTPEndoint: otlpHTTPEndpoint,
-		Timeout:         timeout,
+		Signal:               signal,
+		WriteExpected:        writeExpected,
+		DiffFile:             diffFile,
+		CompareOptions:       opts,
+		TracesCompareOptions: tracesOpts,
+		LogsCompareOptions:   logsOpts,
+		ExpectedFile:         expectedFile,
+		OTLPEndpoint:         otlpEndpoint,
+		OTLPHTTPEndoint:      otlpHTTPEndpoint,
+		Timeout:              timeout,
 	}, nil
 }
@@ -88,6 +88,35 @@ func Test_ReadConfig(t *testing.T) {
 				CompareOptions: []pmetrictest.CompareMetricsOption{pmetrictest.IgnoreMetricValues("foo.bar")},
 			},
 		},
+		{
+			name: "traces",
+			args: []string{
+				"--signal", "traces",
+				"--expected", "foo.yaml",
+				"--diff-file", "diff.json",
+				"--ignore-span-id",
+			},
+			cfg: &Config{
+				Signal:       SignalTraces,
+				ExpectedFile: "foo.yaml",
+				DiffFile:     "diff.json",
+			},
+		},
+		{
+			name: "unsupported signal",
+			args: []string{
+				"--signal", "profiles",
+			},
+			err: `unsupported signal "profiles", must be one of metrics, traces or logs`,
+		},
+		{
+			name: "missing diff file",
+			args: []string{
+				"--expected", "foo.yaml",
+				"--diff-file",
+			},
+			err: "--diff-file requires an argument",
+		},
 	}
 
 	for _, test := range tests {
@@ -99,7 +128,20 @@ func Test_ReadConfig(t *testing.T) {
 				assert.Equal(tt, test.cfg.WriteExpected, cfg.WriteExpected)
 				assert.Equal(tt, test.cfg.ExpectedFile, cfg.ExpectedFile)
 				assert.Len(tt, test.cfg.CompareOptions, len(cfg.CompareOptions))
+				if test.cfg.Signal != "" {
+					assert.Equal(tt, test.cfg.Signal, cfg.Signal)
+				}
+				assert.Equal(tt, test.cfg.DiffFile, cfg.DiffFile)
 			}
 		})
 	}
 }
+
+func Test_ReadConfigOrderInsensitive(t *testing.T) {
+	cfg, err := ReadConfig([]string{"--expected", "foo.yaml", "--ignore-timestamp"})
+	require.NoError(t, err)
+	assert.Equal(t, SignalMetrics, cfg.Signal)
+	// the order of the spans and log records is always ignored
+	assert.Len(t, cfg.TracesCompareOptions, 3)
+	assert.Len(t, cfg.LogsCompareOptions, 4)
+}
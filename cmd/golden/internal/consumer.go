@@ -5,68 +5,160 @@ package internal // import "github.com/open-telemetry/opentelemetry-collector-co
 
 import (
 	"context"
-	"testing"
+	"errors"
+	"sync"
 
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/plogtest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/ptracetest"
 )
 
-var _ consumer.Metrics = (*MetricsSink)(nil)
+var (
+	_ consumer.Metrics = (*Sink)(nil)
+	_ consumer.Traces  = (*Sink)(nil)
+	_ consumer.Logs    = (*Sink)(nil)
+)
 
-type MetricsSink struct {
-	cfg        *Config
-	noExpected bool
-	expected   pmetric.Metrics
-	DoneChan   chan struct{}
-	Error      error
+// Sink compares the data it receives with the expected data of the configured signal.
+// DoneChan is closed once the received data matches. Until then, Error and Report describe
+// how the last received data differs from the expected data.
+type Sink struct {
+	cfg             *Config
+	noExpected      bool
+	expectedMetrics pmetric.Metrics
+	expectedTraces  ptrace.Traces
+	expectedLogs    plog.Logs
+	doneOnce        sync.Once
+	DoneChan        chan struct{}
+	Error           error
+	Report          *Report
 }
 
-func (*MetricsSink) Capabilities() consumer.Capabilities {
+func (*Sink) Capabilities() consumer.Capabilities {
 	return consumer.Capabilities{
 		MutatesData: false,
 	}
 }
 
-func (m *MetricsSink) ConsumeMetrics(_ context.Context, md pmetric.Metrics) error {
-	if m.noExpected {
-		if m.cfg.WriteExpected {
-			err := golden.WriteMetricsToFile(m.cfg.ExpectedFile, md)
-			if err != nil {
+func (s *Sink) ConsumeMetrics(_ context.Context, md pmetric.Metrics) error {
+	if s.noExpected {
+		if s.cfg.WriteExpected {
+			if err := golden.WriteMetricsToFile(s.cfg.ExpectedFile, md); err != nil {
 				return err
 			}
 		}
-		close(m.DoneChan)
+		s.done()
+		return nil
+	}
+	err := pmetrictest.CompareMetrics(s.expectedMetrics, md, s.cfg.CompareOptions...)
+	if err != nil {
+		s.mismatch(err, DiffMetrics(s.expectedMetrics, md, s.cfg.CompareOptions...))
 		return nil
 	}
-	err := pmetrictest.CompareMetrics(m.expected, md, m.cfg.CompareOptions...)
-	if err == nil {
-		m.Error = nil
-		if m.cfg.WriteExpected {
-			err = golden.WriteMetrics(&testing.T{}, m.cfg.ExpectedFile, md)
-			if err != nil {
+	if s.cfg.WriteExpected {
+		if err = golden.WriteMetricsToFile(s.cfg.ExpectedFile, md); err != nil {
+			return err
+		}
+	}
+	s.match()
+	return nil
+}
+
+func (s *Sink) ConsumeTraces(_ context.Context, td ptrace.Traces) error {
+	if s.noExpected {
+		if s.cfg.WriteExpected {
+			if err := golden.WriteTracesToFile(s.cfg.ExpectedFile, td); err != nil {
+				return err
+			}
+		}
+		s.done()
+		return nil
+	}
+	err := ptracetest.CompareTraces(s.expectedTraces, td, s.cfg.TracesCompareOptions...)
+	if err != nil {
+		s.mismatch(err, DiffTraces(s.expectedTraces, td, s.cfg.TracesCompareOptions...))
+		return nil
+	}
+	if s.cfg.WriteExpected {
+		if err = golden.WriteTracesToFile(s.cfg.ExpectedFile, td); err != nil {
+			return err
+		}
+	}
+	s.match()
+	return nil
+}
+
+func (s *Sink) ConsumeLogs(_ context.Context, ld plog.Logs) error {
+	if s.noExpected {
+		if s.cfg.WriteExpected {
+			if err := golden.WriteLogsToFile(s.cfg.ExpectedFile, ld); err != nil {
 				return err
 			}
 		}
-		close(m.DoneChan)
-	} else {
-		m.Error = err
+		s.done()
+		return nil
 	}
+	err := plogtest.CompareLogs(s.expectedLogs, ld, s.cfg.LogsCompareOptions...)
+	if err != nil {
+		s.mismatch(err, DiffLogs(s.expectedLogs, ld, s.cfg.LogsCompareOptions...))
+		return nil
+	}
+	if s.cfg.WriteExpected {
+		if err = golden.WriteLogsToFile(s.cfg.ExpectedFile, ld); err != nil {
+			return err
+		}
+	}
+	s.match()
 	return nil
 }
 
-func NewConsumer(cfg *Config) (*MetricsSink, error) {
-	expected, err := golden.ReadMetrics(cfg.ExpectedFile)
-	noExpected := err != nil
+// mismatch records how the received data differs from the expected data. The report is preferred
+// to the comparison error, unless it is empty because only the order of the data differs.
+func (s *Sink) mismatch(err error, report *Report) {
+	s.Report = report
+	if report.Empty() {
+		s.Error = err
+		return
+	}
+	s.Error = errors.New("the received data does not match the expected data:\n" + report.String())
+}
+
+func (s *Sink) match() {
+	s.Error = nil
+	s.Report = &Report{Changes: []Change{}}
+	s.done()
+}
+
+func (s *Sink) done() {
+	s.doneOnce.Do(func() {
+		close(s.DoneChan)
+	})
+}
+
+// NewConsumer creates a Sink comparing the data with the expected file of the configured signal.
+func NewConsumer(cfg *Config) (*Sink, error) {
+	s := &Sink{
+		cfg:      cfg,
+		DoneChan: make(chan struct{}),
+	}
+	var err error
+	switch cfg.Signal {
+	case SignalTraces:
+		s.expectedTraces, err = golden.ReadTraces(cfg.ExpectedFile)
+	case SignalLogs:
+		s.expectedLogs, err = golden.ReadLogs(cfg.ExpectedFile)
+	default:
+		s.expectedMetrics, err = golden.ReadMetrics(cfg.ExpectedFile)
+	}
+	s.noExpected = err != nil
 	if err != nil && !cfg.WriteExpected {
 		return nil, err
 	}
-	return &MetricsSink{
-		expected:   expected,
-		cfg:        cfg,
-		noExpected: noExpected,
-		DoneChan:   make(chan struct{}),
-	}, nil
+	return s, nil
 }
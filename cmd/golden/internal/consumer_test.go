@@ -7,8 +7,11 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
 )
@@ -33,3 +36,77 @@ func TestConsumeMetrics(t *testing.T) {
 	err = s.ConsumeMetrics(t.Context(), m)
 	require.NoError(t, err)
 }
+
+func TestConsumeMetricsMismatch(t *testing.T) {
+	s, err := NewConsumer(&Config{
+		ExpectedFile: filepath.Join("testdata", "expected.yaml"),
+	})
+	require.NoError(t, err)
+	m := pmetric.NewMetrics()
+	mm := m.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	mm.SetName("foo")
+	mm.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(3)
+	require.NoError(t, s.ConsumeMetrics(t.Context(), m))
+	require.EqualError(t, s.Error, `the received data does not match the expected data:
+~ resource {} > scope "" > metric "foo" > data point {}: value: expected 2, actual 3`)
+	require.Len(t, s.Report.Changes, 1)
+	select {
+	case <-s.DoneChan:
+		t.Fatal("the sink should wait for matching data")
+	default:
+	}
+
+	mm.Gauge().DataPoints().At(0).SetIntValue(2)
+	require.NoError(t, s.ConsumeMetrics(t.Context(), m))
+	require.NoError(t, s.Error)
+	assert.True(t, s.Report.Empty())
+	<-s.DoneChan
+}
+
+func TestConsumeTraces(t *testing.T) {
+	cfg, err := ReadConfig([]string{"--signal", "traces", "--expected", filepath.Join(t.TempDir(), "expected.yaml"), "--write-expected"})
+	require.NoError(t, err)
+	newTraces := func(names ...string) ptrace.Traces {
+		td := ptrace.NewTraces()
+		spans := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+		for _, name := range names {
+			spans.AppendEmpty().SetName(name)
+		}
+		return td
+	}
+
+	// the expected file is written from the first traces received
+	s, err := NewConsumer(cfg)
+	require.NoError(t, err)
+	require.NoError(t, s.ConsumeTraces(t.Context(), newTraces("foo", "bar")))
+	<-s.DoneChan
+
+	// the spans are compared regardless of their order
+	cfg.WriteExpected = false
+	s, err = NewConsumer(cfg)
+	require.NoError(t, err)
+	require.NoError(t, s.ConsumeTraces(t.Context(), newTraces("bar", "foo")))
+	require.NoError(t, s.Error)
+	<-s.DoneChan
+}
+
+func TestConsumeLogs(t *testing.T) {
+	cfg, err := ReadConfig([]string{"--signal", "logs", "--expected", filepath.Join(t.TempDir(), "expected.yaml"), "--write-expected"})
+	require.NoError(t, err)
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("foo")
+
+	s, err := NewConsumer(cfg)
+	require.NoError(t, err)
+	require.NoError(t, s.ConsumeLogs(t.Context(), ld))
+	<-s.DoneChan
+
+	cfg.WriteExpected = false
+	s, err = NewConsumer(cfg)
+	require.NoError(t, err)
+	ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().SetStr("bar")
+	require.NoError(t, s.ConsumeLogs(t.Context(), ld))
+	require.EqualError(t, s.Error, `the received data does not match the expected data:
+- resource {} > scope "" > log record "foo"
++ resource {} > scope "" > log record "bar"`)
+}
@@ -0,0 +1,278 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "github.com/open-telemetry/opentelemetry-collector-contrib/cmd/golden/internal"
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// ChangeType is the type of a difference between the expected and the actual data.
+type ChangeType string
+
+const (
+	// Added is an element or a field only found in the actual data.
+	Added ChangeType = "added"
+	// Removed is an element or a field only found in the expected data.
+	Removed ChangeType = "removed"
+	// Changed is a field whose value differs between the expected and the actual data.
+	Changed ChangeType = "changed"
+)
+
+// Change is a difference between the expected and the actual data.
+type Change struct {
+	Type ChangeType `json:"type"`
+	// Path locates the element, from its resource, e.g. `resource {host.name="a"}`, `scope "b"`, `metric "c"`.
+	Path []string `json:"path"`
+	// Field is the changed field of the element, empty when the whole element was added or removed.
+	Field    string `json:"field,omitempty"`
+	Expected any    `json:"expected,omitempty"`
+	Actual   any    `json:"actual,omitempty"`
+}
+
+func (c Change) String() string {
+	var sb strings.Builder
+	switch c.Type {
+	case Added:
+		sb.WriteString("+ ")
+	case Removed:
+		sb.WriteString("- ")
+	default:
+		sb.WriteString("~ ")
+	}
+	sb.WriteString(strings.Join(c.Path, " > "))
+	if c.Field == "" {
+		return sb.String()
+	}
+	sb.WriteString(": ")
+	sb.WriteString(c.Field)
+	switch c.Type {
+	case Added:
+		fmt.Fprintf(&sb, ": %s", formatValue(c.Actual))
+	case Removed:
+		fmt.Fprintf(&sb, ": %s", formatValue(c.Expected))
+	default:
+		fmt.Fprintf(&sb, ": expected %s, actual %s", formatValue(c.Expected), formatValue(c.Actual))
+	}
+	return sb.String()
+}
+
+// Report lists the differences between the expected and the actual data.
+type Report struct {
+	Changes []Change `json:"changes"`
+}
+
+// Empty returns whether the expected and the actual data have no differences.
+func (r *Report) Empty() bool {
+	return len(r.Changes) == 0
+}
+
+// String renders the report as text, one change per line. Added elements and fields are prefixed
+// with "+", removed ones with "-" and changed fields with "~".
+func (r *Report) String() string {
+	lines := make([]string, 0, len(r.Changes))
+	for _, c := range r.Changes {
+		lines = append(lines, c.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// WriteToFile writes the report to a file in JSON format.
+func (r *Report) WriteToFile(filePath string) error {
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filePath, append(b, '\n'), 0o600)
+}
+
+// element is a node of the telemetry tree: a resource, a scope, a metric, a data point, a span or a log record.
+// The diff compares the fields of the elements matched between the expected and the actual trees.
+type element struct {
+	kind string
+	// identity lists the fields telling the element apart from its siblings.
+	identity []string
+	fields   map[string]any
+	children []*element
+}
+
+func newElement(kind string, identity ...string) *element {
+	return &element{kind: kind, identity: identity, fields: map[string]any{}}
+}
+
+// putAttributes adds the attributes to the fields of the element, under the attributes. prefix.
+// When identifying is set, the attributes identify the element.
+func (e *element) putAttributes(attrs map[string]any, identifying bool) {
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		field := attributesPrefix + k
+		e.fields[field] = attrs[k]
+		if identifying {
+			e.identity = append(e.identity, field)
+		}
+	}
+}
+
+const attributesPrefix = "attributes."
+
+func (e *element) key() string {
+	var sb strings.Builder
+	sb.WriteString(e.kind)
+	for _, field := range e.identity {
+		fmt.Fprintf(&sb, "\x00%s=%s", field, formatValue(e.fields[field]))
+	}
+	return sb.String()
+}
+
+// label names the element in a path, e.g. `metric "system.cpu.time"` or `data point {state="idle"}`.
+func (e *element) label() string {
+	var values, attrs []string
+	for _, field := range e.identity {
+		if k, ok := strings.CutPrefix(field, attributesPrefix); ok {
+			attrs = append(attrs, k+"="+formatValue(e.fields[field]))
+		} else {
+			values = append(values, formatValue(e.fields[field]))
+		}
+	}
+	parts := append([]string{e.kind}, values...)
+	if len(attrs) > 0 || len(values) == 0 {
+		parts = append(parts, "{"+strings.Join(attrs, ", ")+"}")
+	}
+	return strings.Join(parts, " ")
+}
+
+// similarity counts the identifying fields of the expected element having the same value in the actual element.
+func (e *element) similarity(actual *element) int {
+	n := 0
+	for _, field := range e.identity {
+		if v, ok := actual.fields[field]; ok && reflect.DeepEqual(v, e.fields[field]) {
+			n++
+		}
+	}
+	return n
+}
+
+// distance counts the fields differing between the expected and the actual element.
+func (e *element) distance(actual *element) int {
+	n := 0
+	for field, v := range e.fields {
+		if av, ok := actual.fields[field]; !ok || !reflect.DeepEqual(v, av) {
+			n++
+		}
+	}
+	for field := range actual.fields {
+		if _, ok := e.fields[field]; !ok {
+			n++
+		}
+	}
+	return n
+}
+
+// diffElements appends to the report the differences between the expected and actual sibling elements.
+// The order of the elements does not matter: an expected element is matched with the actual element
+// having the same identity and the fewest different fields. The remaining elements are matched with
+// the actual element of the same kind sharing the most identifying fields, so that a changed attribute
+// is reported as such rather than as a removed and an added element.
+func (r *Report) diffElements(path []string, expected, actual []*element) {
+	matches := make([]*element, len(expected))
+	matched := make([]bool, len(actual))
+	for i, e := range expected {
+		best, bestDistance := -1, 0
+		key := e.key()
+		for j, a := range actual {
+			if matched[j] || a.key() != key {
+				continue
+			}
+			if d := e.distance(a); best == -1 || d < bestDistance {
+				best, bestDistance = j, d
+			}
+		}
+		if best != -1 {
+			matches[i], matched[best] = actual[best], true
+		}
+	}
+	for i, e := range expected {
+		if matches[i] != nil {
+			continue
+		}
+		best, bestSimilarity := -1, 0
+		for j, a := range actual {
+			if matched[j] || a.kind != e.kind {
+				continue
+			}
+			if s := e.similarity(a); s > bestSimilarity {
+				best, bestSimilarity = j, s
+			}
+		}
+		if best != -1 {
+			matches[i], matched[best] = actual[best], true
+		}
+	}
+
+	for i, e := range expected {
+		if matches[i] == nil {
+			r.Changes = append(r.Changes, Change{Type: Removed, Path: appendPath(path, e.label())})
+			continue
+		}
+		r.diffElement(path, e, matches[i])
+	}
+	for j, a := range actual {
+		if !matched[j] {
+			r.Changes = append(r.Changes, Change{Type: Added, Path: appendPath(path, a.label())})
+		}
+	}
+}
+
+func (r *Report) diffElement(path []string, expected, actual *element) {
+	elementPath := appendPath(path, expected.label())
+	fields := make([]string, 0, len(expected.fields)+len(actual.fields))
+	for field := range expected.fields {
+		fields = append(fields, field)
+	}
+	for field := range actual.fields {
+		if _, ok := expected.fields[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	slices.Sort(fields)
+	for _, field := range fields {
+		ev, inExpected := expected.fields[field]
+		av, inActual := actual.fields[field]
+		switch {
+		case !inExpected:
+			r.Changes = append(r.Changes, Change{Type: Added, Path: elementPath, Field: field, Actual: av})
+		case !inActual:
+			r.Changes = append(r.Changes, Change{Type: Removed, Path: elementPath, Field: field, Expected: ev})
+		case !reflect.DeepEqual(ev, av):
+			r.Changes = append(r.Changes, Change{Type: Changed, Path: elementPath, Field: field, Expected: ev, Actual: av})
+		}
+	}
+	r.diffElements(elementPath, expected.children, actual.children)
+}
+
+func appendPath(path []string, label string) []string {
+	return append(slices.Clip(path), label)
+}
+
+func diff(expected, actual []*element) *Report {
+	r := &Report{Changes: []Change{}}
+	r.diffElements(nil, expected, actual)
+	return r
+}
+
+func formatValue(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
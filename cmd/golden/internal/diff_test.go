@@ -0,0 +1,164 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"encoding/json"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+)
+
+type testDataPoint struct {
+	cpu   string
+	state string
+	value int64
+}
+
+func testMetrics(version string, dps ...testDataPoint) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("host.name", "a")
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName("receiver")
+	sm.Scope().SetVersion(version)
+	m := sm.Metrics().AppendEmpty()
+	m.SetName("cpu.time")
+	m.SetUnit("s")
+	gauge := m.SetEmptyGauge()
+	for _, tdp := range dps {
+		dp := gauge.DataPoints().AppendEmpty()
+		dp.Attributes().PutStr("cpu", tdp.cpu)
+		dp.Attributes().PutStr("state", tdp.state)
+		dp.SetIntValue(tdp.value)
+	}
+	return md
+}
+
+func TestDiffMetrics(t *testing.T) {
+	expected := testMetrics("1.0.0", testDataPoint{"0", "idle", 1}, testDataPoint{"1", "idle", 1})
+	expected.ResourceMetrics().AppendEmpty().Resource().Attributes().PutStr("host.name", "b")
+	// the order of the data points does not matter
+	actual := testMetrics("2.0.0", testDataPoint{"1", "idle", 1}, testDataPoint{"0", "user", 2})
+	actual.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().AppendEmpty().SetName("cpu.load")
+
+	report := DiffMetrics(expected, actual)
+	dataPoint := []string{`resource {host.name="a"}`, `scope "receiver"`, `metric "cpu.time"`, `data point {cpu="0", state="idle"}`}
+	assert.Equal(t, []Change{
+		{Type: Changed, Path: []string{`resource {host.name="a"}`, `scope "receiver"`}, Field: "version", Expected: "1.0.0", Actual: "2.0.0"},
+		{Type: Changed, Path: dataPoint, Field: "attributes.state", Expected: "idle", Actual: "user"},
+		{Type: Changed, Path: dataPoint, Field: "value", Expected: int64(1), Actual: int64(2)},
+		{Type: Added, Path: []string{`resource {host.name="a"}`, `scope "receiver"`, `metric "cpu.load"`}},
+		{Type: Removed, Path: []string{`resource {host.name="b"}`}},
+	}, report.Changes)
+	assert.Equal(t, `~ resource {host.name="a"} > scope "receiver": version: expected "1.0.0", actual "2.0.0"
+~ resource {host.name="a"} > scope "receiver" > metric "cpu.time" > data point {cpu="0", state="idle"}: attributes.state: expected "idle", actual "user"
+~ resource {host.name="a"} > scope "receiver" > metric "cpu.time" > data point {cpu="0", state="idle"}: value: expected 1, actual 2
++ resource {host.name="a"} > scope "receiver" > metric "cpu.load"
+- resource {host.name="b"}`, report.String())
+}
+
+func TestDiffMetricsWithOptions(t *testing.T) {
+	expected := testMetrics("1.0.0", testDataPoint{"0", "idle", 1})
+	actual := testMetrics("2.0.0", testDataPoint{"0", "idle", 1})
+	assert.False(t, DiffMetrics(expected, actual).Empty())
+	assert.True(t, DiffMetrics(expected, actual, pmetrictest.IgnoreScopeVersion()).Empty())
+}
+
+func TestDiffMetricsNaN(t *testing.T) {
+	md := pmetric.NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("ratio")
+	m.SetEmptyGauge().DataPoints().AppendEmpty().SetDoubleValue(math.NaN())
+	assert.True(t, DiffMetrics(md, md).Empty())
+}
+
+func TestDiffTraces(t *testing.T) {
+	newTraces := func(names ...string) ptrace.Traces {
+		td := ptrace.NewTraces()
+		rs := td.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutStr("service.name", "frontend")
+		spans := rs.ScopeSpans().AppendEmpty().Spans()
+		for _, name := range names {
+			span := spans.AppendEmpty()
+			span.SetName(name)
+			span.Attributes().PutStr("http.route", "/"+name)
+		}
+		return td
+	}
+	expected := newTraces("checkout", "cart", "cart")
+	actual := newTraces("cart", "checkout", "cart", "payment")
+	span := actual.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(2)
+	span.Status().SetCode(ptrace.StatusCodeError)
+	span.Attributes().PutStr("error.type", "timeout")
+
+	report := DiffTraces(expected, actual)
+	cart := []string{`resource {service.name="frontend"}`, `scope ""`, `span "cart"`}
+	assert.Equal(t, []Change{
+		{Type: Added, Path: cart, Field: "attributes.error.type", Actual: "timeout"},
+		{Type: Changed, Path: cart, Field: "status.code", Expected: "Unset", Actual: "Error"},
+		{Type: Added, Path: []string{`resource {service.name="frontend"}`, `scope ""`, `span "payment"`}},
+	}, report.Changes)
+	assert.Equal(t, `+ resource {service.name="frontend"} > scope "" > span "cart": attributes.error.type: "timeout"
+~ resource {service.name="frontend"} > scope "" > span "cart": status.code: expected "Unset", actual "Error"
++ resource {service.name="frontend"} > scope "" > span "payment"`, report.String())
+}
+
+func TestDiffLogs(t *testing.T) {
+	newLogs := func(bodies ...string) plog.Logs {
+		ld := plog.NewLogs()
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("service.name", "frontend")
+		records := rl.ScopeLogs().AppendEmpty().LogRecords()
+		for _, body := range bodies {
+			lr := records.AppendEmpty()
+			lr.Body().SetStr(body)
+			lr.SetSeverityNumber(plog.SeverityNumberInfo)
+		}
+		return ld
+	}
+	expected := newLogs("started", "stopped")
+	actual := newLogs("stopped", "started")
+	assert.True(t, DiffLogs(expected, actual).Empty())
+
+	actual.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).SetSeverityNumber(plog.SeverityNumberWarn)
+	actual.ResourceLogs().At(0).Resource().Attributes().PutStr("service.version", "1.0.0")
+	report := DiffLogs(expected, actual)
+	resource := []string{`resource {service.name="frontend"}`}
+	assert.Equal(t, []Change{
+		{Type: Added, Path: resource, Field: "attributes.service.version", Actual: "1.0.0"},
+		{Type: Changed, Path: append(resource, `scope ""`, `log record "stopped"`), Field: "severity_number", Expected: "Info", Actual: "Warn"},
+	}, report.Changes)
+}
+
+func TestReportWriteToFile(t *testing.T) {
+	report := DiffMetrics(testMetrics("1.0.0"), testMetrics("2.0.0"))
+	path := filepath.Join(t.TempDir(), "diff.json")
+	require.NoError(t, report.WriteToFile(path))
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var written map[string]any
+	require.NoError(t, json.Unmarshal(b, &written))
+	assert.Equal(t, map[string]any{
+		"changes": []any{
+			map[string]any{
+				"type":     "changed",
+				"path":     []any{`resource {host.name="a"}`, `scope "receiver"`},
+				"field":    "version",
+				"expected": "1.0.0",
+				"actual":   "2.0.0",
+			},
+		},
+	}, written)
+}
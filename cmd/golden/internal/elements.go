@@ -0,0 +1,318 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "github.com/open-telemetry/opentelemetry-collector-contrib/cmd/golden/internal"
+
+import (
+	"math"
+	"strconv"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/plogtest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/ptracetest"
+)
+
+// DiffMetrics reports the differences between the expected and actual metrics, once the options are applied.
+func DiffMetrics(expected, actual pmetric.Metrics, options ...pmetrictest.CompareMetricsOption) *Report {
+	exp, act := pmetrictest.ApplyCompareOptions(expected, actual, options...)
+	return diff(metricsElements(exp), metricsElements(act))
+}
+
+// DiffTraces reports the differences between the expected and actual traces, once the options are applied.
+func DiffTraces(expected, actual ptrace.Traces, options ...ptracetest.CompareTracesOption) *Report {
+	exp, act := ptracetest.ApplyCompareOptions(expected, actual, options...)
+	return diff(tracesElements(exp), tracesElements(act))
+}
+
+// DiffLogs reports the differences between the expected and actual logs, once the options are applied.
+func DiffLogs(expected, actual plog.Logs, options ...plogtest.CompareLogsOption) *Report {
+	exp, act := plogtest.ApplyCompareOptions(expected, actual, options...)
+	return diff(logsElements(exp), logsElements(act))
+}
+
+func resourceElement(resource pcommon.Resource, schemaURL string) *element {
+	e := newElement("resource")
+	e.putAttributes(resource.Attributes().AsRaw(), true)
+	e.fields["dropped_attributes_count"] = resource.DroppedAttributesCount()
+	e.fields["schema_url"] = schemaURL
+	return e
+}
+
+func scopeElement(scope pcommon.InstrumentationScope, schemaURL string) *element {
+	e := newElement("scope", "name")
+	e.fields["name"] = scope.Name()
+	e.fields["version"] = scope.Version()
+	e.putAttributes(scope.Attributes().AsRaw(), false)
+	e.fields["dropped_attributes_count"] = scope.DroppedAttributesCount()
+	e.fields["schema_url"] = schemaURL
+	return e
+}
+
+func metricsElements(md pmetric.Metrics) []*element {
+	resources := make([]*element, 0, md.ResourceMetrics().Len())
+	for i := range md.ResourceMetrics().Len() {
+		rm := md.ResourceMetrics().At(i)
+		resource := resourceElement(rm.Resource(), rm.SchemaUrl())
+		for j := range rm.ScopeMetrics().Len() {
+			sm := rm.ScopeMetrics().At(j)
+			scope := scopeElement(sm.Scope(), sm.SchemaUrl())
+			for k := range sm.Metrics().Len() {
+				scope.children = append(scope.children, metricElement(sm.Metrics().At(k)))
+			}
+			resource.children = append(resource.children, scope)
+		}
+		resources = append(resources, resource)
+	}
+	return resources
+}
+
+func metricElement(metric pmetric.Metric) *element {
+	e := newElement("metric", "name")
+	e.fields["name"] = metric.Name()
+	e.fields["description"] = metric.Description()
+	e.fields["unit"] = metric.Unit()
+	e.fields["type"] = metric.Type().String()
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		e.children = numberDataPointElements(metric.Gauge().DataPoints())
+	case pmetric.MetricTypeSum:
+		e.fields["aggregation_temporality"] = metric.Sum().AggregationTemporality().String()
+		e.fields["is_monotonic"] = metric.Sum().IsMonotonic()
+		e.children = numberDataPointElements(metric.Sum().DataPoints())
+	case pmetric.MetricTypeHistogram:
+		e.fields["aggregation_temporality"] = metric.Histogram().AggregationTemporality().String()
+		for i := range metric.Histogram().DataPoints().Len() {
+			e.children = append(e.children, histogramDataPointElement(metric.Histogram().DataPoints().At(i)))
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		e.fields["aggregation_temporality"] = metric.ExponentialHistogram().AggregationTemporality().String()
+		for i := range metric.ExponentialHistogram().DataPoints().Len() {
+			e.children = append(e.children, exponentialHistogramDataPointElement(metric.ExponentialHistogram().DataPoints().At(i)))
+		}
+	case pmetric.MetricTypeSummary:
+		for i := range metric.Summary().DataPoints().Len() {
+			e.children = append(e.children, summaryDataPointElement(metric.Summary().DataPoints().At(i)))
+		}
+	}
+	return e
+}
+
+func dataPointElement(attributes pcommon.Map, startTimestamp, timestamp pcommon.Timestamp, flags pmetric.DataPointFlags) *element {
+	e := newElement("data point")
+	e.putAttributes(attributes.AsRaw(), true)
+	e.fields["start_timestamp"] = startTimestamp.String()
+	e.fields["timestamp"] = timestamp.String()
+	e.fields["flags"] = uint32(flags)
+	return e
+}
+
+func numberDataPointElements(dps pmetric.NumberDataPointSlice) []*element {
+	elements := make([]*element, 0, dps.Len())
+	for i := range dps.Len() {
+		dp := dps.At(i)
+		e := dataPointElement(dp.Attributes(), dp.StartTimestamp(), dp.Timestamp(), dp.Flags())
+		switch dp.ValueType() {
+		case pmetric.NumberDataPointValueTypeInt:
+			e.fields["value"] = dp.IntValue()
+		case pmetric.NumberDataPointValueTypeDouble:
+			e.fields["value"] = double(dp.DoubleValue())
+		}
+		e.fields["exemplars"] = exemplars(dp.Exemplars())
+		elements = append(elements, e)
+	}
+	return elements
+}
+
+func histogramDataPointElement(dp pmetric.HistogramDataPoint) *element {
+	e := dataPointElement(dp.Attributes(), dp.StartTimestamp(), dp.Timestamp(), dp.Flags())
+	e.fields["count"] = dp.Count()
+	if dp.HasSum() {
+		e.fields["sum"] = double(dp.Sum())
+	}
+	if dp.HasMin() {
+		e.fields["min"] = double(dp.Min())
+	}
+	if dp.HasMax() {
+		e.fields["max"] = double(dp.Max())
+	}
+	e.fields["bucket_counts"] = dp.BucketCounts().AsRaw()
+	e.fields["explicit_bounds"] = doubles(dp.ExplicitBounds().AsRaw())
+	e.fields["exemplars"] = exemplars(dp.Exemplars())
+	return e
+}
+
+func exponentialHistogramDataPointElement(dp pmetric.ExponentialHistogramDataPoint) *element {
+	e := dataPointElement(dp.Attributes(), dp.StartTimestamp(), dp.Timestamp(), dp.Flags())
+	e.fields["count"] = dp.Count()
+	if dp.HasSum() {
+		e.fields["sum"] = double(dp.Sum())
+	}
+	if dp.HasMin() {
+		e.fields["min"] = double(dp.Min())
+	}
+	if dp.HasMax() {
+		e.fields["max"] = double(dp.Max())
+	}
+	e.fields["scale"] = dp.Scale()
+	e.fields["zero_count"] = dp.ZeroCount()
+	e.fields["zero_threshold"] = double(dp.ZeroThreshold())
+	e.fields["positive.offset"] = dp.Positive().Offset()
+	e.fields["positive.bucket_counts"] = dp.Positive().BucketCounts().AsRaw()
+	e.fields["negative.offset"] = dp.Negative().Offset()
+	e.fields["negative.bucket_counts"] = dp.Negative().BucketCounts().AsRaw()
+	e.fields["exemplars"] = exemplars(dp.Exemplars())
+	return e
+}
+
+func summaryDataPointElement(dp pmetric.SummaryDataPoint) *element {
+	e := dataPointElement(dp.Attributes(), dp.StartTimestamp(), dp.Timestamp(), dp.Flags())
+	e.fields["count"] = dp.Count()
+	e.fields["sum"] = double(dp.Sum())
+	quantiles := make([]any, 0, dp.QuantileValues().Len())
+	for i := range dp.QuantileValues().Len() {
+		q := dp.QuantileValues().At(i)
+		quantiles = append(quantiles, map[string]any{"quantile": double(q.Quantile()), "value": double(q.Value())})
+	}
+	e.fields["quantile_values"] = quantiles
+	return e
+}
+
+func exemplars(exemplars pmetric.ExemplarSlice) []any {
+	values := make([]any, 0, exemplars.Len())
+	for i := range exemplars.Len() {
+		ex := exemplars.At(i)
+		value := map[string]any{
+			"filtered_attributes": ex.FilteredAttributes().AsRaw(),
+			"timestamp":           ex.Timestamp().String(),
+			"trace_id":            ex.TraceID().String(),
+			"span_id":             ex.SpanID().String(),
+		}
+		switch ex.ValueType() {
+		case pmetric.ExemplarValueTypeInt:
+			value["value"] = ex.IntValue()
+		case pmetric.ExemplarValueTypeDouble:
+			value["value"] = double(ex.DoubleValue())
+		}
+		values = append(values, value)
+	}
+	return values
+}
+
+func tracesElements(td ptrace.Traces) []*element {
+	resources := make([]*element, 0, td.ResourceSpans().Len())
+	for i := range td.ResourceSpans().Len() {
+		rs := td.ResourceSpans().At(i)
+		resource := resourceElement(rs.Resource(), rs.SchemaUrl())
+		for j := range rs.ScopeSpans().Len() {
+			ss := rs.ScopeSpans().At(j)
+			scope := scopeElement(ss.Scope(), ss.SchemaUrl())
+			for k := range ss.Spans().Len() {
+				scope.children = append(scope.children, spanElement(ss.Spans().At(k)))
+			}
+			resource.children = append(resource.children, scope)
+		}
+		resources = append(resources, resource)
+	}
+	return resources
+}
+
+func spanElement(span ptrace.Span) *element {
+	e := newElement("span", "name")
+	e.fields["name"] = span.Name()
+	e.fields["kind"] = span.Kind().String()
+	e.fields["trace_id"] = span.TraceID().String()
+	e.fields["span_id"] = span.SpanID().String()
+	e.fields["parent_span_id"] = span.ParentSpanID().String()
+	e.fields["trace_state"] = span.TraceState().AsRaw()
+	e.fields["flags"] = span.Flags()
+	e.fields["start_timestamp"] = span.StartTimestamp().String()
+	e.fields["end_timestamp"] = span.EndTimestamp().String()
+	e.fields["status.code"] = span.Status().Code().String()
+	e.fields["status.message"] = span.Status().Message()
+	e.putAttributes(span.Attributes().AsRaw(), false)
+	e.fields["dropped_attributes_count"] = span.DroppedAttributesCount()
+
+	events := make([]any, 0, span.Events().Len())
+	for i := range span.Events().Len() {
+		event := span.Events().At(i)
+		events = append(events, map[string]any{
+			"name":                     event.Name(),
+			"timestamp":                event.Timestamp().String(),
+			"attributes":               event.Attributes().AsRaw(),
+			"dropped_attributes_count": event.DroppedAttributesCount(),
+		})
+	}
+	e.fields["events"] = events
+	e.fields["dropped_events_count"] = span.DroppedEventsCount()
+
+	links := make([]any, 0, span.Links().Len())
+	for i := range span.Links().Len() {
+		link := span.Links().At(i)
+		links = append(links, map[string]any{
+			"trace_id":                 link.TraceID().String(),
+			"span_id":                  link.SpanID().String(),
+			"trace_state":              link.TraceState().AsRaw(),
+			"flags":                    link.Flags(),
+			"attributes":               link.Attributes().AsRaw(),
+			"dropped_attributes_count": link.DroppedAttributesCount(),
+		})
+	}
+	e.fields["links"] = links
+	e.fields["dropped_links_count"] = span.DroppedLinksCount()
+	return e
+}
+
+func logsElements(ld plog.Logs) []*element {
+	resources := make([]*element, 0, ld.ResourceLogs().Len())
+	for i := range ld.ResourceLogs().Len() {
+		rl := ld.ResourceLogs().At(i)
+		resource := resourceElement(rl.Resource(), rl.SchemaUrl())
+		for j := range rl.ScopeLogs().Len() {
+			sl := rl.ScopeLogs().At(j)
+			scope := scopeElement(sl.Scope(), sl.SchemaUrl())
+			for k := range sl.LogRecords().Len() {
+				scope.children = append(scope.children, logRecordElement(sl.LogRecords().At(k)))
+			}
+			resource.children = append(resource.children, scope)
+		}
+		resources = append(resources, resource)
+	}
+	return resources
+}
+
+func logRecordElement(lr plog.LogRecord) *element {
+	e := newElement("log record", "body")
+	e.fields["body"] = lr.Body().AsRaw()
+	e.fields["event_name"] = lr.EventName()
+	e.fields["timestamp"] = lr.Timestamp().String()
+	e.fields["observed_timestamp"] = lr.ObservedTimestamp().String()
+	e.fields["severity_number"] = lr.SeverityNumber().String()
+	e.fields["severity_text"] = lr.SeverityText()
+	e.fields["trace_id"] = lr.TraceID().String()
+	e.fields["span_id"] = lr.SpanID().String()
+	e.fields["flags"] = uint32(lr.Flags())
+	e.putAttributes(lr.Attributes().AsRaw(), false)
+	e.fields["dropped_attributes_count"] = lr.DroppedAttributesCount()
+	return e
+}
+
+// double keeps the NaN and infinite values comparable and encodable in JSON.
+func double(v float64) any {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+	return v
+}
+
+func doubles(values []float64) []any {
+	converted := make([]any, 0, len(values))
+	for _, v := range values {
+		converted = append(converted, double(v))
+	}
+	return converted
+}
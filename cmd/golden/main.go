@@ -81,7 +81,15 @@ func run(args []string) error {
 		BuildInfo: component.BuildInfo{},
 	}
 
-	otlpReceiver, err := factory.CreateMetrics(context.Background(), set, receiverConfig, sink)
+	var otlpReceiver component.Component
+	switch cfg.Signal {
+	case internal.SignalTraces:
+		otlpReceiver, err = factory.CreateTraces(context.Background(), set, receiverConfig, sink)
+	case internal.SignalLogs:
+		otlpReceiver, err = factory.CreateLogs(context.Background(), set, receiverConfig, sink)
+	default:
+		otlpReceiver, err = factory.CreateMetrics(context.Background(), set, receiverConfig, sink)
+	}
 	if err != nil {
 		return err
 	}
@@ -103,6 +111,12 @@ func run(args []string) error {
 	case <-sink.DoneChan:
 	}
 
+	if cfg.DiffFile != "" && sink.Report != nil {
+		if err = sink.Report.WriteToFile(cfg.DiffFile); err != nil {
+			return err
+		}
+	}
+
 	if sink.Error != nil {
 		return sink.Error
 	}
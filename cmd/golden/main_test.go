@@ -23,3 +23,8 @@ func TestMissingArgumentLast(t *testing.T) {
 	err := run([]string{"--write-expected", "--expected", "foo.yaml", "--timeout"})
 	require.EqualError(t, err, "--timeout requires an argument")
 }
+
+func TestUnsupportedSignal(t *testing.T) {
+	err := run([]string{"--signal", "profiles", "--expected", "foo.yaml"})
+	require.EqualError(t, err, `unsupported signal "profiles", must be one of metrics, traces or logs`)
+}
@@ -2261,6 +2261,82 @@ func TestSupervisorRemoteConfigApplyStatus(t *testing.T) {
 	}
 }
 
+func TestSupervisorRollsBackDegradingRemoteConfig(t *testing.T) {
+	var agentConfig atomic.Value
+	var remoteConfigStatus atomic.Value
+	server := newOpAMPServer(
+		t,
+		defaultConnectingHandler,
+		types.ConnectionCallbacks{
+			OnMessage: func(_ context.Context, _ types.Connection, message *protobufs.AgentToServer) *protobufs.ServerToAgent {
+				if message.EffectiveConfig != nil {
+					config := message.EffectiveConfig.ConfigMap.ConfigMap[""]
+					if config != nil {
+						agentConfig.Store(string(config.Body))
+					}
+				}
+				if message.RemoteConfigStatus != nil {
+					remoteConfigStatus.Store(message.RemoteConfigStatus)
+				}
+
+				return &protobufs.ServerToAgent{}
+			},
+		})
+
+	s, supervisorCfg := newSupervisor(t, "config_rollback", map[string]string{"url": server.addr})
+	require.Nil(t, s.Start(t.Context()))
+	defer s.Shutdown()
+
+	waitForSupervisorConnection(server.supervisorConnected, true)
+
+	cfg, hash, _, _ := createSimplePipelineCollectorConf(t)
+	server.sendToSupervisor(&protobufs.ServerToAgent{
+		RemoteConfig: &protobufs.AgentRemoteConfig{
+			Config: &protobufs.AgentConfigMap{
+				ConfigMap: map[string]*protobufs.AgentConfigFile{
+					"": {Body: cfg.Bytes()},
+				},
+			},
+			ConfigHash: hash,
+		},
+	})
+
+	require.Eventually(t, func() bool {
+		status, ok := remoteConfigStatus.Load().(*protobufs.RemoteConfigStatus)
+		return ok && status.Status == protobufs.RemoteConfigStatuses_RemoteConfigStatuses_APPLIED && bytes.Equal(status.LastRemoteConfigHash, hash)
+	}, 10*time.Second, 100*time.Millisecond, "Remote config status was not set to APPLIED")
+
+	// Wait for the observation window to pass so the config is known-good.
+	require.Eventually(t, func() bool {
+		_, err := os.Stat(filepath.Join(supervisorCfg.Storage.Directory, "last_good_remote_config.dat"))
+		return err == nil
+	}, 15*time.Second, 100*time.Millisecond, "Remote config was not marked as known-good")
+
+	badCfg, badHash := createBadCollectorConf(t)
+	server.sendToSupervisor(&protobufs.ServerToAgent{
+		RemoteConfig: &protobufs.AgentRemoteConfig{
+			Config: &protobufs.AgentConfigMap{
+				ConfigMap: map[string]*protobufs.AgentConfigFile{
+					"": {Body: badCfg.Bytes()},
+				},
+			},
+			ConfigHash: badHash,
+		},
+	})
+
+	require.Eventually(t, func() bool {
+		status, ok := remoteConfigStatus.Load().(*protobufs.RemoteConfigStatus)
+		return ok && status.Status == protobufs.RemoteConfigStatuses_RemoteConfigStatuses_FAILED &&
+			bytes.Equal(status.LastRemoteConfigHash, badHash) &&
+			strings.HasPrefix(status.ErrorMessage, "Rolled back to last known-good config")
+	}, 30*time.Second, 100*time.Millisecond, "Bad remote config was not rolled back")
+
+	require.Eventually(t, func() bool {
+		cfg, ok := agentConfig.Load().(string)
+		return ok && strings.Contains(cfg, "filelog")
+	}, 15*time.Second, 100*time.Millisecond, "Collector was not restarted with the known-good config")
+}
+
 func TestSupervisorOpAmpServerPort(t *testing.T) {
 	var agentConfig atomic.Value
 	server := newOpAMPServer(
@@ -223,6 +223,7 @@ type Agent struct {
 	ConfigFiles             []string          `mapstructure:"config_files"`
 	Arguments               []string          `mapstructure:"args"`
 	Env                     map[string]string `mapstructure:"env"`
+	ConfigRollback          ConfigRollback    `mapstructure:"config_rollback"`
 }
 
 func (a Agent) Validate() error {
@@ -264,6 +265,46 @@ func (a Agent) Validate() error {
 		return errors.New("agent::use_hup_config_reload is not supported on Windows")
 	}
 
+	if err := a.ConfigRollback.Validate(a.ConfigApplyTimeout); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ConfigRollback configures the automatic rollback of remote configs that
+// degrade the agent after being applied.
+type ConfigRollback struct {
+	// Enabled turns on the observation of newly applied remote configs and
+	// the rollback to the last known-good remote config.
+	Enabled bool `mapstructure:"enabled"`
+	// ObservationWindow is how long a newly applied remote config is observed
+	// before it is considered known-good.
+	ObservationWindow time.Duration `mapstructure:"observation_window"`
+	// MaxCrashes is the number of unexpected agent exits within the observation
+	// window that triggers a rollback.
+	MaxCrashes int `mapstructure:"max_crashes"`
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+func (c ConfigRollback) Validate(configApplyTimeout time.Duration) error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.ObservationWindow <= 0 {
+		return errors.New("agent::config_rollback::observation_window must be positive")
+	}
+
+	if c.ObservationWindow < configApplyTimeout {
+		return errors.New("agent::config_rollback::observation_window must not be shorter than agent::config_apply_timeout")
+	}
+
+	if c.MaxCrashes <= 0 {
+		return errors.New("agent::config_rollback::max_crashes must be positive")
+	}
+
 	return nil
 }
 
@@ -379,6 +420,11 @@ func DefaultSupervisor() Supervisor {
 			ConfigApplyTimeout:      5 * time.Second,
 			BootstrapTimeout:        3 * time.Second,
 			PassthroughLogs:         false,
+			ConfigRollback: ConfigRollback{
+				Enabled:           false,
+				ObservationWindow: 5 * time.Minute,
+				MaxCrashes:        3,
+			},
 		},
 		Telemetry: Telemetry{
 			Logs: Logs{
@@ -442,6 +442,65 @@ func TestValidate(t *testing.T) {
 			},
 			expectedErrorFunc: simpleError("agent::config_files contains invalid special file: \"$DOESNTEXIST\". Must be one of [$OWN_TELEMETRY_CONFIG $OPAMP_EXTENSION_CONFIG $REMOTE_CONFIG]"),
 		},
+		{
+			name: "Invalid config rollback observation window",
+			config: Supervisor{
+				Server: OpAMPServer{
+					Endpoint: "wss://localhost:9090/opamp",
+					Headers: http.Header{
+						"Header1": []string{"HeaderValue"},
+					},
+					TLS: tlsConfig,
+				},
+				Agent: Agent{
+					Executable:              "${file_path}",
+					OrphanDetectionInterval: 5 * time.Second,
+					ConfigApplyTimeout:      2 * time.Second,
+					BootstrapTimeout:        5 * time.Second,
+					ConfigRollback: ConfigRollback{
+						Enabled:           true,
+						ObservationWindow: time.Second,
+						MaxCrashes:        3,
+					},
+				},
+				Capabilities: Capabilities{
+					AcceptsRemoteConfig: true,
+				},
+				Storage: Storage{
+					Directory: "/etc/opamp-supervisor/storage",
+				},
+			},
+			expectedErrorFunc: simpleError("agent::config_rollback::observation_window must not be shorter than agent::config_apply_timeout"),
+		},
+		{
+			name: "Invalid config rollback max crashes",
+			config: Supervisor{
+				Server: OpAMPServer{
+					Endpoint: "wss://localhost:9090/opamp",
+					Headers: http.Header{
+						"Header1": []string{"HeaderValue"},
+					},
+					TLS: tlsConfig,
+				},
+				Agent: Agent{
+					Executable:              "${file_path}",
+					OrphanDetectionInterval: 5 * time.Second,
+					ConfigApplyTimeout:      2 * time.Second,
+					BootstrapTimeout:        5 * time.Second,
+					ConfigRollback: ConfigRollback{
+						Enabled:           true,
+						ObservationWindow: time.Minute,
+					},
+				},
+				Capabilities: Capabilities{
+					AcceptsRemoteConfig: true,
+				},
+				Storage: Storage{
+					Directory: "/etc/opamp-supervisor/storage",
+				},
+			},
+			expectedErrorFunc: simpleError("agent::config_rollback::max_crashes must be positive"),
+		},
 		{
 			name: "Invalid HealthCheck port",
 			config: Supervisor{
@@ -649,6 +708,7 @@ agent:
 						OrphanDetectionInterval: DefaultSupervisor().Agent.OrphanDetectionInterval,
 						ConfigApplyTimeout:      DefaultSupervisor().Agent.ConfigApplyTimeout,
 						BootstrapTimeout:        DefaultSupervisor().Agent.BootstrapTimeout,
+						ConfigRollback:          DefaultSupervisor().Agent.ConfigRollback,
 					},
 					Telemetry: DefaultSupervisor().Telemetry,
 				}
@@ -691,6 +751,10 @@ agent:
   bootstrap_timeout: 8s
   opamp_server_port: 8090
   passthrough_logs: true
+  config_rollback:
+    enabled: true
+    observation_window: 2m
+    max_crashes: 2
 
 telemetry:
   logs:
@@ -737,6 +801,11 @@ telemetry:
 						BootstrapTimeout:        8 * time.Second,
 						OpAMPServerPort:         8090,
 						PassthroughLogs:         true,
+						ConfigRollback: ConfigRollback{
+							Enabled:           true,
+							ObservationWindow: 2 * time.Minute,
+							MaxCrashes:        2,
+						},
 					},
 					Telemetry: Telemetry{
 						Logs: Logs{
@@ -772,6 +841,7 @@ agent:
 						OrphanDetectionInterval: DefaultSupervisor().Agent.OrphanDetectionInterval,
 						ConfigApplyTimeout:      DefaultSupervisor().Agent.ConfigApplyTimeout,
 						BootstrapTimeout:        DefaultSupervisor().Agent.BootstrapTimeout,
+						ConfigRollback:          DefaultSupervisor().Agent.ConfigRollback,
 					},
 					Telemetry: DefaultSupervisor().Telemetry,
 				}
@@ -7,6 +7,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"os"
+	"sync"
 
 	"github.com/google/uuid"
 	"github.com/open-telemetry/opamp-go/protobufs"
@@ -18,11 +19,18 @@ import (
 type persistentState struct {
 	InstanceID             uuid.UUID           `yaml:"instance_id"`
 	LastRemoteConfigStatus *RemoteConfigStatus `yaml:"last_remote_config_status"`
+	// RolledBackConfigHash is a hex encoded string of the hash of the last remote config
+	// that was rolled back because it degraded the agent.
+	RolledBackConfigHash string `yaml:"rolled_back_config_hash,omitempty"`
 
 	// Path to the config file that the state should be saved to.
 	// This is not marshaled.
 	configPath string      `yaml:"-"`
 	logger     *zap.Logger `yaml:"-"`
+
+	// mu guards the state, which is updated from both the OpAMP client
+	// callbacks and the agent process loop.
+	mu sync.Mutex `yaml:"-"`
 }
 
 // RemoteConfigStatus is a custom struct that is used to marshal/unmarshal the remote config status.
@@ -37,11 +45,15 @@ type RemoteConfigStatus struct {
 }
 
 func (p *persistentState) SetInstanceID(id uuid.UUID) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
 	p.InstanceID = id
 	return p.writeState()
 }
 
 func (p *persistentState) SetLastRemoteConfigStatus(status *protobufs.RemoteConfigStatus) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
 	p.LastRemoteConfigStatus = &RemoteConfigStatus{
 		Status:               status.Status,
 		LastRemoteConfigHash: hex.EncodeToString(status.LastRemoteConfigHash),
@@ -51,6 +63,8 @@ func (p *persistentState) SetLastRemoteConfigStatus(status *protobufs.RemoteConf
 }
 
 func (p *persistentState) GetLastRemoteConfigStatus() *protobufs.RemoteConfigStatus {
+	p.mu.Lock()
+	defer p.mu.Unlock()
 	if p.LastRemoteConfigStatus == nil {
 		return nil
 	}
@@ -66,6 +80,27 @@ func (p *persistentState) GetLastRemoteConfigStatus() *protobufs.RemoteConfigSta
 	}
 }
 
+func (p *persistentState) SetRolledBackConfigHash(hash []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.RolledBackConfigHash = hex.EncodeToString(hash)
+	return p.writeState()
+}
+
+func (p *persistentState) GetRolledBackConfigHash() []byte {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.RolledBackConfigHash == "" {
+		return nil
+	}
+	hash, err := hex.DecodeString(p.RolledBackConfigHash)
+	if err != nil {
+		p.logger.Error("Failed to decode rolled back config hash", zap.Error(err))
+		return nil
+	}
+	return hash
+}
+
 func (p *persistentState) writeState() error {
 	by, err := yaml.Marshal(p)
 	if err != nil {
@@ -105,3 +105,27 @@ func TestPersistentState_SetLastRemoteConfigStatus(t *testing.T) {
 	}, loadedState.GetLastRemoteConfigStatus())
 	require.FileExists(t, f)
 }
+
+func TestPersistentState_SetRolledBackConfigHash(t *testing.T) {
+	f := filepath.Join(t.TempDir(), "state.yaml")
+	state, err := createNewPersistentState(f, zap.NewNop())
+	require.NoError(t, err)
+
+	require.Nil(t, state.GetRolledBackConfigHash())
+
+	rolledBackHash, err := hex.DecodeString("259ac3f596a87e6b8ca3b431908ac237ed8ae86128274da7911fcbedb93186a3")
+	require.NoError(t, err)
+
+	require.NoError(t, state.SetRolledBackConfigHash(rolledBackHash))
+
+	// Test that loading the state after setting the rolled back hash has the new hash
+	loadedState, err := loadPersistentState(f, zap.NewNop())
+	require.NoError(t, err)
+	require.Equal(t, rolledBackHash, loadedState.GetRolledBackConfigHash())
+
+	// Clearing the hash removes it from the state
+	require.NoError(t, loadedState.SetRolledBackConfigHash(nil))
+	loadedState, err = loadPersistentState(f, zap.NewNop())
+	require.NoError(t, err)
+	require.Nil(t, loadedState.GetRolledBackConfigHash())
+}
@@ -71,6 +71,7 @@ var (
 
 	lastRecvRemoteConfigFile       = "last_recv_remote_config.dat"
 	lastRecvOwnTelemetryConfigFile = "last_recv_own_telemetry_config.dat"
+	lastGoodRemoteConfigFile       = "last_good_remote_config.dat"
 
 	errNonMatchingInstanceUID = errors.New("received collector instance UID does not match expected UID set by the supervisor")
 )
@@ -147,6 +148,11 @@ type Supervisor struct {
 
 	// Last received remote config.
 	remoteConfig atomic.Pointer[protobufs.AgentRemoteConfig]
+	// Last remote config that was applied and survived the rollback observation window.
+	lastGoodRemoteConfig atomic.Pointer[protobufs.AgentRemoteConfig]
+	// observeNewConfig is true if the next config applied to the agent is a new
+	// remote config that must be observed before it is considered known-good.
+	observeNewConfig atomic.Bool
 
 	// A channel to indicate there is a new config to apply.
 	hasNewConfig chan struct{}
@@ -1282,6 +1288,9 @@ func (s *Supervisor) loadAndWriteInitialMergedConfig() error {
 	// load the last received remote config
 	s.loadRemoteConfig()
 
+	// load the last known-good remote config used for rollbacks
+	s.loadLastGoodRemoteConfig()
+
 	// load the last received own telemetry config
 	s.loadLastReceivedOwnTelemetryConfig()
 
@@ -1327,6 +1336,29 @@ func (s *Supervisor) loadRemoteConfig() {
 	}
 }
 
+// loadLastGoodRemoteConfig loads the last known-good remote config from file if config rollback is enabled.
+func (s *Supervisor) loadLastGoodRemoteConfig() {
+	if !s.config.Capabilities.AcceptsRemoteConfig || !s.config.Agent.ConfigRollback.Enabled {
+		return
+	}
+
+	lastGoodRemoteConfig, err := os.ReadFile(filepath.Join(s.config.Storage.Directory, lastGoodRemoteConfigFile))
+	switch {
+	case err == nil:
+		config := &protobufs.AgentRemoteConfig{}
+		err = proto.Unmarshal(lastGoodRemoteConfig, config)
+		if err != nil {
+			s.telemetrySettings.Logger.Error("Cannot parse last known-good remote config", zap.Error(err))
+		} else {
+			s.lastGoodRemoteConfig.Store(config)
+		}
+	case errors.Is(err, os.ErrNotExist):
+		s.telemetrySettings.Logger.Info("No last known-good remote config found")
+	default:
+		s.telemetrySettings.Logger.Error("error while reading last known-good config", zap.Error(err))
+	}
+}
+
 // loadLastReceivedOwnTelemetryConfig loads the last received own telemetry config from file if the capability is supported.
 func (s *Supervisor) loadLastReceivedOwnTelemetryConfig() {
 	// If none of the own telemetry capabilities are supported, do nothing.
@@ -1526,6 +1558,26 @@ func (s *Supervisor) runAgentProcess() {
 	configApplyTimeoutTimer := time.NewTimer(0)
 	configApplyTimeoutTimer.Stop()
 
+	// observationTimer runs while a newly applied remote config is observed
+	// for a rollback. See [config.ConfigRollback] for more details.
+	observationTimer := time.NewTimer(0)
+	observationTimer.Stop()
+	observing := false
+	crashes := 0
+	stopObserving := func() {
+		observing = false
+		if !observationTimer.Stop() {
+			select {
+			case <-observationTimer.C: // Try to drain the channel
+			default:
+			}
+		}
+	}
+	// applyingRollback is true while the last known-good config is being
+	// re-applied. The outcome is not reported as the remote config status,
+	// as the status of the rolled back config has already been reported.
+	applyingRollback := false
+
 	for {
 		select {
 		case <-s.hasNewConfig:
@@ -1540,6 +1592,15 @@ func (s *Supervisor) runAgentProcess() {
 			configApplyTimeoutTimer.Reset(s.config.Agent.ConfigApplyTimeout)
 			restartTimer.Stop()
 
+			if s.observeNewConfig.Swap(false) && s.config.Agent.ConfigRollback.Enabled {
+				s.telemetrySettings.Logger.Debug("Observing new remote config", zap.Duration("observation_window", s.config.Agent.ConfigRollback.ObservationWindow))
+				stopObserving()
+				observing = true
+				crashes = 0
+				applyingRollback = false
+				observationTimer.Reset(s.config.Agent.ConfigRollback.ObservationWindow)
+			}
+
 			if s.config.Agent.UseHUPConfigReload {
 				if err := s.hupReloadAgent(); err != nil {
 					s.telemetrySettings.Logger.Error("Failed to HUP restart agent", zap.Error(err))
@@ -1564,6 +1625,11 @@ func (s *Supervisor) runAgentProcess() {
 				// not starting agent because of nop config: clear timer, report applied status, report healthy status
 				s.telemetrySettings.Logger.Debug("No config present, nothing to apply")
 				configApplyTimeoutTimer.Stop()
+				if observing {
+					// There is no agent to observe, the empty config is applied as is.
+					stopObserving()
+					s.saveLastGoodRemoteConfig()
+				}
 				s.saveAndReportConfigStatus(protobufs.RemoteConfigStatuses_RemoteConfigStatuses_APPLIED, "")
 				if err := s.opampClient.SetHealth(&protobufs.ComponentHealth{Healthy: true, LastError: ""}); err != nil {
 					s.telemetrySettings.Logger.Error("Could not report healthy status to OpAMP server", zap.Error(err))
@@ -1605,7 +1671,7 @@ func (s *Supervisor) runAgentProcess() {
 				default:
 					// Timer was already stopped
 				}
-			} else {
+			} else if !applyingRollback {
 				// Timer was running, which means we were waiting for config to be applied.
 				// Report FAILED status immediately.
 				s.telemetrySettings.Logger.Info("Agent crashed during config application, reporting FAILED status")
@@ -1622,6 +1688,17 @@ func (s *Supervisor) runAgentProcess() {
 			}
 			restartTimer.Reset(5 * time.Second)
 
+			if observing {
+				crashes++
+				if crashes >= s.config.Agent.ConfigRollback.MaxCrashes {
+					stopObserving()
+					applyingRollback = s.rollbackRemoteConfig(fmt.Sprintf(
+						"agent exited unexpectedly %d times within the observation window of %s, last exit code=%d",
+						crashes, s.config.Agent.ConfigRollback.ObservationWindow, s.commander.ExitCode(),
+					))
+				}
+			}
+
 		case <-restartTimer.C:
 			s.telemetrySettings.Logger.Debug("Agent starting after start backoff")
 			_, err := s.startAgent()
@@ -1632,12 +1709,39 @@ func (s *Supervisor) runAgentProcess() {
 
 		case <-configApplyTimeoutTimer.C:
 			lastHealth := s.lastHealthFromClient.Load()
-			if lastHealth == nil || !lastHealth.Healthy {
+			healthy := lastHealth != nil && lastHealth.Healthy
+			switch {
+			case applyingRollback:
+				applyingRollback = false
+				if healthy {
+					s.telemetrySettings.Logger.Info("Agent is healthy after rolling back to the last known-good remote config")
+				} else {
+					s.telemetrySettings.Logger.Error("Agent is not healthy after rolling back to the last known-good remote config")
+				}
+			case !healthy:
 				s.saveAndReportConfigStatus(protobufs.RemoteConfigStatuses_RemoteConfigStatuses_FAILED, "Config apply timeout exceeded")
-			} else {
+				if observing {
+					stopObserving()
+					applyingRollback = s.rollbackRemoteConfig(fmt.Sprintf("agent did not become healthy within the config apply timeout of %s", s.config.Agent.ConfigApplyTimeout))
+				}
+			default:
 				s.saveAndReportConfigStatus(protobufs.RemoteConfigStatuses_RemoteConfigStatuses_APPLIED, "")
 			}
 
+		case <-observationTimer.C:
+			observing = false
+			lastHealth := s.lastHealthFromClient.Load()
+			if lastHealth == nil || !lastHealth.Healthy {
+				reason := fmt.Sprintf("agent was not healthy at the end of the observation window of %s", s.config.Agent.ConfigRollback.ObservationWindow)
+				if lastError := lastHealth.GetLastError(); lastError != "" {
+					reason = fmt.Sprintf("%s: %s", reason, lastError)
+				}
+				applyingRollback = s.rollbackRemoteConfig(reason)
+			} else {
+				s.telemetrySettings.Logger.Debug("Remote config survived the observation window, marking it as known-good")
+				s.saveLastGoodRemoteConfig()
+			}
+
 		case <-s.doneChan:
 			err := s.commander.Stop(s.runCtx)
 			if err != nil {
@@ -1870,16 +1974,90 @@ func (s *Supervisor) saveLastReceivedOwnTelemetrySettings(set *protobufs.Connect
 	return os.WriteFile(filepath.Join(s.config.Storage.Directory, filePath), cfg, 0o600)
 }
 
+// saveLastGoodRemoteConfig marks the current remote config as known-good and persists it
+// so that it can be rolled back to.
+func (s *Supervisor) saveLastGoodRemoteConfig() {
+	remoteConfig := s.remoteConfig.Load()
+	if remoteConfig == nil {
+		return
+	}
+
+	// A config that was rolled back may be applied again once another config has been applied successfully.
+	if s.persistentState.GetRolledBackConfigHash() != nil {
+		if err := s.persistentState.SetRolledBackConfigHash(nil); err != nil {
+			s.telemetrySettings.Logger.Error("Could not clear rolled back config hash", zap.Error(err))
+		}
+	}
+
+	cfg, err := proto.Marshal(remoteConfig)
+	if err != nil {
+		s.telemetrySettings.Logger.Error("Could not marshal last known-good remote config", zap.Error(err))
+		return
+	}
+	if err := os.WriteFile(filepath.Join(s.config.Storage.Directory, lastGoodRemoteConfigFile), cfg, 0o600); err != nil {
+		s.telemetrySettings.Logger.Error("Could not save last known-good remote config", zap.Error(err))
+	}
+	s.lastGoodRemoteConfig.Store(remoteConfig)
+}
+
+// rollbackRemoteConfig reports the current remote config as FAILED with the given reason
+// and reverts the agent to the last known-good remote config. It returns true if the
+// last known-good remote config is being applied.
+func (s *Supervisor) rollbackRemoteConfig(reason string) bool {
+	failedConfig := s.remoteConfig.Load()
+	lastGoodConfig := s.lastGoodRemoteConfig.Load()
+	if lastGoodConfig == nil || bytes.Equal(lastGoodConfig.GetConfigHash(), failedConfig.GetConfigHash()) {
+		s.telemetrySettings.Logger.Warn("Remote config degraded the agent, but there is no known-good config to roll back to", zap.String("reason", reason))
+		s.saveAndReportConfigStatus(protobufs.RemoteConfigStatuses_RemoteConfigStatuses_FAILED,
+			fmt.Sprintf("Remote config degraded the agent and no known-good config is available to roll back to: %s", reason))
+		return false
+	}
+
+	s.telemetrySettings.Logger.Warn("Remote config degraded the agent, rolling back to the last known-good config",
+		zap.String("reason", reason),
+		zap.String("failed_hash", fmt.Sprintf("%x", failedConfig.GetConfigHash())),
+		zap.String("known_good_hash", fmt.Sprintf("%x", lastGoodConfig.GetConfigHash())))
+	s.saveAndReportConfigStatus(protobufs.RemoteConfigStatuses_RemoteConfigStatuses_FAILED,
+		fmt.Sprintf("Rolled back to last known-good config %x: %s", lastGoodConfig.GetConfigHash(), reason))
+
+	if err := s.persistentState.SetRolledBackConfigHash(failedConfig.GetConfigHash()); err != nil {
+		s.telemetrySettings.Logger.Error("Could not save rolled back config hash", zap.Error(err))
+	}
+	if err := s.saveLastReceivedConfig(lastGoodConfig); err != nil {
+		s.telemetrySettings.Logger.Error("Could not save last known-good config as last received remote config", zap.Error(err))
+	}
+	s.remoteConfig.Store(lastGoodConfig)
+
+	if _, err := s.composeMergedConfig(lastGoodConfig); err != nil {
+		s.telemetrySettings.Logger.Error("Could not compose merged config from last known-good remote config", zap.Error(err))
+		return false
+	}
+	if err := s.opampClient.UpdateEffectiveConfig(s.runCtx); err != nil {
+		s.telemetrySettings.Logger.Error("The OpAMP client failed to update the effective config", zap.Error(err))
+	}
+
+	select {
+	case s.hasNewConfig <- struct{}{}:
+	default:
+	}
+	return true
+}
+
 // saveAndReportConfigStatus saves the config status to the persistent state and reports it to the server.
 func (s *Supervisor) saveAndReportConfigStatus(status protobufs.RemoteConfigStatuses, errorMessage string) {
-	if !s.config.Capabilities.ReportsRemoteConfig {
-		s.telemetrySettings.Logger.Debug("supervisor is not configured to report remote config status")
-	}
-	remoteConfig := s.remoteConfig.Load()
 	var configHash []byte
-	if remoteConfig != nil {
+	if remoteConfig := s.remoteConfig.Load(); remoteConfig != nil {
 		configHash = remoteConfig.GetConfigHash()
 	}
+	s.saveAndReportConfigStatusForHash(configHash, status, errorMessage)
+}
+
+// saveAndReportConfigStatusForHash saves the status of the remote config with the given hash
+// to the persistent state and reports it to the server.
+func (s *Supervisor) saveAndReportConfigStatusForHash(configHash []byte, status protobufs.RemoteConfigStatuses, errorMessage string) {
+	if !s.config.Capabilities.ReportsRemoteConfig {
+		s.telemetrySettings.Logger.Debug("supervisor is not configured to report remote config status")
+	}
 	rcs := &protobufs.RemoteConfigStatus{
 		LastRemoteConfigHash: configHash,
 		Status:               status,
@@ -1987,6 +2165,14 @@ func (s *Supervisor) processRemoteConfigMessage(ctx context.Context, msg *protob
 		return false
 	}
 
+	if s.config.Agent.ConfigRollback.Enabled && len(msg.ConfigHash) != 0 && bytes.Equal(msg.ConfigHash, s.persistentState.GetRolledBackConfigHash()) {
+		span.SetStatus(codes.Error, "Remote config was rolled back previously")
+		s.telemetrySettings.Logger.Warn("Got remote config that was rolled back previously. Ignoring remote config.", zap.String("hash", fmt.Sprintf("%x", msg.ConfigHash)))
+		s.saveAndReportConfigStatusForHash(msg.ConfigHash, protobufs.RemoteConfigStatuses_RemoteConfigStatuses_FAILED,
+			"Remote config was rolled back because it degraded the agent and is not applied again until another config is applied successfully")
+		return false
+	}
+
 	if err := s.saveLastReceivedConfig(msg); err != nil {
 		span.SetStatus(codes.Error, fmt.Sprintf("Could not save last received remote config: %s", err.Error()))
 		s.telemetrySettings.Logger.Error("Could not save last received remote config", zap.Error(err))
@@ -2009,6 +2195,7 @@ func (s *Supervisor) processRemoteConfigMessage(ctx context.Context, msg *protob
 	if configChanged {
 		// only report applying if the config has changed and will run agent with new config
 		s.saveAndReportConfigStatus(protobufs.RemoteConfigStatuses_RemoteConfigStatuses_APPLYING, "")
+		s.observeNewConfig.Store(true)
 	} else {
 		// if the config has not changed report applied status, we should still report a status to the server in this case
 		s.saveAndReportConfigStatus(protobufs.RemoteConfigStatuses_RemoteConfigStatuses_APPLIED, "")
@@ -4,6 +4,7 @@
 package supervisor
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"errors"
@@ -39,6 +40,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/opampsupervisor/supervisor/commander"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/opampsupervisor/supervisor/config"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/opampsupervisor/supervisor/telemetry"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/testbed/testbed"
@@ -2281,3 +2283,208 @@ func TestRemoteConfigConcurrentAccess(t *testing.T) {
 	close(startSignal)
 	wg.Wait()
 }
+
+func TestSupervisor_configRollback(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the stub agent is a shell script")
+	}
+
+	// stub agent that crashes when its config contains "crash" and keeps running otherwise
+	const stubAgent = `#!/bin/sh
+if grep -q crash "$2"; then
+  exit 1
+fi
+exec sleep 600
+`
+
+	newRemoteConfig := func(body string) *protobufs.AgentRemoteConfig {
+		hash := sha256.Sum256([]byte(body))
+		return &protobufs.AgentRemoteConfig{
+			Config: &protobufs.AgentConfigMap{
+				ConfigMap: map[string]*protobufs.AgentConfigFile{
+					"": {Body: []byte(body)},
+				},
+			},
+			ConfigHash: hash[:],
+		}
+	}
+	goodConfig := newRemoteConfig("receivers:\n  nop/good:\n")
+
+	newSupervisor := func(t *testing.T, statuses chan<- *protobufs.RemoteConfigStatus) *Supervisor {
+		storageDir := t.TempDir()
+		executablePath := filepath.Join(t.TempDir(), "otelcol")
+		require.NoError(t, os.WriteFile(executablePath, []byte(stubAgent), 0o700))
+
+		mp := metric.NewMeterProvider()
+		t.Cleanup(func() {
+			_ = mp.Shutdown(context.Background())
+		})
+		metrics, err := telemetry.NewMetrics(mp)
+		require.NoError(t, err)
+
+		state, err := createNewPersistentState(filepath.Join(storageDir, persistentStateFileName), zap.NewNop())
+		require.NoError(t, err)
+
+		cfg := config.Supervisor{
+			Capabilities: config.Capabilities{
+				AcceptsRemoteConfig: true,
+				ReportsRemoteConfig: true,
+			},
+			Storage: config.Storage{
+				Directory: storageDir,
+			},
+			Agent: config.Agent{
+				Executable:         executablePath,
+				ConfigApplyTimeout: 200 * time.Millisecond,
+				ConfigRollback: config.ConfigRollback{
+					Enabled:           true,
+					ObservationWindow: 500 * time.Millisecond,
+					MaxCrashes:        1,
+				},
+			},
+		}
+
+		s := &Supervisor{
+			runCtx:                         t.Context(),
+			telemetrySettings:              newNopTelemetrySettings(),
+			pidProvider:                    defaultPIDProvider{},
+			config:                         cfg,
+			hasNewConfig:                   make(chan struct{}, 1),
+			persistentState:                state,
+			agentConfigOwnTelemetrySection: &atomic.Value{},
+			effectiveConfig:                &atomic.Value{},
+			agentDescription:               &atomic.Value{},
+			cfgState:                       &atomic.Value{},
+			doneChan:                       make(chan struct{}),
+			metrics:                        metrics,
+			opampClient: &mockOpAMPClient{
+				setRemoteConfigStatusFunc: func(rcs *protobufs.RemoteConfigStatus) error {
+					statuses <- rcs
+					return nil
+				},
+				updateEffectiveConfigFunc: func(context.Context) error {
+					return nil
+				},
+				setHealthFunc: func(*protobufs.ComponentHealth) {},
+			},
+		}
+		require.NoError(t, s.createTemplates())
+		s.agentDescription.Store(&protobufs.AgentDescription{
+			IdentifyingAttributes:    []*protobufs.KeyValue{},
+			NonIdentifyingAttributes: []*protobufs.KeyValue{},
+		})
+
+		s.commander, err = commander.NewCommander(zap.NewNop(), storageDir, cfg.Agent, "--config", s.agentConfigFilePath())
+		require.NoError(t, err)
+
+		s.agentWG.Add(1)
+		go func() {
+			defer s.agentWG.Done()
+			s.runAgentProcess()
+		}()
+		t.Cleanup(func() {
+			close(s.doneChan)
+			s.agentWG.Wait()
+		})
+
+		return s
+	}
+
+	// waitForStatus waits for a remote config status that matches the given status and hash.
+	waitForStatus := func(t *testing.T, statuses <-chan *protobufs.RemoteConfigStatus, status protobufs.RemoteConfigStatuses, hash []byte) *protobufs.RemoteConfigStatus {
+		t.Helper()
+		timeout := time.After(10 * time.Second)
+		for {
+			select {
+			case rcs := <-statuses:
+				if rcs.Status == status && bytes.Equal(rcs.LastRemoteConfigHash, hash) {
+					return rcs
+				}
+			case <-timeout:
+				require.FailNow(t, "timed out waiting for remote config status", "status %s", status)
+			}
+		}
+	}
+
+	t.Run("Crashing remote config is rolled back to the last known-good config", func(t *testing.T) {
+		statuses := make(chan *protobufs.RemoteConfigStatus, 100)
+		s := newSupervisor(t, statuses)
+		s.remoteConfig.Store(goodConfig)
+		s.lastGoodRemoteConfig.Store(goodConfig)
+
+		crashingConfig := newRemoteConfig("receivers:\n  nop/crash:\n")
+		s.onMessage(t.Context(), &types.MessageData{RemoteConfig: crashingConfig})
+
+		rcs := waitForStatus(t, statuses, protobufs.RemoteConfigStatuses_RemoteConfigStatuses_FAILED, crashingConfig.ConfigHash)
+		for !strings.HasPrefix(rcs.ErrorMessage, "Rolled back") {
+			rcs = waitForStatus(t, statuses, protobufs.RemoteConfigStatuses_RemoteConfigStatuses_FAILED, crashingConfig.ConfigHash)
+		}
+		assert.Contains(t, rcs.ErrorMessage, fmt.Sprintf("Rolled back to last known-good config %x", goodConfig.ConfigHash))
+		assert.Contains(t, rcs.ErrorMessage, "agent exited unexpectedly 1 times within the observation window")
+
+		assert.Equal(t, goodConfig.ConfigHash, s.remoteConfig.Load().ConfigHash)
+		assert.Equal(t, crashingConfig.ConfigHash, s.persistentState.GetRolledBackConfigHash())
+		assert.Contains(t, s.cfgState.Load().(*configState).mergedConfig, "nop/good")
+
+		lastReceived, err := os.ReadFile(filepath.Join(s.config.Storage.Directory, lastRecvRemoteConfigFile))
+		require.NoError(t, err)
+		assert.Contains(t, string(lastReceived), "nop/good")
+
+		// The rolled back config is rejected if the server sends it again.
+		s.onMessage(t.Context(), &types.MessageData{RemoteConfig: crashingConfig})
+		rcs = waitForStatus(t, statuses, protobufs.RemoteConfigStatuses_RemoteConfigStatuses_FAILED, crashingConfig.ConfigHash)
+		assert.Contains(t, rcs.ErrorMessage, "Remote config was rolled back")
+		assert.Equal(t, goodConfig.ConfigHash, s.remoteConfig.Load().ConfigHash)
+	})
+
+	t.Run("Healthy remote config becomes the last known-good config", func(t *testing.T) {
+		statuses := make(chan *protobufs.RemoteConfigStatus, 100)
+		s := newSupervisor(t, statuses)
+		require.NoError(t, s.persistentState.SetRolledBackConfigHash([]byte("rolledback")))
+
+		// Simulate the agent reporting health regularly.
+		healthDone := make(chan struct{})
+		defer close(healthDone)
+		go func() {
+			ticker := time.NewTicker(10 * time.Millisecond)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ticker.C:
+					s.lastHealthFromClient.Store(&protobufs.ComponentHealth{Healthy: true})
+				case <-healthDone:
+					return
+				}
+			}
+		}()
+
+		s.onMessage(t.Context(), &types.MessageData{RemoteConfig: goodConfig})
+		waitForStatus(t, statuses, protobufs.RemoteConfigStatuses_RemoteConfigStatuses_APPLIED, goodConfig.ConfigHash)
+
+		require.Eventually(t, func() bool {
+			return s.lastGoodRemoteConfig.Load() != nil
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(t, goodConfig.ConfigHash, s.lastGoodRemoteConfig.Load().ConfigHash)
+		assert.Nil(t, s.persistentState.GetRolledBackConfigHash())
+
+		lastGood, err := os.ReadFile(filepath.Join(s.config.Storage.Directory, lastGoodRemoteConfigFile))
+		require.NoError(t, err)
+		assert.Contains(t, string(lastGood), "nop/good")
+	})
+
+	t.Run("Unhealthy remote config is reported when there is no known-good config", func(t *testing.T) {
+		statuses := make(chan *protobufs.RemoteConfigStatus, 100)
+		s := newSupervisor(t, statuses)
+
+		// The agent never reports health, so the config apply timeout is exceeded.
+		unhealthyConfig := newRemoteConfig("receivers:\n  nop/unhealthy:\n")
+		s.onMessage(t.Context(), &types.MessageData{RemoteConfig: unhealthyConfig})
+
+		rcs := waitForStatus(t, statuses, protobufs.RemoteConfigStatuses_RemoteConfigStatuses_FAILED, unhealthyConfig.ConfigHash)
+		assert.Equal(t, "Config apply timeout exceeded", rcs.ErrorMessage)
+		rcs = waitForStatus(t, statuses, protobufs.RemoteConfigStatuses_RemoteConfigStatuses_FAILED, unhealthyConfig.ConfigHash)
+		assert.Contains(t, rcs.ErrorMessage, "no known-good config is available to roll back to")
+		assert.Equal(t, unhealthyConfig.ConfigHash, s.remoteConfig.Load().ConfigHash)
+		assert.Nil(t, s.persistentState.GetRolledBackConfigHash())
+	})
+}
@@ -13,20 +13,22 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/pkg/logs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/pkg/metrics"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/pkg/profiles"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/pkg/traces"
 )
 
 var (
-	tracesCfg  *traces.Config
-	metricsCfg *metrics.Config
-	logsCfg    *logs.Config
+	tracesCfg   *traces.Config
+	metricsCfg  *metrics.Config
+	logsCfg     *logs.Config
+	profilesCfg *profiles.Config
 )
 
 // rootCmd is the root command on which will be run children commands
 var rootCmd = &cobra.Command{
 	Use:     "telemetrygen",
-	Short:   "Telemetrygen simulates a client generating traces, metrics, and logs",
-	Example: "telemetrygen traces\ntelemetrygen metrics\ntelemetrygen logs",
+	Short:   "Telemetrygen simulates a client generating traces, metrics, logs, and profiles",
+	Example: "telemetrygen traces\ntelemetrygen metrics\ntelemetrygen logs\ntelemetrygen profiles",
 }
 
 // tracesCmd is the command responsible for sending traces
@@ -59,8 +61,18 @@ var logsCmd = &cobra.Command{
 	},
 }
 
+// profilesCmd is the command responsible for sending profiles
+var profilesCmd = &cobra.Command{
+	Use:     "profiles",
+	Short:   "Simulates a client generating profiles. (Stability level: development)",
+	Example: "telemetrygen profiles",
+	RunE: func(*cobra.Command, []string) error {
+		return profiles.Start(profilesCfg)
+	},
+}
+
 func init() {
-	rootCmd.AddCommand(tracesCmd, metricsCmd, logsCmd)
+	rootCmd.AddCommand(tracesCmd, metricsCmd, logsCmd, profilesCmd)
 
 	tracesCfg = traces.NewConfig()
 	tracesCfg.Flags(tracesCmd.Flags())
@@ -71,6 +83,9 @@ func init() {
 	logsCfg = logs.NewConfig()
 	logsCfg.Flags(logsCmd.Flags())
 
+	profilesCfg = profiles.NewConfig()
+	profilesCfg.Flags(profilesCmd.Flags())
+
 	// Disabling completion command for end user
 	// https://github.com/spf13/cobra/blob/master/shell_completions.md
 	rootCmd.CompletionOptions.DisableDefaultCmd = true
@@ -0,0 +1,176 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpexporter
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/config"
+)
+
+// TraceGRPCOptions creates the configuration options for a gRPC-based OTLP trace exporter.
+// It configures the exporter with the provided endpoint, connection security settings, and headers.
+func TraceGRPCOptions(cfg *config.Config) ([]otlptracegrpc.Option, error) {
+	grpcExpOpt := []otlptracegrpc.Option{
+		otlptracegrpc.WithEndpoint(cfg.Endpoint()),
+	}
+
+	if cfg.Insecure {
+		grpcExpOpt = append(grpcExpOpt, otlptracegrpc.WithInsecure())
+	} else {
+		credentials, err := config.GetTLSCredentialsForGRPCExporter(
+			cfg.CaFile, cfg.ClientAuth, cfg.InsecureSkipVerify,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get TLS credentials: %w", err)
+		}
+		grpcExpOpt = append(grpcExpOpt, otlptracegrpc.WithTLSCredentials(credentials))
+	}
+
+	if len(cfg.Headers) > 0 {
+		grpcExpOpt = append(grpcExpOpt, otlptracegrpc.WithHeaders(cfg.GetHeaders()))
+	}
+
+	return grpcExpOpt, nil
+}
+
+// TraceHTTPOptions creates the configuration options for an HTTP-based OTLP trace exporter.
+// It configures the exporter with the provided endpoint, URL path, connection security settings, and headers.
+func TraceHTTPOptions(cfg *config.Config) ([]otlptracehttp.Option, error) {
+	httpExpOpt := []otlptracehttp.Option{
+		otlptracehttp.WithEndpoint(cfg.Endpoint()),
+		otlptracehttp.WithURLPath(cfg.HTTPPath),
+	}
+
+	if cfg.Insecure {
+		httpExpOpt = append(httpExpOpt, otlptracehttp.WithInsecure())
+	} else {
+		tlsCfg, err := config.GetTLSCredentialsForHTTPExporter(
+			cfg.CaFile, cfg.ClientAuth, cfg.InsecureSkipVerify,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get TLS credentials: %w", err)
+		}
+		httpExpOpt = append(httpExpOpt, otlptracehttp.WithTLSClientConfig(tlsCfg))
+	}
+
+	if len(cfg.Headers) > 0 {
+		httpExpOpt = append(httpExpOpt, otlptracehttp.WithHeaders(cfg.GetHeaders()))
+	}
+
+	return httpExpOpt, nil
+}
+
+// MetricGRPCOptions creates the configuration options for a gRPC-based OTLP metric exporter.
+// It configures the exporter with the provided endpoint, connection security settings, and headers.
+func MetricGRPCOptions(cfg *config.Config) ([]otlpmetricgrpc.Option, error) {
+	grpcExpOpt := []otlpmetricgrpc.Option{
+		otlpmetricgrpc.WithEndpoint(cfg.Endpoint()),
+	}
+
+	if cfg.Insecure {
+		grpcExpOpt = append(grpcExpOpt, otlpmetricgrpc.WithInsecure())
+	} else {
+		credentials, err := config.GetTLSCredentialsForGRPCExporter(
+			cfg.CaFile, cfg.ClientAuth, cfg.InsecureSkipVerify,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get TLS credentials: %w", err)
+		}
+		grpcExpOpt = append(grpcExpOpt, otlpmetricgrpc.WithTLSCredentials(credentials))
+	}
+
+	if len(cfg.Headers) > 0 {
+		grpcExpOpt = append(grpcExpOpt, otlpmetricgrpc.WithHeaders(cfg.GetHeaders()))
+	}
+
+	return grpcExpOpt, nil
+}
+
+// MetricHTTPOptions creates the configuration options for an HTTP-based OTLP metric exporter.
+// It configures the exporter with the provided endpoint, URL path, connection security settings, and headers.
+func MetricHTTPOptions(cfg *config.Config) ([]otlpmetrichttp.Option, error) {
+	httpExpOpt := []otlpmetrichttp.Option{
+		otlpmetrichttp.WithEndpoint(cfg.Endpoint()),
+		otlpmetrichttp.WithURLPath(cfg.HTTPPath),
+	}
+
+	if cfg.Insecure {
+		httpExpOpt = append(httpExpOpt, otlpmetrichttp.WithInsecure())
+	} else {
+		tlsCfg, err := config.GetTLSCredentialsForHTTPExporter(
+			cfg.CaFile, cfg.ClientAuth, cfg.InsecureSkipVerify,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get TLS credentials: %w", err)
+		}
+		httpExpOpt = append(httpExpOpt, otlpmetrichttp.WithTLSClientConfig(tlsCfg))
+	}
+
+	if len(cfg.Headers) > 0 {
+		httpExpOpt = append(httpExpOpt, otlpmetrichttp.WithHeaders(cfg.GetHeaders()))
+	}
+
+	return httpExpOpt, nil
+}
+
+// LogGRPCOptions creates the configuration options for a gRPC-based OTLP log exporter.
+// It configures the exporter with the provided endpoint, connection security settings, and headers.
+func LogGRPCOptions(cfg *config.Config) ([]otlploggrpc.Option, error) {
+	grpcExpOpt := []otlploggrpc.Option{
+		otlploggrpc.WithEndpoint(cfg.Endpoint()),
+	}
+
+	if cfg.Insecure {
+		grpcExpOpt = append(grpcExpOpt, otlploggrpc.WithInsecure())
+	} else {
+		credentials, err := config.GetTLSCredentialsForGRPCExporter(
+			cfg.CaFile, cfg.ClientAuth, cfg.InsecureSkipVerify,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get TLS credentials: %w", err)
+		}
+		grpcExpOpt = append(grpcExpOpt, otlploggrpc.WithTLSCredentials(credentials))
+	}
+
+	if len(cfg.Headers) > 0 {
+		grpcExpOpt = append(grpcExpOpt, otlploggrpc.WithHeaders(cfg.GetHeaders()))
+	}
+
+	return grpcExpOpt, nil
+}
+
+// LogHTTPOptions creates the configuration options for an HTTP-based OTLP log exporter.
+// It configures the exporter with the provided endpoint, URL path, connection security settings, and headers.
+func LogHTTPOptions(cfg *config.Config) ([]otlploghttp.Option, error) {
+	httpExpOpt := []otlploghttp.Option{
+		otlploghttp.WithEndpoint(cfg.Endpoint()),
+		otlploghttp.WithURLPath(cfg.HTTPPath),
+	}
+
+	if cfg.Insecure {
+		httpExpOpt = append(httpExpOpt, otlploghttp.WithInsecure())
+	} else {
+		tlsCfg, err := config.GetTLSCredentialsForHTTPExporter(
+			cfg.CaFile, cfg.ClientAuth, cfg.InsecureSkipVerify,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get TLS credentials: %w", err)
+		}
+		httpExpOpt = append(httpExpOpt, otlploghttp.WithTLSClientConfig(tlsCfg))
+	}
+
+	if len(cfg.Headers) > 0 {
+		httpExpOpt = append(httpExpOpt, otlploghttp.WithHeaders(cfg.GetHeaders()))
+	}
+
+	return httpExpOpt, nil
+}
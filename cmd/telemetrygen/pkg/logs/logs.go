@@ -22,6 +22,7 @@ import (
 	"golang.org/x/time/rate"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/log"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/otlpexporter"
 )
 
 // Start starts the log telemetry generator
@@ -123,7 +124,7 @@ func createExporter(cfg *Config, logger *zap.Logger) (sdklog.Exporter, error) {
 		var exporterOpts []otlploghttp.Option
 
 		logger.Info("starting HTTP exporter")
-		exporterOpts, err = httpExporterOptions(cfg)
+		exporterOpts, err = otlpexporter.LogHTTPOptions(&cfg.Config)
 		if err != nil {
 			return nil, err
 		}
@@ -135,7 +136,7 @@ func createExporter(cfg *Config, logger *zap.Logger) (sdklog.Exporter, error) {
 		var exporterOpts []otlploggrpc.Option
 
 		logger.Info("starting gRPC exporter")
-		exporterOpts, err = grpcExporterOptions(cfg)
+		exporterOpts, err = otlpexporter.LogGRPCOptions(&cfg.Config)
 		if err != nil {
 			return nil, err
 		}
@@ -22,6 +22,7 @@ import (
 	"golang.org/x/time/rate"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/log"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/otlpexporter"
 )
 
 // Start starts the metric telemetry generator
@@ -125,7 +126,7 @@ func createExporter(cfg *Config, logger *zap.Logger) (sdkmetric.Exporter, error)
 		var exporterOpts []otlpmetrichttp.Option
 
 		logger.Info("starting HTTP exporter")
-		exporterOpts, err = httpExporterOptions(cfg)
+		exporterOpts, err = otlpexporter.MetricHTTPOptions(&cfg.Config)
 		if err != nil {
 			return nil, err
 		}
@@ -137,7 +138,7 @@ func createExporter(cfg *Config, logger *zap.Logger) (sdkmetric.Exporter, error)
 		var exporterOpts []otlpmetricgrpc.Option
 
 		logger.Info("starting gRPC exporter")
-		exporterOpts, err = grpcExporterOptions(cfg)
+		exporterOpts, err = otlpexporter.MetricGRPCOptions(&cfg.Config)
 		if err != nil {
 			return nil, err
 		}
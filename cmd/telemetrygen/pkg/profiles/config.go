@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package profiles
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/config"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/validate"
+	types "github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/pkg"
+)
+
+// Config describes the test scenario.
+type Config struct {
+	config.Config
+	NumProfiles     int
+	NumSamples      int
+	StackDepth      int
+	NumFunctions    int
+	NumMappings     int
+	SampleTypes     []string
+	ProfileDuration time.Duration
+	TraceID         string
+	SpanID          string
+}
+
+func NewConfig() *Config {
+	cfg := &Config{}
+	cfg.SetDefaults()
+	return cfg
+}
+
+// Flags registers config flags.
+func (c *Config) Flags(fs *pflag.FlagSet) {
+	c.CommonFlags(fs)
+
+	fs.StringVar(&c.HTTPPath, "otlp-http-url-path", c.HTTPPath, "Which URL path to write to")
+
+	fs.IntVar(&c.NumProfiles, "profiles", c.NumProfiles, "Number of profiles to generate in each worker (ignored if duration is provided)")
+	fs.IntVar(&c.NumSamples, "samples", c.NumSamples, "Number of samples of each profile")
+	fs.IntVar(&c.StackDepth, "stack-depth", c.StackDepth, "Number of frames of the stack of each sample")
+	fs.IntVar(&c.NumFunctions, "functions", c.NumFunctions, "Number of distinct functions the stacks are made of")
+	fs.IntVar(&c.NumMappings, "mappings", c.NumMappings, "Number of distinct mappings (binaries and libraries) the functions belong to")
+	fs.StringSliceVar(&c.SampleTypes, "sample-type", c.SampleTypes, "Sample type of the profiles, in the format type:unit (e.g. cpu:nanoseconds). "+
+		"Flag may be repeated to generate one profile per sample type for the same samples")
+	fs.DurationVar(&c.ProfileDuration, "profile-duration", c.ProfileDuration, "Duration of the time window covered by each profile")
+	fs.StringVar(&c.TraceID, "trace-id", c.TraceID, "TraceID the samples are linked to")
+	fs.StringVar(&c.SpanID, "span-id", c.SpanID, "SpanID the samples are linked to")
+}
+
+// SetDefaults sets the default values for the configuration
+// This is called before parsing the command line flags and when
+// calling NewConfig()
+func (c *Config) SetDefaults() {
+	c.Config.SetDefaults()
+	c.HTTPPath = "/v1development/profiles"
+	c.Rate = 1
+	c.TotalDuration = types.DurationWithInf(0)
+	c.NumSamples = 10
+	c.StackDepth = 10
+	c.NumFunctions = 100
+	c.NumMappings = 5
+	c.SampleTypes = []string{"cpu:nanoseconds"}
+	c.ProfileDuration = 10 * time.Second
+	c.TraceID = ""
+	c.SpanID = ""
+}
+
+// Validate validates the test scenario parameters.
+func (c *Config) Validate() error {
+	if c.TotalDuration.Duration() <= 0 && c.NumProfiles <= 0 && !c.TotalDuration.IsInf() {
+		return errors.New("either `profiles` or `duration` must be greater than 0")
+	}
+
+	if c.NumSamples <= 0 {
+		return fmt.Errorf("`samples` must be greater than 0, found %d", c.NumSamples)
+	}
+
+	if c.StackDepth <= 0 {
+		return fmt.Errorf("`stack-depth` must be greater than 0, found %d", c.StackDepth)
+	}
+
+	if c.NumFunctions <= 0 {
+		return fmt.Errorf("`functions` must be greater than 0, found %d", c.NumFunctions)
+	}
+
+	if c.NumMappings <= 0 {
+		return fmt.Errorf("`mappings` must be greater than 0, found %d", c.NumMappings)
+	}
+
+	if c.ProfileDuration <= 0 {
+		return fmt.Errorf("`profile-duration` must be greater than 0, found %v", c.ProfileDuration)
+	}
+
+	if len(c.SampleTypes) == 0 {
+		return errors.New("at least one `sample-type` must be provided")
+	}
+	for _, sampleType := range c.SampleTypes {
+		if _, _, err := parseSampleType(sampleType); err != nil {
+			return err
+		}
+	}
+
+	if c.TraceID != "" {
+		if err := validate.TraceID(c.TraceID); err != nil {
+			return err
+		}
+	}
+
+	if c.SpanID != "" {
+		if err := validate.SpanID(c.SpanID); err != nil {
+			return err
+		}
+	}
+
+	if (c.TraceID == "") != (c.SpanID == "") {
+		return errors.New("`trace-id` and `span-id` must be provided together")
+	}
+
+	return nil
+}
+
+// parseSampleType splits a sample type in the format type:unit.
+func parseSampleType(sampleType string) (string, string, error) {
+	typ, unit, ok := strings.Cut(sampleType, ":")
+	if !ok || typ == "" || unit == "" {
+		return "", "", fmt.Errorf("sample type %q should be in the format type:unit", sampleType)
+	}
+	return typ, unit, nil
+}
@@ -0,0 +1,153 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package profiles
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/pprofile/pprofileotlp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/config"
+)
+
+// exporter sends profiles to an OTLP endpoint. The OpenTelemetry Go SDK has no profiles support,
+// so the profiles are built with pdata and sent with the OTLP profiles service.
+type exporter interface {
+	Export(ctx context.Context, profiles pprofile.Profiles) error
+	Shutdown(ctx context.Context) error
+}
+
+// grpcExporter sends profiles with the OTLP gRPC profiles service.
+type grpcExporter struct {
+	conn    *grpc.ClientConn
+	client  pprofileotlp.GRPCClient
+	headers map[string]string
+}
+
+func newGRPCExporter(cfg *Config) (*grpcExporter, error) {
+	opts, err := grpcDialOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.NewClient(cfg.Endpoint(), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the gRPC connection: %w", err)
+	}
+	return &grpcExporter{
+		conn:    conn,
+		client:  pprofileotlp.NewGRPCClient(conn),
+		headers: cfg.GetHeaders(),
+	}, nil
+}
+
+// grpcDialOptions creates the options of the gRPC connection of the profiles exporter.
+// It configures the connection security settings.
+func grpcDialOptions(cfg *Config) ([]grpc.DialOption, error) {
+	if cfg.Insecure {
+		return []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, nil
+	}
+	credentials, err := config.GetTLSCredentialsForGRPCExporter(
+		cfg.CaFile, cfg.ClientAuth, cfg.InsecureSkipVerify,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get TLS credentials: %w", err)
+	}
+	return []grpc.DialOption{grpc.WithTransportCredentials(credentials)}, nil
+}
+
+func (e *grpcExporter) Export(ctx context.Context, profiles pprofile.Profiles) error {
+	if len(e.headers) > 0 {
+		ctx = metadata.NewOutgoingContext(ctx, metadata.New(e.headers))
+	}
+	resp, err := e.client.Export(ctx, pprofileotlp.NewExportRequestFromProfiles(profiles))
+	if err != nil {
+		return err
+	}
+	return partialSuccessError(resp)
+}
+
+func (e *grpcExporter) Shutdown(context.Context) error {
+	return e.conn.Close()
+}
+
+// httpExporter sends profiles with the OTLP HTTP profiles service, encoded in protobuf.
+type httpExporter struct {
+	client  *http.Client
+	url     string
+	headers map[string]string
+}
+
+func newHTTPExporter(cfg *Config) (*httpExporter, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	scheme := "http"
+	if !cfg.Insecure {
+		tlsCfg, err := config.GetTLSCredentialsForHTTPExporter(
+			cfg.CaFile, cfg.ClientAuth, cfg.InsecureSkipVerify,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get TLS credentials: %w", err)
+		}
+		transport.TLSClientConfig = tlsCfg
+		scheme = "https"
+	}
+	u := url.URL{Scheme: scheme, Host: cfg.Endpoint(), Path: cfg.HTTPPath}
+	return &httpExporter{
+		client:  &http.Client{Transport: transport},
+		url:     u.String(),
+		headers: cfg.GetHeaders(),
+	}, nil
+}
+
+func (e *httpExporter) Export(ctx context.Context, profiles pprofile.Profiles) error {
+	body, err := pprofileotlp.NewExportRequestFromProfiles(profiles).MarshalProto()
+	if err != nil {
+		return fmt.Errorf("failed to marshal the profiles: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	for k, v := range e.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read the response: %w", err)
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("failed to export profiles to %s: %s", e.url, resp.Status)
+	}
+	exportResp := pprofileotlp.NewExportResponse()
+	if err := exportResp.UnmarshalProto(respBody); err != nil {
+		return fmt.Errorf("failed to unmarshal the response: %w", err)
+	}
+	return partialSuccessError(exportResp)
+}
+
+func (e *httpExporter) Shutdown(context.Context) error {
+	e.client.CloseIdleConnections()
+	return nil
+}
+
+func partialSuccessError(resp pprofileotlp.ExportResponse) error {
+	if rejected := resp.PartialSuccess().RejectedProfiles(); rejected > 0 {
+		return fmt.Errorf("%d profiles were rejected: %s", rejected, resp.PartialSuccess().ErrorMessage())
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package profiles
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/pprofile/pprofileotlp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/config"
+)
+
+func testProfiles() pprofile.Profiles {
+	profiles := pprofile.NewProfiles()
+	profiles.Dictionary().StringTable().Append("")
+	profiles.ResourceProfiles().AppendEmpty().ScopeProfiles().AppendEmpty().Profiles().AppendEmpty().Samples().AppendEmpty().Values().Append(1)
+	return profiles
+}
+
+type testProfilesServer struct {
+	pprofileotlp.UnimplementedGRPCServer
+	received chan pprofile.Profiles
+	headers  chan metadata.MD
+	rejected int64
+}
+
+func (s *testProfilesServer) Export(ctx context.Context, req pprofileotlp.ExportRequest) (pprofileotlp.ExportResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.headers <- md
+	s.received <- req.Profiles()
+	resp := pprofileotlp.NewExportResponse()
+	if s.rejected > 0 {
+		resp.PartialSuccess().SetRejectedProfiles(s.rejected)
+		resp.PartialSuccess().SetErrorMessage("invalid profiles")
+	}
+	return resp, nil
+}
+
+func TestGRPCExporter(t *testing.T) {
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer()
+	profilesServer := &testProfilesServer{received: make(chan pprofile.Profiles, 2), headers: make(chan metadata.MD, 2)}
+	pprofileotlp.RegisterGRPCServer(srv, profilesServer)
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	defer srv.Stop()
+
+	cfg := NewConfig()
+	cfg.CustomEndpoint = lis.Addr().String()
+	cfg.Insecure = true
+	cfg.Headers = config.KeyValue{"x-tenant": "acme"}
+	exp, err := newGRPCExporter(cfg)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, exp.Shutdown(t.Context()))
+	}()
+
+	require.NoError(t, exp.Export(t.Context(), testProfiles()))
+	assert.Equal(t, 1, (<-profilesServer.received).SampleCount())
+	assert.Equal(t, []string{"acme"}, (<-profilesServer.headers).Get("x-tenant"))
+
+	profilesServer.rejected = 1
+	assert.EqualError(t, exp.Export(t.Context(), testProfiles()), "1 profiles were rejected: invalid profiles")
+}
+
+func TestHTTPExporter(t *testing.T) {
+	received := make(chan pprofile.Profiles, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1development/profiles" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		assert.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+		assert.Equal(t, "acme", r.Header.Get("x-tenant"))
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		req := pprofileotlp.NewExportRequest()
+		assert.NoError(t, req.UnmarshalProto(body))
+		received <- req.Profiles()
+		resp, err := pprofileotlp.NewExportResponse().MarshalProto()
+		assert.NoError(t, err)
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		_, _ = w.Write(resp)
+	}))
+	defer srv.Close()
+
+	cfg := NewConfig()
+	cfg.CustomEndpoint = strings.TrimPrefix(srv.URL, "http://")
+	cfg.Insecure = true
+	cfg.UseHTTP = true
+	cfg.Headers = config.KeyValue{"x-tenant": "acme"}
+	exp, err := newHTTPExporter(cfg)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, exp.Shutdown(t.Context()))
+	}()
+
+	require.NoError(t, exp.Export(t.Context(), testProfiles()))
+	assert.Equal(t, 1, (<-received).SampleCount())
+
+	exp.url = srv.URL + "/unknown"
+	assert.ErrorContains(t, exp.Export(t.Context(), testProfiles()), "404 Not Found")
+}
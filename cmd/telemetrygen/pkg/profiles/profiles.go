@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package profiles
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/log"
+)
+
+// Start starts the profile telemetry generator
+func Start(cfg *Config) error {
+	logger, err := log.CreateLogger(cfg.SkipSettingGRPCLogger)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("starting the profiles generator with configuration", zap.Any("config", cfg))
+
+	if err := run(cfg, exporterFactory(cfg, logger), logger); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// run executes the test scenario.
+func run(c *Config, expF exporterFunc, logger *zap.Logger) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+
+	if c.TotalDuration.Duration() > 0 || c.TotalDuration.IsInf() {
+		c.NumProfiles = 0
+	}
+
+	limit := rate.Limit(c.Rate)
+	if c.Rate == 0 {
+		limit = rate.Inf
+		logger.Info("generation of profiles isn't being throttled")
+	} else {
+		logger.Info("generation of profiles is limited", zap.Float64("per-second", float64(limit)))
+	}
+
+	sampleTypes := make([]sampleType, 0, len(c.SampleTypes))
+	for _, st := range c.SampleTypes {
+		// we checked this for errors in the Validate function
+		typ, unit, _ := parseSampleType(st)
+		sampleTypes = append(sampleTypes, sampleType{typ: typ, unit: unit})
+	}
+
+	var link *traceLink
+	if c.TraceID != "" {
+		// we checked this for errors in the Validate function
+		tid, _ := hex.DecodeString(c.TraceID)
+		sid, _ := hex.DecodeString(c.SpanID)
+		link = &traceLink{}
+		copy(link.traceID[:], tid)
+		copy(link.spanID[:], sid)
+	}
+
+	wg := sync.WaitGroup{}
+
+	running := &atomic.Bool{}
+	running.Store(true)
+
+	for i := 0; i < c.WorkerCount; i++ {
+		wg.Add(1)
+		w := worker{
+			numProfiles:     c.NumProfiles,
+			numSamples:      c.NumSamples,
+			stackDepth:      c.StackDepth,
+			numFunctions:    c.NumFunctions,
+			numMappings:     c.NumMappings,
+			sampleTypes:     sampleTypes,
+			profileDuration: c.ProfileDuration,
+			link:            link,
+			limitPerSecond:  limit,
+			totalDuration:   c.TotalDuration,
+			running:         running,
+			wg:              &wg,
+			logger:          logger.With(zap.Int("worker", i)),
+			index:           i,
+			loadSize:        c.LoadSize,
+			allowFailures:   c.AllowExportFailures,
+		}
+		exp, err := expF()
+		if err != nil {
+			w.logger.Error("failed to create the exporter", zap.Error(err))
+			return err
+		}
+		defer func() {
+			w.logger.Info("stopping the exporter")
+			if tempError := exp.Shutdown(context.Background()); tempError != nil {
+				w.logger.Error("failed to stop the exporter", zap.Error(tempError))
+			}
+		}()
+		go w.simulateProfiles(c.GetAttributes(), exp, c.GetTelemetryAttributes())
+	}
+	if c.TotalDuration.Duration() > 0 && !c.TotalDuration.IsInf() {
+		time.Sleep(c.TotalDuration.Duration())
+		running.Store(false)
+	}
+	wg.Wait()
+	return nil
+}
+
+type exporterFunc func() (exporter, error)
+
+func exporterFactory(cfg *Config, logger *zap.Logger) exporterFunc {
+	return func() (exporter, error) {
+		return createExporter(cfg, logger)
+	}
+}
+
+func createExporter(cfg *Config, logger *zap.Logger) (exporter, error) {
+	if cfg.UseHTTP {
+		logger.Info("starting HTTP exporter")
+		return newHTTPExporter(cfg)
+	}
+	logger.Info("starting gRPC exporter")
+	return newGRPCExporter(cfg)
+}
@@ -0,0 +1,262 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package profiles
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/config"
+	types "github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/pkg"
+)
+
+const (
+	// mappingStart and mappingSize lay the mappings out contiguously in the address space
+	mappingStart uint64 = 0x400000
+	mappingSize  uint64 = 0x1000000
+	// functionSize is the address space taken by each function of a mapping
+	functionSize uint64 = 0x10
+)
+
+type sampleType struct {
+	typ  string
+	unit string
+}
+
+// traceLink is the span the samples are linked to.
+type traceLink struct {
+	traceID pcommon.TraceID
+	spanID  pcommon.SpanID
+}
+
+type worker struct {
+	running         *atomic.Bool          // pointer to shared flag that indicates it's time to stop the test
+	numProfiles     int                   // how many profiles the worker has to generate (only when duration==0)
+	numSamples      int                   // how many samples each profile has
+	stackDepth      int                   // how many frames the stack of each sample has
+	numFunctions    int                   // how many distinct functions the stacks are made of
+	numMappings     int                   // how many distinct mappings the functions belong to
+	sampleTypes     []sampleType          // the sample types, one profile is generated for each of them
+	profileDuration time.Duration         // the time window covered by each profile
+	link            *traceLink            // the span the samples are linked to, if any
+	totalDuration   types.DurationWithInf // how long to run the test for (overrides `numProfiles`)
+	limitPerSecond  rate.Limit            // how many profiles per second to generate
+	wg              *sync.WaitGroup       // notify when done
+	logger          *zap.Logger           // logger
+	index           int                   // worker index
+	loadSize        int                   // desired minimum size in MB of string data for each generated profile
+	allowFailures   bool                  // whether to continue on export failures
+}
+
+func (w worker) simulateProfiles(resourceAttributes []attribute.KeyValue, exp exporter, telemetryAttributes []attribute.KeyValue) {
+	limiter := rate.NewLimiter(w.limitPerSecond, 1)
+	rng := rand.New(rand.NewPCG(rand.Uint64(), uint64(w.index)))
+	var i int64
+
+	for j := 0; j < w.loadSize; j++ {
+		telemetryAttributes = append(telemetryAttributes, config.CreateLoadAttribute(fmt.Sprintf("load-%v", j), 1))
+	}
+
+	for w.running.Load() {
+		profiles := w.newProfiles(rng, time.Now(), resourceAttributes, telemetryAttributes)
+
+		if err := limiter.Wait(context.Background()); err != nil {
+			w.logger.Fatal("limiter wait failed, retry", zap.Error(err))
+		}
+
+		if err := exp.Export(context.Background(), profiles); err != nil {
+			if w.allowFailures {
+				w.logger.Error("exporter failed, continuing due to --allow-export-failures", zap.Error(err))
+			} else {
+				w.logger.Fatal("exporter failed", zap.Error(err))
+			}
+		}
+
+		i++
+		if w.numProfiles != 0 && i >= int64(w.numProfiles) {
+			break
+		}
+	}
+
+	w.logger.Info("profiles generated", zap.Int64("profiles", i))
+	w.wg.Done()
+}
+
+// newProfiles generates a profile for each sample type, covering the profile duration until the given time.
+// The profiles share the same samples, whose stacks are made of random functions of the dictionary.
+func (w worker) newProfiles(rng *rand.Rand, end time.Time, resourceAttributes, telemetryAttributes []attribute.KeyValue) pprofile.Profiles {
+	profiles := pprofile.NewProfiles()
+	dic := profiles.Dictionary()
+	strs := newStringTable(dic.StringTable())
+
+	// the first entry of each table of the dictionary is its zero value
+	dic.MappingTable().AppendEmpty()
+	dic.LocationTable().AppendEmpty()
+	dic.FunctionTable().AppendEmpty()
+	dic.StackTable().AppendEmpty()
+	dic.LinkTable().AppendEmpty()
+	dic.AttributeTable().AppendEmpty()
+
+	for m := range w.numMappings {
+		mapping := dic.MappingTable().AppendEmpty()
+		mapping.SetMemoryStart(mappingStart + uint64(m)*mappingSize)
+		mapping.SetMemoryLimit(mapping.MemoryStart() + mappingSize)
+		mapping.SetFilenameStrindex(strs.index("/usr/lib/libtelemetrygen" + strconv.Itoa(m) + ".so"))
+	}
+
+	for f := range w.numFunctions {
+		fn := dic.FunctionTable().AppendEmpty()
+		name := strs.index("telemetrygen.function" + strconv.Itoa(f))
+		fn.SetNameStrindex(name)
+		fn.SetSystemNameStrindex(name)
+		fn.SetFilenameStrindex(strs.index("telemetrygen/file" + strconv.Itoa(f%10) + ".go"))
+		fn.SetStartLine(int64(f*10 + 1))
+
+		// each function has one location, in the mapping it belongs to
+		m := f % w.numMappings
+		loc := dic.LocationTable().AppendEmpty()
+		loc.SetMappingIndex(int32(m + 1))
+		loc.SetAddress(mappingStart + uint64(m)*mappingSize + uint64(f)*functionSize)
+		line := loc.Lines().AppendEmpty()
+		line.SetFunctionIndex(int32(f + 1))
+		line.SetLine(fn.StartLine() + 1)
+	}
+
+	var linkIndex int32
+	if w.link != nil {
+		link := dic.LinkTable().AppendEmpty()
+		link.SetTraceID(w.link.traceID)
+		link.SetSpanID(w.link.spanID)
+		linkIndex = 1
+	}
+
+	attributeIndices := make([]int32, 0, len(telemetryAttributes))
+	for _, attr := range telemetryAttributes {
+		kv := dic.AttributeTable().AppendEmpty()
+		kv.SetKeyStrindex(strs.index(string(attr.Key)))
+		setValue(kv.Value(), attr.Value)
+		attributeIndices = append(attributeIndices, int32(dic.AttributeTable().Len()-1))
+	}
+
+	start := end.Add(-w.profileDuration)
+	stackIndices := make([]int32, w.numSamples)
+	timestamps := make([]uint64, w.numSamples)
+	for s := range w.numSamples {
+		stack := dic.StackTable().AppendEmpty()
+		for range w.stackDepth {
+			stack.LocationIndices().Append(int32(rng.IntN(w.numFunctions) + 1))
+		}
+		stackIndices[s] = int32(dic.StackTable().Len() - 1)
+		timestamps[s] = uint64(start.Add(time.Duration(rng.Int64N(int64(w.profileDuration)))).UnixNano())
+	}
+
+	rp := profiles.ResourceProfiles().AppendEmpty()
+	for _, attr := range resourceAttributes {
+		setValue(rp.Resource().Attributes().PutEmpty(string(attr.Key)), attr.Value)
+	}
+	sp := rp.ScopeProfiles().AppendEmpty()
+	sp.Scope().SetName("telemetrygen")
+
+	for _, st := range w.sampleTypes {
+		profile := sp.Profiles().AppendEmpty()
+		var id [16]byte
+		for b := range id {
+			id[b] = byte(rng.UintN(256))
+		}
+		profile.SetProfileID(id)
+		profile.SetTime(pcommon.NewTimestampFromTime(start))
+		profile.SetDurationNano(uint64(w.profileDuration))
+		profile.SampleType().SetTypeStrindex(strs.index(st.typ))
+		profile.SampleType().SetUnitStrindex(strs.index(st.unit))
+		profile.AttributeIndices().FromRaw(attributeIndices)
+
+		for s := range w.numSamples {
+			sample := profile.Samples().AppendEmpty()
+			sample.SetStackIndex(stackIndices[s])
+			sample.Values().Append(sampleValue(rng, st.unit))
+			sample.TimestampsUnixNano().Append(timestamps[s])
+			sample.SetLinkIndex(linkIndex)
+		}
+	}
+
+	return profiles
+}
+
+// sampleValue returns a random value in a plausible range for the unit.
+func sampleValue(rng *rand.Rand, unit string) int64 {
+	switch unit {
+	case "nanoseconds":
+		return int64(time.Millisecond) + rng.Int64N(int64(10*time.Millisecond))
+	case "bytes":
+		return 1 + rng.Int64N(1<<20)
+	default:
+		return 1 + rng.Int64N(100)
+	}
+}
+
+// stringTable adds strings to the string table of a dictionary, once.
+type stringTable struct {
+	table   pcommon.StringSlice
+	indices map[string]int32
+}
+
+func newStringTable(table pcommon.StringSlice) *stringTable {
+	// the first string of the table is the empty string
+	table.Append("")
+	return &stringTable{table: table, indices: map[string]int32{"": 0}}
+}
+
+func (t *stringTable) index(s string) int32 {
+	if i, ok := t.indices[s]; ok {
+		return i
+	}
+	t.table.Append(s)
+	i := int32(t.table.Len() - 1)
+	t.indices[s] = i
+	return i
+}
+
+func setValue(v pcommon.Value, av attribute.Value) {
+	switch av.Type() {
+	case attribute.BOOL:
+		v.SetBool(av.AsBool())
+	case attribute.INT64:
+		v.SetInt(av.AsInt64())
+	case attribute.FLOAT64:
+		v.SetDouble(av.AsFloat64())
+	case attribute.STRING:
+		v.SetStr(av.AsString())
+	case attribute.BOOLSLICE:
+		s := v.SetEmptySlice()
+		for _, b := range av.AsBoolSlice() {
+			s.AppendEmpty().SetBool(b)
+		}
+	case attribute.INT64SLICE:
+		s := v.SetEmptySlice()
+		for _, i := range av.AsInt64Slice() {
+			s.AppendEmpty().SetInt(i)
+		}
+	case attribute.FLOAT64SLICE:
+		s := v.SetEmptySlice()
+		for _, f := range av.AsFloat64Slice() {
+			s.AppendEmpty().SetDouble(f)
+		}
+	case attribute.STRINGSLICE:
+		s := v.SetEmptySlice()
+		for _, str := range av.AsStringSlice() {
+			s.AppendEmpty().SetStr(str)
+		}
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package profiles
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/config"
+	types "github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/pkg"
+)
+
+type mockExporter struct {
+	mu       sync.Mutex
+	profiles []pprofile.Profiles
+}
+
+func (m *mockExporter) Export(_ context.Context, profiles pprofile.Profiles) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.profiles = append(m.profiles, profiles)
+	return nil
+}
+
+func (*mockExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+func newTestConfig() *Config {
+	cfg := NewConfig()
+	cfg.WorkerCount = 1
+	cfg.Rate = 0
+	cfg.NumProfiles = 1
+	return cfg
+}
+
+func runWithMockExporter(t *testing.T, cfg *Config) *mockExporter {
+	m := &mockExporter{}
+	expFunc := func() (exporter, error) {
+		return m, nil
+	}
+	require.NoError(t, run(cfg, expFunc, zap.NewNop()))
+	return m
+}
+
+func TestFixedNumberOfProfiles(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.WorkerCount = 2
+	cfg.NumProfiles = 5
+
+	m := runWithMockExporter(t, cfg)
+
+	assert.Len(t, m.profiles, 10)
+}
+
+func TestDurationInf(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.TotalDuration = types.DurationWithInf(-1)
+
+	assert.NoError(t, cfg.Validate())
+	assert.True(t, cfg.TotalDuration.IsInf())
+}
+
+func TestRateOfProfiles(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.Rate = 10
+	cfg.TotalDuration = types.DurationWithInf(time.Second / 2)
+
+	m := runWithMockExporter(t, cfg)
+
+	// the minimum acceptable number of profiles for the rate of 10/sec for half a second
+	assert.GreaterOrEqual(t, len(m.profiles), 5, "there should have been 5 or more profiles, had %d", len(m.profiles))
+	// the maximum acceptable number of profiles for the rate of 10/sec for half a second
+	assert.LessOrEqual(t, len(m.profiles), 20, "there should have been less than 20 profiles, had %d", len(m.profiles))
+}
+
+func TestProfilesContent(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.NumSamples = 20
+	cfg.StackDepth = 7
+	cfg.NumFunctions = 30
+	cfg.NumMappings = 3
+	cfg.SampleTypes = []string{"cpu:nanoseconds", "samples:count"}
+	cfg.ProfileDuration = time.Minute
+	cfg.ServiceName = "profiled"
+	cfg.TelemetryAttributes = config.KeyValue{"k1": "v1"}
+
+	m := runWithMockExporter(t, cfg)
+	require.Len(t, m.profiles, 1)
+	profiles := m.profiles[0]
+	dic := profiles.Dictionary()
+
+	// the first entry of each table is its zero value
+	assert.Equal(t, 3+1, dic.MappingTable().Len())
+	assert.Equal(t, 30+1, dic.FunctionTable().Len())
+	assert.Equal(t, 30+1, dic.LocationTable().Len())
+	assert.Equal(t, 20+1, dic.StackTable().Len())
+	assert.Equal(t, 1, dic.LinkTable().Len())
+	assert.Empty(t, dic.StringTable().At(0))
+
+	for i := 1; i < dic.LocationTable().Len(); i++ {
+		loc := dic.LocationTable().At(i)
+		mapping := dic.MappingTable().At(int(loc.MappingIndex()))
+		assert.GreaterOrEqual(t, loc.Address(), mapping.MemoryStart())
+		assert.Less(t, loc.Address(), mapping.MemoryLimit())
+		require.Equal(t, 1, loc.Lines().Len())
+		fn := dic.FunctionTable().At(int(loc.Lines().At(0).FunctionIndex()))
+		assert.Contains(t, dic.StringTable().At(int(fn.NameStrindex())), "telemetrygen.function")
+	}
+
+	rp := profiles.ResourceProfiles().At(0)
+	serviceName, ok := rp.Resource().Attributes().Get("service.name")
+	require.True(t, ok)
+	assert.Equal(t, "profiled", serviceName.Str())
+
+	sps := rp.ScopeProfiles().At(0).Profiles()
+	require.Equal(t, 2, sps.Len())
+	assert.Equal(t, 40, profiles.SampleCount())
+	for i, want := range [][2]string{{"cpu", "nanoseconds"}, {"samples", "count"}} {
+		profile := sps.At(i)
+		assert.Equal(t, want[0], dic.StringTable().At(int(profile.SampleType().TypeStrindex())))
+		assert.Equal(t, want[1], dic.StringTable().At(int(profile.SampleType().UnitStrindex())))
+		assert.Equal(t, uint64(time.Minute), profile.DurationNano())
+		assert.False(t, profile.ProfileID().IsEmpty())
+		assert.Equal(t, map[string]any{"k1": "v1"}, pprofile.FromAttributeIndices(dic.AttributeTable(), profile, dic).AsRaw())
+
+		start := profile.Time().AsTime()
+		for j := range profile.Samples().Len() {
+			sample := profile.Samples().At(j)
+			assert.Equal(t, 7, dic.StackTable().At(int(sample.StackIndex())).LocationIndices().Len())
+			require.Equal(t, 1, sample.Values().Len())
+			assert.Positive(t, sample.Values().At(0))
+			ts := time.Unix(0, int64(sample.TimestampsUnixNano().At(0)))
+			assert.False(t, ts.Before(start))
+			assert.True(t, ts.Before(start.Add(time.Minute)))
+			assert.Zero(t, sample.LinkIndex())
+		}
+	}
+	// the profiles of the sample types share the same samples
+	assert.Equal(t, sps.At(0).Samples().At(3).StackIndex(), sps.At(1).Samples().At(3).StackIndex())
+}
+
+func TestProfilesWithTraceIDAndSpanID(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.TraceID = "ae87dadd90e9935a4bc9660628efd569"
+	cfg.SpanID = "5828fa4960140870"
+
+	m := runWithMockExporter(t, cfg)
+	require.Len(t, m.profiles, 1)
+	dic := m.profiles[0].Dictionary()
+	require.Equal(t, 2, dic.LinkTable().Len())
+	link := dic.LinkTable().At(1)
+	assert.Equal(t, pcommon.TraceID{0xae, 0x87, 0xda, 0xdd, 0x90, 0xe9, 0x93, 0x5a, 0x4b, 0xc9, 0x66, 0x06, 0x28, 0xef, 0xd5, 0x69}, link.TraceID())
+	assert.Equal(t, pcommon.SpanID{0x58, 0x28, 0xfa, 0x49, 0x60, 0x14, 0x08, 0x70}, link.SpanID())
+
+	samples := m.profiles[0].ResourceProfiles().At(0).ScopeProfiles().At(0).Profiles().At(0).Samples()
+	for i := range samples.Len() {
+		assert.Equal(t, int32(1), samples.At(i).LinkIndex())
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Config)
+		err    string
+	}{
+		{
+			name:   "no profiles nor duration",
+			modify: func(c *Config) { c.NumProfiles = 0 },
+			err:    "either `profiles` or `duration` must be greater than 0",
+		},
+		{
+			name:   "no samples",
+			modify: func(c *Config) { c.NumSamples = 0 },
+			err:    "`samples` must be greater than 0, found 0",
+		},
+		{
+			name:   "no stack depth",
+			modify: func(c *Config) { c.StackDepth = 0 },
+			err:    "`stack-depth` must be greater than 0, found 0",
+		},
+		{
+			name:   "no functions",
+			modify: func(c *Config) { c.NumFunctions = -1 },
+			err:    "`functions` must be greater than 0, found -1",
+		},
+		{
+			name:   "no mappings",
+			modify: func(c *Config) { c.NumMappings = 0 },
+			err:    "`mappings` must be greater than 0, found 0",
+		},
+		{
+			name:   "no profile duration",
+			modify: func(c *Config) { c.ProfileDuration = 0 },
+			err:    "`profile-duration` must be greater than 0, found 0s",
+		},
+		{
+			name:   "no sample types",
+			modify: func(c *Config) { c.SampleTypes = nil },
+			err:    "at least one `sample-type` must be provided",
+		},
+		{
+			name:   "invalid sample type",
+			modify: func(c *Config) { c.SampleTypes = []string{"cpu"} },
+			err:    `sample type "cpu" should be in the format type:unit`,
+		},
+		{
+			name:   "invalid trace ID",
+			modify: func(c *Config) { c.TraceID = "123"; c.SpanID = "5828fa4960140870" },
+			err:    "TraceID must be a 32 character hex string",
+		},
+		{
+			name:   "span ID without trace ID",
+			modify: func(c *Config) { c.SpanID = "5828fa4960140870" },
+			err:    "`trace-id` and `span-id` must be provided together",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := newTestConfig()
+			tt.modify(cfg)
+			assert.ErrorContains(t, cfg.Validate(), tt.err)
+		})
+	}
+	assert.NoError(t, newTestConfig().Validate())
+}
@@ -24,6 +24,7 @@ type Config struct {
 	NumSpanLinks     int
 
 	SpanDuration time.Duration
+	Scenario     string
 }
 
 func NewConfig() *Config {
@@ -45,6 +46,7 @@ func (c *Config) Flags(fs *pflag.FlagSet) {
 	fs.BoolVar(&c.Batch, "batch", c.Batch, "Whether to batch traces")
 	fs.IntVar(&c.NumSpanLinks, "span-links", c.NumSpanLinks, "Number of span links to generate for each span")
 	fs.DurationVar(&c.SpanDuration, "span-duration", c.SpanDuration, "The duration of each generated span.")
+	fs.StringVar(&c.Scenario, "scenario", c.Scenario, "Path to a YAML file describing the services to generate traces for, and how they call each other. When set, the rate applies to traces, and child-spans, status-code, span-links and span-duration are ignored.")
 }
 
 // SetDefaults sets the default values for the configuration
@@ -61,6 +63,7 @@ func (c *Config) SetDefaults() {
 	c.Batch = true
 	c.NumSpanLinks = 0
 	c.SpanDuration = 123 * time.Microsecond
+	c.Scenario = ""
 }
 
 // Validate validates the test scenario parameters.
@@ -0,0 +1,469 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package traces
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"maps"
+	"math/rand/v2"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.yaml.in/yaml/v3"
+)
+
+// scenario describes the services of a system and how their operations call each other.
+// Each generated trace starts at one of the entrypoints and follows the calls of the operations.
+type scenario struct {
+	// Services are the services of the system.
+	Services []*scenarioService `yaml:"services"`
+	// Entrypoints are the operations traces start at, picked according to their weight.
+	Entrypoints []*scenarioEntrypoint `yaml:"entrypoints"`
+	// Logs enables the generation of a log record for each operation, correlated with its span.
+	Logs bool `yaml:"logs"`
+	// Metrics enables the generation of a duration histogram for each operation.
+	Metrics bool `yaml:"metrics"`
+}
+
+type scenarioService struct {
+	Name string `yaml:"name"`
+	// ResourceAttributes are added to the resource attributes of the service.
+	ResourceAttributes map[string]any       `yaml:"resource_attributes"`
+	Operations         []*scenarioOperation `yaml:"operations"`
+
+	resource []attribute.KeyValue
+}
+
+type scenarioOperation struct {
+	Name string `yaml:"name"`
+	// Kind is the kind of the span of the operation, one of server (default), consumer or internal.
+	Kind string `yaml:"kind"`
+	// Latency is the time spent in the operation itself, excluding its calls.
+	Latency latency `yaml:"latency"`
+	// ErrorProbability is the probability of the operation failing, between 0 and 1.
+	ErrorProbability float64         `yaml:"error_probability"`
+	Attributes       map[string]any  `yaml:"attributes"`
+	Calls            []*scenarioCall `yaml:"calls"`
+
+	service    *scenarioService
+	spanKind   trace.SpanKind
+	attributes []attribute.KeyValue
+}
+
+type scenarioCall struct {
+	Service   string `yaml:"service"`
+	Operation string `yaml:"operation"`
+	// Kind is the kind of the span of the call, one of client (default) or producer.
+	// Producer calls are asynchronous: the calling operation doesn't wait for the called
+	// operation, and doesn't fail when the called operation fails.
+	Kind string `yaml:"kind"`
+	// Count is the number of times the operation is called, 1 by default.
+	Count int `yaml:"count"`
+	// MaxCount makes the number of calls random, between Count and MaxCount.
+	MaxCount int `yaml:"max_count"`
+	// Probability is the probability of the calls being made, 1 by default.
+	Probability *float64 `yaml:"probability"`
+	// Parallel makes the calls concurrent instead of sequential.
+	Parallel bool `yaml:"parallel"`
+
+	target   *scenarioOperation
+	spanKind trace.SpanKind
+}
+
+type scenarioEntrypoint struct {
+	Service   string `yaml:"service"`
+	Operation string `yaml:"operation"`
+	// Weight is the relative frequency of the entrypoint, 1 by default.
+	Weight float64 `yaml:"weight"`
+
+	target *scenarioOperation
+}
+
+// latency describes the distribution of the duration of an operation.
+type latency struct {
+	// Distribution is one of constant (default), uniform, normal or exponential.
+	Distribution string        `yaml:"distribution"`
+	Mean         time.Duration `yaml:"mean"`
+	StdDev       time.Duration `yaml:"stddev"`
+	// Min and Max bound the sampled durations, and are the range of the uniform distribution.
+	Min time.Duration `yaml:"min"`
+	Max time.Duration `yaml:"max"`
+}
+
+// loadScenario reads and validates the scenario file at the given path.
+func loadScenario(path string) (*scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scenario file: %w", err)
+	}
+	return parseScenario(data)
+}
+
+func parseScenario(data []byte) (*scenario, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	s := &scenario{}
+	if err := decoder.Decode(s); err != nil {
+		return nil, fmt.Errorf("failed to parse scenario: %w", err)
+	}
+	if err := s.resolve(); err != nil {
+		return nil, fmt.Errorf("invalid scenario: %w", err)
+	}
+	return s, nil
+}
+
+// resolve validates the scenario and links the calls and entrypoints to the operations they refer to.
+func (s *scenario) resolve() error {
+	if len(s.Services) == 0 {
+		return errors.New("at least one service must be defined")
+	}
+	operations := map[string]map[string]*scenarioOperation{}
+	for _, svc := range s.Services {
+		if svc.Name == "" {
+			return errors.New("services must have a name")
+		}
+		if _, ok := operations[svc.Name]; ok {
+			return fmt.Errorf("service %q is defined more than once", svc.Name)
+		}
+		var err error
+		if svc.resource, err = toAttributes(svc.ResourceAttributes); err != nil {
+			return fmt.Errorf("service %q: %w", svc.Name, err)
+		}
+		operations[svc.Name] = map[string]*scenarioOperation{}
+		for _, op := range svc.Operations {
+			if op.Name == "" {
+				return fmt.Errorf("service %q: operations must have a name", svc.Name)
+			}
+			if _, ok := operations[svc.Name][op.Name]; ok {
+				return fmt.Errorf("service %q: operation %q is defined more than once", svc.Name, op.Name)
+			}
+			if err := op.validate(); err != nil {
+				return fmt.Errorf("operation %q of service %q: %w", op.Name, svc.Name, err)
+			}
+			op.service = svc
+			operations[svc.Name][op.Name] = op
+		}
+	}
+
+	lookup := func(service, operation string) (*scenarioOperation, error) {
+		ops, ok := operations[service]
+		if !ok {
+			return nil, fmt.Errorf("unknown service %q", service)
+		}
+		op, ok := ops[operation]
+		if !ok {
+			return nil, fmt.Errorf("unknown operation %q of service %q", operation, service)
+		}
+		return op, nil
+	}
+
+	for _, svc := range s.Services {
+		for _, op := range svc.Operations {
+			for _, call := range op.Calls {
+				var err error
+				if call.target, err = lookup(call.Service, call.Operation); err != nil {
+					return fmt.Errorf("operation %q of service %q calls an %w", op.Name, svc.Name, err)
+				}
+				if err = call.validate(); err != nil {
+					return fmt.Errorf("call of operation %q of service %q to %q: %w", op.Name, svc.Name, call.Operation, err)
+				}
+			}
+		}
+	}
+
+	if len(s.Entrypoints) == 0 {
+		return errors.New("at least one entrypoint must be defined")
+	}
+	for _, e := range s.Entrypoints {
+		var err error
+		if e.target, err = lookup(e.Service, e.Operation); err != nil {
+			return fmt.Errorf("entrypoint refers to an %w", err)
+		}
+		if e.Weight < 0 {
+			return fmt.Errorf("entrypoint %q of service %q: weight cannot be negative", e.Operation, e.Service)
+		}
+		if e.Weight == 0 {
+			e.Weight = 1
+		}
+	}
+
+	return s.checkCycles()
+}
+
+// checkCycles ensures that no operation calls itself, directly or through other operations,
+// since the traces of such a scenario would never end.
+func (s *scenario) checkCycles() error {
+	const (
+		visiting = iota + 1
+		visited
+	)
+	state := map[*scenarioOperation]int{}
+	var visit func(op *scenarioOperation, path []string) error
+	visit = func(op *scenarioOperation, path []string) error {
+		path = append(path, op.service.Name+"/"+op.Name)
+		switch state[op] {
+		case visiting:
+			return fmt.Errorf("the calls between operations form a cycle: %s", strings.Join(path, " -> "))
+		case visited:
+			return nil
+		}
+		state[op] = visiting
+		for _, call := range op.Calls {
+			if err := visit(call.target, path); err != nil {
+				return err
+			}
+		}
+		state[op] = visited
+		return nil
+	}
+	for _, svc := range s.Services {
+		for _, op := range svc.Operations {
+			if err := visit(op, nil); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (op *scenarioOperation) validate() error {
+	switch strings.ToLower(op.Kind) {
+	case "", "server":
+		op.spanKind = trace.SpanKindServer
+	case "consumer":
+		op.spanKind = trace.SpanKindConsumer
+	case "internal":
+		op.spanKind = trace.SpanKindInternal
+	default:
+		return fmt.Errorf("kind must be one of (server, consumer, internal), got %q", op.Kind)
+	}
+	if op.ErrorProbability < 0 || op.ErrorProbability > 1 {
+		return fmt.Errorf("error_probability must be between 0 and 1, got %v", op.ErrorProbability)
+	}
+	var err error
+	if op.attributes, err = toAttributes(op.Attributes); err != nil {
+		return err
+	}
+	return op.Latency.validate()
+}
+
+func (c *scenarioCall) validate() error {
+	switch strings.ToLower(c.Kind) {
+	case "", "client":
+		c.spanKind = trace.SpanKindClient
+	case "producer":
+		c.spanKind = trace.SpanKindProducer
+	default:
+		return fmt.Errorf("kind must be one of (client, producer), got %q", c.Kind)
+	}
+	if c.Count < 0 {
+		return fmt.Errorf("count cannot be negative, got %d", c.Count)
+	}
+	if c.Count == 0 {
+		c.Count = 1
+	}
+	if c.MaxCount != 0 && c.MaxCount < c.Count {
+		return fmt.Errorf("max_count must be greater than or equal to count, got %d", c.MaxCount)
+	}
+	if c.Probability != nil && (*c.Probability < 0 || *c.Probability > 1) {
+		return fmt.Errorf("probability must be between 0 and 1, got %v", *c.Probability)
+	}
+	return nil
+}
+
+func (l *latency) validate() error {
+	if l.Min < 0 || l.Max < 0 || l.Mean < 0 || l.StdDev < 0 {
+		return errors.New("latency durations cannot be negative")
+	}
+	if l.Max != 0 && l.Max < l.Min {
+		return errors.New("latency max must be greater than or equal to min")
+	}
+	switch strings.ToLower(l.Distribution) {
+	case "", "constant", "normal", "exponential":
+	case "uniform":
+		if l.Max == 0 {
+			return errors.New("uniform latency requires a max")
+		}
+	default:
+		return fmt.Errorf("latency distribution must be one of (constant, uniform, normal, exponential), got %q", l.Distribution)
+	}
+	return nil
+}
+
+// sample returns a random duration following the distribution.
+func (l *latency) sample(rng *rand.Rand) time.Duration {
+	var d time.Duration
+	switch strings.ToLower(l.Distribution) {
+	case "uniform":
+		d = l.Min + time.Duration(rng.Float64()*float64(l.Max-l.Min))
+	case "normal":
+		d = l.Mean + time.Duration(rng.NormFloat64()*float64(l.StdDev))
+	case "exponential":
+		d = time.Duration(rng.ExpFloat64() * float64(l.Mean))
+	default:
+		d = l.Mean
+	}
+	if d < l.Min {
+		d = l.Min
+	}
+	if l.Max != 0 && d > l.Max {
+		d = l.Max
+	}
+	return max(d, 0)
+}
+
+// toAttributes converts the attributes of a scenario file, sorted by key.
+func toAttributes(m map[string]any) ([]attribute.KeyValue, error) {
+	attributes := make([]attribute.KeyValue, 0, len(m))
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		switch v := m[k].(type) {
+		case string:
+			attributes = append(attributes, attribute.String(k, v))
+		case bool:
+			attributes = append(attributes, attribute.Bool(k, v))
+		case int:
+			attributes = append(attributes, attribute.Int(k, v))
+		case float64:
+			attributes = append(attributes, attribute.Float64(k, v))
+		case []any:
+			kv, err := toSliceAttribute(k, v)
+			if err != nil {
+				return nil, err
+			}
+			attributes = append(attributes, kv)
+		default:
+			return nil, fmt.Errorf("attribute %q has an unsupported value of type %T", k, v)
+		}
+	}
+	return attributes, nil
+}
+
+func toSliceAttribute(k string, values []any) (attribute.KeyValue, error) {
+	if len(values) == 0 {
+		return attribute.StringSlice(k, nil), nil
+	}
+	switch values[0].(type) {
+	case string:
+		return toTypedSliceAttribute(k, values, attribute.StringSlice)
+	case bool:
+		return toTypedSliceAttribute(k, values, attribute.BoolSlice)
+	case int:
+		return toTypedSliceAttribute(k, values, attribute.IntSlice)
+	case float64:
+		return toTypedSliceAttribute(k, values, attribute.Float64Slice)
+	default:
+		return attribute.KeyValue{}, fmt.Errorf("attribute %q has an unsupported value of type %T", k, values[0])
+	}
+}
+
+func toTypedSliceAttribute[T any](k string, values []any, newAttribute func(string, []T) attribute.KeyValue) (attribute.KeyValue, error) {
+	typed := make([]T, 0, len(values))
+	for _, v := range values {
+		t, ok := v.(T)
+		if !ok {
+			return attribute.KeyValue{}, fmt.Errorf("attribute %q: all items in a slice should be of the same type", k)
+		}
+		typed = append(typed, t)
+	}
+	return newAttribute(k, typed), nil
+}
+
+// pickEntrypoint returns a random entrypoint, according to their weights.
+func (s *scenario) pickEntrypoint(rng *rand.Rand) *scenarioOperation {
+	var total float64
+	for _, e := range s.Entrypoints {
+		total += e.Weight
+	}
+	r := rng.Float64() * total
+	for _, e := range s.Entrypoints {
+		if r < e.Weight {
+			return e.target
+		}
+		r -= e.Weight
+	}
+	return s.Entrypoints[len(s.Entrypoints)-1].target
+}
+
+// spanPlan is a span of a trace to generate, with its timing and outcome already decided.
+type spanPlan struct {
+	service    *scenarioService
+	operation  *scenarioOperation // set for the spans of operations, nil for the spans of calls
+	name       string
+	kind       trace.SpanKind
+	attributes []attribute.KeyValue
+	start      time.Time
+	end        time.Time
+	failed     bool
+	children   []*spanPlan
+}
+
+// plan decides the spans of a trace starting with the operation at the given time.
+// Half of the latency of an operation is spent before its calls and half after them.
+// Sequential calls start when the previous call ends, parallel calls start together.
+func (op *scenarioOperation) plan(rng *rand.Rand, start time.Time) *spanPlan {
+	self := op.Latency.sample(rng)
+	sp := &spanPlan{
+		service:    op.service,
+		operation:  op,
+		name:       op.Name,
+		kind:       op.spanKind,
+		attributes: op.attributes,
+		start:      start,
+		failed:     rng.Float64() < op.ErrorProbability,
+	}
+	cursor := start.Add(self / 2)
+	for _, call := range op.Calls {
+		if call.Probability != nil && rng.Float64() >= *call.Probability {
+			continue
+		}
+		count := call.Count
+		if call.MaxCount > call.Count {
+			count += rng.IntN(call.MaxCount - call.Count + 1)
+		}
+		callsEnd := cursor
+		for range count {
+			callee := call.target.plan(rng, cursor)
+			end := callee.end
+			if call.spanKind == trace.SpanKindProducer {
+				end = cursor
+			}
+			client := &spanPlan{
+				service: op.service,
+				name:    call.target.Name,
+				kind:    call.spanKind,
+				attributes: []attribute.KeyValue{
+					attribute.String("peer.service", call.target.service.Name),
+				},
+				start:    cursor,
+				end:      end,
+				failed:   callee.failed && call.spanKind != trace.SpanKindProducer,
+				children: []*spanPlan{callee},
+			}
+			sp.children = append(sp.children, client)
+			if client.failed {
+				sp.failed = true
+			}
+			callsEnd = maxTime(callsEnd, client.end)
+			if !call.Parallel {
+				cursor = client.end
+			}
+		}
+		cursor = callsEnd
+	}
+	sp.end = cursor.Add(self - self/2)
+	return sp
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
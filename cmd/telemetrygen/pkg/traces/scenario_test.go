@@ -0,0 +1,541 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package traces
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/config"
+)
+
+func TestLoadScenario(t *testing.T) {
+	s, err := loadScenario("testdata/scenario.yaml")
+	require.NoError(t, err)
+
+	require.Len(t, s.Services, 5)
+	frontend := s.Services[0]
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("deployment.environment.name", "production"),
+		attribute.String("service.version", "1.4.2"),
+	}, frontend.resource)
+	checkout := frontend.Operations[0]
+	assert.Equal(t, trace.SpanKindServer, checkout.spanKind)
+	assert.Equal(t, 5*time.Millisecond, checkout.Latency.StdDev)
+	require.Len(t, checkout.Calls, 2)
+	assert.Same(t, s.Services[3].Operations[0], checkout.Calls[1].target)
+	assert.Equal(t, trace.SpanKindProducer, s.Services[3].Operations[0].Calls[1].spanKind)
+	assert.Equal(t, 4.0, s.Entrypoints[0].Weight)
+	assert.Equal(t, 1.0, s.Entrypoints[1].Weight)
+	assert.True(t, s.Logs)
+	assert.True(t, s.Metrics)
+
+	_, err = loadScenario("testdata/missing.yaml")
+	assert.ErrorContains(t, err, "failed to read scenario file")
+}
+
+func TestParseScenarioErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		scenario string
+		err      string
+	}{
+		{
+			name:     "no services",
+			scenario: `entrypoints: []`,
+			err:      "at least one service must be defined",
+		},
+		{
+			name:     "unknown field",
+			scenario: `servics: []`,
+			err:      "field servics not found",
+		},
+		{
+			name: "duplicate service",
+			scenario: `
+services:
+  - name: a
+  - name: a`,
+			err: `service "a" is defined more than once`,
+		},
+		{
+			name: "duplicate operation",
+			scenario: `
+services:
+  - name: a
+    operations:
+      - name: op
+      - name: op`,
+			err: `service "a": operation "op" is defined more than once`,
+		},
+		{
+			name: "invalid kind",
+			scenario: `
+services:
+  - name: a
+    operations:
+      - name: op
+        kind: client`,
+			err: `operation "op" of service "a": kind must be one of (server, consumer, internal), got "client"`,
+		},
+		{
+			name: "invalid error probability",
+			scenario: `
+services:
+  - name: a
+    operations:
+      - name: op
+        error_probability: 2`,
+			err: "error_probability must be between 0 and 1, got 2",
+		},
+		{
+			name: "invalid latency distribution",
+			scenario: `
+services:
+  - name: a
+    operations:
+      - name: op
+        latency:
+          distribution: pareto`,
+			err: `latency distribution must be one of (constant, uniform, normal, exponential), got "pareto"`,
+		},
+		{
+			name: "uniform latency without max",
+			scenario: `
+services:
+  - name: a
+    operations:
+      - name: op
+        latency:
+          distribution: uniform
+          min: 1ms`,
+			err: "uniform latency requires a max",
+		},
+		{
+			name: "unsupported attribute",
+			scenario: `
+services:
+  - name: a
+    resource_attributes:
+      k: {nested: true}`,
+			err: `service "a": attribute "k" has an unsupported value of type map[string]interface {}`,
+		},
+		{
+			name: "mixed slice attribute",
+			scenario: `
+services:
+  - name: a
+    resource_attributes:
+      k: [1, "2"]`,
+			err: `attribute "k": all items in a slice should be of the same type`,
+		},
+		{
+			name: "unknown called service",
+			scenario: `
+services:
+  - name: a
+    operations:
+      - name: op
+        calls:
+          - service: b
+            operation: op`,
+			err: `operation "op" of service "a" calls an unknown service "b"`,
+		},
+		{
+			name: "unknown called operation",
+			scenario: `
+services:
+  - name: a
+    operations:
+      - name: op
+        calls:
+          - service: a
+            operation: other`,
+			err: `operation "op" of service "a" calls an unknown operation "other" of service "a"`,
+		},
+		{
+			name: "invalid max count",
+			scenario: `
+services:
+  - name: a
+    operations:
+      - name: op
+        calls:
+          - service: b
+            operation: op
+            count: 3
+            max_count: 2
+  - name: b
+    operations:
+      - name: op`,
+			err: "max_count must be greater than or equal to count, got 2",
+		},
+		{
+			name: "no entrypoints",
+			scenario: `
+services:
+  - name: a
+    operations:
+      - name: op`,
+			err: "at least one entrypoint must be defined",
+		},
+		{
+			name: "unknown entrypoint",
+			scenario: `
+services:
+  - name: a
+    operations:
+      - name: op
+entrypoints:
+  - service: a
+    operation: other`,
+			err: `entrypoint refers to an unknown operation "other" of service "a"`,
+		},
+		{
+			name: "cycle",
+			scenario: `
+services:
+  - name: a
+    operations:
+      - name: op
+        calls:
+          - service: b
+            operation: op
+  - name: b
+    operations:
+      - name: op
+        calls:
+          - service: a
+            operation: op
+entrypoints:
+  - service: a
+    operation: op`,
+			err: "the calls between operations form a cycle: a/op -> b/op -> a/op",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseScenario([]byte(tt.scenario))
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestLatencySample(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	tests := []struct {
+		name    string
+		latency latency
+		min     time.Duration
+		max     time.Duration
+	}{
+		{
+			name:    "constant",
+			latency: latency{Mean: 10 * time.Millisecond},
+			min:     10 * time.Millisecond,
+			max:     10 * time.Millisecond,
+		},
+		{
+			name:    "uniform",
+			latency: latency{Distribution: "uniform", Min: time.Millisecond, Max: 2 * time.Millisecond},
+			min:     time.Millisecond,
+			max:     2 * time.Millisecond,
+		},
+		{
+			name:    "bounded normal",
+			latency: latency{Distribution: "normal", Mean: 10 * time.Millisecond, StdDev: 10 * time.Millisecond, Min: 5 * time.Millisecond, Max: 15 * time.Millisecond},
+			min:     5 * time.Millisecond,
+			max:     15 * time.Millisecond,
+		},
+		{
+			name:    "unbounded normal is never negative",
+			latency: latency{Distribution: "normal", Mean: time.Millisecond, StdDev: 10 * time.Millisecond},
+			min:     0,
+			max:     time.Hour,
+		},
+		{
+			name:    "bounded exponential",
+			latency: latency{Distribution: "exponential", Mean: 10 * time.Millisecond, Max: 20 * time.Millisecond},
+			min:     0,
+			max:     20 * time.Millisecond,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, tt.latency.validate())
+			for range 100 {
+				d := tt.latency.sample(rng)
+				assert.GreaterOrEqual(t, d, tt.min)
+				assert.LessOrEqual(t, d, tt.max)
+			}
+		})
+	}
+}
+
+func TestScenarioPlan(t *testing.T) {
+	s, err := parseScenario([]byte(`
+services:
+  - name: gateway
+    operations:
+      - name: GET /orders
+        latency:
+          mean: 10ms
+        calls:
+          - service: orders
+            operation: ListOrders
+            count: 2
+          - service: orders
+            operation: GetOrder
+            count: 3
+            parallel: true
+          - service: audit
+            operation: Record
+            kind: producer
+  - name: orders
+    operations:
+      - name: ListOrders
+        latency:
+          mean: 4ms
+      - name: GetOrder
+        latency:
+          mean: 2ms
+        error_probability: 1
+  - name: audit
+    operations:
+      - name: Record
+        kind: consumer
+        latency:
+          mean: 50ms
+        error_probability: 1
+entrypoints:
+  - service: gateway
+    operation: GET /orders
+`))
+	require.NoError(t, err)
+
+	start := time.Unix(1000, 0)
+	root := s.pickEntrypoint(rand.New(rand.NewPCG(1, 2))).plan(rand.New(rand.NewPCG(1, 2)), start)
+
+	assert.Equal(t, "GET /orders", root.name)
+	assert.Equal(t, trace.SpanKindServer, root.kind)
+	assert.Equal(t, start, root.start)
+	// 10ms of its own, 2 sequential calls of 4ms and 3 parallel calls of 2ms
+	assert.Equal(t, start.Add(10*time.Millisecond+2*4*time.Millisecond+2*time.Millisecond), root.end)
+	assert.True(t, root.failed, "the failure of a client call fails the caller")
+
+	require.Len(t, root.children, 6)
+	cursor := start.Add(5 * time.Millisecond)
+	for _, client := range root.children[:2] {
+		assert.Equal(t, "ListOrders", client.name)
+		assert.Equal(t, trace.SpanKindClient, client.kind)
+		assert.Equal(t, []attribute.KeyValue{attribute.String("peer.service", "orders")}, client.attributes)
+		assert.Equal(t, cursor, client.start)
+		assert.False(t, client.failed)
+		require.Len(t, client.children, 1)
+		assert.Equal(t, trace.SpanKindServer, client.children[0].kind)
+		assert.Equal(t, client.start, client.children[0].start)
+		assert.Equal(t, client.end, client.children[0].end)
+		cursor = client.end
+	}
+	for _, client := range root.children[2:5] {
+		assert.Equal(t, "GetOrder", client.name)
+		assert.Equal(t, cursor, client.start)
+		assert.Equal(t, cursor.Add(2*time.Millisecond), client.end)
+		assert.True(t, client.failed)
+		assert.True(t, client.children[0].failed)
+	}
+
+	producer := root.children[5]
+	assert.Equal(t, trace.SpanKindProducer, producer.kind)
+	assert.Equal(t, producer.start, producer.end, "producer calls don't wait for the consumer")
+	assert.False(t, producer.failed)
+	consumer := producer.children[0]
+	assert.Equal(t, trace.SpanKindConsumer, consumer.kind)
+	assert.Equal(t, producer.start.Add(50*time.Millisecond), consumer.end)
+	assert.True(t, consumer.failed)
+}
+
+func TestScenarioPlanFanOut(t *testing.T) {
+	s, err := parseScenario([]byte(`
+services:
+  - name: a
+    operations:
+      - name: op
+        calls:
+          - service: b
+            operation: op
+            count: 1
+            max_count: 3
+          - service: b
+            operation: other
+            probability: 0
+  - name: b
+    operations:
+      - name: op
+      - name: other
+entrypoints:
+  - service: a
+    operation: op
+`))
+	require.NoError(t, err)
+
+	rng := rand.New(rand.NewPCG(1, 2))
+	counts := map[int]int{}
+	for range 100 {
+		root := s.pickEntrypoint(rng).plan(rng, time.Now())
+		for _, client := range root.children {
+			assert.Equal(t, "op", client.name)
+		}
+		counts[len(root.children)]++
+	}
+	assert.Len(t, counts, 3)
+	for n := range counts {
+		assert.True(t, n >= 1 && n <= 3)
+	}
+}
+
+type recordingLogExporter struct {
+	mu      sync.Mutex
+	records []sdklog.Record
+}
+
+func (e *recordingLogExporter) Export(_ context.Context, records []sdklog.Record) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, r := range records {
+		e.records = append(e.records, r.Clone())
+	}
+	return nil
+}
+
+func (*recordingLogExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+func (*recordingLogExporter) ForceFlush(context.Context) error {
+	return nil
+}
+
+func TestScenarioTelemetry(t *testing.T) {
+	s, err := parseScenario([]byte(`
+services:
+  - name: frontend
+    resource_attributes:
+      service.version: 1.0.0
+    operations:
+      - name: GET /
+        latency:
+          mean: 10ms
+        attributes:
+          http.route: /
+        calls:
+          - service: backend
+            operation: Get
+  - name: backend
+    operations:
+      - name: Get
+        latency:
+          mean: 5ms
+        error_probability: 1
+entrypoints:
+  - service: frontend
+    operation: GET /
+logs: true
+metrics: true
+`))
+	require.NoError(t, err)
+
+	spans := tracetest.NewInMemoryExporter()
+	logs := &recordingLogExporter{}
+	readers := map[string]*sdkmetric.ManualReader{}
+	var services []string
+	for _, svc := range s.Services {
+		services = append(services, svc.Name)
+	}
+	st, err := newScenarioTelemetry(s, []attribute.KeyValue{attribute.String("k", "v")}, scenarioExporters{
+		spanProcessor: sdktrace.NewSimpleSpanProcessor(spans),
+		logProcessor:  sdklog.NewSimpleProcessor(logs),
+		newMetricReader: func() sdkmetric.Reader {
+			reader := sdkmetric.NewManualReader()
+			readers[services[len(readers)]] = reader
+			return reader
+		},
+	})
+	require.NoError(t, err)
+
+	cfg := &Config{
+		Config: config.Config{
+			WorkerCount: 1,
+			TelemetryAttributes: config.KeyValue{
+				"env": "test",
+			},
+		},
+		NumTraces: 2,
+	}
+	require.NoError(t, generate(cfg, st, zap.NewNop()))
+
+	got := spans.GetSpans()
+	require.Len(t, got, 6)
+	// spans are exported when they end, so the spans of the callees come first
+	server, client, root := got[0], got[1], got[2]
+	assert.Equal(t, "GET /", root.Name)
+	assert.Equal(t, trace.SpanKindServer, root.SpanKind)
+	assert.False(t, root.Parent.IsValid())
+	assert.Contains(t, root.Attributes, attribute.String("http.route", "/"))
+	assert.Contains(t, root.Attributes, attribute.String("env", "test"))
+	assert.Equal(t, codes.Error, root.Status.Code)
+	assert.Equal(t, 15*time.Millisecond, root.EndTime.Sub(root.StartTime))
+
+	assert.Contains(t, root.Resource.Attributes(), semconv.ServiceName("frontend"))
+	assert.Contains(t, root.Resource.Attributes(), attribute.String("service.version", "1.0.0"))
+	assert.Contains(t, root.Resource.Attributes(), attribute.String("k", "v"))
+	assert.Contains(t, server.Resource.Attributes(), semconv.ServiceName("backend"))
+
+	assert.Equal(t, "Get", client.Name)
+	assert.Equal(t, trace.SpanKindClient, client.SpanKind)
+	assert.Equal(t, root.SpanContext.SpanID(), client.Parent.SpanID())
+	assert.Equal(t, trace.SpanKindServer, server.SpanKind)
+	assert.Equal(t, client.SpanContext.SpanID(), server.Parent.SpanID())
+	assert.Equal(t, root.SpanContext.TraceID(), server.SpanContext.TraceID())
+
+	require.Len(t, logs.records, 4)
+	assert.Equal(t, "Get failed", logs.records[0].Body().AsString())
+	assert.Equal(t, server.SpanContext.TraceID(), logs.records[0].TraceID())
+	assert.Equal(t, server.SpanContext.SpanID(), logs.records[0].SpanID())
+	assert.Equal(t, server.EndTime, logs.records[0].Timestamp())
+	assert.Equal(t, "GET / failed", logs.records[1].Body().AsString())
+	assert.Equal(t, root.SpanContext.SpanID(), logs.records[1].SpanID())
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, readers["backend"].Collect(t.Context(), &rm))
+	assert.Contains(t, rm.Resource.Attributes(), semconv.ServiceName("backend"))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+	m := rm.ScopeMetrics[0].Metrics[0]
+	assert.Equal(t, "telemetrygen.operation.duration", m.Name)
+	histogram := m.Data.(metricdata.Histogram[float64])
+	require.Len(t, histogram.DataPoints, 1)
+	assert.Equal(t, uint64(2), histogram.DataPoints[0].Count)
+	assert.InDelta(t, 0.01, histogram.DataPoints[0].Sum, 1e-9)
+	assert.Equal(t, attribute.NewSet(attribute.String("operation", "Get"), attribute.Bool("error", true)), histogram.DataPoints[0].Attributes)
+
+	require.NoError(t, st.shutdown(t.Context()))
+}
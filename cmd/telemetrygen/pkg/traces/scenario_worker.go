@@ -0,0 +1,280 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package traces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	otellog "go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/metric"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/config"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/otlpexporter"
+)
+
+const scenarioScopeName = "telemetrygen"
+
+// scenarioExporters are the destinations of the telemetry of a scenario.
+type scenarioExporters struct {
+	spanProcessor sdktrace.SpanProcessor
+	// logProcessor is only used when the scenario enables logs.
+	logProcessor sdklog.Processor
+	// newMetricReader creates the reader of the metrics of a service, and is only used when the scenario enables metrics.
+	newMetricReader func() sdkmetric.Reader
+}
+
+// scenarioTelemetry holds the tracer, logger and instruments of each service of a scenario,
+// which are created from providers with the resource of the service.
+type scenarioTelemetry struct {
+	scenario  *scenario
+	tracers   map[*scenarioService]trace.Tracer
+	loggers   map[*scenarioService]otellog.Logger
+	durations map[*scenarioService]metric.Float64Histogram
+	shutdowns []func(context.Context) error
+}
+
+func newScenarioTelemetry(s *scenario, attributes []attribute.KeyValue, exporters scenarioExporters) (*scenarioTelemetry, error) {
+	t := &scenarioTelemetry{
+		scenario:  s,
+		tracers:   map[*scenarioService]trace.Tracer{},
+		loggers:   map[*scenarioService]otellog.Logger{},
+		durations: map[*scenarioService]metric.Float64Histogram{},
+	}
+	for _, svc := range s.Services {
+		// the attributes of the service override the attributes of the command line
+		attrs := append(append(append([]attribute.KeyValue{}, attributes...), semconv.ServiceName(svc.Name)), svc.resource...)
+		res := resource.NewWithAttributes(semconv.SchemaURL, attrs...)
+
+		tp := sdktrace.NewTracerProvider(sdktrace.WithResource(res), sdktrace.WithSpanProcessor(exporters.spanProcessor))
+		t.shutdowns = append(t.shutdowns, tp.Shutdown)
+		t.tracers[svc] = tp.Tracer(scenarioScopeName)
+
+		if s.Logs {
+			lp := sdklog.NewLoggerProvider(sdklog.WithResource(res), sdklog.WithProcessor(exporters.logProcessor))
+			t.shutdowns = append(t.shutdowns, lp.Shutdown)
+			t.loggers[svc] = lp.Logger(scenarioScopeName)
+		}
+
+		if s.Metrics {
+			mp := sdkmetric.NewMeterProvider(sdkmetric.WithResource(res), sdkmetric.WithReader(exporters.newMetricReader()))
+			t.shutdowns = append(t.shutdowns, mp.Shutdown)
+			histogram, err := mp.Meter(scenarioScopeName).Float64Histogram(
+				"telemetrygen.operation.duration",
+				metric.WithDescription("Duration of the operations of the scenario."),
+				metric.WithUnit("s"),
+			)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create the duration histogram of service %q: %w", svc.Name, err)
+			}
+			t.durations[svc] = histogram
+		}
+	}
+	return t, nil
+}
+
+// emit generates the span, and the correlated log record and metric if enabled, of a planned span and its children.
+func (t *scenarioTelemetry) emit(ctx context.Context, sp *spanPlan, telemetryAttributes []attribute.KeyValue) {
+	ctx, span := t.tracers[sp.service].Start(ctx, sp.name,
+		trace.WithSpanKind(sp.kind),
+		trace.WithTimestamp(sp.start),
+		trace.WithAttributes(sp.attributes...),
+	)
+	span.SetAttributes(telemetryAttributes...)
+	for _, child := range sp.children {
+		t.emit(ctx, child, telemetryAttributes)
+	}
+	if sp.failed {
+		span.SetStatus(codes.Error, "simulated failure")
+	}
+	if sp.operation != nil {
+		t.record(ctx, sp)
+	}
+	span.End(trace.WithTimestamp(sp.end))
+}
+
+// record generates the log record and the metric of an operation, in the context of its span.
+func (t *scenarioTelemetry) record(ctx context.Context, sp *spanPlan) {
+	if logger, ok := t.loggers[sp.service]; ok {
+		var record otellog.Record
+		record.SetTimestamp(sp.end)
+		record.SetObservedTimestamp(sp.end)
+		if sp.failed {
+			record.SetSeverity(otellog.SeverityError)
+			record.SetSeverityText("ERROR")
+			record.SetBody(otellog.StringValue(sp.name + " failed"))
+		} else {
+			record.SetSeverity(otellog.SeverityInfo)
+			record.SetSeverityText("INFO")
+			record.SetBody(otellog.StringValue(sp.name + " succeeded"))
+		}
+		logger.Emit(ctx, record)
+	}
+	if histogram, ok := t.durations[sp.service]; ok {
+		histogram.Record(ctx, sp.end.Sub(sp.start).Seconds(), metric.WithAttributes(
+			attribute.String("operation", sp.name),
+			attribute.Bool("error", sp.failed),
+		))
+	}
+}
+
+func (t *scenarioTelemetry) shutdown(ctx context.Context) error {
+	var errs error
+	for _, shutdown := range t.shutdowns {
+		errs = errors.Join(errs, shutdown(ctx))
+	}
+	return errs
+}
+
+// simulateScenarioTraces generates traces following the calls of the operations of a scenario,
+// starting at its entrypoints.
+func (w *worker) simulateScenarioTraces(t *scenarioTelemetry, telemetryAttributes []attribute.KeyValue) {
+	limiter := rate.NewLimiter(w.limitPerSecond, 1)
+	rng := rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	var i int
+
+	for w.running.Load() {
+		if err := limiter.Wait(context.Background()); err != nil {
+			w.logger.Fatal("limiter waited failed, retry", zap.Error(err))
+		}
+
+		root := t.scenario.pickEntrypoint(rng).plan(rng, time.Now())
+		t.emit(context.Background(), root, telemetryAttributes)
+
+		i++
+		if w.numTraces != 0 {
+			if i >= w.numTraces {
+				break
+			}
+		}
+	}
+	w.logger.Info("traces generated", zap.Int("traces", i))
+	w.wg.Done()
+}
+
+// runScenario generates the traces of the scenario file of the configuration, exporting the spans
+// with the given processor, and the logs and metrics with exporters of their own if the scenario enables them.
+func runScenario(cfg *Config, ssp sdktrace.SpanProcessor, logger *zap.Logger) error {
+	s, err := loadScenario(cfg.Scenario)
+	if err != nil {
+		return err
+	}
+
+	exporters := scenarioExporters{spanProcessor: ssp}
+	if s.Logs {
+		exp, err := newScenarioLogExporter(cfg)
+		if err != nil {
+			return err
+		}
+		exporters.logProcessor = sdklog.NewBatchProcessor(exp)
+	}
+	if s.Metrics {
+		exp, err := newScenarioMetricExporter(cfg)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if tempError := exp.Shutdown(context.Background()); tempError != nil {
+				logger.Error("failed to stop the metric exporter", zap.Error(tempError))
+			}
+		}()
+		exporters.newMetricReader = func() sdkmetric.Reader {
+			return sdkmetric.NewPeriodicReader(sharedMetricExporter{exp}, sdkmetric.WithInterval(cfg.ReportingInterval))
+		}
+	}
+
+	t, err := newScenarioTelemetry(s, cfg.GetAttributes(), exporters)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		logger.Info("stopping the scenario providers")
+		if tempError := t.shutdown(context.Background()); tempError != nil {
+			logger.Error("failed to stop the scenario providers", zap.Error(tempError))
+		}
+	}()
+
+	return generate(cfg, t, logger)
+}
+
+// scenarioExporterConfig returns the exporter configuration of the logs and metrics of a scenario,
+// which are sent to the same endpoint as the spans with the URL path of their signal.
+func scenarioExporterConfig(cfg *Config, httpPath string) *config.Config {
+	exporterCfg := cfg.Config
+	exporterCfg.HTTPPath = httpPath
+	return &exporterCfg
+}
+
+func newScenarioLogExporter(cfg *Config) (sdklog.Exporter, error) {
+	if cfg.UseHTTP {
+		opts, err := otlpexporter.LogHTTPOptions(scenarioExporterConfig(cfg, "/v1/logs"))
+		if err != nil {
+			return nil, err
+		}
+		exp, err := otlploghttp.New(context.Background(), opts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to obtain OTLP HTTP log exporter: %w", err)
+		}
+		return exp, nil
+	}
+	opts, err := otlpexporter.LogGRPCOptions(&cfg.Config)
+	if err != nil {
+		return nil, err
+	}
+	exp, err := otlploggrpc.New(context.Background(), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain OTLP gRPC log exporter: %w", err)
+	}
+	return exp, nil
+}
+
+func newScenarioMetricExporter(cfg *Config) (sdkmetric.Exporter, error) {
+	if cfg.UseHTTP {
+		opts, err := otlpexporter.MetricHTTPOptions(scenarioExporterConfig(cfg, "/v1/metrics"))
+		if err != nil {
+			return nil, err
+		}
+		exp, err := otlpmetrichttp.New(context.Background(), opts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to obtain OTLP HTTP metric exporter: %w", err)
+		}
+		return exp, nil
+	}
+	opts, err := otlpexporter.MetricGRPCOptions(&cfg.Config)
+	if err != nil {
+		return nil, err
+	}
+	exp, err := otlpmetricgrpc.New(context.Background(), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain OTLP gRPC metric exporter: %w", err)
+	}
+	return exp, nil
+}
+
+// sharedMetricExporter lets the readers of all services use the same exporter,
+// which is only shut down once all of them are.
+type sharedMetricExporter struct {
+	sdkmetric.Exporter
+}
+
+func (sharedMetricExporter) Shutdown(context.Context) error {
+	return nil
+}
@@ -26,6 +26,7 @@ import (
 	"golang.org/x/time/rate"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/log"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/otlpexporter"
 )
 
 func Start(cfg *Config) error {
@@ -39,7 +40,7 @@ func Start(cfg *Config) error {
 		var exporterOpts []otlptracehttp.Option
 
 		logger.Info("starting HTTP exporter")
-		exporterOpts, err = httpExporterOptions(cfg)
+		exporterOpts, err = otlpexporter.TraceHTTPOptions(&cfg.Config)
 		if err != nil {
 			return err
 		}
@@ -51,7 +52,7 @@ func Start(cfg *Config) error {
 		var exporterOpts []otlptracegrpc.Option
 
 		logger.Info("starting gRPC exporter")
-		exporterOpts, err = grpcExporterOptions(cfg)
+		exporterOpts, err = otlpexporter.TraceGRPCOptions(&cfg.Config)
 		if err != nil {
 			return err
 		}
@@ -80,6 +81,17 @@ func Start(cfg *Config) error {
 		}()
 	}
 
+	if cfg.Scenario != "" {
+		if ssp == nil {
+			ssp = sdktrace.NewSimpleSpanProcessor(exp)
+		}
+		if err = runScenario(cfg, ssp, logger); err != nil {
+			logger.Error("failed to execute the test scenario.", zap.Error(err))
+			return err
+		}
+		return nil
+	}
+
 	var attributes []attribute.KeyValue
 	attributes = append(attributes, cfg.GetAttributes()...)
 
@@ -103,6 +115,12 @@ func Start(cfg *Config) error {
 
 // run executes the test scenario.
 func run(c *Config, logger *zap.Logger) error {
+	return generate(c, nil, logger)
+}
+
+// generate runs the workers of the test scenario, which follow the scenario file
+// when its telemetry is given.
+func generate(c *Config, st *scenarioTelemetry, logger *zap.Logger) error {
 	if err := c.Validate(); err != nil {
 		return err
 	}
@@ -158,7 +176,11 @@ func run(c *Config, logger *zap.Logger) error {
 			spanContexts:     make([]trace.SpanContext, 0),
 		}
 
-		go w.simulateTraces(telemetryAttributes)
+		if st != nil {
+			go w.simulateScenarioTraces(st, telemetryAttributes)
+		} else {
+			go w.simulateTraces(telemetryAttributes)
+		}
 	}
 	if c.TotalDuration.Duration() > 0 && !c.TotalDuration.IsInf() {
 		time.Sleep(c.TotalDuration.Duration())
@@ -7,10 +7,12 @@ import (
 	"errors"
 	"strings"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confignet"
 	"go.opentelemetry.io/collector/config/configretry"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.uber.org/zap"
 )
 
 var (
@@ -19,6 +21,13 @@ var (
 	errUnsupportedNetwork  = errors.New("unsupported network: network is required, only tcp/udp/unix supported")
 	errUnsupportedProtocol = errors.New("unsupported protocol: Only rfc5424 and rfc3164 supported")
 	errOctetCounting       = errors.New("octet counting is only supported for rfc5424 protocol")
+	errStructuredData      = errors.New("structured data is only supported for rfc5424 protocol")
+	errStructuredDataID    = errors.New("structured data: id is required")
+	errStructuredDataSrc   = errors.New("structured data: unsupported source: only attributes and resource supported")
+	errPayloadFormat       = errors.New("payload: unsupported format: only cef and leef supported")
+	errPayloadMessage      = errors.New("payload: cannot be combined with the fields.message expression")
+	errPayloadEventID      = errors.New("payload: event_id is required")
+	errPayloadName         = errors.New("payload: name is required for the cef format")
 )
 
 // Config defines configuration for Syslog exporter.
@@ -37,6 +46,15 @@ type Config struct {
 	// Whether or not to enable RFC 6587 Octet Counting.
 	EnableOctetCounting bool `mapstructure:"enable_octet_counting"`
 
+	// Fields sets the syslog header fields and the message with OTTL expressions.
+	Fields FieldsConfig `mapstructure:"fields"`
+
+	// StructuredData builds SD-ELEMENTs from log record or resource attributes.
+	StructuredData []StructuredDataConfig `mapstructure:"structured_data"`
+
+	// Payload builds the message as a CEF or LEEF payload.
+	Payload PayloadConfig `mapstructure:"payload"`
+
 	// TLS struct exposes TLS client configuration.
 	TLS configtls.ClientConfig `mapstructure:"tls"`
 
@@ -75,6 +93,26 @@ func (cfg *Config) Validate() error {
 		invalidFields = append(invalidFields, errOctetCounting)
 	}
 
+	if len(cfg.StructuredData) > 0 && cfg.Protocol != protocolRFC5424Str {
+		invalidFields = append(invalidFields, errStructuredData)
+	}
+	for _, sd := range cfg.StructuredData {
+		if sd.ID == "" {
+			invalidFields = append(invalidFields, errStructuredDataID)
+		}
+		switch sd.Source {
+		case "", structuredDataSourceAttributes, structuredDataSourceResource:
+		default:
+			invalidFields = append(invalidFields, errStructuredDataSrc)
+		}
+	}
+
+	invalidFields = append(invalidFields, cfg.Payload.validate(cfg.Fields)...)
+
+	if _, err := newRecordMapper(cfg, component.TelemetrySettings{Logger: zap.NewNop()}); err != nil {
+		invalidFields = append(invalidFields, err)
+	}
+
 	if len(invalidFields) > 0 {
 		return errors.Join(invalidFields...)
 	}
@@ -82,6 +120,71 @@ func (cfg *Config) Validate() error {
 	return nil
 }
 
+// FieldsConfig holds OTTL value expressions, evaluated in the log context, that set the
+// syslog header fields and the message. A field without an expression, or whose expression
+// evaluates to nil, is read from the log record attributes instead.
+type FieldsConfig struct {
+	Priority  string `mapstructure:"priority"`
+	Timestamp string `mapstructure:"timestamp"`
+	Hostname  string `mapstructure:"hostname"`
+	Appname   string `mapstructure:"appname"`
+	ProcID    string `mapstructure:"proc_id"`
+	MsgID     string `mapstructure:"msg_id"`
+	Message   string `mapstructure:"message"`
+}
+
+// StructuredDataConfig defines an SD-ELEMENT built from the attributes matching a prefix.
+type StructuredDataConfig struct {
+	// ID is the SD-ID of the element, e.g. "k8s@32473".
+	ID string `mapstructure:"id"`
+	// Source of the attributes
+	// options: attributes (default), resource
+	Source string `mapstructure:"source"`
+	// Prefix selects the attributes to include, it is trimmed from the parameter names.
+	// All attributes are included when empty.
+	Prefix string `mapstructure:"prefix"`
+}
+
+// PayloadConfig defines how the message is built as a CEF or LEEF payload.
+type PayloadConfig struct {
+	// Format of the payload
+	// options: cef, leef
+	Format        string `mapstructure:"format"`
+	DeviceVendor  string `mapstructure:"device_vendor"`
+	DeviceProduct string `mapstructure:"device_product"`
+	DeviceVersion string `mapstructure:"device_version"`
+	// EventID is the OTTL expression of the CEF signature ID or LEEF event ID.
+	EventID string `mapstructure:"event_id"`
+	// Name is the OTTL expression of the CEF event name.
+	Name string `mapstructure:"name"`
+	// Severity is the OTTL expression of the CEF severity.
+	Severity string `mapstructure:"severity"`
+	// Extensions maps CEF extension or LEEF attribute keys to OTTL expressions.
+	Extensions map[string]string `mapstructure:"extensions"`
+}
+
+func (cfg *PayloadConfig) validate(fields FieldsConfig) []error {
+	var invalidFields []error
+	switch cfg.Format {
+	case "":
+		return nil
+	case payloadFormatCEF:
+		if cfg.Name == "" {
+			invalidFields = append(invalidFields, errPayloadName)
+		}
+	case payloadFormatLEEF:
+	default:
+		return []error{errPayloadFormat}
+	}
+	if cfg.EventID == "" {
+		invalidFields = append(invalidFields, errPayloadEventID)
+	}
+	if fields.Message != "" {
+		invalidFields = append(invalidFields, errPayloadMessage)
+	}
+	return invalidFields
+}
+
 const (
 	// Syslog Network
 	DefaultNetwork = string(confignet.TransportTypeTCP)
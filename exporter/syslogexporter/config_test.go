@@ -65,6 +65,71 @@ func TestValidate(t *testing.T) {
 			},
 			err: "invalid endpoint: endpoint is required but it is not configured",
 		},
+		{
+			name: "invalid structured data",
+			cfg: &Config{
+				Port:     514,
+				Endpoint: "host.domain.com",
+				Network:  "udp",
+				Protocol: "rfc3164",
+				StructuredData: []StructuredDataConfig{
+					{Source: "scope"},
+				},
+			},
+			err: "structured data is only supported for rfc5424 protocol" + "\n" +
+				"structured data: id is required" + "\n" +
+				"structured data: unsupported source: only attributes and resource supported",
+		},
+		{
+			name: "invalid payload",
+			cfg: &Config{
+				Port:     514,
+				Endpoint: "host.domain.com",
+				Network:  "udp",
+				Protocol: "rfc5424",
+				Fields: FieldsConfig{
+					Message: "body",
+				},
+				Payload: PayloadConfig{
+					Format: "cef",
+				},
+			},
+			err: "payload: name is required for the cef format" + "\n" +
+				"payload: event_id is required" + "\n" +
+				"payload: cannot be combined with the fields.message expression",
+		},
+		{
+			name: "invalid expression",
+			cfg: &Config{
+				Port:     514,
+				Endpoint: "host.domain.com",
+				Network:  "udp",
+				Protocol: "rfc5424",
+				Fields: FieldsConfig{
+					Hostname: `Unknown()`,
+				},
+			},
+			err: `invalid fields.hostname expression: undefined function "Unknown"`,
+		},
+		{
+			name: "valid mapping",
+			cfg: &Config{
+				Port:     514,
+				Endpoint: "host.domain.com",
+				Network:  "udp",
+				Protocol: "rfc5424",
+				Fields: FieldsConfig{
+					Hostname: `resource.attributes["host.name"]`,
+				},
+				StructuredData: []StructuredDataConfig{
+					{ID: "k8s@32473", Source: "resource", Prefix: "k8s."},
+				},
+				Payload: PayloadConfig{
+					Format:  "leef",
+					EventID: `attributes["event.id"]`,
+				},
+			},
+		},
 	}
 	for _, testInstance := range tests {
 		t.Run(testInstance.name, func(t *testing.T) {
@@ -23,6 +23,7 @@ type syslogexporter struct {
 	logger    *zap.Logger
 	tlsConfig *tls.Config
 	formatter formatter
+	mapper    *recordMapper
 }
 
 func initExporter(cfg *Config, createSettings exporter.Settings) (*syslogexporter, error) {
@@ -35,11 +36,17 @@ func initExporter(cfg *Config, createSettings exporter.Settings) (*syslogexporte
 		}
 	}
 
+	mapper, err := newRecordMapper(cfg, createSettings.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+
 	s := &syslogexporter{
 		config:    cfg,
 		logger:    createSettings.Logger,
 		tlsConfig: loadedTLSConfig,
 		formatter: createFormatter(cfg.Protocol, cfg.EnableOctetCounting),
+		mapper:    mapper,
 	}
 
 	s.logger.Info("Syslog Exporter configured",
@@ -92,7 +99,7 @@ func (se *syslogexporter) exportBatch(ctx context.Context, logs plog.Logs) error
 			scopeLogs := resourceLogs.ScopeLogs().At(j)
 			for k := 0; k < scopeLogs.LogRecords().Len(); k++ {
 				logRecord := scopeLogs.LogRecords().At(k)
-				formatted := se.formatter.format(logRecord)
+				formatted := se.format(ctx, resourceLogs, scopeLogs, logRecord)
 				payload.WriteString(formatted)
 			}
 		}
@@ -129,7 +136,7 @@ func (se *syslogexporter) exportNonBatch(ctx context.Context, logs plog.Logs) er
 			droppedScopeLogs := droppedResourceLogs.ScopeLogs().AppendEmpty()
 			for k := 0; k < scopeLogs.LogRecords().Len(); k++ {
 				logRecord := scopeLogs.LogRecords().At(k)
-				formatted := se.formatter.format(logRecord)
+				formatted := se.format(ctx, resourceLogs, scopeLogs, logRecord)
 				err = sender.Write(ctx, formatted)
 				if err != nil {
 					errs = append(errs, err)
@@ -147,3 +154,11 @@ func (se *syslogexporter) exportNonBatch(ctx context.Context, logs plog.Logs) er
 
 	return nil
 }
+
+// format formats a log record, after mapping it with the configured expressions if any.
+func (se *syslogexporter) format(ctx context.Context, resourceLogs plog.ResourceLogs, scopeLogs plog.ScopeLogs, logRecord plog.LogRecord) string {
+	if se.mapper != nil {
+		logRecord = se.mapper.mapRecord(ctx, resourceLogs, scopeLogs, logRecord)
+	}
+	return se.formatter.format(logRecord)
+}
@@ -0,0 +1,124 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package syslogexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/syslogexporter"
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+)
+
+const (
+	payloadFormatCEF  = "cef"
+	payloadFormatLEEF = "leef"
+
+	defaultCEFSeverity = "Unknown"
+)
+
+var (
+	headerEscaper       = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\r", " ", "\n", " ")
+	cefExtensionEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\r", `\r`, "\n", `\n`)
+	leefValueEscaper    = strings.NewReplacer("\t", " ", "\r", " ", "\n", " ")
+)
+
+// payloadBuilder builds a CEF or LEEF message from a log record.
+type payloadBuilder struct {
+	format        string
+	deviceVendor  string
+	deviceProduct string
+	deviceVersion string
+	eventID       valueExpression
+	name          valueExpression
+	severity      valueExpression
+	extensions    []fieldExpression
+}
+
+// sortExtensions orders the extensions by key, so that messages are built deterministically.
+func (b *payloadBuilder) sortExtensions() {
+	slices.SortFunc(b.extensions, func(a, b fieldExpression) int {
+		return strings.Compare(a.attribute, b.attribute)
+	})
+}
+
+func (b *payloadBuilder) build(ctx context.Context, tCtx *ottllog.TransformContext, m *recordMapper) string {
+	if b.format == payloadFormatLEEF {
+		return b.buildLEEF(ctx, tCtx, m)
+	}
+	return b.buildCEF(ctx, tCtx, m)
+}
+
+// buildCEF returns a CEF:0 message:
+// CEF:Version|Device Vendor|Device Product|Device Version|Signature ID|Name|Severity|Extension
+func (b *payloadBuilder) buildCEF(ctx context.Context, tCtx *ottllog.TransformContext, m *recordMapper) string {
+	severity := m.evalString(ctx, tCtx, fieldExpression{attribute: "severity", expression: b.severity})
+	if severity == "" {
+		severity = defaultCEFSeverity
+	}
+
+	var sb strings.Builder
+	sb.WriteString("CEF:0")
+	for _, field := range []string{
+		b.deviceVendor,
+		b.deviceProduct,
+		b.deviceVersion,
+		m.evalString(ctx, tCtx, fieldExpression{attribute: "event_id", expression: b.eventID}),
+		m.evalString(ctx, tCtx, fieldExpression{attribute: "name", expression: b.name}),
+		severity,
+	} {
+		sb.WriteByte('|')
+		sb.WriteString(headerEscaper.Replace(field))
+	}
+	sb.WriteByte('|')
+
+	first := true
+	for _, extension := range b.extensions {
+		value, ok := m.evalValue(ctx, tCtx, extension)
+		if !ok {
+			continue
+		}
+		if !first {
+			sb.WriteByte(' ')
+		}
+		first = false
+		sb.WriteString(extension.attribute)
+		sb.WriteByte('=')
+		sb.WriteString(cefExtensionEscaper.Replace(value.AsString()))
+	}
+	return sb.String()
+}
+
+// buildLEEF returns a LEEF:2.0 message using the default tab delimiter:
+// LEEF:Version|Vendor|Product|Version|EventID|Attributes
+func (b *payloadBuilder) buildLEEF(ctx context.Context, tCtx *ottllog.TransformContext, m *recordMapper) string {
+	var sb strings.Builder
+	sb.WriteString("LEEF:2.0")
+	for _, field := range []string{
+		b.deviceVendor,
+		b.deviceProduct,
+		b.deviceVersion,
+		m.evalString(ctx, tCtx, fieldExpression{attribute: "event_id", expression: b.eventID}),
+	} {
+		sb.WriteByte('|')
+		sb.WriteString(headerEscaper.Replace(field))
+	}
+	sb.WriteByte('|')
+
+	first := true
+	for _, extension := range b.extensions {
+		value, ok := m.evalValue(ctx, tCtx, extension)
+		if !ok {
+			continue
+		}
+		if !first {
+			sb.WriteByte('\t')
+		}
+		first = false
+		sb.WriteString(extension.attribute)
+		sb.WriteByte('=')
+		sb.WriteString(leefValueEscaper.Replace(value.AsString()))
+	}
+	return sb.String()
+}
@@ -0,0 +1,230 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package syslogexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/syslogexporter"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+)
+
+const (
+	structuredDataSourceAttributes = "attributes"
+	structuredDataSourceResource   = "resource"
+)
+
+type valueExpression = *ottl.ValueExpression[*ottllog.TransformContext]
+
+// fieldExpression sets the attribute read by the formatters from an OTTL expression.
+type fieldExpression struct {
+	attribute  string
+	expression valueExpression
+}
+
+// recordMapper builds the log record handed to the formatters from the configured
+// expressions, structured data and payload.
+type recordMapper struct {
+	logger         *zap.Logger
+	timestamp      valueExpression
+	fields         []fieldExpression
+	structuredData []StructuredDataConfig
+	payload        *payloadBuilder
+}
+
+// newRecordMapper returns a mapper for the configuration, or nil when the log records
+// can be formatted as they are.
+func newRecordMapper(cfg *Config, settings component.TelemetrySettings) (*recordMapper, error) {
+	parser, err := ottllog.NewParser(ottlfuncs.StandardConverters[*ottllog.TransformContext](), settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTTL parser: %w", err)
+	}
+
+	m := &recordMapper{
+		logger:         settings.Logger,
+		structuredData: cfg.StructuredData,
+	}
+	var errs error
+	parse := func(name, raw string) valueExpression {
+		if raw == "" {
+			return nil
+		}
+		expression, parseErr := parser.ParseValueExpression(raw)
+		if parseErr != nil {
+			errs = errors.Join(errs, fmt.Errorf("invalid %s expression: %w", name, parseErr))
+		}
+		return expression
+	}
+
+	m.timestamp = parse("fields.timestamp", cfg.Fields.Timestamp)
+	for _, field := range []struct {
+		attribute string
+		raw       string
+	}{
+		{attribute: priority, raw: cfg.Fields.Priority},
+		{attribute: hostname, raw: cfg.Fields.Hostname},
+		{attribute: app, raw: cfg.Fields.Appname},
+		{attribute: pid, raw: cfg.Fields.ProcID},
+		{attribute: msgID, raw: cfg.Fields.MsgID},
+		{attribute: message, raw: cfg.Fields.Message},
+	} {
+		if expression := parse("fields."+field.attribute, field.raw); expression != nil {
+			m.fields = append(m.fields, fieldExpression{attribute: field.attribute, expression: expression})
+		}
+	}
+
+	if cfg.Payload.Format != "" {
+		m.payload = &payloadBuilder{
+			format:        cfg.Payload.Format,
+			deviceVendor:  cfg.Payload.DeviceVendor,
+			deviceProduct: cfg.Payload.DeviceProduct,
+			deviceVersion: cfg.Payload.DeviceVersion,
+			eventID:       parse("payload.event_id", cfg.Payload.EventID),
+			name:          parse("payload.name", cfg.Payload.Name),
+			severity:      parse("payload.severity", cfg.Payload.Severity),
+		}
+		for key, raw := range cfg.Payload.Extensions {
+			if expression := parse("payload.extensions."+key, raw); expression != nil {
+				m.payload.extensions = append(m.payload.extensions, fieldExpression{attribute: key, expression: expression})
+			}
+		}
+		m.payload.sortExtensions()
+	}
+	if errs != nil {
+		return nil, errs
+	}
+
+	if m.timestamp == nil && len(m.fields) == 0 && len(m.structuredData) == 0 && m.payload == nil {
+		return nil, nil
+	}
+	return m, nil
+}
+
+// mapRecord returns a copy of the log record with the attributes read by the formatters set
+// from the configured expressions, structured data and payload.
+func (m *recordMapper) mapRecord(ctx context.Context, resourceLogs plog.ResourceLogs, scopeLogs plog.ScopeLogs, logRecord plog.LogRecord) plog.LogRecord {
+	tCtx := ottllog.NewTransformContextPtr(resourceLogs, scopeLogs, logRecord)
+	defer tCtx.Close()
+
+	mapped := plog.NewLogRecord()
+	logRecord.CopyTo(mapped)
+
+	if m.timestamp != nil {
+		if value, ok := m.eval(ctx, tCtx, "timestamp", m.timestamp); ok {
+			if timestamp, isTime := value.(time.Time); isTime {
+				mapped.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
+			} else {
+				m.logger.Debug("timestamp expression did not evaluate to a time", zap.Any("value", value))
+			}
+		}
+	}
+	for _, field := range m.fields {
+		if value, ok := m.evalValue(ctx, tCtx, field); ok {
+			value.CopyTo(mapped.Attributes().PutEmpty(field.attribute))
+		}
+	}
+	for _, sd := range m.structuredData {
+		source := logRecord.Attributes()
+		if sd.Source == structuredDataSourceResource {
+			source = resourceLogs.Resource().Attributes()
+		}
+		appendStructuredData(mapped, source, sd)
+	}
+	if m.payload != nil {
+		mapped.Attributes().PutStr(message, m.payload.build(ctx, tCtx, m))
+	}
+	return mapped
+}
+
+// appendStructuredData adds an SD-ELEMENT built from the source attributes to the structured_data
+// attribute, merging its parameters with an existing element of the same ID.
+func appendStructuredData(logRecord plog.LogRecord, source pcommon.Map, sd StructuredDataConfig) {
+	params := pcommon.NewMap()
+	for key, value := range source.All() {
+		if key == structuredData || !strings.HasPrefix(key, sd.Prefix) {
+			continue
+		}
+		name := strings.TrimPrefix(key, sd.Prefix)
+		if name == "" {
+			continue
+		}
+		params.PutStr(name, value.AsString())
+	}
+	if params.Len() == 0 {
+		return
+	}
+
+	var sdMap pcommon.Map
+	if sdValue, found := logRecord.Attributes().Get(structuredData); found && sdValue.Type() == pcommon.ValueTypeMap {
+		sdMap = sdValue.Map()
+	} else {
+		sdMap = logRecord.Attributes().PutEmptyMap(structuredData)
+	}
+	var element pcommon.Map
+	if elementValue, found := sdMap.Get(sd.ID); found && elementValue.Type() == pcommon.ValueTypeMap {
+		element = elementValue.Map()
+	} else {
+		element = sdMap.PutEmptyMap(sd.ID)
+	}
+	for name, value := range params.All() {
+		element.PutStr(name, value.Str())
+	}
+}
+
+// eval evaluates an expression, logging errors and reporting nil results as not found.
+func (m *recordMapper) eval(ctx context.Context, tCtx *ottllog.TransformContext, name string, expression valueExpression) (any, bool) {
+	value, err := expression.Eval(ctx, tCtx)
+	if err != nil {
+		m.logger.Debug("failed to evaluate expression", zap.String("field", name), zap.Error(err))
+		return nil, false
+	}
+	return value, value != nil
+}
+
+// evalValue evaluates an expression to a pcommon.Value.
+func (m *recordMapper) evalValue(ctx context.Context, tCtx *ottllog.TransformContext, field fieldExpression) (pcommon.Value, bool) {
+	raw, ok := m.eval(ctx, tCtx, field.attribute, field.expression)
+	if !ok {
+		return pcommon.Value{}, false
+	}
+	switch v := raw.(type) {
+	case pcommon.Value:
+		return v, v.Type() != pcommon.ValueTypeEmpty
+	case pcommon.Map:
+		value := pcommon.NewValueMap()
+		v.CopyTo(value.Map())
+		return value, true
+	case pcommon.Slice:
+		value := pcommon.NewValueSlice()
+		v.CopyTo(value.Slice())
+		return value, true
+	}
+	value := pcommon.NewValueEmpty()
+	if err := value.FromRaw(raw); err != nil {
+		value.SetStr(fmt.Sprint(raw))
+	}
+	return value, true
+}
+
+// evalString evaluates an expression to a string, returning an empty string when it evaluates to nil.
+func (m *recordMapper) evalString(ctx context.Context, tCtx *ottllog.TransformContext, field fieldExpression) string {
+	if field.expression == nil {
+		return ""
+	}
+	value, ok := m.evalValue(ctx, tCtx, field)
+	if !ok {
+		return ""
+	}
+	return value.AsString()
+}
@@ -0,0 +1,166 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package syslogexporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func newTestLogs() (plog.ResourceLogs, plog.ScopeLogs, plog.LogRecord) {
+	logs := plog.NewLogs()
+	resourceLogs := logs.ResourceLogs().AppendEmpty()
+	resourceLogs.Resource().Attributes().PutStr("host.name", "node-1")
+	resourceLogs.Resource().Attributes().PutStr("service.name", "checkout")
+	resourceLogs.Resource().Attributes().PutStr("k8s.namespace.name", "shop")
+	resourceLogs.Resource().Attributes().PutStr("k8s.pod.name", "checkout-7d9f")
+	scopeLogs := resourceLogs.ScopeLogs().AppendEmpty()
+	logRecord := scopeLogs.LogRecords().AppendEmpty()
+	logRecord.Body().SetStr("payment declined")
+	logRecord.Attributes().PutStr("user.name", "alice")
+	logRecord.Attributes().PutStr("client.address", "10.0.0.7")
+	logRecord.Attributes().PutInt("event.code", 4625)
+	logRecord.Attributes().PutStr("appname", "legacy")
+	timestamp, _ := time.Parse(time.RFC3339, "2024-03-01T10:00:00Z")
+	logRecord.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
+	return resourceLogs, scopeLogs, logRecord
+}
+
+func TestRecordMapper(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      Config
+		expected string
+	}{
+		{
+			name:     "no mapping",
+			cfg:      Config{Protocol: protocolRFC5424Str},
+			expected: "<165>1 2024-03-01T10:00:00Z - legacy - - -\n",
+		},
+		{
+			name: "fields",
+			cfg: Config{
+				Protocol: protocolRFC5424Str,
+				Fields: FieldsConfig{
+					Priority:  `84`,
+					Timestamp: `Time("2024-03-01 11:30:00", "%Y-%m-%d %H:%M:%S")`,
+					Hostname:  `resource.attributes["host.name"]`,
+					Appname:   `resource.attributes["service.name"]`,
+					ProcID:    `attributes["missing"]`,
+					MsgID:     `attributes["event.code"]`,
+					Message:   `Concat([attributes["user.name"], body], ": ")`,
+				},
+			},
+			expected: "<84>1 2024-03-01T11:30:00Z node-1 checkout - 4625 - alice: payment declined\n",
+		},
+		{
+			name: "structured data",
+			cfg: Config{
+				Protocol: protocolRFC5424Str,
+				StructuredData: []StructuredDataConfig{
+					{ID: "k8s@32473", Source: structuredDataSourceResource, Prefix: "k8s."},
+					{ID: "client@32473", Prefix: "client."},
+					{ID: "none@32473", Prefix: "missing."},
+				},
+			},
+			expected: `<165>1 2024-03-01T10:00:00Z - legacy - - [client@32473 address="10.0.0.7"][k8s@32473 namespace.name="shop" pod.name="checkout-7d9f"]` + "\n",
+		},
+		{
+			name: "cef payload",
+			cfg: Config{
+				Protocol: protocolRFC3164Str,
+				Fields: FieldsConfig{
+					Hostname: `resource.attributes["host.name"]`,
+				},
+				Payload: PayloadConfig{
+					Format:        payloadFormatCEF,
+					DeviceVendor:  "Acme",
+					DeviceProduct: "Shop|Checkout",
+					DeviceVersion: "1.0",
+					EventID:       `attributes["event.code"]`,
+					Name:          `body`,
+					Extensions: map[string]string{
+						"suser":   `attributes["user.name"]`,
+						"src":     `attributes["client.address"]`,
+						"msg":     `"a=b"`,
+						"missing": `attributes["missing"]`,
+					},
+				},
+			},
+			expected: `<165>Mar 01 10:00:00 node-1 legacy: CEF:0|Acme|Shop\|Checkout|1.0|4625|payment declined|Unknown|msg=a\=b src=10.0.0.7 suser=alice` + "\n",
+		},
+		{
+			name: "leef payload",
+			cfg: Config{
+				Protocol: protocolRFC5424Str,
+				Payload: PayloadConfig{
+					Format:        payloadFormatLEEF,
+					DeviceVendor:  "Acme",
+					DeviceProduct: "Shop",
+					DeviceVersion: "1.0",
+					EventID:       `attributes["event.code"]`,
+					Extensions: map[string]string{
+						"usrName": `attributes["user.name"]`,
+						"src":     `attributes["client.address"]`,
+					},
+				},
+			},
+			expected: "<165>1 2024-03-01T10:00:00Z - legacy - - - LEEF:2.0|Acme|Shop|1.0|4625|src=10.0.0.7\tusrName=alice\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapper, err := newRecordMapper(&tt.cfg, componenttest.NewNopTelemetrySettings())
+			require.NoError(t, err)
+
+			resourceLogs, scopeLogs, logRecord := newTestLogs()
+			if mapper != nil {
+				mapped := mapper.mapRecord(t.Context(), resourceLogs, scopeLogs, logRecord)
+				_, found := logRecord.Attributes().Get("hostname")
+				assert.False(t, found, "the original log record must not be modified")
+				logRecord = mapped
+			}
+			assert.Equal(t, tt.expected, createFormatter(tt.cfg.Protocol, false).format(logRecord))
+		})
+	}
+}
+
+func TestRecordMapperMergesStructuredData(t *testing.T) {
+	mapper, err := newRecordMapper(&Config{
+		Protocol: protocolRFC5424Str,
+		StructuredData: []StructuredDataConfig{
+			{ID: "origin@32473", Prefix: "client."},
+		},
+	}, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	resourceLogs, scopeLogs, logRecord := newTestLogs()
+	sd := logRecord.Attributes().PutEmptyMap("structured_data")
+	sd.PutEmptyMap("origin@32473").PutStr("ip", "192.0.2.1")
+
+	mapped := mapper.mapRecord(t.Context(), resourceLogs, scopeLogs, logRecord)
+	assert.Equal(t, `[origin@32473 address="10.0.0.7" ip="192.0.2.1"]`, newRFC5424Formatter(false).formatStructuredData(mapped))
+}
+
+func TestNewRecordMapperInvalidExpression(t *testing.T) {
+	_, err := newRecordMapper(&Config{
+		Fields: FieldsConfig{
+			Hostname: `resource.attributes["host.name"`,
+		},
+		Payload: PayloadConfig{
+			Format:  payloadFormatLEEF,
+			EventID: `Unknown()`,
+		},
+	}, componenttest.NewNopTelemetrySettings())
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "invalid fields.hostname expression")
+	assert.ErrorContains(t, err, "invalid payload.event_id expression")
+}
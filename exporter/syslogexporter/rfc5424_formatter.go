@@ -5,6 +5,8 @@ package syslogexporter // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -80,14 +82,15 @@ func (*rfc5424Formatter) formatStructuredData(logRecord plog.LogRecord) string {
 	}
 
 	var sdBuilder strings.Builder
-	for key, val := range structuredDataAttributeValue.Map().AsRaw() {
+	sdMap := structuredDataAttributeValue.Map().AsRaw()
+	for _, key := range slices.Sorted(maps.Keys(sdMap)) {
 		sdElements := []string{key}
-		vval, ok := val.(map[string]any)
+		vval, ok := sdMap[key].(map[string]any)
 		if !ok {
 			continue
 		}
-		for k, v := range vval {
-			vv, ok := v.(string)
+		for _, k := range slices.Sorted(maps.Keys(vval)) {
+			vv, ok := vval[k].(string)
 			if !ok {
 				continue
 			}
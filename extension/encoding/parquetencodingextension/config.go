@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package parquetencodingextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/encoding/parquetencodingextension"
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/apache/arrow-go/v18/parquet/compress"
+	"go.opentelemetry.io/collector/confmap/xconfmap"
+)
+
+const (
+	compressionNone   = "none"
+	compressionSnappy = "snappy"
+	compressionGzip   = "gzip"
+	compressionZstd   = "zstd"
+	compressionBrotli = "brotli"
+	compressionLz4Raw = "lz4_raw"
+)
+
+var compressionCodecs = map[string]compress.Compression{
+	compressionNone:   compress.Codecs.Uncompressed,
+	compressionSnappy: compress.Codecs.Snappy,
+	compressionGzip:   compress.Codecs.Gzip,
+	compressionZstd:   compress.Codecs.Zstd,
+	compressionBrotli: compress.Codecs.Brotli,
+	compressionLz4Raw: compress.Codecs.Lz4Raw,
+}
+
+var _ xconfmap.Validator = (*Config)(nil)
+
+type Config struct {
+	// Compression is the codec used to compress the Parquet column chunks.
+	// One of none, snappy, gzip, zstd, brotli or lz4_raw.
+	Compression string `mapstructure:"compression"`
+	// RowGroupSize is the maximum number of rows written to a single row group.
+	RowGroupSize int64 `mapstructure:"row_group_size"`
+	// PromotedAttributes lists the attributes that are copied to their own
+	// columns in addition to the JSON encoded attribute columns.
+	PromotedAttributes PromotedAttributes `mapstructure:"promoted_attributes"`
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// PromotedAttributes configures the attributes that get a dedicated column.
+type PromotedAttributes struct {
+	// Resource attributes are promoted to columns named "resource_<key>".
+	Resource []string `mapstructure:"resource"`
+	// Record attributes of log records, spans and data points are promoted
+	// to columns named "attribute_<key>".
+	Record []string `mapstructure:"record"`
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+func (c *Config) Validate() error {
+	if _, ok := compressionCodecs[c.Compression]; !ok {
+		return fmt.Errorf("unsupported compression: %q", c.Compression)
+	}
+
+	if c.RowGroupSize <= 0 {
+		return errors.New("row_group_size must be positive")
+	}
+
+	columns := map[string]string{}
+	for _, name := range reservedColumnNames() {
+		columns[name] = ""
+	}
+	promoted := append(promotedColumns(resourceColumnPrefix, c.PromotedAttributes.Resource),
+		promotedColumns(attributeColumnPrefix, c.PromotedAttributes.Record)...)
+	for _, col := range promoted {
+		if col.key == "" {
+			return errors.New("promoted attribute keys must not be empty")
+		}
+		if other, ok := columns[col.name]; ok {
+			if other == "" {
+				return fmt.Errorf("promoted attribute %q conflicts with column %q", col.key, col.name)
+			}
+			return fmt.Errorf("promoted attributes %q and %q map to the same column %q", other, col.key, col.name)
+		}
+		columns[col.name] = col.key
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package parquetencodingextension
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/confmap/xconfmap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/encoding/parquetencodingextension/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr string
+	}{
+		{
+			id:       component.NewIDWithName(metadata.Type, ""),
+			expected: createDefaultConfig(),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "custom"),
+			expected: &Config{
+				Compression:  compressionZstd,
+				RowGroupSize: 1000,
+				PromotedAttributes: PromotedAttributes{
+					Resource: []string{"k8s.namespace.name", "k8s.pod.name"},
+					Record:   []string{"http.route"},
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_compression"),
+			expectedErr: `unsupported compression: "lzo"`,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_row_group_size"),
+			expectedErr: "row_group_size must be positive",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "empty_key"),
+			expectedErr: "promoted attribute keys must not be empty",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "reserved_column"),
+			expectedErr: `promoted attribute "attributes" conflicts with column "resource_attributes"`,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "duplicate_column"),
+			expectedErr: `promoted attributes "http.route" and "http_route" map to the same column "attribute_http_route"`,
+		},
+	}
+
+	for _, tt := range tests {
+		name := strings.ReplaceAll(tt.id.String(), "/", "_")
+		t.Run(name, func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			err = xconfmap.Validate(cfg)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, cfg)
+			}
+		})
+	}
+}
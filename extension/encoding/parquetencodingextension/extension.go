@@ -0,0 +1,45 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package parquetencodingextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/encoding/parquetencodingextension"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/encoding"
+)
+
+var (
+	_ encoding.LogsMarshalerExtension      = (*parquetExtension)(nil)
+	_ encoding.LogsUnmarshalerExtension    = (*parquetExtension)(nil)
+	_ encoding.TracesMarshalerExtension    = (*parquetExtension)(nil)
+	_ encoding.TracesUnmarshalerExtension  = (*parquetExtension)(nil)
+	_ encoding.MetricsMarshalerExtension   = (*parquetExtension)(nil)
+	_ encoding.MetricsUnmarshalerExtension = (*parquetExtension)(nil)
+)
+
+type parquetExtension struct {
+	config        *Config
+	logsSchema    *signalSchema
+	tracesSchema  *signalSchema
+	metricsSchema *signalSchema
+}
+
+func newExtension(config *Config) *parquetExtension {
+	return &parquetExtension{
+		config:        config,
+		logsSchema:    newSignalSchema(logFields, config.PromotedAttributes),
+		tracesSchema:  newSignalSchema(spanFields, config.PromotedAttributes),
+		metricsSchema: newSignalSchema(dataPointFields, config.PromotedAttributes),
+	}
+}
+
+func (*parquetExtension) Start(context.Context, component.Host) error {
+	return nil
+}
+
+func (*parquetExtension) Shutdown(context.Context) error {
+	return nil
+}
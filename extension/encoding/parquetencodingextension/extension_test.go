@@ -0,0 +1,431 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package parquetencodingextension
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func newTestExtension(t *testing.T, modify func(*Config)) *parquetExtension {
+	t.Helper()
+	cfg := createDefaultConfig().(*Config)
+	if modify != nil {
+		modify(cfg)
+	}
+	require.NoError(t, cfg.Validate())
+	return newExtension(cfg)
+}
+
+func newTestResource(dest pcommon.Resource, service string) {
+	dest.Attributes().PutStr("k8s.pod.name", service+"-0")
+	dest.Attributes().PutInt("replicas", 3)
+	dest.Attributes().PutStr("service.name", service)
+}
+
+func newTestLogs() plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.SetSchemaUrl("https://opentelemetry.io/schemas/1.37.0")
+	newTestResource(rl.Resource(), "checkout")
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("github.com/example/logger")
+	sl.Scope().SetVersion("v1.2.3")
+	sl.Scope().Attributes().PutBool("sampled", true)
+
+	lr := sl.LogRecords().AppendEmpty()
+	lr.SetTimestamp(pcommon.Timestamp(1700000000000000001))
+	lr.SetObservedTimestamp(pcommon.Timestamp(1700000000000000002))
+	lr.SetSeverityNumber(plog.SeverityNumberWarn)
+	lr.SetSeverityText("WARN")
+	lr.Body().SetStr("payment declined")
+	lr.SetEventName("payment.declined")
+	lr.SetTraceID(pcommon.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	lr.SetSpanID(pcommon.SpanID{1, 2, 3, 4, 5, 6, 7, 8})
+	lr.SetFlags(plog.DefaultLogRecordFlags.WithIsSampled(true))
+	lr.Attributes().PutDouble("amount", 12.5)
+	lr.Attributes().PutStr("http.route", "/pay")
+
+	lr = sl.LogRecords().AppendEmpty()
+	lr.SetTimestamp(pcommon.Timestamp(1700000000000000003))
+	body := lr.Body().SetEmptyMap()
+	body.PutStr("msg", "structured")
+	body.PutEmptySlice("values").FromRaw([]any{int64(1), "two"})
+
+	lr = sl.LogRecords().AppendEmpty()
+	lr.Body().SetEmptyBytes().FromRaw([]byte("raw"))
+
+	sl = rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("github.com/example/other")
+	sl.LogRecords().AppendEmpty().Body().SetInt(42)
+
+	rl = ld.ResourceLogs().AppendEmpty()
+	newTestResource(rl.Resource(), "cart")
+	lr = rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.Body().SetDouble(1.5)
+	lr.Attributes().PutStr("http.route", "/cart")
+	return ld
+}
+
+func newTestTraces() ptrace.Traces {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	newTestResource(rs.Resource(), "checkout")
+	ss := rs.ScopeSpans().AppendEmpty()
+	ss.Scope().SetName("github.com/example/tracer")
+	ss.SetSchemaUrl("https://opentelemetry.io/schemas/1.37.0")
+
+	span := ss.Spans().AppendEmpty()
+	span.SetTraceID(pcommon.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	span.SetSpanID(pcommon.SpanID{1, 2, 3, 4, 5, 6, 7, 8})
+	span.SetParentSpanID(pcommon.SpanID{8, 7, 6, 5, 4, 3, 2, 1})
+	span.TraceState().FromRaw("vendor=value")
+	span.SetName("POST /pay")
+	span.SetKind(ptrace.SpanKindServer)
+	span.SetStartTimestamp(pcommon.Timestamp(1700000000000000000))
+	span.SetEndTimestamp(pcommon.Timestamp(1700000000250000000))
+	span.Status().SetCode(ptrace.StatusCodeError)
+	span.Status().SetMessage("declined")
+	span.SetFlags(1)
+	span.Attributes().PutInt("http.response.status_code", 402)
+	span.Attributes().PutStr("http.route", "/pay")
+
+	event := span.Events().AppendEmpty()
+	event.SetTimestamp(pcommon.Timestamp(1700000000100000000))
+	event.SetName("exception")
+	event.Attributes().PutStr("exception.type", "PaymentError")
+
+	link := span.Links().AppendEmpty()
+	link.SetTraceID(pcommon.TraceID{16, 15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1})
+	link.SetSpanID(pcommon.SpanID{9, 9, 9, 9, 9, 9, 9, 9})
+	link.TraceState().FromRaw("other=state")
+	link.SetFlags(1)
+	link.Attributes().PutDouble("weight", 0.5)
+
+	span = ss.Spans().AppendEmpty()
+	span.SetTraceID(pcommon.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	span.SetSpanID(pcommon.SpanID{2, 2, 2, 2, 2, 2, 2, 2})
+	span.SetName("db.query")
+	span.SetKind(ptrace.SpanKindClient)
+
+	rs = td.ResourceSpans().AppendEmpty()
+	newTestResource(rs.Resource(), "cart")
+	rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("GET /cart")
+	return td
+}
+
+func newTestMetrics() pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	newTestResource(rm.Resource(), "checkout")
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName("github.com/example/meter")
+
+	m := sm.Metrics().AppendEmpty()
+	m.SetName("queue.size")
+	m.SetUnit("{item}")
+	dp := m.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetTimestamp(pcommon.Timestamp(1700000000000000000))
+	dp.SetIntValue(7)
+	dp.Attributes().PutStr("queue", "a")
+	dp = m.Gauge().DataPoints().AppendEmpty()
+	dp.SetTimestamp(pcommon.Timestamp(1700000000000000000))
+	dp.SetIntValue(9)
+	dp.Attributes().PutStr("queue", "b")
+
+	m = sm.Metrics().AppendEmpty()
+	m.SetName("requests")
+	m.SetDescription("Number of requests")
+	sum := m.SetEmptySum()
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	sum.SetIsMonotonic(true)
+	dp = sum.DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(pcommon.Timestamp(1690000000000000000))
+	dp.SetTimestamp(pcommon.Timestamp(1700000000000000000))
+	dp.SetDoubleValue(12.5)
+	dp.SetFlags(pmetric.DefaultDataPointFlags.WithNoRecordedValue(true))
+	dp.Attributes().PutStr("http.route", "/pay")
+
+	m = sm.Metrics().AppendEmpty()
+	m.SetName("latency")
+	m.SetUnit("ms")
+	hist := m.SetEmptyHistogram()
+	hist.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	hdp := hist.DataPoints().AppendEmpty()
+	hdp.SetTimestamp(pcommon.Timestamp(1700000000000000000))
+	hdp.SetCount(6)
+	hdp.SetSum(120.5)
+	hdp.SetMin(1)
+	hdp.SetMax(80)
+	hdp.BucketCounts().FromRaw([]uint64{1, 2, 3})
+	hdp.ExplicitBounds().FromRaw([]float64{10, 50})
+	hdp = hist.DataPoints().AppendEmpty()
+	hdp.SetCount(0)
+
+	m = sm.Metrics().AppendEmpty()
+	m.SetName("latency.exponential")
+	ehist := m.SetEmptyExponentialHistogram()
+	ehist.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	edp := ehist.DataPoints().AppendEmpty()
+	edp.SetTimestamp(pcommon.Timestamp(1700000000000000000))
+	edp.SetCount(10)
+	edp.SetSum(55.5)
+	edp.SetScale(2)
+	edp.SetZeroCount(1)
+	edp.SetZeroThreshold(0.001)
+	edp.Positive().SetOffset(-1)
+	edp.Positive().BucketCounts().FromRaw([]uint64{3, 4})
+	edp.Negative().SetOffset(1)
+	edp.Negative().BucketCounts().FromRaw([]uint64{2})
+
+	m = sm.Metrics().AppendEmpty()
+	m.SetName("latency.summary")
+	sdp := m.SetEmptySummary().DataPoints().AppendEmpty()
+	sdp.SetTimestamp(pcommon.Timestamp(1700000000000000000))
+	sdp.SetCount(4)
+	sdp.SetSum(40)
+	qv := sdp.QuantileValues().AppendEmpty()
+	qv.SetQuantile(0.5)
+	qv.SetValue(8)
+	qv = sdp.QuantileValues().AppendEmpty()
+	qv.SetQuantile(0.99)
+	qv.SetValue(20)
+
+	rm = md.ResourceMetrics().AppendEmpty()
+	newTestResource(rm.Resource(), "cart")
+	m = rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("queue.size")
+	m.SetEmptyGauge().DataPoints().AppendEmpty().SetDoubleValue(1.5)
+	return md
+}
+
+// The attributes of the test data are sorted by key, as attribute maps are
+// encoded as JSON objects which do not preserve the order of their keys.
+func assertLogsEqual(t *testing.T, expected, actual plog.Logs) {
+	var m plog.JSONMarshaler
+	expectedJSON, err := m.MarshalLogs(expected)
+	require.NoError(t, err)
+	actualJSON, err := m.MarshalLogs(actual)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(expectedJSON), string(actualJSON))
+}
+
+func assertTracesEqual(t *testing.T, expected, actual ptrace.Traces) {
+	var m ptrace.JSONMarshaler
+	expectedJSON, err := m.MarshalTraces(expected)
+	require.NoError(t, err)
+	actualJSON, err := m.MarshalTraces(actual)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(expectedJSON), string(actualJSON))
+}
+
+func assertMetricsEqual(t *testing.T, expected, actual pmetric.Metrics) {
+	var m pmetric.JSONMarshaler
+	expectedJSON, err := m.MarshalMetrics(expected)
+	require.NoError(t, err)
+	actualJSON, err := m.MarshalMetrics(actual)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(expectedJSON), string(actualJSON))
+}
+
+// readColumn returns the string values of a column of a Parquet file.
+func readColumn(t *testing.T, buf []byte, name string) []string {
+	table, err := pqarrow.ReadTable(context.Background(), bytes.NewReader(buf), nil, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	require.NoError(t, err)
+	defer table.Release()
+
+	indices := table.Schema().FieldIndices(name)
+	require.Len(t, indices, 1, "column %q not found", name)
+	var values []string
+	for _, chunk := range table.Column(indices[0]).Data().Chunks() {
+		col := chunk.(*array.String)
+		for i := 0; i < col.Len(); i++ {
+			if col.IsNull(i) {
+				values = append(values, "")
+			} else {
+				values = append(values, col.Value(i))
+			}
+		}
+	}
+	return values
+}
+
+func TestLogsRoundTrip(t *testing.T) {
+	for compression := range compressionCodecs {
+		t.Run(compression, func(t *testing.T) {
+			ext := newTestExtension(t, func(cfg *Config) {
+				cfg.Compression = compression
+			})
+			ld := newTestLogs()
+			buf, err := ext.MarshalLogs(ld)
+			require.NoError(t, err)
+
+			actual, err := ext.UnmarshalLogs(buf)
+			require.NoError(t, err)
+			assertLogsEqual(t, ld, actual)
+		})
+	}
+}
+
+func TestTracesRoundTrip(t *testing.T) {
+	ext := newTestExtension(t, nil)
+	td := newTestTraces()
+	buf, err := ext.MarshalTraces(td)
+	require.NoError(t, err)
+
+	actual, err := ext.UnmarshalTraces(buf)
+	require.NoError(t, err)
+	assertTracesEqual(t, td, actual)
+	assert.Equal(t, []string{"checkout", "checkout", "cart"}, readColumn(t, buf, columnServiceName))
+	assert.Equal(t, []string{"Server", "Client", "Unspecified"}, readColumn(t, buf, columnKind))
+}
+
+func TestMetricsRoundTrip(t *testing.T) {
+	ext := newTestExtension(t, nil)
+	md := newTestMetrics()
+	buf, err := ext.MarshalMetrics(md)
+	require.NoError(t, err)
+
+	actual, err := ext.UnmarshalMetrics(buf)
+	require.NoError(t, err)
+	assertMetricsEqual(t, md, actual)
+	assert.Equal(t, []string{
+		"Gauge", "Gauge", "Sum", "Histogram", "Histogram", "ExponentialHistogram", "Summary", "Gauge",
+	}, readColumn(t, buf, columnMetricType))
+}
+
+// putTypedValues puts values whose type is lost by a plain JSON encoding, sorted by key.
+func putTypedValues(m pcommon.Map) {
+	m.PutDouble("-inf", math.Inf(-1))
+	m.PutEmptyBytes("bytes").FromRaw([]byte{0, 1, 2})
+	m.PutEmpty("empty")
+	m.PutDouble("inf", math.Inf(1))
+	m.PutEmptyMap("map").PutDouble("whole", 4)
+	m.PutInt("max", math.MaxInt64)
+	m.PutDouble("nan", math.NaN())
+	m.PutEmptySlice("slice").FromRaw([]any{float64(3), []byte("b"), true})
+	m.PutDouble("whole", 2)
+}
+
+func TestAttributeTypesRoundTrip(t *testing.T) {
+	ext := newTestExtension(t, nil)
+
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	putTypedValues(rl.Resource().Attributes())
+	lr := rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	putTypedValues(lr.Attributes())
+	putTypedValues(lr.Body().SetEmptyMap())
+	lr = rl.ScopeLogs().At(0).LogRecords().AppendEmpty()
+	lr.Body().SetEmptySlice().FromRaw([]any{float64(5), []byte("c")})
+	buf, err := ext.MarshalLogs(ld)
+	require.NoError(t, err)
+	actualLogs, err := ext.UnmarshalLogs(buf)
+	require.NoError(t, err)
+	assertLogsEqual(t, ld, actualLogs)
+	assert.Contains(t, readColumn(t, buf, columnAttributes)[0], `"whole":{"doubleValue":2}`)
+
+	td := ptrace.NewTraces()
+	span := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	putTypedValues(span.Events().AppendEmpty().Attributes())
+	putTypedValues(span.Links().AppendEmpty().Attributes())
+	buf, err = ext.MarshalTraces(td)
+	require.NoError(t, err)
+	actualTraces, err := ext.UnmarshalTraces(buf)
+	require.NoError(t, err)
+	assertTracesEqual(t, td, actualTraces)
+}
+
+func TestPromotedAttributes(t *testing.T) {
+	ext := newTestExtension(t, func(cfg *Config) {
+		cfg.PromotedAttributes = PromotedAttributes{
+			Resource: []string{"k8s.pod.name", "replicas"},
+			Record:   []string{"http.route"},
+		}
+	})
+
+	buf, err := ext.MarshalLogs(newTestLogs())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"checkout-0", "checkout-0", "checkout-0", "checkout-0", "cart-0"}, readColumn(t, buf, "resource_k8s_pod_name"))
+	assert.Equal(t, []string{"3", "3", "3", "3", "3"}, readColumn(t, buf, "resource_replicas"))
+	assert.Equal(t, []string{"/pay", "", "", "", "/cart"}, readColumn(t, buf, "attribute_http_route"))
+
+	// Promoted columns are redundant and must not change the unmarshaled data.
+	actual, err := ext.UnmarshalLogs(buf)
+	require.NoError(t, err)
+	assertLogsEqual(t, newTestLogs(), actual)
+
+	buf, err = ext.MarshalTraces(newTestTraces())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/pay", "", ""}, readColumn(t, buf, "attribute_http_route"))
+
+	buf, err = ext.MarshalMetrics(newTestMetrics())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"", "", "/pay", "", "", "", "", ""}, readColumn(t, buf, "attribute_http_route"))
+}
+
+func TestRowGroupSize(t *testing.T) {
+	ext := newTestExtension(t, func(cfg *Config) {
+		cfg.RowGroupSize = 2
+	})
+	ld := newTestLogs()
+	buf, err := ext.MarshalLogs(ld)
+	require.NoError(t, err)
+
+	reader, err := file.NewParquetReader(bytes.NewReader(buf))
+	require.NoError(t, err)
+	defer reader.Close()
+	assert.Equal(t, 3, reader.NumRowGroups())
+	assert.Equal(t, int64(5), reader.NumRows())
+
+	actual, err := ext.UnmarshalLogs(buf)
+	require.NoError(t, err)
+	assertLogsEqual(t, ld, actual)
+}
+
+func TestEmpty(t *testing.T) {
+	ext := newTestExtension(t, nil)
+
+	buf, err := ext.MarshalLogs(plog.NewLogs())
+	require.NoError(t, err)
+	ld, err := ext.UnmarshalLogs(buf)
+	require.NoError(t, err)
+	assert.Equal(t, 0, ld.ResourceLogs().Len())
+
+	buf, err = ext.MarshalTraces(ptrace.NewTraces())
+	require.NoError(t, err)
+	td, err := ext.UnmarshalTraces(buf)
+	require.NoError(t, err)
+	assert.Equal(t, 0, td.ResourceSpans().Len())
+
+	buf, err = ext.MarshalMetrics(pmetric.NewMetrics())
+	require.NoError(t, err)
+	md, err := ext.UnmarshalMetrics(buf)
+	require.NoError(t, err)
+	assert.Equal(t, 0, md.ResourceMetrics().Len())
+}
+
+func TestUnmarshalInvalid(t *testing.T) {
+	ext := newTestExtension(t, nil)
+
+	_, err := ext.UnmarshalLogs([]byte("not parquet"))
+	assert.ErrorContains(t, err, "failed to read parquet file")
+	_, err = ext.UnmarshalTraces([]byte("not parquet"))
+	assert.ErrorContains(t, err, "failed to read parquet file")
+	_, err = ext.UnmarshalMetrics([]byte("not parquet"))
+	assert.ErrorContains(t, err, "failed to read parquet file")
+}
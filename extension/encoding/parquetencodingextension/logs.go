@@ -0,0 +1,201 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package parquetencodingextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/encoding/parquetencodingextension"
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+const (
+	columnTimestamp         = "timestamp"
+	columnObservedTimestamp = "observed_timestamp"
+	columnSeverityNumber    = "severity_number"
+	columnSeverityText      = "severity_text"
+	columnBody              = "body"
+	columnBodyType          = "body_type"
+	columnEventName         = "event_name"
+	columnTraceID           = "trace_id"
+	columnSpanID            = "span_id"
+	columnFlags             = "flags"
+	columnAttributes        = "attributes"
+)
+
+// logFields are the columns of a log record row.
+var logFields = []arrow.Field{
+	{Name: columnTimestamp, Type: timestampType, Nullable: true},
+	{Name: columnObservedTimestamp, Type: timestampType, Nullable: true},
+	{Name: columnSeverityNumber, Type: int32Type, Nullable: true},
+	{Name: columnSeverityText, Type: stringType, Nullable: true},
+	{Name: columnBody, Type: stringType, Nullable: true},
+	{Name: columnBodyType, Type: stringType, Nullable: true},
+	{Name: columnEventName, Type: stringType, Nullable: true},
+	{Name: columnTraceID, Type: stringType, Nullable: true},
+	{Name: columnSpanID, Type: stringType, Nullable: true},
+	{Name: columnFlags, Type: int64Type, Nullable: true},
+	{Name: columnAttributes, Type: stringType, Nullable: true},
+}
+
+func (e *parquetExtension) MarshalLogs(ld plog.Logs) ([]byte, error) {
+	b := newRowBuilder(e.logsSchema)
+	for _, rl := range ld.ResourceLogs().All() {
+		for _, sl := range rl.ScopeLogs().All() {
+			for _, lr := range sl.LogRecords().All() {
+				b.appendTimestamp(columnTimestamp, lr.Timestamp())
+				b.appendTimestamp(columnObservedTimestamp, lr.ObservedTimestamp())
+				b.appendInt32(columnSeverityNumber, int32(lr.SeverityNumber()), lr.SeverityNumber() != plog.SeverityNumberUnspecified)
+				b.appendString(columnSeverityText, lr.SeverityText())
+				if err := b.appendBody(lr.Body()); err != nil {
+					return nil, err
+				}
+				b.appendString(columnEventName, lr.EventName())
+				b.appendString(columnTraceID, traceIDString(lr.TraceID()))
+				b.appendString(columnSpanID, spanIDString(lr.SpanID()))
+				b.appendInt64(columnFlags, int64(lr.Flags()), lr.Flags() != 0)
+				if err := b.appendAttributes(columnAttributes, lr.Attributes()); err != nil {
+					return nil, err
+				}
+				if err := b.appendCommon(rl.Resource(), rl.SchemaUrl(), sl.Scope(), sl.SchemaUrl(), lr.Attributes()); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return b.writeParquet(e.config)
+}
+
+// appendBody appends a log body as a string and its type. Maps and slices are
+// encoded as JSON with typed values.
+func (b *rowBuilder) appendBody(body pcommon.Value) error {
+	var encoded string
+	switch body.Type() {
+	case pcommon.ValueTypeEmpty:
+		b.appendString(columnBody, "")
+		b.appendString(columnBodyType, "")
+		return nil
+	case pcommon.ValueTypeMap:
+		s, err := marshalJSON(newAnyValueMap(body.Map()))
+		if err != nil {
+			return fmt.Errorf("failed to encode %s: %w", columnBody, err)
+		}
+		encoded = s
+	case pcommon.ValueTypeSlice:
+		s, err := marshalJSON(newAnyValueSlice(body.Slice()))
+		if err != nil {
+			return fmt.Errorf("failed to encode %s: %w", columnBody, err)
+		}
+		encoded = s
+	default:
+		encoded = body.AsString()
+	}
+	b.appendString(columnBody, encoded)
+	b.appendString(columnBodyType, body.Type().String())
+	return nil
+}
+
+func (*parquetExtension) UnmarshalLogs(buf []byte) (plog.Logs, error) {
+	ld := plog.NewLogs()
+	var rl plog.ResourceLogs
+	var sl plog.ScopeLogs
+	var groups rowGroups
+	err := readParquet(buf, func(r *rowReader, row int) error {
+		if groups.nextResource(r, row) {
+			rl = ld.ResourceLogs().AppendEmpty()
+			schemaURL, err := r.resource(row, rl.Resource())
+			if err != nil {
+				return err
+			}
+			rl.SetSchemaUrl(schemaURL)
+		}
+		if groups.nextScope(r, row) {
+			sl = rl.ScopeLogs().AppendEmpty()
+			schemaURL, err := r.scope(row, sl.Scope())
+			if err != nil {
+				return err
+			}
+			sl.SetSchemaUrl(schemaURL)
+		}
+
+		lr := sl.LogRecords().AppendEmpty()
+		lr.SetTimestamp(r.timestamp(columnTimestamp, row))
+		lr.SetObservedTimestamp(r.timestamp(columnObservedTimestamp, row))
+		lr.SetSeverityNumber(plog.SeverityNumber(r.int32(columnSeverityNumber, row)))
+		lr.SetSeverityText(r.string(columnSeverityText, row))
+		if err := unmarshalBody(r.string(columnBodyType, row), r.string(columnBody, row), lr.Body()); err != nil {
+			return fmt.Errorf("failed to decode %s: %w", columnBody, err)
+		}
+		lr.SetEventName(r.string(columnEventName, row))
+		traceID, err := parseTraceID(r.string(columnTraceID, row))
+		if err != nil {
+			return err
+		}
+		lr.SetTraceID(traceID)
+		spanID, err := parseSpanID(r.string(columnSpanID, row))
+		if err != nil {
+			return err
+		}
+		lr.SetSpanID(spanID)
+		lr.SetFlags(plog.LogRecordFlags(r.int64(columnFlags, row)))
+		return r.attributes(columnAttributes, row, lr.Attributes())
+	})
+	if err != nil {
+		return plog.NewLogs(), err
+	}
+	return ld, nil
+}
+
+// unmarshalBody restores a log body from its string representation and type.
+func unmarshalBody(bodyType, body string, dest pcommon.Value) error {
+	switch bodyType {
+	case "":
+		return nil
+	case pcommon.ValueTypeStr.String():
+		dest.SetStr(body)
+	case pcommon.ValueTypeInt.String():
+		i, err := strconv.ParseInt(body, 10, 64)
+		if err != nil {
+			return err
+		}
+		dest.SetInt(i)
+	case pcommon.ValueTypeDouble.String():
+		f, err := strconv.ParseFloat(body, 64)
+		if err != nil {
+			return err
+		}
+		dest.SetDouble(f)
+	case pcommon.ValueTypeBool.String():
+		b, err := strconv.ParseBool(body)
+		if err != nil {
+			return err
+		}
+		dest.SetBool(b)
+	case pcommon.ValueTypeBytes.String():
+		decoded, err := base64.StdEncoding.DecodeString(body)
+		if err != nil {
+			return err
+		}
+		dest.SetEmptyBytes().FromRaw(decoded)
+	case pcommon.ValueTypeMap.String():
+		var values map[string]anyValue
+		if err := json.Unmarshal([]byte(body), &values); err != nil {
+			return err
+		}
+		copyAnyValueMap(values, dest.SetEmptyMap())
+	case pcommon.ValueTypeSlice.String():
+		var values []anyValue
+		if err := json.Unmarshal([]byte(body), &values); err != nil {
+			return err
+		}
+		copyAnyValueSlice(values, dest.SetEmptySlice())
+	default:
+		return fmt.Errorf("unsupported body type %q", bodyType)
+	}
+	return nil
+}
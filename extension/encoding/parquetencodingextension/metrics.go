@@ -0,0 +1,413 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package parquetencodingextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/encoding/parquetencodingextension"
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+const (
+	columnMetricName             = "metric_name"
+	columnMetricDescription      = "metric_description"
+	columnMetricUnit             = "metric_unit"
+	columnMetricType             = "metric_type"
+	columnAggregationTemporality = "aggregation_temporality"
+	columnIsMonotonic            = "is_monotonic"
+	columnValueInt               = "value_int"
+	columnValueDouble            = "value_double"
+	columnCount                  = "count"
+	columnSum                    = "sum"
+	columnMin                    = "min"
+	columnMax                    = "max"
+	columnBucketCounts           = "bucket_counts"
+	columnExplicitBounds         = "explicit_bounds"
+	columnScale                  = "scale"
+	columnZeroCount              = "zero_count"
+	columnZeroThreshold          = "zero_threshold"
+	columnPositiveOffset         = "positive_offset"
+	columnPositiveBucketCounts   = "positive_bucket_counts"
+	columnNegativeOffset         = "negative_offset"
+	columnNegativeBucketCounts   = "negative_bucket_counts"
+	columnQuantiles              = "quantiles"
+	columnQuantileValues         = "quantile_values"
+)
+
+// dataPointFields are the columns of a metric data point row. Columns that do
+// not apply to the type of the metric are null.
+var dataPointFields = []arrow.Field{
+	{Name: columnMetricName, Type: stringType, Nullable: true},
+	{Name: columnMetricDescription, Type: stringType, Nullable: true},
+	{Name: columnMetricUnit, Type: stringType, Nullable: true},
+	{Name: columnMetricType, Type: stringType, Nullable: true},
+	{Name: columnAggregationTemporality, Type: stringType, Nullable: true},
+	{Name: columnIsMonotonic, Type: boolType, Nullable: true},
+	{Name: columnStartTimestamp, Type: timestampType, Nullable: true},
+	{Name: columnTimestamp, Type: timestampType, Nullable: true},
+	{Name: columnFlags, Type: int64Type, Nullable: true},
+	{Name: columnAttributes, Type: stringType, Nullable: true},
+	{Name: columnValueInt, Type: int64Type, Nullable: true},
+	{Name: columnValueDouble, Type: float64Type, Nullable: true},
+	{Name: columnCount, Type: int64Type, Nullable: true},
+	{Name: columnSum, Type: float64Type, Nullable: true},
+	{Name: columnMin, Type: float64Type, Nullable: true},
+	{Name: columnMax, Type: float64Type, Nullable: true},
+	{Name: columnBucketCounts, Type: int64ListType, Nullable: true},
+	{Name: columnExplicitBounds, Type: floatListType, Nullable: true},
+	{Name: columnScale, Type: int32Type, Nullable: true},
+	{Name: columnZeroCount, Type: int64Type, Nullable: true},
+	{Name: columnZeroThreshold, Type: float64Type, Nullable: true},
+	{Name: columnPositiveOffset, Type: int32Type, Nullable: true},
+	{Name: columnPositiveBucketCounts, Type: int64ListType, Nullable: true},
+	{Name: columnNegativeOffset, Type: int32Type, Nullable: true},
+	{Name: columnNegativeBucketCounts, Type: int64ListType, Nullable: true},
+	{Name: columnQuantiles, Type: floatListType, Nullable: true},
+	{Name: columnQuantileValues, Type: floatListType, Nullable: true},
+}
+
+var metricTypes = map[string]pmetric.MetricType{
+	pmetric.MetricTypeGauge.String():                pmetric.MetricTypeGauge,
+	pmetric.MetricTypeSum.String():                  pmetric.MetricTypeSum,
+	pmetric.MetricTypeHistogram.String():            pmetric.MetricTypeHistogram,
+	pmetric.MetricTypeExponentialHistogram.String(): pmetric.MetricTypeExponentialHistogram,
+	pmetric.MetricTypeSummary.String():              pmetric.MetricTypeSummary,
+}
+
+var aggregationTemporalities = map[string]pmetric.AggregationTemporality{
+	pmetric.AggregationTemporalityUnspecified.String(): pmetric.AggregationTemporalityUnspecified,
+	pmetric.AggregationTemporalityDelta.String():       pmetric.AggregationTemporalityDelta,
+	pmetric.AggregationTemporalityCumulative.String():  pmetric.AggregationTemporalityCumulative,
+}
+
+// metricColumns holds the metric level values repeated on every data point row.
+type metricColumns struct {
+	resource    pmetric.ResourceMetrics
+	scope       pmetric.ScopeMetrics
+	metric      pmetric.Metric
+	temporality pmetric.AggregationTemporality
+	monotonic   bool
+	hasSumInfo  bool
+}
+
+func (e *parquetExtension) MarshalMetrics(md pmetric.Metrics) ([]byte, error) {
+	b := newRowBuilder(e.metricsSchema)
+	for _, rm := range md.ResourceMetrics().All() {
+		for _, sm := range rm.ScopeMetrics().All() {
+			for _, m := range sm.Metrics().All() {
+				mc := metricColumns{resource: rm, scope: sm, metric: m}
+				switch m.Type() {
+				case pmetric.MetricTypeGauge:
+					for _, dp := range m.Gauge().DataPoints().All() {
+						if err := b.appendNumberDataPoint(mc, dp); err != nil {
+							return nil, err
+						}
+					}
+				case pmetric.MetricTypeSum:
+					mc.temporality = m.Sum().AggregationTemporality()
+					mc.monotonic = m.Sum().IsMonotonic()
+					mc.hasSumInfo = true
+					for _, dp := range m.Sum().DataPoints().All() {
+						if err := b.appendNumberDataPoint(mc, dp); err != nil {
+							return nil, err
+						}
+					}
+				case pmetric.MetricTypeHistogram:
+					mc.temporality = m.Histogram().AggregationTemporality()
+					for _, dp := range m.Histogram().DataPoints().All() {
+						if err := b.appendHistogramDataPoint(mc, dp); err != nil {
+							return nil, err
+						}
+					}
+				case pmetric.MetricTypeExponentialHistogram:
+					mc.temporality = m.ExponentialHistogram().AggregationTemporality()
+					for _, dp := range m.ExponentialHistogram().DataPoints().All() {
+						if err := b.appendExponentialHistogramDataPoint(mc, dp); err != nil {
+							return nil, err
+						}
+					}
+				case pmetric.MetricTypeSummary:
+					for _, dp := range m.Summary().DataPoints().All() {
+						if err := b.appendSummaryDataPoint(mc, dp); err != nil {
+							return nil, err
+						}
+					}
+				}
+			}
+		}
+	}
+	return b.writeParquet(e.config)
+}
+
+// appendMetricColumns appends the metric level, common data point, resource and
+// scope columns of a row.
+func (b *rowBuilder) appendMetricColumns(mc metricColumns, start, ts pcommon.Timestamp, flags pmetric.DataPointFlags, attrs pcommon.Map) error {
+	m := mc.metric
+	b.appendString(columnMetricName, m.Name())
+	b.appendString(columnMetricDescription, m.Description())
+	b.appendString(columnMetricUnit, m.Unit())
+	b.appendString(columnMetricType, m.Type().String())
+	if m.Type() == pmetric.MetricTypeGauge || m.Type() == pmetric.MetricTypeSummary {
+		b.appendString(columnAggregationTemporality, "")
+	} else {
+		b.appendString(columnAggregationTemporality, mc.temporality.String())
+	}
+	b.appendBool(columnIsMonotonic, mc.monotonic, mc.hasSumInfo)
+	b.appendTimestamp(columnStartTimestamp, start)
+	b.appendTimestamp(columnTimestamp, ts)
+	b.appendInt64(columnFlags, int64(flags), flags != 0)
+	if err := b.appendAttributes(columnAttributes, attrs); err != nil {
+		return err
+	}
+	return b.appendCommon(mc.resource.Resource(), mc.resource.SchemaUrl(), mc.scope.Scope(), mc.scope.SchemaUrl(), attrs)
+}
+
+// appendNullColumns appends nulls to the value columns that do not apply to a row.
+func (b *rowBuilder) appendNullColumns(names ...string) {
+	for _, name := range names {
+		b.field(name).AppendNull()
+	}
+}
+
+func (b *rowBuilder) appendNumberDataPoint(mc metricColumns, dp pmetric.NumberDataPoint) error {
+	if err := b.appendMetricColumns(mc, dp.StartTimestamp(), dp.Timestamp(), dp.Flags(), dp.Attributes()); err != nil {
+		return err
+	}
+	b.appendInt64(columnValueInt, dp.IntValue(), dp.ValueType() == pmetric.NumberDataPointValueTypeInt)
+	b.appendFloat64(columnValueDouble, dp.DoubleValue(), dp.ValueType() == pmetric.NumberDataPointValueTypeDouble)
+	b.appendNullColumns(columnCount, columnSum, columnMin, columnMax,
+		columnBucketCounts, columnExplicitBounds,
+		columnScale, columnZeroCount, columnZeroThreshold,
+		columnPositiveOffset, columnPositiveBucketCounts, columnNegativeOffset, columnNegativeBucketCounts,
+		columnQuantiles, columnQuantileValues)
+	return nil
+}
+
+func (b *rowBuilder) appendHistogramDataPoint(mc metricColumns, dp pmetric.HistogramDataPoint) error {
+	if err := b.appendMetricColumns(mc, dp.StartTimestamp(), dp.Timestamp(), dp.Flags(), dp.Attributes()); err != nil {
+		return err
+	}
+	b.appendNullColumns(columnValueInt, columnValueDouble)
+	b.appendInt64(columnCount, int64(dp.Count()), true)
+	b.appendFloat64(columnSum, dp.Sum(), dp.HasSum())
+	b.appendFloat64(columnMin, dp.Min(), dp.HasMin())
+	b.appendFloat64(columnMax, dp.Max(), dp.HasMax())
+	b.appendUInt64List(columnBucketCounts, dp.BucketCounts(), true)
+	b.appendFloat64List(columnExplicitBounds, dp.ExplicitBounds().AsRaw(), true)
+	b.appendNullColumns(columnScale, columnZeroCount, columnZeroThreshold,
+		columnPositiveOffset, columnPositiveBucketCounts, columnNegativeOffset, columnNegativeBucketCounts,
+		columnQuantiles, columnQuantileValues)
+	return nil
+}
+
+func (b *rowBuilder) appendExponentialHistogramDataPoint(mc metricColumns, dp pmetric.ExponentialHistogramDataPoint) error {
+	if err := b.appendMetricColumns(mc, dp.StartTimestamp(), dp.Timestamp(), dp.Flags(), dp.Attributes()); err != nil {
+		return err
+	}
+	b.appendNullColumns(columnValueInt, columnValueDouble)
+	b.appendInt64(columnCount, int64(dp.Count()), true)
+	b.appendFloat64(columnSum, dp.Sum(), dp.HasSum())
+	b.appendFloat64(columnMin, dp.Min(), dp.HasMin())
+	b.appendFloat64(columnMax, dp.Max(), dp.HasMax())
+	b.appendNullColumns(columnBucketCounts, columnExplicitBounds)
+	b.appendInt32(columnScale, dp.Scale(), true)
+	b.appendInt64(columnZeroCount, int64(dp.ZeroCount()), true)
+	b.appendFloat64(columnZeroThreshold, dp.ZeroThreshold(), true)
+	b.appendInt32(columnPositiveOffset, dp.Positive().Offset(), true)
+	b.appendUInt64List(columnPositiveBucketCounts, dp.Positive().BucketCounts(), true)
+	b.appendInt32(columnNegativeOffset, dp.Negative().Offset(), true)
+	b.appendUInt64List(columnNegativeBucketCounts, dp.Negative().BucketCounts(), true)
+	b.appendNullColumns(columnQuantiles, columnQuantileValues)
+	return nil
+}
+
+func (b *rowBuilder) appendSummaryDataPoint(mc metricColumns, dp pmetric.SummaryDataPoint) error {
+	if err := b.appendMetricColumns(mc, dp.StartTimestamp(), dp.Timestamp(), dp.Flags(), dp.Attributes()); err != nil {
+		return err
+	}
+	b.appendNullColumns(columnValueInt, columnValueDouble)
+	b.appendInt64(columnCount, int64(dp.Count()), true)
+	b.appendFloat64(columnSum, dp.Sum(), true)
+	b.appendNullColumns(columnMin, columnMax, columnBucketCounts, columnExplicitBounds,
+		columnScale, columnZeroCount, columnZeroThreshold,
+		columnPositiveOffset, columnPositiveBucketCounts, columnNegativeOffset, columnNegativeBucketCounts)
+	quantiles := make([]float64, 0, dp.QuantileValues().Len())
+	values := make([]float64, 0, dp.QuantileValues().Len())
+	for _, q := range dp.QuantileValues().All() {
+		quantiles = append(quantiles, q.Quantile())
+		values = append(values, q.Value())
+	}
+	b.appendFloat64List(columnQuantiles, quantiles, true)
+	b.appendFloat64List(columnQuantileValues, values, true)
+	return nil
+}
+
+func (*parquetExtension) UnmarshalMetrics(buf []byte) (pmetric.Metrics, error) {
+	md := pmetric.NewMetrics()
+	var rm pmetric.ResourceMetrics
+	var sm pmetric.ScopeMetrics
+	var m pmetric.Metric
+	var groups rowGroups
+	var metricKey string
+	err := readParquet(buf, func(r *rowReader, row int) error {
+		if groups.nextResource(r, row) {
+			rm = md.ResourceMetrics().AppendEmpty()
+			schemaURL, err := r.resource(row, rm.Resource())
+			if err != nil {
+				return err
+			}
+			rm.SetSchemaUrl(schemaURL)
+		}
+		if groups.nextScope(r, row) {
+			sm = rm.ScopeMetrics().AppendEmpty()
+			schemaURL, err := r.scope(row, sm.Scope())
+			if err != nil {
+				return err
+			}
+			sm.SetSchemaUrl(schemaURL)
+			metricKey = ""
+		}
+		if key := r.metricKey(row); metricKey == "" || key != metricKey {
+			var err error
+			if m, err = r.metric(row, sm.Metrics().AppendEmpty()); err != nil {
+				return err
+			}
+			metricKey = key
+		}
+
+		switch m.Type() {
+		case pmetric.MetricTypeGauge:
+			return r.numberDataPoint(row, m.Gauge().DataPoints().AppendEmpty())
+		case pmetric.MetricTypeSum:
+			return r.numberDataPoint(row, m.Sum().DataPoints().AppendEmpty())
+		case pmetric.MetricTypeHistogram:
+			return r.histogramDataPoint(row, m.Histogram().DataPoints().AppendEmpty())
+		case pmetric.MetricTypeExponentialHistogram:
+			return r.exponentialHistogramDataPoint(row, m.ExponentialHistogram().DataPoints().AppendEmpty())
+		case pmetric.MetricTypeSummary:
+			return r.summaryDataPoint(row, m.Summary().DataPoints().AppendEmpty())
+		}
+		return nil
+	})
+	if err != nil {
+		return pmetric.NewMetrics(), err
+	}
+	return md, nil
+}
+
+// metricKey identifies the metric a data point row belongs to.
+func (r *rowReader) metricKey(row int) string {
+	return strings.Join([]string{
+		r.string(columnMetricName, row),
+		r.string(columnMetricDescription, row),
+		r.string(columnMetricUnit, row),
+		r.string(columnMetricType, row),
+		r.string(columnAggregationTemporality, row),
+		strconv.FormatBool(r.bool(columnIsMonotonic, row)),
+	}, "\x00")
+}
+
+func (r *rowReader) metric(row int, dest pmetric.Metric) (pmetric.Metric, error) {
+	dest.SetName(r.string(columnMetricName, row))
+	dest.SetDescription(r.string(columnMetricDescription, row))
+	dest.SetUnit(r.string(columnMetricUnit, row))
+	temporality := aggregationTemporalities[r.string(columnAggregationTemporality, row)]
+	metricType := r.string(columnMetricType, row)
+	switch metricTypes[metricType] {
+	case pmetric.MetricTypeGauge:
+		dest.SetEmptyGauge()
+	case pmetric.MetricTypeSum:
+		sum := dest.SetEmptySum()
+		sum.SetAggregationTemporality(temporality)
+		sum.SetIsMonotonic(r.bool(columnIsMonotonic, row))
+	case pmetric.MetricTypeHistogram:
+		dest.SetEmptyHistogram().SetAggregationTemporality(temporality)
+	case pmetric.MetricTypeExponentialHistogram:
+		dest.SetEmptyExponentialHistogram().SetAggregationTemporality(temporality)
+	case pmetric.MetricTypeSummary:
+		dest.SetEmptySummary()
+	default:
+		return dest, fmt.Errorf("unsupported metric type %q", metricType)
+	}
+	return dest, nil
+}
+
+func (r *rowReader) numberDataPoint(row int, dp pmetric.NumberDataPoint) error {
+	dp.SetStartTimestamp(r.timestamp(columnStartTimestamp, row))
+	dp.SetTimestamp(r.timestamp(columnTimestamp, row))
+	dp.SetFlags(pmetric.DataPointFlags(r.int64(columnFlags, row)))
+	switch {
+	case !r.isNull(columnValueInt, row):
+		dp.SetIntValue(r.int64(columnValueInt, row))
+	case !r.isNull(columnValueDouble, row):
+		dp.SetDoubleValue(r.float64(columnValueDouble, row))
+	}
+	return r.attributes(columnAttributes, row, dp.Attributes())
+}
+
+func (r *rowReader) histogramDataPoint(row int, dp pmetric.HistogramDataPoint) error {
+	dp.SetStartTimestamp(r.timestamp(columnStartTimestamp, row))
+	dp.SetTimestamp(r.timestamp(columnTimestamp, row))
+	dp.SetFlags(pmetric.DataPointFlags(r.int64(columnFlags, row)))
+	dp.SetCount(uint64(r.int64(columnCount, row)))
+	if !r.isNull(columnSum, row) {
+		dp.SetSum(r.float64(columnSum, row))
+	}
+	if !r.isNull(columnMin, row) {
+		dp.SetMin(r.float64(columnMin, row))
+	}
+	if !r.isNull(columnMax, row) {
+		dp.SetMax(r.float64(columnMax, row))
+	}
+	r.uint64List(columnBucketCounts, row, dp.BucketCounts())
+	dp.ExplicitBounds().FromRaw(r.float64List(columnExplicitBounds, row))
+	return r.attributes(columnAttributes, row, dp.Attributes())
+}
+
+func (r *rowReader) exponentialHistogramDataPoint(row int, dp pmetric.ExponentialHistogramDataPoint) error {
+	dp.SetStartTimestamp(r.timestamp(columnStartTimestamp, row))
+	dp.SetTimestamp(r.timestamp(columnTimestamp, row))
+	dp.SetFlags(pmetric.DataPointFlags(r.int64(columnFlags, row)))
+	dp.SetCount(uint64(r.int64(columnCount, row)))
+	if !r.isNull(columnSum, row) {
+		dp.SetSum(r.float64(columnSum, row))
+	}
+	if !r.isNull(columnMin, row) {
+		dp.SetMin(r.float64(columnMin, row))
+	}
+	if !r.isNull(columnMax, row) {
+		dp.SetMax(r.float64(columnMax, row))
+	}
+	dp.SetScale(r.int32(columnScale, row))
+	dp.SetZeroCount(uint64(r.int64(columnZeroCount, row)))
+	dp.SetZeroThreshold(r.float64(columnZeroThreshold, row))
+	dp.Positive().SetOffset(r.int32(columnPositiveOffset, row))
+	r.uint64List(columnPositiveBucketCounts, row, dp.Positive().BucketCounts())
+	dp.Negative().SetOffset(r.int32(columnNegativeOffset, row))
+	r.uint64List(columnNegativeBucketCounts, row, dp.Negative().BucketCounts())
+	return r.attributes(columnAttributes, row, dp.Attributes())
+}
+
+func (r *rowReader) summaryDataPoint(row int, dp pmetric.SummaryDataPoint) error {
+	dp.SetStartTimestamp(r.timestamp(columnStartTimestamp, row))
+	dp.SetTimestamp(r.timestamp(columnTimestamp, row))
+	dp.SetFlags(pmetric.DataPointFlags(r.int64(columnFlags, row)))
+	dp.SetCount(uint64(r.int64(columnCount, row)))
+	dp.SetSum(r.float64(columnSum, row))
+	quantiles := r.float64List(columnQuantiles, row)
+	values := r.float64List(columnQuantileValues, row)
+	if len(quantiles) != len(values) {
+		return fmt.Errorf("%s and %s have different lengths", columnQuantiles, columnQuantileValues)
+	}
+	for i, q := range quantiles {
+		qv := dp.QuantileValues().AppendEmpty()
+		qv.SetQuantile(q)
+		qv.SetValue(values[i])
+	}
+	return r.attributes(columnAttributes, row, dp.Attributes())
+}
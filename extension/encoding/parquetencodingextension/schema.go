@@ -0,0 +1,541 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package parquetencodingextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/encoding/parquetencodingextension"
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	conventions "go.opentelemetry.io/otel/semconv/v1.37.0"
+)
+
+const (
+	resourceColumnPrefix  = "resource_"
+	attributeColumnPrefix = "attribute_"
+
+	columnResourceSchemaURL  = "resource_schema_url"
+	columnResourceAttributes = "resource_attributes"
+	columnServiceName        = "service_name"
+	columnScopeName          = "scope_name"
+	columnScopeVersion       = "scope_version"
+	columnScopeAttributes    = "scope_attributes"
+	columnScopeSchemaURL     = "scope_schema_url"
+)
+
+var (
+	stringType    = arrow.BinaryTypes.String
+	timestampType = arrow.FixedWidthTypes.Timestamp_ns
+	int32Type     = arrow.PrimitiveTypes.Int32
+	int64Type     = arrow.PrimitiveTypes.Int64
+	float64Type   = arrow.PrimitiveTypes.Float64
+	boolType      = arrow.FixedWidthTypes.Boolean
+	int64ListType = arrow.ListOf(arrow.PrimitiveTypes.Int64)
+	floatListType = arrow.ListOf(arrow.PrimitiveTypes.Float64)
+
+	// commonFields are the resource and scope columns shared by all signals.
+	commonFields = []arrow.Field{
+		{Name: columnServiceName, Type: stringType, Nullable: true},
+		{Name: columnResourceAttributes, Type: stringType, Nullable: true},
+		{Name: columnResourceSchemaURL, Type: stringType, Nullable: true},
+		{Name: columnScopeName, Type: stringType, Nullable: true},
+		{Name: columnScopeVersion, Type: stringType, Nullable: true},
+		{Name: columnScopeAttributes, Type: stringType, Nullable: true},
+		{Name: columnScopeSchemaURL, Type: stringType, Nullable: true},
+	}
+)
+
+// reservedColumnNames returns the names of the fixed columns of all signals.
+func reservedColumnNames() []string {
+	var names []string
+	for _, fields := range [][]arrow.Field{commonFields, logFields, spanFields, dataPointFields} {
+		for _, f := range fields {
+			names = append(names, f.Name)
+		}
+	}
+	return names
+}
+
+// promotedColumn is an attribute that is copied to its own column.
+type promotedColumn struct {
+	key  string
+	name string
+}
+
+func promotedColumns(prefix string, keys []string) []promotedColumn {
+	columns := make([]promotedColumn, 0, len(keys))
+	for _, key := range keys {
+		columns = append(columns, promotedColumn{key: key, name: prefix + sanitizeColumnName(key)})
+	}
+	return columns
+}
+
+// sanitizeColumnName replaces the characters of an attribute key that are not
+// safe to use in unquoted SQL identifiers.
+func sanitizeColumnName(key string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_':
+			return r
+		default:
+			return '_'
+		}
+	}, key)
+}
+
+// signalSchema is the Parquet schema of a signal, including the promoted attribute columns.
+type signalSchema struct {
+	schema             *arrow.Schema
+	resourceAttributes []promotedColumn
+	recordAttributes   []promotedColumn
+}
+
+func newSignalSchema(fields []arrow.Field, promoted PromotedAttributes) *signalSchema {
+	s := &signalSchema{
+		resourceAttributes: promotedColumns(resourceColumnPrefix, promoted.Resource),
+		recordAttributes:   promotedColumns(attributeColumnPrefix, promoted.Record),
+	}
+	all := make([]arrow.Field, 0, len(fields)+len(commonFields)+len(s.resourceAttributes)+len(s.recordAttributes))
+	all = append(all, fields...)
+	all = append(all, commonFields...)
+	for _, col := range s.resourceAttributes {
+		all = append(all, arrow.Field{Name: col.name, Type: stringType, Nullable: true})
+	}
+	for _, col := range s.recordAttributes {
+		all = append(all, arrow.Field{Name: col.name, Type: stringType, Nullable: true})
+	}
+	s.schema = arrow.NewSchema(all, nil)
+	return s
+}
+
+// rowBuilder appends the values of a row to the columns of an Arrow record.
+type rowBuilder struct {
+	schema  *signalSchema
+	builder *array.RecordBuilder
+	index   map[string]int
+}
+
+func newRowBuilder(schema *signalSchema) *rowBuilder {
+	index := make(map[string]int, schema.schema.NumFields())
+	for i, f := range schema.schema.Fields() {
+		index[f.Name] = i
+	}
+	return &rowBuilder{
+		schema:  schema,
+		builder: array.NewRecordBuilder(memory.DefaultAllocator, schema.schema),
+		index:   index,
+	}
+}
+
+func (b *rowBuilder) field(name string) array.Builder {
+	return b.builder.Field(b.index[name])
+}
+
+func (b *rowBuilder) appendString(name, v string) {
+	sb := b.field(name).(*array.StringBuilder)
+	if v == "" {
+		sb.AppendNull()
+		return
+	}
+	sb.Append(v)
+}
+
+func (b *rowBuilder) appendTimestamp(name string, v pcommon.Timestamp) {
+	tb := b.field(name).(*array.TimestampBuilder)
+	if v == 0 {
+		tb.AppendNull()
+		return
+	}
+	tb.Append(arrow.Timestamp(v))
+}
+
+func (b *rowBuilder) appendInt32(name string, v int32, valid bool) {
+	ib := b.field(name).(*array.Int32Builder)
+	if !valid {
+		ib.AppendNull()
+		return
+	}
+	ib.Append(v)
+}
+
+func (b *rowBuilder) appendInt64(name string, v int64, valid bool) {
+	ib := b.field(name).(*array.Int64Builder)
+	if !valid {
+		ib.AppendNull()
+		return
+	}
+	ib.Append(v)
+}
+
+func (b *rowBuilder) appendFloat64(name string, v float64, valid bool) {
+	fb := b.field(name).(*array.Float64Builder)
+	if !valid {
+		fb.AppendNull()
+		return
+	}
+	fb.Append(v)
+}
+
+func (b *rowBuilder) appendBool(name string, v, valid bool) {
+	bb := b.field(name).(*array.BooleanBuilder)
+	if !valid {
+		bb.AppendNull()
+		return
+	}
+	bb.Append(v)
+}
+
+func (b *rowBuilder) appendUInt64List(name string, vs pcommon.UInt64Slice, valid bool) {
+	lb := b.field(name).(*array.ListBuilder)
+	if !valid {
+		lb.AppendNull()
+		return
+	}
+	lb.Append(true)
+	vb := lb.ValueBuilder().(*array.Int64Builder)
+	for _, v := range vs.All() {
+		vb.Append(int64(v))
+	}
+}
+
+func (b *rowBuilder) appendFloat64List(name string, vs []float64, valid bool) {
+	lb := b.field(name).(*array.ListBuilder)
+	if !valid {
+		lb.AppendNull()
+		return
+	}
+	lb.Append(true)
+	lb.ValueBuilder().(*array.Float64Builder).AppendValues(vs, nil)
+}
+
+func (b *rowBuilder) appendAttributes(name string, attrs pcommon.Map) error {
+	encoded, err := marshalMap(attrs)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", name, err)
+	}
+	b.appendString(name, encoded)
+	return nil
+}
+
+// appendCommon appends the resource, scope and promoted attribute columns of a row.
+func (b *rowBuilder) appendCommon(resource pcommon.Resource, resourceSchemaURL string, scope pcommon.InstrumentationScope, scopeSchemaURL string, attrs pcommon.Map) error {
+	b.appendString(columnServiceName, attributeString(resource.Attributes(), string(conventions.ServiceNameKey)))
+	if err := b.appendAttributes(columnResourceAttributes, resource.Attributes()); err != nil {
+		return err
+	}
+	b.appendString(columnResourceSchemaURL, resourceSchemaURL)
+	b.appendString(columnScopeName, scope.Name())
+	b.appendString(columnScopeVersion, scope.Version())
+	if err := b.appendAttributes(columnScopeAttributes, scope.Attributes()); err != nil {
+		return err
+	}
+	b.appendString(columnScopeSchemaURL, scopeSchemaURL)
+
+	for _, col := range b.schema.resourceAttributes {
+		b.appendString(col.name, attributeString(resource.Attributes(), col.key))
+	}
+	for _, col := range b.schema.recordAttributes {
+		b.appendString(col.name, attributeString(attrs, col.key))
+	}
+	return nil
+}
+
+func attributeString(attrs pcommon.Map, key string) string {
+	if v, ok := attrs.Get(key); ok {
+		return v.AsString()
+	}
+	return ""
+}
+
+// writeParquet writes the rows appended so far as a Parquet file.
+func (b *rowBuilder) writeParquet(config *Config) ([]byte, error) {
+	rec := b.builder.NewRecord()
+	defer rec.Release()
+	defer b.builder.Release()
+
+	var buf bytes.Buffer
+	props := parquet.NewWriterProperties(
+		parquet.WithCompression(compressionCodecs[config.Compression]),
+		parquet.WithMaxRowGroupLength(config.RowGroupSize),
+	)
+	w, err := pqarrow.NewFileWriter(b.schema.schema, &buf, props, pqarrow.NewArrowWriterProperties(pqarrow.WithStoreSchema()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create parquet writer: %w", err)
+	}
+	if err := w.Write(rec); err != nil {
+		_ = w.Close()
+		return nil, fmt.Errorf("failed to write parquet rows: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close parquet writer: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// readParquet reads a Parquet file and calls fn for each of its rows.
+func readParquet(buf []byte, fn func(r *rowReader, row int) error) error {
+	table, err := pqarrow.ReadTable(context.Background(), bytes.NewReader(buf), nil, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	if err != nil {
+		return fmt.Errorf("failed to read parquet file: %w", err)
+	}
+	defer table.Release()
+
+	tr := array.NewTableReader(table, 0)
+	defer tr.Release()
+	for tr.Next() {
+		r := newRowReader(tr.Record())
+		for row := 0; row < int(r.rec.NumRows()); row++ {
+			if err := fn(r, row); err != nil {
+				return err
+			}
+		}
+	}
+	return tr.Err()
+}
+
+// rowReader reads the values of a row from the columns of an Arrow record.
+// Missing columns and null values are read as zero values.
+type rowReader struct {
+	rec   arrow.Record
+	index map[string]int
+}
+
+func newRowReader(rec arrow.Record) *rowReader {
+	index := make(map[string]int, rec.Schema().NumFields())
+	for i, f := range rec.Schema().Fields() {
+		index[f.Name] = i
+	}
+	return &rowReader{rec: rec, index: index}
+}
+
+func (r *rowReader) column(name string, row int) arrow.Array {
+	i, ok := r.index[name]
+	if !ok {
+		return nil
+	}
+	col := r.rec.Column(i)
+	if col.IsNull(row) {
+		return nil
+	}
+	return col
+}
+
+func (r *rowReader) isNull(name string, row int) bool {
+	return r.column(name, row) == nil
+}
+
+func (r *rowReader) string(name string, row int) string {
+	if col, ok := r.column(name, row).(*array.String); ok {
+		return col.Value(row)
+	}
+	return ""
+}
+
+func (r *rowReader) timestamp(name string, row int) pcommon.Timestamp {
+	if col, ok := r.column(name, row).(*array.Timestamp); ok {
+		return pcommon.Timestamp(col.Value(row))
+	}
+	return 0
+}
+
+func (r *rowReader) int32(name string, row int) int32 {
+	if col, ok := r.column(name, row).(*array.Int32); ok {
+		return col.Value(row)
+	}
+	return 0
+}
+
+func (r *rowReader) int64(name string, row int) int64 {
+	if col, ok := r.column(name, row).(*array.Int64); ok {
+		return col.Value(row)
+	}
+	return 0
+}
+
+func (r *rowReader) float64(name string, row int) float64 {
+	if col, ok := r.column(name, row).(*array.Float64); ok {
+		return col.Value(row)
+	}
+	return 0
+}
+
+func (r *rowReader) bool(name string, row int) bool {
+	if col, ok := r.column(name, row).(*array.Boolean); ok {
+		return col.Value(row)
+	}
+	return false
+}
+
+func (r *rowReader) uint64List(name string, row int, dest pcommon.UInt64Slice) {
+	col, ok := r.column(name, row).(*array.List)
+	if !ok {
+		return
+	}
+	values, ok := col.ListValues().(*array.Int64)
+	if !ok {
+		return
+	}
+	start, end := col.ValueOffsets(row)
+	dest.EnsureCapacity(int(end - start))
+	for i := start; i < end; i++ {
+		dest.Append(uint64(values.Value(int(i))))
+	}
+}
+
+func (r *rowReader) float64List(name string, row int) []float64 {
+	col, ok := r.column(name, row).(*array.List)
+	if !ok {
+		return nil
+	}
+	values, ok := col.ListValues().(*array.Float64)
+	if !ok {
+		return nil
+	}
+	start, end := col.ValueOffsets(row)
+	return values.Float64Values()[start:end]
+}
+
+func (r *rowReader) attributes(name string, row int, dest pcommon.Map) error {
+	if err := unmarshalMap(r.string(name, row), dest); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", name, err)
+	}
+	return nil
+}
+
+// rowGroups tracks the resource and scope of consecutive rows, which are
+// grouped back together when unmarshaling.
+type rowGroups struct {
+	resourceKey string
+	scopeKey    string
+	hasResource bool
+	hasScope    bool
+}
+
+// nextResource returns true if the row starts a new resource.
+func (g *rowGroups) nextResource(r *rowReader, row int) bool {
+	key := r.resourceKey(row)
+	if g.hasResource && key == g.resourceKey {
+		return false
+	}
+	g.resourceKey, g.hasResource, g.hasScope = key, true, false
+	return true
+}
+
+// nextScope returns true if the row starts a new scope.
+func (g *rowGroups) nextScope(r *rowReader, row int) bool {
+	key := r.scopeKey(row)
+	if g.hasScope && key == g.scopeKey {
+		return false
+	}
+	g.scopeKey, g.hasScope = key, true
+	return true
+}
+
+// resourceKey identifies the resource a row belongs to.
+func (r *rowReader) resourceKey(row int) string {
+	return r.string(columnResourceSchemaURL, row) + "\x00" + r.string(columnResourceAttributes, row)
+}
+
+// scopeKey identifies the instrumentation scope a row belongs to.
+func (r *rowReader) scopeKey(row int) string {
+	return strings.Join([]string{
+		r.string(columnScopeName, row),
+		r.string(columnScopeVersion, row),
+		r.string(columnScopeAttributes, row),
+		r.string(columnScopeSchemaURL, row),
+	}, "\x00")
+}
+
+func (r *rowReader) resource(row int, dest pcommon.Resource) (schemaURL string, err error) {
+	return r.string(columnResourceSchemaURL, row), r.attributes(columnResourceAttributes, row, dest.Attributes())
+}
+
+func (r *rowReader) scope(row int, dest pcommon.InstrumentationScope) (schemaURL string, err error) {
+	dest.SetName(r.string(columnScopeName, row))
+	dest.SetVersion(r.string(columnScopeVersion, row))
+	return r.string(columnScopeSchemaURL, row), r.attributes(columnScopeAttributes, row, dest.Attributes())
+}
+
+// marshalMap encodes a map as a JSON object of typed values. Empty maps are
+// encoded as an empty string.
+func marshalMap(m pcommon.Map) (string, error) {
+	if m.Len() == 0 {
+		return "", nil
+	}
+	return marshalJSON(newAnyValueMap(m))
+}
+
+// unmarshalMap decodes a JSON object of typed values into a map.
+func unmarshalMap(encoded string, dest pcommon.Map) error {
+	if encoded == "" {
+		return nil
+	}
+	var values map[string]anyValue
+	if err := json.Unmarshal([]byte(encoded), &values); err != nil {
+		return err
+	}
+	copyAnyValueMap(values, dest)
+	return nil
+}
+
+func traceIDString(id pcommon.TraceID) string {
+	if id.IsEmpty() {
+		return ""
+	}
+	return hex.EncodeToString(id[:])
+}
+
+func spanIDString(id pcommon.SpanID) string {
+	if id.IsEmpty() {
+		return ""
+	}
+	return hex.EncodeToString(id[:])
+}
+
+func parseTraceID(s string) (pcommon.TraceID, error) {
+	var id pcommon.TraceID
+	if s == "" {
+		return id, nil
+	}
+	decoded, err := hex.DecodeString(s)
+	if err != nil || len(decoded) != len(id) {
+		return id, fmt.Errorf("invalid trace id %q", s)
+	}
+	copy(id[:], decoded)
+	return id, nil
+}
+
+func parseSpanID(s string) (pcommon.SpanID, error) {
+	var id pcommon.SpanID
+	if s == "" {
+		return id, nil
+	}
+	decoded, err := hex.DecodeString(s)
+	if err != nil || len(decoded) != len(id) {
+		return id, fmt.Errorf("invalid span id %q", s)
+	}
+	copy(id[:], decoded)
+	return id, nil
+}
+
+// marshalJSON encodes v as a JSON string. Nil values are encoded as an empty string.
+func marshalJSON(v any) (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
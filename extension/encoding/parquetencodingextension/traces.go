@@ -0,0 +1,265 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package parquetencodingextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/encoding/parquetencodingextension"
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+const (
+	columnParentSpanID   = "parent_span_id"
+	columnTraceState     = "trace_state"
+	columnName           = "name"
+	columnKind           = "kind"
+	columnStartTimestamp = "start_timestamp"
+	columnEndTimestamp   = "end_timestamp"
+	columnDurationNano   = "duration_nano"
+	columnStatusCode     = "status_code"
+	columnStatusMessage  = "status_message"
+	columnEvents         = "events"
+	columnLinks          = "links"
+)
+
+// spanFields are the columns of a span row.
+var spanFields = []arrow.Field{
+	{Name: columnTraceID, Type: stringType, Nullable: true},
+	{Name: columnSpanID, Type: stringType, Nullable: true},
+	{Name: columnParentSpanID, Type: stringType, Nullable: true},
+	{Name: columnTraceState, Type: stringType, Nullable: true},
+	{Name: columnName, Type: stringType, Nullable: true},
+	{Name: columnKind, Type: stringType, Nullable: true},
+	{Name: columnStartTimestamp, Type: timestampType, Nullable: true},
+	{Name: columnEndTimestamp, Type: timestampType, Nullable: true},
+	{Name: columnDurationNano, Type: int64Type, Nullable: true},
+	{Name: columnStatusCode, Type: stringType, Nullable: true},
+	{Name: columnStatusMessage, Type: stringType, Nullable: true},
+	{Name: columnFlags, Type: int64Type, Nullable: true},
+	{Name: columnAttributes, Type: stringType, Nullable: true},
+	{Name: columnEvents, Type: stringType, Nullable: true},
+	{Name: columnLinks, Type: stringType, Nullable: true},
+}
+
+// spanEvent is the JSON representation of a span event in the events column.
+type spanEvent struct {
+	TimeUnixNano uint64              `json:"time_unix_nano,omitempty"`
+	Name         string              `json:"name,omitempty"`
+	Attributes   map[string]anyValue `json:"attributes,omitempty"`
+}
+
+// spanLink is the JSON representation of a span link in the links column.
+type spanLink struct {
+	TraceID    string              `json:"trace_id,omitempty"`
+	SpanID     string              `json:"span_id,omitempty"`
+	TraceState string              `json:"trace_state,omitempty"`
+	Flags      uint32              `json:"flags,omitempty"`
+	Attributes map[string]anyValue `json:"attributes,omitempty"`
+}
+
+var spanKinds = map[string]ptrace.SpanKind{
+	ptrace.SpanKindUnspecified.String(): ptrace.SpanKindUnspecified,
+	ptrace.SpanKindInternal.String():    ptrace.SpanKindInternal,
+	ptrace.SpanKindServer.String():      ptrace.SpanKindServer,
+	ptrace.SpanKindClient.String():      ptrace.SpanKindClient,
+	ptrace.SpanKindProducer.String():    ptrace.SpanKindProducer,
+	ptrace.SpanKindConsumer.String():    ptrace.SpanKindConsumer,
+}
+
+var statusCodes = map[string]ptrace.StatusCode{
+	ptrace.StatusCodeUnset.String(): ptrace.StatusCodeUnset,
+	ptrace.StatusCodeOk.String():    ptrace.StatusCodeOk,
+	ptrace.StatusCodeError.String(): ptrace.StatusCodeError,
+}
+
+func (e *parquetExtension) MarshalTraces(td ptrace.Traces) ([]byte, error) {
+	b := newRowBuilder(e.tracesSchema)
+	for _, rs := range td.ResourceSpans().All() {
+		for _, ss := range rs.ScopeSpans().All() {
+			for _, span := range ss.Spans().All() {
+				b.appendString(columnTraceID, traceIDString(span.TraceID()))
+				b.appendString(columnSpanID, spanIDString(span.SpanID()))
+				b.appendString(columnParentSpanID, spanIDString(span.ParentSpanID()))
+				b.appendString(columnTraceState, span.TraceState().AsRaw())
+				b.appendString(columnName, span.Name())
+				b.appendString(columnKind, span.Kind().String())
+				b.appendTimestamp(columnStartTimestamp, span.StartTimestamp())
+				b.appendTimestamp(columnEndTimestamp, span.EndTimestamp())
+				b.appendInt64(columnDurationNano, int64(span.EndTimestamp())-int64(span.StartTimestamp()),
+					span.StartTimestamp() != 0 && span.EndTimestamp() >= span.StartTimestamp())
+				b.appendString(columnStatusCode, span.Status().Code().String())
+				b.appendString(columnStatusMessage, span.Status().Message())
+				b.appendInt64(columnFlags, int64(span.Flags()), span.Flags() != 0)
+				if err := b.appendAttributes(columnAttributes, span.Attributes()); err != nil {
+					return nil, err
+				}
+				if err := b.appendSpanEvents(span.Events()); err != nil {
+					return nil, err
+				}
+				if err := b.appendSpanLinks(span.Links()); err != nil {
+					return nil, err
+				}
+				if err := b.appendCommon(rs.Resource(), rs.SchemaUrl(), ss.Scope(), ss.SchemaUrl(), span.Attributes()); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return b.writeParquet(e.config)
+}
+
+func (b *rowBuilder) appendSpanEvents(events ptrace.SpanEventSlice) error {
+	var encoded []spanEvent
+	for _, event := range events.All() {
+		encoded = append(encoded, spanEvent{
+			TimeUnixNano: uint64(event.Timestamp()),
+			Name:         event.Name(),
+			Attributes:   newAnyValueMap(event.Attributes()),
+		})
+	}
+	if len(encoded) == 0 {
+		b.appendString(columnEvents, "")
+		return nil
+	}
+	s, err := marshalJSON(encoded)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", columnEvents, err)
+	}
+	b.appendString(columnEvents, s)
+	return nil
+}
+
+func (b *rowBuilder) appendSpanLinks(links ptrace.SpanLinkSlice) error {
+	var encoded []spanLink
+	for _, link := range links.All() {
+		encoded = append(encoded, spanLink{
+			TraceID:    traceIDString(link.TraceID()),
+			SpanID:     spanIDString(link.SpanID()),
+			TraceState: link.TraceState().AsRaw(),
+			Flags:      link.Flags(),
+			Attributes: newAnyValueMap(link.Attributes()),
+		})
+	}
+	if len(encoded) == 0 {
+		b.appendString(columnLinks, "")
+		return nil
+	}
+	s, err := marshalJSON(encoded)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", columnLinks, err)
+	}
+	b.appendString(columnLinks, s)
+	return nil
+}
+
+func (*parquetExtension) UnmarshalTraces(buf []byte) (ptrace.Traces, error) {
+	td := ptrace.NewTraces()
+	var rs ptrace.ResourceSpans
+	var ss ptrace.ScopeSpans
+	var groups rowGroups
+	err := readParquet(buf, func(r *rowReader, row int) error {
+		if groups.nextResource(r, row) {
+			rs = td.ResourceSpans().AppendEmpty()
+			schemaURL, err := r.resource(row, rs.Resource())
+			if err != nil {
+				return err
+			}
+			rs.SetSchemaUrl(schemaURL)
+		}
+		if groups.nextScope(r, row) {
+			ss = rs.ScopeSpans().AppendEmpty()
+			schemaURL, err := r.scope(row, ss.Scope())
+			if err != nil {
+				return err
+			}
+			ss.SetSchemaUrl(schemaURL)
+		}
+
+		span := ss.Spans().AppendEmpty()
+		traceID, err := parseTraceID(r.string(columnTraceID, row))
+		if err != nil {
+			return err
+		}
+		span.SetTraceID(traceID)
+		spanID, err := parseSpanID(r.string(columnSpanID, row))
+		if err != nil {
+			return err
+		}
+		span.SetSpanID(spanID)
+		parentSpanID, err := parseSpanID(r.string(columnParentSpanID, row))
+		if err != nil {
+			return err
+		}
+		span.SetParentSpanID(parentSpanID)
+		span.TraceState().FromRaw(r.string(columnTraceState, row))
+		span.SetName(r.string(columnName, row))
+		span.SetKind(spanKinds[r.string(columnKind, row)])
+		span.SetStartTimestamp(r.timestamp(columnStartTimestamp, row))
+		span.SetEndTimestamp(r.timestamp(columnEndTimestamp, row))
+		span.Status().SetCode(statusCodes[r.string(columnStatusCode, row)])
+		span.Status().SetMessage(r.string(columnStatusMessage, row))
+		span.SetFlags(uint32(r.int64(columnFlags, row)))
+		if err := r.attributes(columnAttributes, row, span.Attributes()); err != nil {
+			return err
+		}
+		if err := unmarshalSpanEvents(r.string(columnEvents, row), span.Events()); err != nil {
+			return fmt.Errorf("failed to decode %s: %w", columnEvents, err)
+		}
+		if err := unmarshalSpanLinks(r.string(columnLinks, row), span.Links()); err != nil {
+			return fmt.Errorf("failed to decode %s: %w", columnLinks, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return ptrace.NewTraces(), err
+	}
+	return td, nil
+}
+
+func unmarshalSpanEvents(encoded string, dest ptrace.SpanEventSlice) error {
+	if encoded == "" {
+		return nil
+	}
+	var events []spanEvent
+	if err := json.Unmarshal([]byte(encoded), &events); err != nil {
+		return err
+	}
+	for _, e := range events {
+		event := dest.AppendEmpty()
+		event.SetTimestamp(pcommon.Timestamp(e.TimeUnixNano))
+		event.SetName(e.Name)
+		copyAnyValueMap(e.Attributes, event.Attributes())
+	}
+	return nil
+}
+
+func unmarshalSpanLinks(encoded string, dest ptrace.SpanLinkSlice) error {
+	if encoded == "" {
+		return nil
+	}
+	var links []spanLink
+	if err := json.Unmarshal([]byte(encoded), &links); err != nil {
+		return err
+	}
+	for _, l := range links {
+		link := dest.AppendEmpty()
+		traceID, err := parseTraceID(l.TraceID)
+		if err != nil {
+			return err
+		}
+		link.SetTraceID(traceID)
+		spanID, err := parseSpanID(l.SpanID)
+		if err != nil {
+			return err
+		}
+		link.SetSpanID(spanID)
+		link.TraceState().FromRaw(l.TraceState)
+		link.SetFlags(l.Flags)
+		copyAnyValueMap(l.Attributes, link.Attributes())
+	}
+	return nil
+}
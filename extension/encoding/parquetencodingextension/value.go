@@ -0,0 +1,154 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package parquetencodingextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/encoding/parquetencodingextension"
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// anyValue is the JSON representation of a pcommon.Value. Like the OTLP JSON
+// encoding of AnyValue, the value is wrapped in an object keyed by its type, so
+// that whole doubles, integers and bytes are decoded with their original type.
+// An empty value is encoded as an empty object.
+type anyValue struct {
+	String *string              `json:"stringValue,omitempty"`
+	Bool   *bool                `json:"boolValue,omitempty"`
+	Int    *int64               `json:"intValue,omitempty"`
+	Double *jsonDouble          `json:"doubleValue,omitempty"`
+	Bytes  *[]byte              `json:"bytesValue,omitempty"`
+	Array  *[]anyValue          `json:"arrayValue,omitempty"`
+	Kvlist *map[string]anyValue `json:"kvlistValue,omitempty"`
+}
+
+func newAnyValue(v pcommon.Value) anyValue {
+	var av anyValue
+	switch v.Type() {
+	case pcommon.ValueTypeStr:
+		s := v.Str()
+		av.String = &s
+	case pcommon.ValueTypeBool:
+		b := v.Bool()
+		av.Bool = &b
+	case pcommon.ValueTypeInt:
+		i := v.Int()
+		av.Int = &i
+	case pcommon.ValueTypeDouble:
+		d := jsonDouble(v.Double())
+		av.Double = &d
+	case pcommon.ValueTypeBytes:
+		b := v.Bytes().AsRaw()
+		av.Bytes = &b
+	case pcommon.ValueTypeSlice:
+		s := newAnyValueSlice(v.Slice())
+		av.Array = &s
+	case pcommon.ValueTypeMap:
+		m := newAnyValueMap(v.Map())
+		av.Kvlist = &m
+	case pcommon.ValueTypeEmpty:
+	}
+	return av
+}
+
+func newAnyValueMap(m pcommon.Map) map[string]anyValue {
+	values := make(map[string]anyValue, m.Len())
+	for k, v := range m.All() {
+		values[k] = newAnyValue(v)
+	}
+	return values
+}
+
+func newAnyValueSlice(s pcommon.Slice) []anyValue {
+	values := make([]anyValue, 0, s.Len())
+	for _, v := range s.All() {
+		values = append(values, newAnyValue(v))
+	}
+	return values
+}
+
+func (av anyValue) copyTo(dest pcommon.Value) {
+	switch {
+	case av.String != nil:
+		dest.SetStr(*av.String)
+	case av.Bool != nil:
+		dest.SetBool(*av.Bool)
+	case av.Int != nil:
+		dest.SetInt(*av.Int)
+	case av.Double != nil:
+		dest.SetDouble(float64(*av.Double))
+	case av.Bytes != nil:
+		dest.SetEmptyBytes().FromRaw(*av.Bytes)
+	case av.Array != nil:
+		copyAnyValueSlice(*av.Array, dest.SetEmptySlice())
+	case av.Kvlist != nil:
+		copyAnyValueMap(*av.Kvlist, dest.SetEmptyMap())
+	}
+}
+
+// copyAnyValueMap inserts the keys in sorted order, so that decoding the same
+// JSON object always produces the same map.
+func copyAnyValueMap(values map[string]anyValue, dest pcommon.Map) {
+	dest.Clear()
+	dest.EnsureCapacity(len(values))
+	for _, k := range slices.Sorted(maps.Keys(values)) {
+		values[k].copyTo(dest.PutEmpty(k))
+	}
+}
+
+func copyAnyValueSlice(values []anyValue, dest pcommon.Slice) {
+	dest.EnsureCapacity(len(values))
+	for _, v := range values {
+		v.copyTo(dest.AppendEmpty())
+	}
+}
+
+// jsonDouble is a float64 that encodes NaN and infinities as the strings used by
+// the OTLP JSON encoding, since JSON numbers can't represent them.
+type jsonDouble float64
+
+func (d jsonDouble) MarshalJSON() ([]byte, error) {
+	f := float64(d)
+	switch {
+	case math.IsNaN(f):
+		return []byte(`"NaN"`), nil
+	case math.IsInf(f, 1):
+		return []byte(`"Infinity"`), nil
+	case math.IsInf(f, -1):
+		return []byte(`"-Infinity"`), nil
+	default:
+		return strconv.AppendFloat(nil, f, 'g', -1, 64), nil
+	}
+}
+
+func (d *jsonDouble) UnmarshalJSON(data []byte) error {
+	var s string
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+	} else {
+		s = string(data)
+	}
+	switch s {
+	case "NaN":
+		*d = jsonDouble(math.NaN())
+	case "Infinity":
+		*d = jsonDouble(math.Inf(1))
+	case "-Infinity":
+		*d = jsonDouble(math.Inf(-1))
+	default:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return fmt.Errorf("invalid double %q", s)
+		}
+		*d = jsonDouble(f)
+	}
+	return nil
+}
@@ -37,6 +37,8 @@ const (
 	ContainerType EndpointType = "container"
 	// KafkaTopicType is a kafka topic endpoint
 	KafkaTopicType EndpointType = "kafka.topics"
+	// ProcessType is a host process endpoint.
+	ProcessType EndpointType = "process"
 )
 
 var (
@@ -47,6 +49,7 @@ var (
 	_ EndpointDetails = (*HostPort)(nil)
 	_ EndpointDetails = (*Container)(nil)
 	_ EndpointDetails = (*KafkaTopic)(nil)
+	_ EndpointDetails = (*Process)(nil)
 )
 
 // EndpointDetails provides additional context about an endpoint such as a Pod or Port.
@@ -292,6 +295,49 @@ func (*HostPort) Type() EndpointType {
 	return HostPortType
 }
 
+// Process is a process discovered on a host.
+type Process struct {
+	// PID is the process identifier.
+	PID int32
+	// Name is the short name of the process, as reported by the kernel.
+	Name string
+	// Executable is the absolute path of the process executable.
+	Executable string
+	// Command used to invoke the process, including the executable itself
+	// at the beginning.
+	Command string
+	// Args are the individual command line arguments of the process.
+	Args []string
+	// User is the name of the user owning the process. If the name can't be
+	// resolved this is the numeric user ID.
+	User string
+	// Cgroup is the path of the process in the unified (v2) cgroup hierarchy.
+	Cgroup string
+	// SystemdUnit is the systemd unit the process belongs to, derived from
+	// its cgroup. Empty if the process is not managed by systemd.
+	SystemdUnit string
+	// Ports are the TCP and UDP ports the process is listening on.
+	Ports []uint16
+}
+
+func (p *Process) Env() EndpointEnv {
+	return map[string]any{
+		"pid":          p.PID,
+		"process_name": p.Name,
+		"executable":   p.Executable,
+		"command":      p.Command,
+		"args":         p.Args,
+		"user":         p.User,
+		"cgroup":       p.Cgroup,
+		"systemd_unit": p.SystemdUnit,
+		"ports":        p.Ports,
+	}
+}
+
+func (*Process) Type() EndpointType {
+	return ProcessType
+}
+
 // Container is a discovered container
 type Container struct {
 	// Name is the primary name of the container
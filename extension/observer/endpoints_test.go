@@ -308,6 +308,40 @@ func TestEndpointEnv(t *testing.T) {
 				"endpoint": "topic1",
 			},
 		},
+		{
+			name: "Process",
+			endpoint: Endpoint{
+				ID:     EndpointID("process_id"),
+				Target: "127.0.0.1:6379",
+				Details: &Process{
+					PID:         1234,
+					Name:        "redis-server",
+					Executable:  "/usr/bin/redis-server",
+					Command:     "/usr/bin/redis-server 127.0.0.1:6379",
+					Args:        []string{"/usr/bin/redis-server", "127.0.0.1:6379"},
+					User:        "redis",
+					Cgroup:      "/system.slice/redis-server.service",
+					SystemdUnit: "redis-server.service",
+					Ports:       []uint16{6379},
+				},
+			},
+			want: EndpointEnv{
+				"type":         "process",
+				"endpoint":     "127.0.0.1:6379",
+				"id":           "process_id",
+				"pid":          int32(1234),
+				"process_name": "redis-server",
+				"executable":   "/usr/bin/redis-server",
+				"command":      "/usr/bin/redis-server 127.0.0.1:6379",
+				"args":         []string{"/usr/bin/redis-server", "127.0.0.1:6379"},
+				"user":         "redis",
+				"cgroup":       "/system.slice/redis-server.service",
+				"systemd_unit": "redis-server.service",
+				"ports":        []uint16{6379},
+				"host":         "127.0.0.1",
+				"port":         "6379",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
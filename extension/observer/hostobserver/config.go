@@ -4,15 +4,32 @@
 package hostobserver // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/hostobserver"
 
 import (
+	"errors"
+	"runtime"
 	"time"
 )
 
+var errProcessesUnsupported = errors.New("observe_processes is only supported on Linux")
+
 // Config defines configuration for host observer.
 type Config struct {
 	// RefreshInterval determines how frequency at which the observer
 	// needs to poll for collecting information about new processes.
 	RefreshInterval time.Duration `mapstructure:"refresh_interval"`
 
+	// ObserveProcesses enables the discovery of a `process` endpoint for
+	// every process running on the host, so that receiver_creator rules can
+	// match on the executable, user or systemd unit of a process.
+	ObserveProcesses bool `mapstructure:"observe_processes"`
+
 	// prevent unkeyed literal initialization
 	_ struct{}
 }
+
+// Validate checks if the extension configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.ObserveProcesses && runtime.GOOS != "linux" {
+		return errProcessesUnsupported
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ package hostobserver
 
 import (
 	"path/filepath"
+	"runtime"
 	"testing"
 	"time"
 
@@ -23,6 +24,9 @@ func TestLoadConfig(t *testing.T) {
 	tests := []struct {
 		id       component.ID
 		expected component.Config
+		// linuxOnly is set for configurations that fail validation on
+		// other operating systems.
+		linuxOnly bool
 	}{
 		{
 			id:       component.NewID(metadata.Type),
@@ -31,8 +35,10 @@ func TestLoadConfig(t *testing.T) {
 		{
 			id: component.NewIDWithName(metadata.Type, "all_settings"),
 			expected: &Config{
-				RefreshInterval: 20 * time.Second,
+				RefreshInterval:  20 * time.Second,
+				ObserveProcesses: true,
 			},
+			linuxOnly: true,
 		},
 	}
 	for _, tt := range tests {
@@ -45,7 +51,11 @@ func TestLoadConfig(t *testing.T) {
 			require.NoError(t, err)
 			require.NoError(t, sub.Unmarshal(cfg))
 
-			assert.NoError(t, xconfmap.Validate(cfg))
+			if tt.linuxOnly && runtime.GOOS != "linux" {
+				assert.ErrorIs(t, xconfmap.Validate(cfg), errProcessesUnsupported)
+			} else {
+				assert.NoError(t, xconfmap.Validate(cfg))
+			}
 			assert.Equal(t, tt.expected, cfg)
 		})
 	}
@@ -24,13 +24,15 @@ type hostObserver struct {
 }
 
 type endpointsLister struct {
-	logger       *zap.Logger
-	observerName string
+	logger           *zap.Logger
+	observerName     string
+	observeProcesses bool
 
 	// For testing
 	getConnections        func() ([]net.ConnectionStat, error)
 	getProcess            func(pid int32) (*process.Process, error)
 	collectProcessDetails func(proc *process.Process) (*processDetails, error)
+	listProcesses         func() ([]*observer.Process, error)
 }
 
 var _ extension.Extension = (*hostObserver)(nil)
@@ -41,9 +43,11 @@ func newObserver(params extension.Settings, config *Config) (extension.Extension
 			endpointsLister{
 				logger:                params.Logger,
 				observerName:          params.ID.String(),
+				observeProcesses:      config.ObserveProcesses,
 				getConnections:        getConnections,
 				getProcess:            process.NewProcess,
 				collectProcessDetails: collectProcessDetails,
+				listProcesses:         newProcessLister(),
 			},
 			config.RefreshInterval,
 			params.Logger,
@@ -69,7 +73,11 @@ func (e endpointsLister) ListEndpoints() []observer.Endpoint {
 		return nil
 	}
 
-	return e.collectEndpoints(conns)
+	endpoints := e.collectEndpoints(conns)
+	if e.observeProcesses {
+		endpoints = append(endpoints, e.collectProcessEndpoints(conns)...)
+	}
+	return endpoints
 }
 
 func getConnections() (conns []net.ConnectionStat, err error) {
@@ -90,14 +98,7 @@ func (e endpointsLister) collectEndpoints(conns []net.ConnectionStat) []observer
 	connsByPID := make(map[int32][]*net.ConnectionStat)
 	for i := range conns {
 		c := conns[i]
-		isIPSocket := c.Family == syscall.AF_INET || c.Family == syscall.AF_INET6
-		isTCPOrUDP := c.Type == syscall.SOCK_STREAM || c.Type == syscall.SOCK_DGRAM
-		// UDP doesn't have any status
-		isUDPOrListening := c.Type == syscall.SOCK_DGRAM || c.Status == "LISTEN"
-		// UDP is "listening" when it has a remote port of 0
-		isTCPOrHasNoRemotePort := c.Type == syscall.SOCK_STREAM || c.Raddr.Port == 0
-
-		if !isIPSocket || !isTCPOrUDP || !isUDPOrListening || !isTCPOrHasNoRemotePort {
+		if !isListening(&c) {
 			continue
 		}
 
@@ -175,6 +176,19 @@ func (e endpointsLister) collectEndpoints(conns []net.ConnectionStat) []observer
 	return endpoints
 }
 
+// isListening reports whether c is a TCP or UDP socket listening for
+// connections.
+func isListening(c *net.ConnectionStat) bool {
+	isIPSocket := c.Family == syscall.AF_INET || c.Family == syscall.AF_INET6
+	isTCPOrUDP := c.Type == syscall.SOCK_STREAM || c.Type == syscall.SOCK_DGRAM
+	// UDP doesn't have any status
+	isUDPOrListening := c.Type == syscall.SOCK_DGRAM || c.Status == "LISTEN"
+	// UDP is "listening" when it has a remote port of 0
+	isTCPOrHasNoRemotePort := c.Type == syscall.SOCK_STREAM || c.Raddr.Port == 0
+
+	return isIPSocket && isTCPOrUDP && isUDPOrListening && isTCPOrHasNoRemotePort
+}
+
 type connectionDetails struct {
 	ip        string
 	isIPv6    bool
@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package hostobserver // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/hostobserver"
+
+import (
+	"cmp"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/shirou/gopsutil/v4/net"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+// hostProcRoot returns the root of the proc filesystem, honouring the
+// HOST_PROC environment variable used by gopsutil when the collector runs
+// in a container with the host's /proc mounted elsewhere.
+func hostProcRoot() string {
+	if root := os.Getenv("HOST_PROC"); root != "" {
+		return root
+	}
+	return "/proc"
+}
+
+// collectProcessEndpoints returns a process endpoint for every process
+// running on the host. Listening sockets found in conns are attached to
+// their owning process.
+func (e endpointsLister) collectProcessEndpoints(conns []net.ConnectionStat) []observer.Endpoint {
+	procs, err := e.listProcesses()
+	if err != nil {
+		e.logger.Error("Could not list host processes", zap.Error(err))
+		return nil
+	}
+
+	listenersByPID := make(map[int32][]connectionDetails)
+	for i := range conns {
+		c := &conns[i]
+		if c.Pid == 0 || !isListening(c) {
+			continue
+		}
+		listenersByPID[c.Pid] = append(listenersByPID[c.Pid], collectConnectionDetails(c))
+	}
+
+	endpoints := make([]observer.Endpoint, 0, len(procs))
+	for _, proc := range procs {
+		listeners := listenersByPID[proc.PID]
+		// Prefer the lowest IPv4 TCP port as the endpoint target so that
+		// the resulting `endpoint` is stable between refreshes.
+		slices.SortFunc(listeners, func(a, b connectionDetails) int {
+			if c := cmp.Compare(a.port, b.port); c != 0 {
+				return c
+			}
+			if a.isIPv6 != b.isIPv6 {
+				if a.isIPv6 {
+					return 1
+				}
+				return -1
+			}
+			return cmp.Compare(a.transport, b.transport)
+		})
+
+		var target string
+		for _, l := range listeners {
+			if target == "" {
+				target = l.target
+			}
+			if len(proc.Ports) == 0 || proc.Ports[len(proc.Ports)-1] != l.port {
+				proc.Ports = append(proc.Ports, l.port)
+			}
+		}
+
+		endpoints = append(endpoints, observer.Endpoint{
+			ID:      observer.EndpointID(fmt.Sprintf("(%s)process-%d", e.observerName, proc.PID)),
+			Target:  target,
+			Details: proc,
+		})
+	}
+
+	return endpoints
+}
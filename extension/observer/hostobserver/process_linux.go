@@ -0,0 +1,181 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package hostobserver // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/hostobserver"
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+// procfs discovers processes by reading a proc filesystem mounted at root.
+type procfs struct {
+	root string
+	// lookupUser resolves a numeric user ID to a user name.
+	lookupUser func(uid string) (string, error)
+}
+
+func newProcessLister() func() ([]*observer.Process, error) {
+	fs := procfs{
+		root: hostProcRoot(),
+		lookupUser: func(uid string) (string, error) {
+			u, err := user.LookupId(uid)
+			if err != nil {
+				return "", err
+			}
+			return u.Username, nil
+		},
+	}
+	return fs.processes
+}
+
+func (fs procfs) processes() ([]*observer.Process, error) {
+	entries, err := os.ReadDir(fs.root)
+	if err != nil {
+		return nil, fmt.Errorf("could not read %s: %w", fs.root, err)
+	}
+
+	users := map[string]string{}
+	var procs []*observer.Process
+	for _, entry := range entries {
+		pid, err := strconv.ParseInt(entry.Name(), 10, 32)
+		if err != nil || !entry.IsDir() {
+			continue
+		}
+		proc, err := fs.process(int32(pid), users)
+		// The process might have terminated since the directory was listed.
+		if err != nil || proc == nil {
+			continue
+		}
+		procs = append(procs, proc)
+	}
+	return procs, nil
+}
+
+// process reads the details of a single process. It returns a nil process
+// for kernel threads and zombies, which have an empty command line.
+func (fs procfs) process(pid int32, users map[string]string) (*observer.Process, error) {
+	dir := filepath.Join(fs.root, strconv.Itoa(int(pid)))
+
+	cmdline, err := os.ReadFile(filepath.Join(dir, "cmdline"))
+	if err != nil {
+		return nil, err
+	}
+	args := strings.Split(string(bytes.TrimRight(cmdline, "\x00")), "\x00")
+	if len(args) == 1 && args[0] == "" {
+		return nil, nil
+	}
+
+	comm, err := os.ReadFile(filepath.Join(dir, "comm"))
+	if err != nil {
+		return nil, err
+	}
+
+	proc := &observer.Process{
+		PID:     pid,
+		Name:    strings.TrimSpace(string(comm)),
+		Command: strings.Join(args, " "),
+		Args:    args,
+	}
+
+	// Reading the executable of processes owned by other users requires
+	// the SYS_PTRACE capability, so don't fail if it can't be resolved.
+	if exe, err := os.Readlink(filepath.Join(dir, "exe")); err == nil {
+		proc.Executable = strings.TrimSuffix(exe, " (deleted)")
+	}
+
+	if uid, err := readUID(filepath.Join(dir, "status")); err == nil {
+		name, ok := users[uid]
+		if !ok {
+			name = uid
+			if resolved, err := fs.lookupUser(uid); err == nil {
+				name = resolved
+			}
+			users[uid] = name
+		}
+		proc.User = name
+	}
+
+	if cgroup, err := readCgroup(filepath.Join(dir, "cgroup")); err == nil {
+		proc.Cgroup = cgroup
+		proc.SystemdUnit = systemdUnit(cgroup)
+	}
+
+	return proc, nil
+}
+
+// readUID returns the real user ID from a /proc/<pid>/status file.
+func readUID(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if rest, ok := strings.CutPrefix(scanner.Text(), "Uid:"); ok {
+			fields := strings.Fields(rest)
+			if len(fields) == 0 {
+				break
+			}
+			return fields[0], nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", errors.New("no Uid entry in " + path)
+}
+
+// readCgroup returns the cgroup path of a process from a /proc/<pid>/cgroup
+// file. The unified (v2) hierarchy is preferred, falling back to the systemd
+// named hierarchy on hosts that only mount cgroup v1.
+func readCgroup(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	var fallback string
+	for _, line := range strings.Split(string(content), "\n") {
+		parts := strings.SplitN(line, ":", 3)
+		if len(parts) != 3 {
+			continue
+		}
+		switch {
+		case parts[0] == "0" && parts[1] == "":
+			return parts[2], nil
+		case parts[1] == "name=systemd":
+			fallback = parts[2]
+		}
+	}
+	if fallback == "" {
+		return "", errors.New("no cgroup entry in " + path)
+	}
+	return fallback, nil
+}
+
+// systemdUnit returns the innermost systemd service or scope unit in a
+// cgroup path, e.g. "redis-server.service" for
+// "/system.slice/redis-server.service".
+func systemdUnit(cgroup string) string {
+	elems := strings.Split(cgroup, "/")
+	for i := len(elems) - 1; i >= 0; i-- {
+		if strings.HasSuffix(elems[i], ".service") || strings.HasSuffix(elems[i], ".scope") {
+			return elems[i]
+		}
+	}
+	return ""
+}
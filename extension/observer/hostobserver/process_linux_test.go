@@ -0,0 +1,184 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package hostobserver
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+type fakeProcess struct {
+	pid     string
+	comm    string
+	cmdline string
+	exe     string
+	uid     string
+	cgroup  string
+}
+
+func newFakeProcfs(t *testing.T, procs ...fakeProcess) string {
+	root := t.TempDir()
+	for _, p := range procs {
+		dir := filepath.Join(root, p.pid)
+		require.NoError(t, os.MkdirAll(dir, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "comm"), []byte(p.comm+"\n"), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "cmdline"), []byte(p.cmdline), 0o600))
+		if p.exe != "" {
+			require.NoError(t, os.Symlink(p.exe, filepath.Join(dir, "exe")))
+		}
+		if p.uid != "" {
+			status := "Name:\t" + p.comm + "\nUid:\t" + p.uid + "\t" + p.uid + "\t" + p.uid + "\t" + p.uid + "\n"
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "status"), []byte(status), 0o600))
+		}
+		if p.cgroup != "" {
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "cgroup"), []byte(p.cgroup), 0o600))
+		}
+	}
+	// Non-process entries of /proc must be ignored.
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "sys"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "uptime"), []byte("1.0 1.0\n"), 0o600))
+	return root
+}
+
+func TestProcfsProcesses(t *testing.T) {
+	root := newFakeProcfs(t,
+		fakeProcess{
+			pid:     "1",
+			comm:    "systemd",
+			cmdline: "/sbin/init\x00splash\x00",
+			exe:     "/usr/lib/systemd/systemd",
+			uid:     "0",
+			cgroup:  "0::/init.scope\n",
+		},
+		fakeProcess{
+			pid:     "2",
+			comm:    "kthreadd",
+			cmdline: "",
+		},
+		fakeProcess{
+			pid:     "812",
+			comm:    "redis-server",
+			cmdline: "/usr/bin/redis-server 127.0.0.1:6379\x00\x00",
+			exe:     "/usr/bin/redis-server (deleted)",
+			uid:     "111",
+			cgroup:  "0::/system.slice/redis-server.service\n",
+		},
+		fakeProcess{
+			pid:     "904",
+			comm:    "postgres",
+			cmdline: "/usr/lib/postgresql/16/bin/postgres\x00-D\x00/var/lib/postgresql/16/main\x00",
+			uid:     "112",
+			cgroup:  "12:pids:/system.slice/postgresql@16-main.service\n1:name=systemd:/system.slice/postgresql@16-main.service\n",
+		},
+		fakeProcess{
+			pid:     "1500",
+			comm:    "bash",
+			cmdline: "-bash\x00",
+			uid:     "1000",
+			cgroup:  "0::/user.slice/user-1000.slice/session-3.scope\n",
+		},
+		fakeProcess{
+			pid:     "1600",
+			comm:    "sleep",
+			cmdline: "sleep\x00infinity\x00",
+		},
+	)
+
+	fs := procfs{
+		root: root,
+		lookupUser: func(uid string) (string, error) {
+			switch uid {
+			case "0":
+				return "root", nil
+			case "111":
+				return "redis", nil
+			case "112":
+				return "postgres", nil
+			}
+			return "", errors.New("unknown user")
+		},
+	}
+
+	procs, err := fs.processes()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []*observer.Process{
+		{
+			PID:         1,
+			Name:        "systemd",
+			Executable:  "/usr/lib/systemd/systemd",
+			Command:     "/sbin/init splash",
+			Args:        []string{"/sbin/init", "splash"},
+			User:        "root",
+			Cgroup:      "/init.scope",
+			SystemdUnit: "init.scope",
+		},
+		{
+			PID:         812,
+			Name:        "redis-server",
+			Executable:  "/usr/bin/redis-server",
+			Command:     "/usr/bin/redis-server 127.0.0.1:6379",
+			Args:        []string{"/usr/bin/redis-server 127.0.0.1:6379"},
+			User:        "redis",
+			Cgroup:      "/system.slice/redis-server.service",
+			SystemdUnit: "redis-server.service",
+		},
+		{
+			PID:         904,
+			Name:        "postgres",
+			Command:     "/usr/lib/postgresql/16/bin/postgres -D /var/lib/postgresql/16/main",
+			Args:        []string{"/usr/lib/postgresql/16/bin/postgres", "-D", "/var/lib/postgresql/16/main"},
+			User:        "postgres",
+			Cgroup:      "/system.slice/postgresql@16-main.service",
+			SystemdUnit: "postgresql@16-main.service",
+		},
+		{
+			PID:         1500,
+			Name:        "bash",
+			Command:     "-bash",
+			Args:        []string{"-bash"},
+			User:        "1000",
+			Cgroup:      "/user.slice/user-1000.slice/session-3.scope",
+			SystemdUnit: "session-3.scope",
+		},
+		{
+			PID:     1600,
+			Name:    "sleep",
+			Command: "sleep infinity",
+			Args:    []string{"sleep", "infinity"},
+		},
+	}, procs)
+}
+
+func TestProcfsProcessesMissingRoot(t *testing.T) {
+	fs := procfs{root: filepath.Join(t.TempDir(), "missing")}
+	_, err := fs.processes()
+	assert.Error(t, err)
+}
+
+func TestSystemdUnit(t *testing.T) {
+	tests := []struct {
+		cgroup string
+		want   string
+	}{
+		{cgroup: "/system.slice/redis-server.service", want: "redis-server.service"},
+		{cgroup: "/user.slice/user-1000.slice/user@1000.service/app.slice/app.service", want: "app.service"},
+		{cgroup: "/user.slice/user-1000.slice/user@1000.service/init.scope", want: "init.scope"},
+		{cgroup: "/kubepods.slice/kubepods-besteffort.slice", want: ""},
+		{cgroup: "/", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.cgroup, func(t *testing.T) {
+			assert.Equal(t, tt.want, systemdUnit(tt.cgroup))
+		})
+	}
+}
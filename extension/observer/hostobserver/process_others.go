@@ -0,0 +1,16 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !linux
+
+package hostobserver // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/hostobserver"
+
+import (
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+func newProcessLister() func() ([]*observer.Process, error) {
+	return func() ([]*observer.Process, error) {
+		return nil, errProcessesUnsupported
+	}
+}
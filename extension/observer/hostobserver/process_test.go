@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package hostobserver
+
+import (
+	"errors"
+	"syscall"
+	"testing"
+
+	psnet "github.com/shirou/gopsutil/v4/net"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+func TestCollectProcessEndpoints(t *testing.T) {
+	listener := func(pid int32, family, sockType uint32, ip string, port uint32) psnet.ConnectionStat {
+		return psnet.ConnectionStat{
+			Family: family,
+			Type:   sockType,
+			Laddr:  psnet.Addr{IP: ip, Port: port},
+			Status: "LISTEN",
+			Pid:    pid,
+		}
+	}
+
+	tests := []struct {
+		name          string
+		conns         []psnet.ConnectionStat
+		listProcesses func() ([]*observer.Process, error)
+		want          []observer.Endpoint
+	}{
+		{
+			name: "Process without listeners",
+			listProcesses: func() ([]*observer.Process, error) {
+				return []*observer.Process{{PID: 10, Name: "cron"}}, nil
+			},
+			want: []observer.Endpoint{
+				{
+					ID:      "(host_observer)process-10",
+					Details: &observer.Process{PID: 10, Name: "cron"},
+				},
+			},
+		},
+		{
+			name: "Process with listeners",
+			conns: []psnet.ConnectionStat{
+				listener(20, syscall.AF_INET6, syscall.SOCK_STREAM, "::", 6379),
+				listener(20, syscall.AF_INET, syscall.SOCK_STREAM, "0.0.0.0", 16379),
+				listener(20, syscall.AF_INET, syscall.SOCK_STREAM, "0.0.0.0", 6379),
+				listener(30, syscall.AF_INET, syscall.SOCK_STREAM, "0.0.0.0", 5432),
+				{
+					Family: syscall.AF_INET,
+					Type:   syscall.SOCK_STREAM,
+					Laddr:  psnet.Addr{IP: "10.0.0.1", Port: 40000},
+					Status: "ESTABLISHED",
+					Pid:    20,
+				},
+			},
+			listProcesses: func() ([]*observer.Process, error) {
+				return []*observer.Process{{PID: 20, Name: "redis-server"}}, nil
+			},
+			want: []observer.Endpoint{
+				{
+					ID:     "(host_observer)process-20",
+					Target: "127.0.0.1:6379",
+					Details: &observer.Process{
+						PID:   20,
+						Name:  "redis-server",
+						Ports: []uint16{6379, 16379},
+					},
+				},
+			},
+		},
+		{
+			name: "Fails to list processes",
+			listProcesses: func() ([]*observer.Process, error) {
+				return nil, errors.New("always fail")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := endpointsLister{
+				logger:        zap.NewNop(),
+				observerName:  "host_observer",
+				listProcesses: tt.listProcesses,
+			}
+			assert.Equal(t, tt.want, e.collectProcessEndpoints(tt.conns))
+		})
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfunctionsextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/ottlfunctionsextension"
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// Config defines the user-defined OTTL functions of the library.
+type Config struct {
+	// Functions are the user-defined functions made available to the components referencing the extension.
+	Functions []ottl.UserFunction `mapstructure:"functions"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// Validate checks that the functions are valid and do not call each other recursively.
+// The paths and functions used by their bodies are only verified by the components calling them.
+func (cfg *Config) Validate() error {
+	if len(cfg.Functions) == 0 {
+		return errors.New("at least one function must be defined")
+	}
+	if _, err := ottl.MergeUserFunctions(map[string]ottl.Factory[any]{}, cfg.Functions); err != nil {
+		return fmt.Errorf("invalid functions: %w", err)
+	}
+	return nil
+}
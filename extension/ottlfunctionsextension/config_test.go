@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfunctionsextension
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/confmap/xconfmap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/ottlfunctionsextension/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id           component.ID
+		expected     component.Config
+		errorMessage string
+	}{
+		{
+			id:           component.NewID(metadata.Type),
+			errorMessage: "at least one function must be defined",
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "http"),
+			expected: &Config{
+				Functions: []ottl.UserFunction{
+					{
+						Signature:  "NormalizeMethod(method string)",
+						Expression: `ToUpperCase(Trim(method))`,
+					},
+					{
+						Signature: "set_route(route string)",
+						Statements: []string{
+							`set(span.attributes["http.route"], route)`,
+							`set(span.name, Concat([NormalizeMethod(span.attributes["http.request.method"]), route], " "))`,
+						},
+					},
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "recursive"),
+			errorMessage: "user-defined functions cannot be called recursively: Fix -> Normalize -> Fix",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_signature"),
+			errorMessage: `invalid type "map[string]" for parameter "value"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			if tt.errorMessage != "" {
+				assert.ErrorContains(t, xconfmap.Validate(cfg), tt.errorMessage)
+				return
+			}
+			assert.NoError(t, xconfmap.Validate(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
@@ -0,0 +1,7 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package ottlfunctionsextension provides a library of user-defined OTTL functions shared by several components.
+package ottlfunctionsextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/ottlfunctionsextension"
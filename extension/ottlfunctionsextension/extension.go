@@ -0,0 +1,35 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfunctionsextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/ottlfunctionsextension"
+
+import (
+	"slices"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+var (
+	_ extension.Extension      = (*functionLibrary)(nil)
+	_ ottl.UserFunctionLibrary = (*functionLibrary)(nil)
+)
+
+// functionLibrary provides its configured functions to the components referencing it.
+type functionLibrary struct {
+	component.StartFunc
+	component.ShutdownFunc
+
+	functions []ottl.UserFunction
+}
+
+func newFunctionLibrary(cfg *Config) *functionLibrary {
+	return &functionLibrary{functions: slices.Clone(cfg.Functions)}
+}
+
+// UserFunctions implements ottl.UserFunctionLibrary.
+func (l *functionLibrary) UserFunctions() []ottl.UserFunction {
+	return slices.Clone(l.functions)
+}
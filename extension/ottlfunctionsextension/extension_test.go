@@ -0,0 +1,53 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfunctionsextension
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/extension/extensiontest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/ottlfunctionsextension/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type testHost struct {
+	component.Host
+	extensions map[component.ID]component.Component
+}
+
+func (h testHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+func TestUserFunctions(t *testing.T) {
+	cfg := &Config{
+		Functions: []ottl.UserFunction{
+			{
+				Signature:  "NormalizeMethod(method string)",
+				Expression: `ToUpperCase(method)`,
+			},
+		},
+	}
+	ext, err := NewFactory().Create(t.Context(), extensiontest.NewNopSettings(metadata.Type), cfg)
+	require.NoError(t, err)
+	require.NoError(t, ext.Start(t.Context(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		assert.NoError(t, ext.Shutdown(t.Context()))
+	})
+
+	id := component.NewIDWithName(metadata.Type, "http")
+	host := testHost{extensions: map[component.ID]component.Component{id: ext}}
+	functions, err := ottl.GetUserFunctionLibraries(host, []ottl.UserFunctionLibraryConfig{{ID: id, Functions: []string{"NormalizeMethod"}}})
+	require.NoError(t, err)
+	assert.Equal(t, cfg.Functions, functions)
+
+	// the functions of the library are not affected by changes of the returned slice
+	functions[0].Expression = `method`
+	assert.Equal(t, `ToUpperCase(method)`, ext.(ottl.UserFunctionLibrary).UserFunctions()[0].Expression)
+}
@@ -0,0 +1,16 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"go.opentelemetry.io/collector/component"
+)
+
+var (
+	Type      = component.MustNewType("ottl_functions")
+	ScopeName = "github.com/open-telemetry/opentelemetry-collector-contrib/extension/ottlfunctionsextension"
+)
+
+const (
+	ExtensionStability = component.StabilityLevelDevelopment
+)
@@ -15,6 +15,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlscope"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanlink"
 )
 
 // NewBoolExprForSpan creates a BoolExpr[*ottlspan.TransformContext] that will return true if any of the given OTTL conditions evaluate to true.
@@ -59,6 +60,27 @@ func NewBoolExprForSpanEventWithOptions(conditions []string, functions map[strin
 	return &c, nil
 }
 
+// NewBoolExprForSpanLink creates a BoolExpr[*ottlspanlink.TransformContext] that will return true if any of the given OTTL conditions evaluate to true.
+// The passed in functions should use the ottlspanlink.TransformContext.
+// If a function named `match` is not present in the function map it will be added automatically so that parsing works as expected
+func NewBoolExprForSpanLink(conditions []string, functions map[string]ottl.Factory[*ottlspanlink.TransformContext], errorMode ottl.ErrorMode, set component.TelemetrySettings) (*ottl.ConditionSequence[*ottlspanlink.TransformContext], error) {
+	return NewBoolExprForSpanLinkWithOptions(conditions, functions, errorMode, set, nil)
+}
+
+// NewBoolExprForSpanLinkWithOptions is like NewBoolExprForSpanLink, but with additional options.
+func NewBoolExprForSpanLinkWithOptions(conditions []string, functions map[string]ottl.Factory[*ottlspanlink.TransformContext], errorMode ottl.ErrorMode, set component.TelemetrySettings, parserOptions []ottl.Option[*ottlspanlink.TransformContext]) (*ottl.ConditionSequence[*ottlspanlink.TransformContext], error) {
+	parser, err := ottlspanlink.NewParser(functions, set, parserOptions...)
+	if err != nil {
+		return nil, err
+	}
+	statements, err := parser.ParseConditions(conditions)
+	if err != nil {
+		return nil, err
+	}
+	c := ottlspanlink.NewConditionSequence(statements, set, ottlspanlink.WithConditionSequenceErrorMode(errorMode))
+	return &c, nil
+}
+
 // NewBoolExprForMetric creates a BoolExpr[*ottlmetric.TransformContext] that will return true if any of the given OTTL conditions evaluate to true.
 // The passed in functions should use the ottlmetric.TransformContext.
 // If a function named `match` is not present in the function map it will be added automatically so that parsing works as expected
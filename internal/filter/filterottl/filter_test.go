@@ -18,6 +18,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlscope"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanlink"
 )
 
 func Test_NewBoolExprForSpan(t *testing.T) {
@@ -126,6 +127,59 @@ func Test_NewBoolExprForSpanEventWithOptions(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func Test_NewBoolExprForSpanLink(t *testing.T) {
+	tests := []struct {
+		name           string
+		conditions     []string
+		expectedResult bool
+	}{
+		{
+			name: "basic",
+			conditions: []string{
+				"true == true",
+			},
+			expectedResult: true,
+		},
+		{
+			name: "multiple",
+			conditions: []string{
+				"false == true",
+				"true == true",
+			},
+			expectedResult: true,
+		},
+		{
+			name: "With Converter",
+			conditions: []string{
+				`IsMatch("test", "pass")`,
+			},
+			expectedResult: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spanLinkBoolExpr, err := NewBoolExprForSpanLink(tt.conditions, StandardSpanLinkFuncs(), ottl.PropagateError, componenttest.NewNopTelemetrySettings())
+			assert.NoError(t, err)
+			assert.NotNil(t, spanLinkBoolExpr)
+			result, err := spanLinkBoolExpr.Eval(t.Context(), &ottlspanlink.TransformContext{})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, result)
+		})
+	}
+}
+
+func Test_NewBoolExprForSpanLinkWithOptions(t *testing.T) {
+	_, err := NewBoolExprForSpanLinkWithOptions(
+		[]string{`spanlink.flags == 1`},
+		StandardSpanLinkFuncs(),
+		ottl.PropagateError,
+		componenttest.NewNopTelemetrySettings(),
+		[]ottl.Option[*ottlspanlink.TransformContext]{ottlspanlink.EnablePathContextNames()},
+	)
+	assert.NoError(t, err)
+}
+
 func Test_NewBoolExprForMetric(t *testing.T) {
 	tests := []struct {
 		name           string
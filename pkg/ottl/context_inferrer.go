@@ -20,6 +20,7 @@ var defaultContextInferPriority = []string{
 	"datapoint",
 	"metric",
 	"spanevent",
+	"spanlink",
 	"span",
 	"profile",
 	"scope",
@@ -289,10 +290,7 @@ func (*priorityContextInferrer) getStatementsHints(statements []string) ([]prior
 			return nil, err
 		}
 		visitor := newGrammarContextInferrerVisitor()
-		parsed.Editor.accept(&visitor)
-		if parsed.WhereClause != nil {
-			parsed.WhereClause.accept(&visitor)
-		}
+		parsed.accept(&visitor)
 		hints = append(hints, visitor)
 	}
 	return hints, nil
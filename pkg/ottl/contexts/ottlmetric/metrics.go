@@ -118,6 +118,11 @@ func (tCtx *TransformContext) GetMetrics() pmetric.MetricSlice {
 	return tCtx.metrics
 }
 
+// GetResourceMetrics returns the resource metrics the metric belongs to from the TransformContext.
+func (tCtx *TransformContext) GetResourceMetrics() pmetric.ResourceMetrics {
+	return tCtx.resourceMetrics
+}
+
 // GetInstrumentationScope returns the instrumentation scope from the TransformContext.
 func (tCtx *TransformContext) GetInstrumentationScope() pcommon.InstrumentationScope {
 	return tCtx.instrumentationScope
@@ -0,0 +1,261 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlspanlink // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanlink"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxcache"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxcommon"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxresource"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxscope"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxspan"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxspanlink"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/logging"
+)
+
+var tcPool = sync.Pool{
+	New: func() any {
+		return &TransformContext{cache: pcommon.NewMap()}
+	},
+}
+
+// ContextName is the name of the context for span links.
+// Experimental: *NOTE* this constant is subject to change or removal in the future.
+const ContextName = ctxspanlink.Name
+
+var _ zapcore.ObjectMarshaler = (*TransformContext)(nil)
+
+// TransformContext represents a span link and its associated hierarchy.
+type TransformContext struct {
+	spanLink             ptrace.SpanLink
+	span                 ptrace.Span
+	instrumentationScope pcommon.InstrumentationScope
+	resource             pcommon.Resource
+	cache                pcommon.Map
+	scopeSpans           ptrace.ScopeSpans
+	resourceSpans        ptrace.ResourceSpans
+	linkIndex            *int64
+}
+
+// MarshalLogObject serializes the TransformContext into a zapcore.ObjectEncoder for logging.
+func (tCtx *TransformContext) MarshalLogObject(encoder zapcore.ObjectEncoder) error {
+	err := encoder.AddObject("resource", logging.Resource(tCtx.resource))
+	err = errors.Join(err, encoder.AddObject("scope", logging.InstrumentationScope(tCtx.instrumentationScope)))
+	err = errors.Join(err, encoder.AddObject("span", logging.Span(tCtx.span)))
+	err = errors.Join(err, encoder.AddObject("spanlink", logging.SpanLink(tCtx.spanLink)))
+	err = errors.Join(err, encoder.AddObject("cache", logging.Map(tCtx.cache)))
+	if tCtx.linkIndex != nil {
+		encoder.AddInt64("link_index", *tCtx.linkIndex)
+	}
+	return err
+}
+
+// TransformContextOption represents an option for configuring a TransformContext.
+type TransformContextOption func(*TransformContext)
+
+// NewTransformContextPtr returns a new TransformContext with the provided parameters from a pool of contexts.
+// Caller must call TransformContext.Close on the returned TransformContext.
+func NewTransformContextPtr(spanLink ptrace.SpanLink, span ptrace.Span, instrumentationScope pcommon.InstrumentationScope, resource pcommon.Resource, scopeSpans ptrace.ScopeSpans, resourceSpans ptrace.ResourceSpans, options ...TransformContextOption) *TransformContext {
+	tCtx := tcPool.Get().(*TransformContext)
+	tCtx.spanLink = spanLink
+	tCtx.span = span
+	tCtx.instrumentationScope = instrumentationScope
+	tCtx.resource = resource
+	tCtx.scopeSpans = scopeSpans
+	tCtx.resourceSpans = resourceSpans
+	for _, opt := range options {
+		opt(tCtx)
+	}
+	return tCtx
+}
+
+// Close the current TransformContext.
+// After this function returns this instance cannot be used.
+func (tCtx *TransformContext) Close() {
+	tCtx.spanLink = ptrace.SpanLink{}
+	tCtx.span = ptrace.Span{}
+	tCtx.instrumentationScope = pcommon.InstrumentationScope{}
+	tCtx.resource = pcommon.Resource{}
+	tCtx.cache.Clear()
+	tCtx.scopeSpans = ptrace.ScopeSpans{}
+	tCtx.resourceSpans = ptrace.ResourceSpans{}
+	tCtx.linkIndex = nil
+	tcPool.Put(tCtx)
+}
+
+// WithLinkIndex sets the index of the SpanLink within the span, to make it accessible via the link_index property of its context.
+// The index must be greater than or equal to zero, otherwise the given value will not be applied.
+func WithLinkIndex(linkIndex int64) TransformContextOption {
+	return func(p *TransformContext) {
+		p.linkIndex = &linkIndex
+	}
+}
+
+// GetSpanLink returns the span link from the TransformContext.
+func (tCtx *TransformContext) GetSpanLink() ptrace.SpanLink {
+	return tCtx.spanLink
+}
+
+// GetSpan returns the span from the TransformContext.
+func (tCtx *TransformContext) GetSpan() ptrace.Span {
+	return tCtx.span
+}
+
+// GetInstrumentationScope returns the instrumentation scope from the TransformContext.
+func (tCtx *TransformContext) GetInstrumentationScope() pcommon.InstrumentationScope {
+	return tCtx.instrumentationScope
+}
+
+// GetResource returns the resource from the TransformContext.
+func (tCtx *TransformContext) GetResource() pcommon.Resource {
+	return tCtx.resource
+}
+
+// GetScopeSchemaURLItem returns the schema URL item for the scope from the TransformContext.
+func (tCtx *TransformContext) GetScopeSchemaURLItem() ctxcommon.SchemaURLItem {
+	return tCtx.scopeSpans
+}
+
+// GetResourceSchemaURLItem returns the schema URL item for the resource from the TransformContext.
+func (tCtx *TransformContext) GetResourceSchemaURLItem() ctxcommon.SchemaURLItem {
+	return tCtx.resourceSpans
+}
+
+// GetLinkIndex returns the link index from the TransformContext.
+// If the link index is not set or invalid, an error is returned.
+func (tCtx *TransformContext) GetLinkIndex() (int64, error) {
+	if tCtx.linkIndex != nil {
+		if *tCtx.linkIndex < 0 {
+			return 0, errors.New("found invalid value for 'link_index'")
+		}
+		return *tCtx.linkIndex, nil
+	}
+	return 0, errors.New("no 'link_index' property has been set")
+}
+
+// EnablePathContextNames enables the support for path's context names on statements.
+// When this option is configured, all statement's paths must have a valid context prefix,
+// otherwise an error is reported.
+//
+// Experimental: *NOTE* this option is subject to change or removal in the future.
+func EnablePathContextNames() ottl.Option[*TransformContext] {
+	return func(p *ottl.Parser[*TransformContext]) {
+		ottl.WithPathContextNames[*TransformContext]([]string{
+			ctxspanlink.Name,
+			ctxspan.Name,
+			ctxresource.Name,
+			ctxscope.LegacyName,
+			ctxscope.Name,
+		})(p)
+	}
+}
+
+// StatementSequenceOption represents an option for configuring a statement sequence.
+type StatementSequenceOption func(*ottl.StatementSequence[*TransformContext])
+
+// WithStatementSequenceErrorMode sets the error mode for a statement sequence.
+func WithStatementSequenceErrorMode(errorMode ottl.ErrorMode) StatementSequenceOption {
+	return func(s *ottl.StatementSequence[*TransformContext]) {
+		ottl.WithStatementSequenceErrorMode[*TransformContext](errorMode)(s)
+	}
+}
+
+// NewStatementSequence creates a new statement sequence with the provided statements and options.
+func NewStatementSequence(statements []*ottl.Statement[*TransformContext], telemetrySettings component.TelemetrySettings, options ...StatementSequenceOption) ottl.StatementSequence[*TransformContext] {
+	s := ottl.NewStatementSequence(statements, telemetrySettings)
+	for _, op := range options {
+		op(&s)
+	}
+	return s
+}
+
+// ConditionSequenceOption represents an option for configuring a condition sequence.
+type ConditionSequenceOption func(*ottl.ConditionSequence[*TransformContext])
+
+// WithConditionSequenceErrorMode sets the error mode for a condition sequence.
+func WithConditionSequenceErrorMode(errorMode ottl.ErrorMode) ConditionSequenceOption {
+	return func(c *ottl.ConditionSequence[*TransformContext]) {
+		ottl.WithConditionSequenceErrorMode[*TransformContext](errorMode)(c)
+	}
+}
+
+// NewConditionSequence creates a new condition sequence with the provided conditions and options.
+func NewConditionSequence(conditions []*ottl.Condition[*TransformContext], telemetrySettings component.TelemetrySettings, options ...ConditionSequenceOption) ottl.ConditionSequence[*TransformContext] {
+	c := ottl.NewConditionSequence(conditions, telemetrySettings)
+	for _, op := range options {
+		op(&c)
+	}
+	return c
+}
+
+// NewParser creates a new span link parser with the provided functions and options.
+func NewParser(
+	functions map[string]ottl.Factory[*TransformContext],
+	telemetrySettings component.TelemetrySettings,
+	options ...ottl.Option[*TransformContext],
+) (ottl.Parser[*TransformContext], error) {
+	return ctxcommon.NewParser(
+		functions,
+		telemetrySettings,
+		pathExpressionParser(getCache),
+		parseEnum,
+		options...,
+	)
+}
+
+func parseEnum(val *ottl.EnumSymbol) (*ottl.Enum, error) {
+	if val != nil {
+		if enum, ok := ctxspan.SymbolTable[*val]; ok {
+			return &enum, nil
+		}
+		return nil, fmt.Errorf("enum symbol, %s, not found", *val)
+	}
+	return nil, errors.New("enum symbol not provided")
+}
+
+func getCache(tCtx *TransformContext) pcommon.Map {
+	return tCtx.cache
+}
+
+func pathExpressionParser(cacheGetter ctxcache.Getter[*TransformContext]) ottl.PathExpressionParser[*TransformContext] {
+	return ctxcommon.PathExpressionParser(
+		ctxspanlink.Name,
+		ctxspanlink.DocRef,
+		cacheGetter,
+		map[string]ottl.PathExpressionParser[*TransformContext]{
+			ctxresource.Name:    ctxresource.PathGetSetter[*TransformContext],
+			ctxscope.Name:       ctxscope.PathGetSetter[*TransformContext],
+			ctxscope.LegacyName: ctxscope.PathGetSetter[*TransformContext],
+			ctxspan.Name:        ctxspan.PathGetSetter[*TransformContext],
+			ctxspanlink.Name:    spanLinkGetSetterWithIndex,
+		})
+}
+
+func spanLinkGetSetterWithIndex(path ottl.Path[*TransformContext]) (ottl.GetSetter[*TransformContext], error) {
+	if path.Name() == "link_index" {
+		return accessSpanLinkIndex(), nil
+	}
+	return ctxspanlink.PathGetSetter(path)
+}
+
+func accessSpanLinkIndex() ottl.StandardGetSetter[*TransformContext] {
+	return ottl.StandardGetSetter[*TransformContext]{
+		Getter: func(_ context.Context, tCtx *TransformContext) (any, error) {
+			return tCtx.GetLinkIndex()
+		},
+		Setter: func(_ context.Context, _ *TransformContext, _ any) error {
+			return errors.New("the 'link_index' path cannot be modified")
+		},
+	}
+}
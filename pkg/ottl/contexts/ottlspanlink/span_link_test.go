@@ -0,0 +1,398 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlspanlink
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxresource"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxscope"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxspan"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxspanlink"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/pathtest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottltest"
+)
+
+var (
+	traceID = [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	spanID  = [8]byte{1, 2, 3, 4, 5, 6, 7, 8}
+	spanID2 = [8]byte{8, 7, 6, 5, 4, 3, 2, 1}
+)
+
+func Test_newPathGetSetter(t *testing.T) {
+	newAttrs := pcommon.NewMap()
+	newAttrs.PutStr("hello", "world")
+
+	newCache := pcommon.NewMap()
+	newCache.PutStr("temp", "value")
+
+	tests := []struct {
+		name              string
+		path              ottl.Path[*TransformContext]
+		orig              any
+		newVal            any
+		expectSetterError bool
+		modified          func(spanLink ptrace.SpanLink, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource, cache pcommon.Map)
+	}{
+		{
+			name: "cache",
+			path: &pathtest.Path[*TransformContext]{
+				N: "cache",
+			},
+			orig:   pcommon.NewMap(),
+			newVal: newCache,
+			modified: func(_ ptrace.SpanLink, _ ptrace.Span, _ pcommon.InstrumentationScope, _ pcommon.Resource, cache pcommon.Map) {
+				newCache.CopyTo(cache)
+			},
+		},
+		{
+			name: "cache access",
+			path: &pathtest.Path[*TransformContext]{
+				N: "cache",
+				KeySlice: []ottl.Key[*TransformContext]{
+					&pathtest.Key[*TransformContext]{
+						S: ottltest.Strp("temp"),
+					},
+				},
+			},
+			orig:   nil,
+			newVal: "new value",
+			modified: func(_ ptrace.SpanLink, _ ptrace.Span, _ pcommon.InstrumentationScope, _ pcommon.Resource, cache pcommon.Map) {
+				cache.PutStr("temp", "new value")
+			},
+		},
+		{
+			name: "span_id",
+			path: &pathtest.Path[*TransformContext]{
+				N: "span_id",
+			},
+			orig:   pcommon.SpanID(spanID),
+			newVal: pcommon.SpanID(spanID2),
+			modified: func(spanLink ptrace.SpanLink, _ ptrace.Span, _ pcommon.InstrumentationScope, _ pcommon.Resource, _ pcommon.Map) {
+				spanLink.SetSpanID(spanID2)
+			},
+		},
+		{
+			name: "trace_id string",
+			path: &pathtest.Path[*TransformContext]{
+				N: "trace_id",
+				NextPath: &pathtest.Path[*TransformContext]{
+					N: "string",
+				},
+			},
+			orig:   "0102030405060708090a0b0c0d0e0f10",
+			newVal: "100f0e0d0c0b0a090807060504030201",
+			modified: func(spanLink ptrace.SpanLink, _ ptrace.Span, _ pcommon.InstrumentationScope, _ pcommon.Resource, _ pcommon.Map) {
+				spanLink.SetTraceID([16]byte{16, 15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1})
+			},
+		},
+		{
+			name: "flags",
+			path: &pathtest.Path[*TransformContext]{
+				N: "flags",
+			},
+			orig:   int64(1),
+			newVal: int64(0),
+			modified: func(spanLink ptrace.SpanLink, _ ptrace.Span, _ pcommon.InstrumentationScope, _ pcommon.Resource, _ pcommon.Map) {
+				spanLink.SetFlags(0)
+			},
+		},
+		{
+			name: "attributes",
+			path: &pathtest.Path[*TransformContext]{
+				N: "attributes",
+			},
+			orig: func() pcommon.Map {
+				spanLink, _, _, _ := createTelemetry()
+				return spanLink.Attributes()
+			}(),
+			newVal: newAttrs,
+			modified: func(spanLink ptrace.SpanLink, _ ptrace.Span, _ pcommon.InstrumentationScope, _ pcommon.Resource, _ pcommon.Map) {
+				newAttrs.CopyTo(spanLink.Attributes())
+			},
+		},
+		{
+			name: "attributes string",
+			path: &pathtest.Path[*TransformContext]{
+				N: "attributes",
+				KeySlice: []ottl.Key[*TransformContext]{
+					&pathtest.Key[*TransformContext]{
+						S: ottltest.Strp("str"),
+					},
+				},
+			},
+			orig:   "val",
+			newVal: "newVal",
+			modified: func(spanLink ptrace.SpanLink, _ ptrace.Span, _ pcommon.InstrumentationScope, _ pcommon.Resource, _ pcommon.Map) {
+				spanLink.Attributes().PutStr("str", "newVal")
+			},
+		},
+		{
+			name: "dropped_attributes_count",
+			path: &pathtest.Path[*TransformContext]{
+				N: "dropped_attributes_count",
+			},
+			orig:   int64(10),
+			newVal: int64(20),
+			modified: func(spanLink ptrace.SpanLink, _ ptrace.Span, _ pcommon.InstrumentationScope, _ pcommon.Resource, _ pcommon.Map) {
+				spanLink.SetDroppedAttributesCount(20)
+			},
+		},
+		{
+			name: "link_index",
+			path: &pathtest.Path[*TransformContext]{
+				N: "link_index",
+			},
+			orig:              int64(1),
+			newVal:            int64(1),
+			expectSetterError: true,
+		},
+	}
+	// Copy all tests cases and sets the path.Context value to the generated ones.
+	// It ensures all exiting field access also work when the path context is set.
+	for _, tt := range slices.Clone(tests) {
+		testWithContext := tt
+		testWithContext.name = "with_path_context:" + tt.name
+		pathWithContext := *tt.path.(*pathtest.Path[*TransformContext])
+		pathWithContext.C = ctxspanlink.Name
+		testWithContext.path = ottl.Path[*TransformContext](&pathWithContext)
+		tests = append(tests, testWithContext)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testCache := pcommon.NewMap()
+			cacheGetter := func(*TransformContext) pcommon.Map {
+				return testCache
+			}
+
+			accessor, err := pathExpressionParser(cacheGetter)(tt.path)
+			require.NoError(t, err)
+
+			spanLink, span, il, resource := createTelemetry()
+
+			tCtx := NewTransformContextPtr(spanLink, span, il, resource, ptrace.NewScopeSpans(), ptrace.NewResourceSpans(), WithLinkIndex(1))
+			defer tCtx.Close()
+
+			got, err := accessor.Get(t.Context(), tCtx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.orig, got)
+
+			err = accessor.Set(t.Context(), tCtx, tt.newVal)
+			if tt.expectSetterError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			exSpanLink, exSpan, exIl, exRes := createTelemetry()
+			exCache := pcommon.NewMap()
+			tt.modified(exSpanLink, exSpan, exIl, exRes, exCache)
+
+			assert.Equal(t, exSpanLink, spanLink)
+			assert.Equal(t, exSpan, span)
+			assert.Equal(t, exIl, il)
+			assert.Equal(t, exRes, resource)
+			assert.Equal(t, exCache, testCache)
+		})
+	}
+}
+
+func Test_newPathGetSetter_higherContextPath(t *testing.T) {
+	resource := pcommon.NewResource()
+	resource.Attributes().PutStr("foo", "bar")
+
+	span := ptrace.NewSpan()
+	span.SetName("span")
+
+	instrumentationScope := pcommon.NewInstrumentationScope()
+	instrumentationScope.SetName("instrumentation_scope")
+
+	ctx := NewTransformContextPtr(ptrace.NewSpanLink(), span, instrumentationScope, resource, ptrace.NewScopeSpans(), ptrace.NewResourceSpans())
+	defer ctx.Close()
+
+	tests := []struct {
+		name     string
+		path     ottl.Path[*TransformContext]
+		expected any
+	}{
+		{
+			name: "resource with context",
+			path: &pathtest.Path[*TransformContext]{C: "resource", N: "attributes", KeySlice: []ottl.Key[*TransformContext]{
+				&pathtest.Key[*TransformContext]{
+					S: ottltest.Strp("foo"),
+				},
+			}},
+			expected: "bar",
+		},
+		{
+			name:     "instrumentation_scope with context",
+			path:     &pathtest.Path[*TransformContext]{C: "instrumentation_scope", N: "name"},
+			expected: instrumentationScope.Name(),
+		},
+		{
+			name:     "scope with context",
+			path:     &pathtest.Path[*TransformContext]{C: "scope", N: "name"},
+			expected: instrumentationScope.Name(),
+		},
+		{
+			name:     "span",
+			path:     &pathtest.Path[*TransformContext]{N: "span", NextPath: &pathtest.Path[*TransformContext]{N: "name"}},
+			expected: span.Name(),
+		},
+		{
+			name:     "span with context",
+			path:     &pathtest.Path[*TransformContext]{C: "span", N: "name"},
+			expected: span.Name(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessor, err := pathExpressionParser(getCache)(tt.path)
+			require.NoError(t, err)
+
+			got, err := accessor.Get(t.Context(), ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func Test_setAndGetLinkIndex(t *testing.T) {
+	tests := []struct {
+		name             string
+		options          []TransformContextOption
+		expected         any
+		expectedErrorMsg string
+	}{
+		{
+			name:     "link index set",
+			options:  []TransformContextOption{WithLinkIndex(1)},
+			expected: int64(1),
+		},
+		{
+			name:             "invalid value for link index",
+			options:          []TransformContextOption{WithLinkIndex(-1)},
+			expectedErrorMsg: "found invalid value for 'link_index'",
+		},
+		{
+			name:             "no value for link index",
+			expectedErrorMsg: "no 'link_index' property has been set",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spanLink, span, il, resource := createTelemetry()
+			tCtx := NewTransformContextPtr(spanLink, span, il, resource, ptrace.NewScopeSpans(), ptrace.NewResourceSpans(), tt.options...)
+			defer tCtx.Close()
+
+			accessor, err := pathExpressionParser(getCache)(&pathtest.Path[*TransformContext]{
+				N: "link_index",
+			})
+			require.NoError(t, err)
+
+			got, err := accessor.Get(t.Context(), tCtx)
+			if tt.expectedErrorMsg != "" {
+				assert.ErrorContains(t, err, tt.expectedErrorMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestHigherContextCacheAccessError(t *testing.T) {
+	higherContexts := []string{
+		ctxresource.Name,
+		ctxscope.Name,
+		ctxscope.LegacyName,
+		ctxspan.Name,
+	}
+	for _, higherContext := range higherContexts {
+		t.Run(higherContext, func(t *testing.T) {
+			path := &pathtest.Path[*TransformContext]{
+				N: "cache",
+				C: higherContext,
+				KeySlice: []ottl.Key[*TransformContext]{
+					&pathtest.Key[*TransformContext]{
+						S: ottltest.Strp("key"),
+					},
+				},
+				FullPath: fmt.Sprintf("%s.cache[key]", higherContext),
+			}
+
+			_, err := pathExpressionParser(getCache)(path)
+			require.Error(t, err)
+			expectError := fmt.Sprintf(`replace "%s.cache[key]" with "spanlink.cache[key]"`, higherContext)
+			require.ErrorContains(t, err, expectError)
+		})
+	}
+}
+
+func TestStatements(t *testing.T) {
+	parser, err := NewParser(ottlfuncs.StandardFuncs[*TransformContext](), componenttest.NewNopTelemetrySettings(), EnablePathContextNames())
+	require.NoError(t, err)
+	statements, err := parser.ParseStatements([]string{
+		`set(spanlink.attributes["link.span"], span.name) where spanlink.flags == 1`,
+		`delete_key(spanlink.attributes, "str") where spanlink.trace_id.string == "0102030405060708090a0b0c0d0e0f10"`,
+		`set(spanlink.attributes["link.span"], "unsampled") where spanlink.flags == 0`,
+	})
+	require.NoError(t, err)
+
+	spanLink, span, il, resource := createTelemetry()
+	tCtx := NewTransformContextPtr(spanLink, span, il, resource, ptrace.NewScopeSpans(), ptrace.NewResourceSpans())
+	defer tCtx.Close()
+	sequence := NewStatementSequence(statements, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, sequence.Execute(t.Context(), tCtx))
+
+	assert.Equal(t, map[string]any{"link.span": "bear"}, spanLink.Attributes().AsRaw())
+}
+
+func createTelemetry() (ptrace.SpanLink, ptrace.Span, pcommon.InstrumentationScope, pcommon.Resource) {
+	span := ptrace.NewSpan()
+	span.SetName("bear")
+	span.Attributes().PutStr("str", "val")
+
+	spanLink := span.Links().AppendEmpty()
+	spanLink.SetTraceID(traceID)
+	spanLink.SetSpanID(spanID)
+	spanLink.SetFlags(1)
+	spanLink.SetDroppedAttributesCount(10)
+	spanLink.Attributes().PutStr("str", "val")
+
+	il := pcommon.NewInstrumentationScope()
+	il.SetName("library")
+	il.SetVersion("version")
+
+	resource := pcommon.NewResource()
+	span.Attributes().CopyTo(resource.Attributes())
+
+	return spanLink, span, il, resource
+}
+
+func Test_ParseEnum(t *testing.T) {
+	actual, err := parseEnum((*ottl.EnumSymbol)(ottltest.Strp("SPAN_KIND_SERVER")))
+	require.NoError(t, err)
+	assert.Equal(t, ottl.Enum(ptrace.SpanKindServer), *actual)
+
+	actual, err = parseEnum((*ottl.EnumSymbol)(ottltest.Strp("not an enum")))
+	assert.Error(t, err)
+	assert.Nil(t, actual)
+
+	actual, err = parseEnum(nil)
+	assert.Error(t, err)
+	assert.Nil(t, actual)
+}
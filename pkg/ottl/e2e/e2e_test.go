@@ -10,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
@@ -1934,6 +1935,392 @@ func Test_e2e_ottl_value_expressions(t *testing.T) {
 	}
 }
 
+func Test_e2e_user_functions(t *testing.T) {
+	userFunctions := []ottl.UserFunction{
+		{
+			Signature:  "NormalizeMethod(method string)",
+			Expression: `ToUpperCase(method)`,
+		},
+		{
+			Signature:  "OperationName(prefix string, suffix string)",
+			Expression: `Concat([prefix, suffix], "")`,
+		},
+		{
+			Signature:  "FirstThingName(things slice)",
+			Expression: `things[0]["name"]`,
+		},
+		{
+			Signature:  "Twice(value int)",
+			Expression: `value * 2`,
+		},
+		{
+			Signature:  "IsHealthCheck(path)",
+			Expression: `IsMatch(path, "^/health$")`,
+		},
+		{
+			Signature: "tag(key string, value)",
+			Statements: []string{
+				`set(attributes[key], value)`,
+				`set(attributes["tagged"], true) where value != nil`,
+			},
+		},
+		{
+			Signature: "tag_method(method)",
+			Statements: []string{
+				`tag("http.method", NormalizeMethod(method))`,
+			},
+		},
+	}
+
+	tests := []struct {
+		statement string
+		want      func(tCtx *ottllog.TransformContext)
+	}{
+		{
+			statement: `set(attributes["test"], NormalizeMethod(attributes["http.method"]))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "GET")
+			},
+		},
+		{
+			statement: `set(attributes["test"], OperationName(suffix = attributes["dynamicsuffix"], prefix = "opera"))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "operationA")
+			},
+		},
+		{
+			statement: `set(attributes["test"], FirstThingName(attributes["things"]))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "foo")
+			},
+		},
+		{
+			statement: `set(attributes["test"], Twice(Twice(3)))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutInt("test", 12)
+			},
+		},
+		{
+			statement: `set(attributes["test"], "pass") where IsHealthCheck(attributes["http.path"])`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "pass")
+			},
+		},
+		{
+			statement: `set(attributes["test"], "pass") where IsHealthCheck(attributes["http.url"])`,
+			want:      func(_ *ottllog.TransformContext) {},
+		},
+		{
+			statement: `tag("test", attributes["foo"]["bar"])`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "pass")
+				tCtx.GetLogRecord().Attributes().PutBool("tagged", true)
+			},
+		},
+		{
+			statement: `tag("test", attributes["missing"])`,
+			want:      func(_ *ottllog.TransformContext) {},
+		},
+		{
+			statement: `tag_method(attributes["http.method"])`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("http.method", "GET")
+				tCtx.GetLogRecord().Attributes().PutBool("tagged", true)
+			},
+		},
+	}
+
+	functions, err := ottl.MergeUserFunctions(ottlfuncs.StandardFuncs[*ottllog.TransformContext](), userFunctions)
+	require.NoError(t, err)
+	parser, err := ottllog.NewParser(functions, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.statement, func(t *testing.T) {
+			statement, err := parser.ParseStatement(tt.statement)
+			require.NoError(t, err)
+
+			tCtx := constructLogTransformContext()
+			_, _, err = statement.Execute(t.Context(), tCtx)
+			require.NoError(t, err)
+
+			exTCtx := constructLogTransformContext()
+			tt.want(exTCtx)
+
+			assert.NoError(t, plogtest.CompareResourceLogs(newResourceLogs(exTCtx), newResourceLogs(tCtx)))
+		})
+	}
+}
+
+func Test_e2e_user_functions_with_path_context(t *testing.T) {
+	userFunctions := []ottl.UserFunction{
+		{
+			Signature: "set_operation(name string)",
+			Statements: []string{
+				`set(log.attributes["operation"], name) where log.body == "operationA"`,
+			},
+		},
+	}
+	functions, err := ottl.MergeUserFunctions(ottlfuncs.StandardFuncs[*ottllog.TransformContext](), userFunctions)
+	require.NoError(t, err)
+	parser, err := ottllog.NewParser(functions, componenttest.NewNopTelemetrySettings(), ottllog.EnablePathContextNames())
+	require.NoError(t, err)
+
+	statement, err := parser.ParseStatement(`set_operation(Concat([log.attributes["dynamicprefix"], "A"], ""))`)
+	require.NoError(t, err)
+	tCtx := constructLogTransformContext()
+	_, _, err = statement.Execute(t.Context(), tCtx)
+	require.NoError(t, err)
+
+	exTCtx := constructLogTransformContext()
+	exTCtx.GetLogRecord().Attributes().PutStr("operation", "operationA")
+	assert.NoError(t, plogtest.CompareResourceLogs(newResourceLogs(exTCtx), newResourceLogs(tCtx)))
+}
+
+func Test_e2e_user_functions_errors(t *testing.T) {
+	userFunctions := []ottl.UserFunction{
+		{
+			Signature:  "Twice(value int)",
+			Expression: `value * 2`,
+		},
+		{
+			Signature:  "SpanName()",
+			Expression: `span.name`,
+		},
+		{
+			Signature:  "overwrite(value)",
+			Statements: []string{`set(value, "overwritten")`},
+		},
+		{
+			Signature:  "Invalid(value)",
+			Statements: []string{`set(attributes["test"], value)`},
+		},
+	}
+	_, err := ottl.MergeUserFunctions(ottlfuncs.StandardFuncs[*ottllog.TransformContext](), userFunctions)
+	assert.ErrorContains(t, err, `user-defined function "Invalid": converters require an expression`)
+
+	functions, err := ottl.MergeUserFunctions(ottlfuncs.StandardFuncs[*ottllog.TransformContext](), userFunctions[:3])
+	require.NoError(t, err)
+	parser, err := ottllog.NewParser(functions, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	_, err = parser.ParseStatement(`set(attributes["test"], Twice("3"))`)
+	assert.ErrorContains(t, err, "invalid argument at position 0")
+	_, err = parser.ParseStatement(`set(attributes["test"], Twice(1, 2))`)
+	assert.ErrorContains(t, err, "incorrect number of arguments")
+	_, err = parser.ParseStatement(`set(attributes["test"], SpanName())`)
+	assert.ErrorContains(t, err, `unable to parse the expression of user-defined function "SpanName"`)
+
+	statement, err := parser.ParseStatement(`set(attributes["test"], Twice(attributes["http.method"]))`)
+	require.NoError(t, err)
+	_, _, err = statement.Execute(t.Context(), constructLogTransformContext())
+	assert.ErrorContains(t, err, `invalid argument at position 0 for user-defined function "Twice"`)
+
+	statement, err = parser.ParseStatement(`overwrite(attributes["foo"])`)
+	require.NoError(t, err)
+	_, _, err = statement.Execute(t.Context(), constructLogTransformContext())
+	assert.ErrorContains(t, err, `parameter "value" of user-defined function "overwrite" cannot be set`)
+}
+
+func Test_e2e_user_function_declarations(t *testing.T) {
+	declarations := ottl.UserFunctionDeclarations([]ottl.UserFunctionLibraryConfig{
+		{ID: component.MustNewID("ottl_functions"), Functions: []string{"NormalizeMethod", "set_route"}},
+	})
+	functions, err := ottl.MergeUserFunctions(ottlfuncs.StandardFuncs[*ottllog.TransformContext](), declarations)
+	require.NoError(t, err)
+	parser, err := ottllog.NewParser(functions, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	// the arguments of the declared functions are parsed without parameters
+	_, err = parser.ParseStatement(`set_route("/users", NormalizeMethod(attributes["http.method"])) where NormalizeMethod(body) == "GET"`)
+	require.NoError(t, err)
+	_, err = parser.ParseStatement(`set_route(Unknown(attributes["http.method"]))`)
+	assert.ErrorContains(t, err, `undefined function "Unknown"`)
+	_, err = parser.ParseStatement(`set_routes("/users")`)
+	assert.ErrorContains(t, err, `undefined function "set_routes"`)
+
+	// declared functions are only defined once the libraries are available
+	statement, err := parser.ParseStatement(`set_route("/users")`)
+	require.NoError(t, err)
+	_, _, err = statement.Execute(t.Context(), constructLogTransformContext())
+	assert.ErrorContains(t, err, `user-defined function "set_route" is declared but not defined`)
+}
+
+func Test_e2e_iteration(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      func(tCtx *ottllog.TransformContext)
+	}{
+		{
+			statement: `for value in attributes["foo"]["slice"]: set(value, ToUpperCase(value))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				v, _ := tCtx.GetLogRecord().Attributes().Get("foo")
+				s, _ := v.Map().Get("slice")
+				s.Slice().At(0).SetStr("VAL")
+			},
+		},
+		{
+			statement: `for key, value in attributes["foo"]: set(value, Concat([key, value], "=")) where IsString(value)`,
+			want: func(tCtx *ottllog.TransformContext) {
+				v, _ := tCtx.GetLogRecord().Attributes().Get("foo")
+				v.Map().PutStr("bar", "bar=pass")
+				v.Map().PutStr("flags", "flags=pass")
+			},
+		},
+		{
+			statement: `for key, value in attributes["foo"]: delete_key(attributes["foo"], key) where IsString(value)`,
+			want: func(tCtx *ottllog.TransformContext) {
+				v, _ := tCtx.GetLogRecord().Attributes().Get("foo")
+				v.Map().Remove("bar")
+				v.Map().Remove("flags")
+			},
+		},
+		{
+			statement: `for index, thing in attributes["things"]: set(attributes[Concat(["thing", thing["name"]], ".")], index)`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutInt("thing.foo", 0)
+				tCtx.GetLogRecord().Attributes().PutInt("thing.bar", 1)
+			},
+		},
+		{
+			statement: `for thing in attributes["things"]: delete_key(thing, "value") where thing["value"] > 3`,
+			want: func(tCtx *ottllog.TransformContext) {
+				v, _ := tCtx.GetLogRecord().Attributes().Get("things")
+				v.Slice().At(1).Map().Remove("value")
+			},
+		},
+		{
+			statement: `for flag in Split(attributes["flags"], "|"): set(attributes[flag], true)`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutBool("A", true)
+				tCtx.GetLogRecord().Attributes().PutBool("B", true)
+				tCtx.GetLogRecord().Attributes().PutBool("C", true)
+			},
+		},
+		{
+			statement: `for key, value in attributes["foo"]["nested"]: set(attributes[key], value) where body == "operationA"`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "pass")
+			},
+		},
+		{
+			statement: `for value in attributes["missing"]: set(attributes["test"], value)`,
+			want:      func(_ *ottllog.TransformContext) {},
+		},
+	}
+
+	parser, err := ottllog.NewParser(ottlfuncs.StandardFuncs[*ottllog.TransformContext](), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.statement, func(t *testing.T) {
+			statement, err := parser.ParseStatement(tt.statement)
+			require.NoError(t, err)
+
+			tCtx := constructLogTransformContext()
+			_, _, err = statement.Execute(t.Context(), tCtx)
+			require.NoError(t, err)
+
+			exTCtx := constructLogTransformContext()
+			tt.want(exTCtx)
+
+			assert.NoError(t, plogtest.CompareResourceLogs(newResourceLogs(exTCtx), newResourceLogs(tCtx)))
+		})
+	}
+}
+
+func Test_e2e_iteration_with_path_context(t *testing.T) {
+	pc, err := ottllog.NewParser(ottlfuncs.StandardFuncs[*ottllog.TransformContext](), componenttest.NewNopTelemetrySettings(), ottllog.EnablePathContextNames())
+	require.NoError(t, err)
+
+	statement, err := pc.ParseStatement(`for key, value in resource.attributes: set(log.attributes[Concat(["resource", key], ".")], value) where key != "A|B|C"`)
+	require.NoError(t, err)
+	tCtx := constructLogTransformContext()
+	_, _, err = statement.Execute(t.Context(), tCtx)
+	require.NoError(t, err)
+
+	exTCtx := constructLogTransformContext()
+	exTCtx.GetLogRecord().Attributes().PutStr("resource.host.name", "localhost")
+	assert.NoError(t, plogtest.CompareResourceLogs(newResourceLogs(exTCtx), newResourceLogs(tCtx)))
+}
+
+func Test_e2e_iteration_with_user_functions(t *testing.T) {
+	functions, err := ottl.MergeUserFunctions(ottlfuncs.StandardFuncs[*ottllog.TransformContext](), []ottl.UserFunction{
+		{
+			Signature:  "uppercase_all(values)",
+			Statements: []string{`for value in values: set(value, ToUpperCase(value)) where IsString(value)`},
+		},
+	})
+	require.NoError(t, err)
+	parser, err := ottllog.NewParser(functions, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	statement, err := parser.ParseStatement(`for value in attributes["slices"]: uppercase_all(value) where IsList(value)`)
+	require.NoError(t, err)
+	tCtx := constructLogTransformContext()
+	_, _, err = statement.Execute(t.Context(), tCtx)
+	require.NoError(t, err)
+
+	statement, err = parser.ParseStatement(`uppercase_all(attributes["slices"])`)
+	require.NoError(t, err)
+	_, _, err = statement.Execute(t.Context(), tCtx)
+	require.NoError(t, err)
+
+	exTCtx := constructLogTransformContext()
+	v, _ := exTCtx.GetLogRecord().Attributes().Get("slices")
+	v.Slice().At(0).SetStr("SLICE1")
+	v.Slice().At(1).SetStr("SLICE2")
+	assert.NoError(t, plogtest.CompareResourceLogs(newResourceLogs(exTCtx), newResourceLogs(tCtx)))
+}
+
+func Test_e2e_iteration_errors(t *testing.T) {
+	parser, err := ottllog.NewParser(
+		ottlfuncs.StandardFuncs[*ottllog.TransformContext](),
+		componenttest.NewNopTelemetrySettings(),
+		ottl.WithIterationLimit[*ottllog.TransformContext](2),
+	)
+	require.NoError(t, err)
+
+	_, err = parser.ParseStatement(`for value in attributes["foo"]: set(attributes["test"], key)`)
+	assert.ErrorContains(t, err, `segment "key" from path "key" is not a valid path`)
+	_, err = parser.ParseStatement(`for value in attributes["foo"]: set(attributes["test"], value[name])`)
+	assert.ErrorContains(t, err, `iteration variable "value" can only be indexed by string and int literals`)
+
+	tests := []struct {
+		statement string
+		expected  string
+	}{
+		{
+			statement: `for value in attributes["foo"]: set(attributes["test"], value)`,
+			expected:  "cannot iterate over 4 elements, the limit is 2",
+		},
+		{
+			statement: `for value in attributes["http.method"]: set(attributes["test"], value)`,
+			expected:  "cannot iterate over string, only maps and slices are supported",
+		},
+		{
+			statement: `for key, value in attributes["things"]: set(key, "test")`,
+			expected:  `iteration key "key" cannot be set`,
+		},
+		{
+			statement: `for value in attributes["things"]: set(value["name"], "test")`,
+			expected:  `iteration value "value" cannot be set with keys`,
+		},
+		{
+			statement: `for value in Split("A|B", "|"): set(value, 1) where value == "B"`,
+			expected:  "failed to execute statement for element 1: cannot set an element of []string to int64",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.statement, func(t *testing.T) {
+			statement, err := parser.ParseStatement(tt.statement)
+			require.NoError(t, err)
+			_, _, err = statement.Execute(t.Context(), constructLogTransformContext())
+			assert.ErrorContains(t, err, tt.expected)
+		})
+	}
+}
+
 func Test_ProcessTraces_TraceContext(t *testing.T) {
 	tests := []struct {
 		statement string
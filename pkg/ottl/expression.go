@@ -1127,11 +1127,7 @@ func (p *Parser[K]) newGetter(val value) (Getter[K], error) {
 			return newLiteral[K, any](*i), nil
 		}
 		if eL.Path != nil {
-			np, err := p.newPath(eL.Path)
-			if err != nil {
-				return nil, err
-			}
-			return p.parsePath(np)
+			return p.buildGetSetterFromPath(eL.Path)
 		}
 		if eL.Converter != nil {
 			return p.newGetterFromConverter(*eL.Converter)
@@ -339,7 +339,18 @@ func (p *Parser[K]) newFunctionCall(ed editor) (Expr[K], error) {
 		}
 	}
 
-	fn, err := f.CreateFunction(FunctionContext{Set: p.telemetrySettings}, args)
+	var fn ExprFunc[K]
+	var err error
+	if uf, ok := f.(*userFunctionFactory[K]); ok {
+		if uf.definition.declared {
+			if err = p.parseDeclaredArguments(ed); err != nil {
+				return Expr[K]{}, fmt.Errorf("error while parsing arguments for call to %q: %w", ed.Function, err)
+			}
+		}
+		fn, err = uf.createFunction(p, args)
+	} else {
+		fn, err = f.CreateFunction(FunctionContext{Set: p.telemetrySettings}, args)
+	}
 	if err != nil {
 		return Expr[K]{}, fmt.Errorf("couldn't create function: %w", err)
 	}
@@ -536,6 +547,12 @@ func (p *Parser[K]) buildSliceArg(argVal value, argType reflect.Type) (any, erro
 }
 
 func (p *Parser[K]) buildGetSetterFromPath(path *path) (GetSetter[K], error) {
+	if variable, err := p.newIterationVariableGetter(path); variable != nil || err != nil {
+		return variable, err
+	}
+	if parameter, err := p.newUserFunctionParameterGetter(path); parameter != nil || err != nil {
+		return parameter, err
+	}
 	np, err := p.newPath(path)
 	if err != nil {
 		return nil, err
@@ -13,7 +13,8 @@ import (
 
 // parsedStatement represents a parsed statement. It is the entry point into the statement DSL.
 type parsedStatement struct {
-	Editor editor `parser:"(@@"`
+	Iteration *iteration `parser:"@@?"`
+	Editor    editor     `parser:"(@@"`
 	// If converter is matched then return error
 	Converter   *converter         `parser:"|@@)"`
 	WhereClause *booleanExpression `parser:"( 'where' @@ )?"`
@@ -24,13 +25,55 @@ func (p *parsedStatement) checkForCustomError() error {
 	if p.Converter != nil {
 		validator.add(fmt.Errorf("editor names must start with a lowercase letter but got '%v'", p.Converter.Function))
 	}
+	if p.Iteration != nil && p.Iteration.Key != nil && *p.Iteration.Key == p.Iteration.Value {
+		validator.add(fmt.Errorf("the key and the value of an iteration must have different names but both are '%v'", p.Iteration.Value))
+	}
+
+	p.accept(validator)
+
+	return validator.join()
+}
 
-	p.Editor.accept(validator)
+// accept visits the iteration target, the editor and the where clause of the statement.
+// The paths referring to the iteration variables are not visited, since they are not telemetry paths.
+func (p *parsedStatement) accept(v grammarVisitor) {
+	if p.Iteration != nil {
+		p.Iteration.Target.accept(v)
+		v = &iterationVariablesVisitor{grammarVisitor: v, iteration: p.Iteration}
+	}
+	p.Editor.accept(v)
 	if p.WhereClause != nil {
-		p.WhereClause.accept(validator)
+		p.WhereClause.accept(v)
 	}
+}
 
-	return validator.join()
+// iteration represents the optional loop of a statement, which executes the statement
+// for each entry of a map or each element of a slice.
+type iteration struct {
+	Key    *string `parser:"'for' ( @Lowercase ',' )?"`
+	Value  string  `parser:"@Lowercase 'in'"`
+	Target value   `parser:"@@ ':'"`
+}
+
+// isVariable returns true if the path refers to one of the iteration variables.
+func (i *iteration) isVariable(p *path) bool {
+	if p.Context != "" || len(p.Fields) != 1 {
+		return false
+	}
+	name := p.Fields[0].Name
+	return name == i.Value || (i.Key != nil && name == *i.Key)
+}
+
+// iterationVariablesVisitor wraps a grammarVisitor, skipping the paths that refer to iteration variables.
+type iterationVariablesVisitor struct {
+	grammarVisitor
+	iteration *iteration
+}
+
+func (v *iterationVariablesVisitor) visitPath(p *path) {
+	if !v.iteration.isVariable(p) {
+		v.grammarVisitor.visitPath(p)
+	}
 }
 
 type constExpr struct {
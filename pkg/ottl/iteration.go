@@ -0,0 +1,267 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/internal/ottlcommon"
+)
+
+// defaultIterationLimit is the maximum number of elements a statement can iterate over,
+// unless configured otherwise with WithIterationLimit.
+const defaultIterationLimit = 1000
+
+// WithIterationLimit sets the maximum number of elements a statement can iterate over.
+// Iterating over a map or slice with more elements fails without executing the statement.
+// Values lower than 1 are ignored, and the default limit of 1000 elements is used.
+func WithIterationLimit[K any](limit int) Option[K] {
+	return func(p *Parser[K]) {
+		if limit > 0 {
+			p.iterationLimit = limit
+		}
+	}
+}
+
+// iterationScope holds the variables of an iteration while its statement is parsed.
+// At runtime, the current element is stored in the context with the scope as key.
+type iterationScope struct {
+	key   string
+	value string
+}
+
+// iterationElement is the entry of a map or the element of a slice being iterated over.
+type iterationElement struct {
+	key   any
+	value any
+	set   func(val any) error
+}
+
+// statementIteration executes a statement for each element of the map or slice returned by its target.
+type statementIteration[K any] struct {
+	scope  *iterationScope
+	target Getter[K]
+	limit  int
+}
+
+func (p *Parser[K]) newStatementIteration(i *iteration) (*statementIteration[K], error) {
+	target, err := p.newGetter(i.Target)
+	if err != nil {
+		return nil, err
+	}
+	scope := &iterationScope{value: i.Value}
+	if i.Key != nil {
+		scope.key = *i.Key
+	}
+	limit := p.iterationLimit
+	if limit == 0 {
+		limit = defaultIterationLimit
+	}
+	return &statementIteration[K]{
+		scope:  scope,
+		target: target,
+		limit:  limit,
+	}, nil
+}
+
+// forEach calls fn with a context holding each element of the target.
+// The map keys and slice length are captured before the first call, so elements
+// added by fn are not iterated over, and elements removed by fn are skipped.
+func (s *statementIteration[K]) forEach(ctx context.Context, tCtx K, fn func(ctx context.Context) error) error {
+	target, err := s.target.Get(ctx, tCtx)
+	if err != nil {
+		return err
+	}
+	yield := func(element *iterationElement) error {
+		if err := fn(context.WithValue(ctx, s.scope, element)); err != nil {
+			return fmt.Errorf("failed to execute statement for element %v: %w", element.key, err)
+		}
+		return nil
+	}
+	switch t := target.(type) {
+	case nil:
+		return nil
+	case pcommon.Map:
+		if err := s.checkLimit(t.Len()); err != nil {
+			return err
+		}
+		keys := make([]string, 0, t.Len())
+		for k := range t.All() {
+			keys = append(keys, k)
+		}
+		for _, k := range keys {
+			v, ok := t.Get(k)
+			if !ok {
+				continue
+			}
+			err := yield(&iterationElement{
+				key:   k,
+				value: ottlcommon.GetValue(v),
+				set: func(val any) error {
+					v, ok := t.Get(k)
+					if !ok {
+						v = t.PutEmpty(k)
+					}
+					return ottlcommon.SetValue(v, val)
+				},
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case map[string]any:
+		if err := s.checkLimit(len(t)); err != nil {
+			return err
+		}
+		for _, k := range slices.Sorted(maps.Keys(t)) {
+			v, ok := t[k]
+			if !ok {
+				continue
+			}
+			err := yield(&iterationElement{
+				key:   k,
+				value: v,
+				set: func(val any) error {
+					t[k] = val
+					return nil
+				},
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case pcommon.Slice:
+		if err := s.checkLimit(t.Len()); err != nil {
+			return err
+		}
+		for i := range t.Len() {
+			if i >= t.Len() {
+				break
+			}
+			err := yield(&iterationElement{
+				key:   int64(i),
+				value: ottlcommon.GetValue(t.At(i)),
+				set: func(val any) error {
+					if i >= t.Len() {
+						return fmt.Errorf("index %d out of bounds", i)
+					}
+					return ottlcommon.SetValue(t.At(i), val)
+				},
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case []any:
+		return forEachSliceElement(s, t, yield)
+	case []string:
+		return forEachSliceElement(s, t, yield)
+	case []int64:
+		return forEachSliceElement(s, t, yield)
+	case []float64:
+		return forEachSliceElement(s, t, yield)
+	case []bool:
+		return forEachSliceElement(s, t, yield)
+	default:
+		return fmt.Errorf("cannot iterate over %T, only maps and slices are supported", target)
+	}
+}
+
+func forEachSliceElement[K, T any](s *statementIteration[K], elements []T, yield func(*iterationElement) error) error {
+	if err := s.checkLimit(len(elements)); err != nil {
+		return err
+	}
+	for i, v := range elements {
+		err := yield(&iterationElement{
+			key:   int64(i),
+			value: v,
+			set: func(val any) error {
+				v, ok := val.(T)
+				if !ok {
+					return fmt.Errorf("cannot set an element of %T to %T", elements, val)
+				}
+				elements[i] = v
+				return nil
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *statementIteration[K]) checkLimit(length int) error {
+	if length > s.limit {
+		return fmt.Errorf("cannot iterate over %d elements, the limit is %d", length, s.limit)
+	}
+	return nil
+}
+
+// newIterationVariableGetter returns a GetSetter for the path if it refers to a variable of the
+// iteration being parsed, or nil otherwise.
+func (p *Parser[K]) newIterationVariableGetter(path *path) (GetSetter[K], error) {
+	scope := p.iterationScope
+	if scope == nil || path.Context != "" || len(path.Fields) != 1 {
+		return nil, nil
+	}
+	field := path.Fields[0]
+	isKey := scope.key != "" && field.Name == scope.key
+	if !isKey && field.Name != scope.value {
+		return nil, nil
+	}
+	for _, k := range field.Keys {
+		if k.String == nil && k.Int == nil {
+			return nil, fmt.Errorf("iteration variable %q can only be indexed by string and int literals", field.Name)
+		}
+	}
+	element := func(ctx context.Context) (*iterationElement, error) {
+		element, ok := ctx.Value(scope).(*iterationElement)
+		if !ok {
+			return nil, fmt.Errorf("iteration variable %q is not bound", field.Name)
+		}
+		return element, nil
+	}
+	getter := exprGetter[K]{
+		expr: Expr[K]{exprFunc: func(ctx context.Context, _ K) (any, error) {
+			element, err := element(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if isKey {
+				return element.key, nil
+			}
+			return element.value, nil
+		}},
+		keys: field.Keys,
+	}
+	return &StandardGetSetter[K]{
+		Getter: getter.Get,
+		Setter: func(ctx context.Context, _ K, val any) error {
+			if isKey {
+				return fmt.Errorf("iteration key %q cannot be set", field.Name)
+			}
+			if len(field.Keys) > 0 {
+				return fmt.Errorf("iteration value %q cannot be set with keys", field.Name)
+			}
+			element, err := element(ctx)
+			if err != nil {
+				return err
+			}
+			if err := element.set(val); err != nil {
+				return err
+			}
+			element.value = val
+			return nil
+		},
+	}, nil
+}
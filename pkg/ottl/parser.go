@@ -22,6 +22,7 @@ import (
 type Statement[K any] struct {
 	function          Expr[K]
 	condition         BoolExpr[K]
+	iteration         *statementIteration[K]
 	origText          string
 	telemetrySettings component.TelemetrySettings
 }
@@ -30,7 +31,22 @@ type Statement[K any] struct {
 // Returns true if the function was run, returns false otherwise.
 // If the statement contains no condition, the function will run and true will be returned.
 // In addition, the functions return value is always returned.
+// If the statement iterates over a map or slice, the condition and the function are evaluated for each element,
+// true is returned if the function was run for at least one element, and no value is returned.
 func (s *Statement[K]) Execute(ctx context.Context, tCtx K) (any, bool, error) {
+	if s.iteration == nil {
+		return s.execute(ctx, tCtx)
+	}
+	var matched bool
+	err := s.iteration.forEach(ctx, tCtx, func(ctx context.Context) error {
+		_, condition, err := s.execute(ctx, tCtx)
+		matched = matched || condition
+		return err
+	})
+	return nil, matched, err
+}
+
+func (s *Statement[K]) execute(ctx context.Context, tCtx K) (any, bool, error) {
 	condition, err := s.condition.Eval(ctx, tCtx)
 	defer func() {
 		if s.telemetrySettings.Logger.Core().Enabled(zap.DebugLevel) {
@@ -69,6 +85,11 @@ type Parser[K any] struct {
 	enumParser        EnumParser
 	telemetrySettings component.TelemetrySettings
 	pathContextNames  map[string]struct{}
+	// userFunctionScope is set while parsing the body of a user-defined function
+	userFunctionScope *userFunctionScope
+	// iterationScope is set while parsing a statement iterating over a map or slice
+	iterationScope *iterationScope
+	iterationLimit int
 }
 
 // NewParser creates a new Parser
@@ -152,6 +173,17 @@ func (p *Parser[K]) ParseStatement(statement string) (*Statement[K], error) {
 	if err != nil {
 		return nil, err
 	}
+	var iteration *statementIteration[K]
+	if parsed.Iteration != nil {
+		iteration, err = p.newStatementIteration(parsed.Iteration)
+		if err != nil {
+			return nil, err
+		}
+		// The editor and the where clause are evaluated for each element, with the iteration variables in scope.
+		iterationParser := *p
+		iterationParser.iterationScope = iteration.scope
+		p = &iterationParser
+	}
 	function, err := p.newFunctionCall(parsed.Editor)
 	if err != nil {
 		return nil, err
@@ -163,6 +195,7 @@ func (p *Parser[K]) ParseStatement(statement string) (*Statement[K], error) {
 	return &Statement[K]{
 		function:          function,
 		condition:         expression,
+		iteration:         iteration,
 		origText:          statement,
 		telemetrySettings: p.telemetrySettings,
 	}, nil
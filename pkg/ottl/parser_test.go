@@ -2492,6 +2492,17 @@ func Test_parseStatement(t *testing.T) {
 		{statement: `Test()`, wantErr: true},
 		{statement: `set() where test(foo)["key"] == "bar"`, wantErrContaining: converterNameErrorPrefix},
 		{statement: `set() where test(foo)["key"] == "bar"`, wantErrContaining: editorWithIndexErrorPrefix},
+		{statement: `for value in attributes: set(value, 1)`},
+		{statement: `for key, value in attributes["foo"]: set(value, key) where value != nil`},
+		{statement: `for value in Split(name, "|"): set(attributes[value], true)`},
+		{statement: `for value in [1, 2]: set(attributes["x"], value)`},
+		{statement: `for value attributes: set(value, 1)`, wantErr: true},
+		{statement: `for key value in attributes: set(value, 1)`, wantErr: true},
+		{statement: `for in attributes: set(value, 1)`, wantErr: true},
+		{statement: `set(value, 1) for value in attributes:`, wantErr: true},
+		{statement: `for value in attributes: Set(value, 1)`, wantErr: true},
+		{statement: `for value, value in attributes: set(value, 1)`, wantErrContaining: "the key and the value of an iteration must have different names"},
+		{statement: `for value in attributes: set(value, int())`, wantErrContaining: converterNameErrorPrefix},
 	}
 	pat := regexp.MustCompile("[^a-zA-Z0-9]+")
 	for _, tt := range tests {
@@ -3107,6 +3118,20 @@ func Test_prependContextToStatementPaths_Success(t *testing.T) {
 			pathContextNames: []string{"log", "resource"},
 			expected:         `set(log.attributes["test"], "pass") where IsMatch(resource.name, "operation[AC]")`,
 		},
+		{
+			name:             "iteration variables",
+			statement:        `for key, value in attributes: set(attributes[key], value) where value != name`,
+			context:          "span",
+			pathContextNames: []string{"span"},
+			expected:         `for key, value in span.attributes: set(span.attributes[key], value) where value != span.name`,
+		},
+		{
+			name:             "iteration variables with context",
+			statement:        `for value in resource.attributes["list"]: set(attributes[value], value["name"])`,
+			context:          "span",
+			pathContextNames: []string{"span", "resource"},
+			expected:         `for value in resource.attributes["list"]: set(span.attributes[value], value["name"])`,
+		},
 	}
 
 	for _, tt := range tests {
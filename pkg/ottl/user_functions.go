@@ -0,0 +1,538 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// UserFunction is an OTTL function defined with OTTL instead of Go, so that the same logic can
+// be reused by several statements, conditions or components.
+// A user-defined function is either a converter, which returns the value of its Expression,
+// or an editor, which executes its Statements.
+type UserFunction struct {
+	// Signature is the name of the function followed by its parameters, for example
+	// `NormalizeHTTP(method string, fallback)`. Converter names must start with an uppercase
+	// letter, and editor names with a lowercase letter.
+	// Each parameter can be followed by its type, one of `any`, `bool`, `float`, `int`, `map`,
+	// `slice` or `string`. The arguments are checked against the types of the parameters
+	// when the function is called. Parameters without type are `any`.
+	Signature string `mapstructure:"signature"`
+	// Expression is the value expression returned by a converter.
+	Expression string `mapstructure:"expression"`
+	// Statements are the statements executed in order by an editor.
+	Statements []string `mapstructure:"statements"`
+
+	// declared is set for the functions of UserFunctionDeclarations, which have no body.
+	declared bool
+}
+
+// Validate checks the signature of the function and the syntax of its body.
+// The functions called by the body are only verified once the function is called.
+func (f UserFunction) Validate() error {
+	_, err := newUserFunctionDefinition(f)
+	return err
+}
+
+// UserFunctionLibrary is implemented by the components, usually extensions, that provide
+// user-defined functions to other components.
+type UserFunctionLibrary interface {
+	// UserFunctions returns the user-defined functions of the library.
+	UserFunctions() []UserFunction
+}
+
+// UserFunctionLibraryConfig references a UserFunctionLibrary extension from the configuration of a component.
+type UserFunctionLibraryConfig struct {
+	// ID is the ID of the extension.
+	ID component.ID `mapstructure:"id"`
+	// Functions are the names of the functions of the library called by the component. Since the library
+	// is only available once the component starts, they allow validating the OTTLs of the component
+	// beforehand, see UserFunctionDeclarations.
+	Functions []string `mapstructure:"functions"`
+}
+
+// Validate checks that the library declares valid and unique function names.
+func (c UserFunctionLibraryConfig) Validate() error {
+	if len(c.Functions) == 0 {
+		return fmt.Errorf("function library %q: at least one function must be declared", c.ID)
+	}
+	var errs []error
+	for i, name := range c.Functions {
+		if !userFunctionNameRegex.MatchString(name) {
+			errs = append(errs, fmt.Errorf("function library %q: invalid function name %q", c.ID, name))
+		} else if slices.Contains(c.Functions[:i], name) {
+			errs = append(errs, fmt.Errorf("function library %q: duplicate function %q", c.ID, name))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// UserFunctionDeclarations returns the functions declared by the given libraries, to be merged with
+// MergeUserFunctions before the libraries are available. The OTTLs calling them are parsed as if the
+// functions were defined, including the context inference of the ParserCollection, except that their
+// arguments are not checked against parameters. Calling a declared function at runtime returns an error.
+func UserFunctionDeclarations(libraries []UserFunctionLibraryConfig) []UserFunction {
+	var declarations []UserFunction
+	for _, library := range libraries {
+		for _, name := range library.Functions {
+			declarations = append(declarations, UserFunction{Signature: name + "()", declared: true})
+		}
+	}
+	return declarations
+}
+
+// GetUserFunctionLibraries returns the user-defined functions of the given libraries, which
+// must be extensions of the host implementing UserFunctionLibrary and define the functions
+// declared by their configuration.
+func GetUserFunctionLibraries(host component.Host, libraries []UserFunctionLibraryConfig) ([]UserFunction, error) {
+	extensions := host.GetExtensions()
+	var userFunctions []UserFunction
+	for _, config := range libraries {
+		ext, ok := extensions[config.ID]
+		if !ok {
+			return nil, fmt.Errorf("function library %q not found", config.ID)
+		}
+		library, ok := ext.(UserFunctionLibrary)
+		if !ok {
+			return nil, fmt.Errorf("extension %q is not an OTTL function library", config.ID)
+		}
+		functions := library.UserFunctions()
+		for _, name := range config.Functions {
+			if !slices.ContainsFunc(functions, func(f UserFunction) bool { return f.name() == name }) {
+				return nil, fmt.Errorf("function library %q does not define the function %q", config.ID, name)
+			}
+		}
+		userFunctions = append(userFunctions, functions...)
+	}
+	return userFunctions, nil
+}
+
+// MergeUserFunctions returns a copy of the functions map including the given user-defined functions,
+// so that the result can be used to create a Parser.
+// The bodies of the user-defined functions are parsed with the Parser calling them, and can therefore
+// use the paths, enums and functions of that Parser, including other user-defined functions.
+// An error is returned if any user-defined function is invalid, has the name of an existing function,
+// or if the user-defined functions call each other recursively.
+func MergeUserFunctions[K any](functions map[string]Factory[K], userFunctions []UserFunction) (map[string]Factory[K], error) {
+	if len(userFunctions) == 0 {
+		return functions, nil
+	}
+
+	merged := make(map[string]Factory[K], len(functions)+len(userFunctions))
+	maps.Copy(merged, functions)
+	definitions := make(map[string]*userFunctionDefinition, len(userFunctions))
+	var errs []error
+	for _, userFunction := range userFunctions {
+		definition, err := newUserFunctionDefinition(userFunction)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if _, ok := merged[definition.name]; ok {
+			errs = append(errs, fmt.Errorf("user-defined function %q: a function with the same name already exists", definition.name))
+			continue
+		}
+		definitions[definition.name] = definition
+		merged[definition.name] = &userFunctionFactory[K]{definition: definition}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	if err := checkUserFunctionCycles(definitions); err != nil {
+		return nil, err
+	}
+	return merged, nil
+}
+
+var (
+	userFunctionNameRegex      = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+	userFunctionSignatureRegex = regexp.MustCompile(`^\s*([A-Za-z][A-Za-z0-9_]*)\s*\(([^()]*)\)\s*$`)
+	userFunctionParameterRegex = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+	userFunctionReservedNames  = []string{"and", "false", "nil", "not", "or", "true", "where"}
+	userFunctionParameterTypes = []string{"any", "bool", "float", "int", "map", "slice", "string"}
+)
+
+type userFunctionParameter struct {
+	name string
+	typ  string
+}
+
+type userFunctionDefinition struct {
+	name       string
+	parameters []userFunctionParameter
+	expression string
+	statements []string
+	// calls are the names of the functions called by the body
+	calls []string
+	// declared is set for the functions without body of UserFunctionDeclarations
+	declared bool
+}
+
+// name returns the name of the function, or an empty string if its signature is invalid.
+func (f UserFunction) name() string {
+	if matches := userFunctionSignatureRegex.FindStringSubmatch(f.Signature); matches != nil {
+		return matches[1]
+	}
+	return ""
+}
+
+func newUserFunctionDefinition(f UserFunction) (*userFunctionDefinition, error) {
+	matches := userFunctionSignatureRegex.FindStringSubmatch(f.Signature)
+	if matches == nil {
+		return nil, fmt.Errorf("invalid user-defined function signature %q, expected a name followed by the parameters in parentheses", f.Signature)
+	}
+	d := &userFunctionDefinition{
+		name:       matches[1],
+		expression: f.Expression,
+		statements: f.Statements,
+		declared:   f.declared,
+	}
+	if d.declared {
+		return d, nil
+	}
+	if err := d.parseParameters(matches[2]); err != nil {
+		return nil, fmt.Errorf("user-defined function %q: %w", d.name, err)
+	}
+	if err := d.parseBody(); err != nil {
+		return nil, fmt.Errorf("user-defined function %q: %w", d.name, err)
+	}
+	return d, nil
+}
+
+func (d *userFunctionDefinition) parseParameters(parameters string) error {
+	if strings.TrimSpace(parameters) == "" {
+		return nil
+	}
+	fieldNames := map[string]struct{}{}
+	for _, parameter := range strings.Split(parameters, ",") {
+		parts := strings.Fields(parameter)
+		if len(parts) == 0 || len(parts) > 2 {
+			return fmt.Errorf("invalid parameter %q, expected a name optionally followed by a type", strings.TrimSpace(parameter))
+		}
+		p := userFunctionParameter{name: parts[0], typ: "any"}
+		if len(parts) == 2 {
+			p.typ = parts[1]
+		}
+		if !userFunctionParameterRegex.MatchString(p.name) || slices.Contains(userFunctionReservedNames, p.name) {
+			return fmt.Errorf("invalid parameter name %q, it must start with a lowercase letter followed by lowercase letters, digits or underscores", p.name)
+		}
+		if !slices.Contains(userFunctionParameterTypes, p.typ) {
+			return fmt.Errorf("invalid type %q for parameter %q, valid types are: %s", p.typ, p.name, strings.Join(userFunctionParameterTypes, ", "))
+		}
+		// Named arguments are matched with the camel case name of the parameter.
+		fieldName := strcase.ToCamel(p.name)
+		if _, ok := fieldNames[fieldName]; ok {
+			return fmt.Errorf("duplicate parameter %q", p.name)
+		}
+		fieldNames[fieldName] = struct{}{}
+		d.parameters = append(d.parameters, p)
+	}
+	return nil
+}
+
+func (d *userFunctionDefinition) parseBody() error {
+	isConverter := d.name[0] >= 'A' && d.name[0] <= 'Z'
+	visitor := &grammarFunctionCallsVisitor{calls: map[string]struct{}{}}
+	switch {
+	case d.expression != "" && len(d.statements) > 0:
+		return errors.New("expression and statements cannot be used together")
+	case isConverter && d.expression == "":
+		return errors.New("converters require an expression, editor names must start with a lowercase letter")
+	case !isConverter && len(d.statements) == 0:
+		return errors.New("editors require statements, converter names must start with an uppercase letter")
+	case isConverter:
+		parsed, err := parseValueExpression(d.expression)
+		if err != nil {
+			return err
+		}
+		parsed.accept(visitor)
+	default:
+		for _, statement := range d.statements {
+			parsed, err := parseStatement(statement)
+			if err != nil {
+				return fmt.Errorf("unable to parse OTTL statement %q: %w", statement, err)
+			}
+			parsed.accept(visitor)
+		}
+	}
+	d.calls = slices.Sorted(maps.Keys(visitor.calls))
+	return nil
+}
+
+// grammarFunctionCallsVisitor is used to extract the names of the functions called by an OTTL.
+type grammarFunctionCallsVisitor struct {
+	calls map[string]struct{}
+}
+
+func (*grammarFunctionCallsVisitor) visitPath(*path)                       {}
+func (*grammarFunctionCallsVisitor) visitValue(*value)                     {}
+func (*grammarFunctionCallsVisitor) visitMathExprLiteral(*mathExprLiteral) {}
+
+func (v *grammarFunctionCallsVisitor) visitEditor(e *editor) {
+	v.calls[e.Function] = struct{}{}
+}
+
+func (v *grammarFunctionCallsVisitor) visitConverter(c *converter) {
+	v.calls[c.Function] = struct{}{}
+}
+
+// checkUserFunctionCycles returns an error if any user-defined function calls itself,
+// directly or through other user-defined functions.
+func checkUserFunctionCycles(definitions map[string]*userFunctionDefinition) error {
+	const (
+		visiting = iota + 1
+		visited
+	)
+	state := make(map[string]int, len(definitions))
+	var stack []string
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visiting:
+			cycle := append(stack[slices.Index(stack, name):], name)
+			return fmt.Errorf("user-defined functions cannot be called recursively: %s", strings.Join(cycle, " -> "))
+		case visited:
+			return nil
+		}
+		state[name] = visiting
+		stack = append(stack, name)
+		for _, call := range definitions[name].calls {
+			if _, ok := definitions[call]; !ok {
+				continue
+			}
+			if err := visit(call); err != nil {
+				return err
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[name] = visited
+		return nil
+	}
+	for _, name := range slices.Sorted(maps.Keys(definitions)) {
+		if err := visit(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// userFunctionFactory is the Factory of a user-defined function. Since the body of the function
+// is parsed with the Parser calling it, the function is created by the Parser instead of
+// CreateFunction.
+type userFunctionFactory[K any] struct {
+	definition *userFunctionDefinition
+}
+
+//nolint:unused
+func (*userFunctionFactory[K]) unexportedFactoryFunc() {}
+
+func (f *userFunctionFactory[K]) Name() string {
+	return f.definition.name
+}
+
+func (f *userFunctionFactory[K]) CreateDefaultArguments() Arguments {
+	if len(f.definition.parameters) == 0 {
+		return nil
+	}
+	fields := make([]reflect.StructField, len(f.definition.parameters))
+	for i, p := range f.definition.parameters {
+		fields[i] = reflect.StructField{
+			Name: strcase.ToCamel(p.name),
+			Type: userFunctionParameterType[K](p.typ),
+		}
+	}
+	return reflect.New(reflect.StructOf(fields)).Interface()
+}
+
+func (f *userFunctionFactory[K]) CreateFunction(FunctionContext, Arguments) (ExprFunc[K], error) {
+	return nil, fmt.Errorf("user-defined function %q can only be called by OTTL statements, conditions and expressions", f.definition.name)
+}
+
+func userFunctionParameterType[K any](typ string) reflect.Type {
+	switch typ {
+	case "bool":
+		return reflect.TypeFor[BoolGetter[K]]()
+	case "float":
+		return reflect.TypeFor[FloatGetter[K]]()
+	case "int":
+		return reflect.TypeFor[IntGetter[K]]()
+	case "map":
+		return reflect.TypeFor[PMapGetter[K]]()
+	case "slice":
+		return reflect.TypeFor[PSliceGetter[K]]()
+	case "string":
+		return reflect.TypeFor[StringGetter[K]]()
+	default:
+		return reflect.TypeFor[Getter[K]]()
+	}
+}
+
+// userFunctionArgument returns the value of an argument passed to a user-defined function.
+type userFunctionArgument[K any] func(ctx context.Context, tCtx K) (any, error)
+
+func newUserFunctionArgument[K, V any](field reflect.Value) userFunctionArgument[K] {
+	getter := field.Interface().(typedGetter[K, V])
+	return func(ctx context.Context, tCtx K) (any, error) {
+		return getter.Get(ctx, tCtx)
+	}
+}
+
+func (f *userFunctionFactory[K]) newArguments(args Arguments) []userFunctionArgument[K] {
+	if args == nil {
+		return nil
+	}
+	fields := reflect.ValueOf(args).Elem()
+	arguments := make([]userFunctionArgument[K], len(f.definition.parameters))
+	for i, p := range f.definition.parameters {
+		switch p.typ {
+		case "bool":
+			arguments[i] = newUserFunctionArgument[K, bool](fields.Field(i))
+		case "float":
+			arguments[i] = newUserFunctionArgument[K, float64](fields.Field(i))
+		case "int":
+			arguments[i] = newUserFunctionArgument[K, int64](fields.Field(i))
+		case "map":
+			arguments[i] = newUserFunctionArgument[K, pcommon.Map](fields.Field(i))
+		case "slice":
+			arguments[i] = newUserFunctionArgument[K, pcommon.Slice](fields.Field(i))
+		case "string":
+			arguments[i] = newUserFunctionArgument[K, string](fields.Field(i))
+		default:
+			arguments[i] = newUserFunctionArgument[K, any](fields.Field(i))
+		}
+	}
+	return arguments
+}
+
+// userFunctionScope holds the parameters of a user-defined function while its body is parsed.
+// At runtime, the values of the arguments are stored in the context with the scope as key.
+type userFunctionScope struct {
+	name       string
+	parameters map[string]int
+}
+
+// bindUserFunctionArguments evaluates the arguments and returns a context holding their values for the body of the function.
+func bindUserFunctionArguments[K any](ctx context.Context, tCtx K, scope *userFunctionScope, arguments []userFunctionArgument[K]) (context.Context, error) {
+	values := make([]any, len(arguments))
+	for i, argument := range arguments {
+		value, err := argument(ctx, tCtx)
+		if err != nil {
+			return nil, fmt.Errorf("invalid argument at position %d for user-defined function %q: %w", i, scope.name, err)
+		}
+		values[i] = value
+	}
+	return context.WithValue(ctx, scope, values), nil
+}
+
+// parseDeclaredArguments parses the arguments of a call to a declared function, whose parameters are unknown,
+// so that the paths and functions they use are validated.
+func (p *Parser[K]) parseDeclaredArguments(ed editor) error {
+	for i, arg := range ed.Arguments {
+		if arg.FunctionName != nil {
+			continue
+		}
+		if _, err := p.newGetter(arg.Value); err != nil {
+			return fmt.Errorf("invalid argument at position %v: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (f *userFunctionFactory[K]) createFunction(p *Parser[K], args Arguments) (ExprFunc[K], error) {
+	if f.definition.declared {
+		return func(context.Context, K) (any, error) {
+			return nil, fmt.Errorf("user-defined function %q is declared but not defined", f.definition.name)
+		}, nil
+	}
+	scope := &userFunctionScope{
+		name:       f.definition.name,
+		parameters: make(map[string]int, len(f.definition.parameters)),
+	}
+	for i, parameter := range f.definition.parameters {
+		scope.parameters[parameter.name] = i
+	}
+	arguments := f.newArguments(args)
+
+	// The body sees the parameters of this function only, the enclosing scope is not inherited.
+	bodyParser := *p
+	bodyParser.userFunctionScope = scope
+	bodyParser.iterationScope = nil
+
+	if f.definition.expression != "" {
+		body, err := bodyParser.ParseValueExpression(f.definition.expression)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse the expression of user-defined function %q: %w", f.definition.name, err)
+		}
+		return func(ctx context.Context, tCtx K) (any, error) {
+			ctx, err := bindUserFunctionArguments(ctx, tCtx, scope, arguments)
+			if err != nil {
+				return nil, err
+			}
+			return body.Eval(ctx, tCtx)
+		}, nil
+	}
+
+	statements, err := bodyParser.ParseStatements(f.definition.statements)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the statements of user-defined function %q: %w", f.definition.name, err)
+	}
+	return func(ctx context.Context, tCtx K) (any, error) {
+		ctx, err := bindUserFunctionArguments(ctx, tCtx, scope, arguments)
+		if err != nil {
+			return nil, err
+		}
+		for _, statement := range statements {
+			if _, _, err := statement.Execute(ctx, tCtx); err != nil {
+				return nil, fmt.Errorf("failed to execute statement %q of user-defined function %q: %w", statement.origText, scope.name, err)
+			}
+		}
+		return nil, nil
+	}, nil
+}
+
+// newUserFunctionParameterGetter returns a GetSetter for the path if it refers to a parameter of the
+// user-defined function being parsed, or nil otherwise.
+func (p *Parser[K]) newUserFunctionParameterGetter(path *path) (GetSetter[K], error) {
+	scope := p.userFunctionScope
+	if scope == nil || path.Context != "" || len(path.Fields) != 1 {
+		return nil, nil
+	}
+	field := path.Fields[0]
+	index, ok := scope.parameters[field.Name]
+	if !ok {
+		return nil, nil
+	}
+	for _, k := range field.Keys {
+		if k.String == nil && k.Int == nil {
+			return nil, fmt.Errorf("parameter %q of user-defined function %q can only be indexed by string and int literals", field.Name, scope.name)
+		}
+	}
+	getter := exprGetter[K]{
+		expr: Expr[K]{exprFunc: func(ctx context.Context, _ K) (any, error) {
+			values, ok := ctx.Value(scope).([]any)
+			if !ok {
+				return nil, fmt.Errorf("parameter %q of user-defined function %q is not bound", field.Name, scope.name)
+			}
+			return values[index], nil
+		}},
+		keys: field.Keys,
+	}
+	return &StandardGetSetter[K]{
+		Getter: getter.Get,
+		Setter: func(context.Context, K, any) error {
+			return fmt.Errorf("parameter %q of user-defined function %q cannot be set", field.Name, scope.name)
+		},
+	}, nil
+}
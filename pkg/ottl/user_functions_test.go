@@ -0,0 +1,348 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+func Test_UserFunction_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		function      UserFunction
+		expectedError string
+	}{
+		{
+			name: "converter",
+			function: UserFunction{
+				Signature:  "Normalize(value string, fallback)",
+				Expression: `value`,
+			},
+		},
+		{
+			name: "editor",
+			function: UserFunction{
+				Signature:  "normalize(target, value string)",
+				Statements: []string{`set(target, value) where value != nil`},
+			},
+		},
+		{
+			name: "no parameters",
+			function: UserFunction{
+				Signature:  "Constant()",
+				Expression: `1`,
+			},
+		},
+		{
+			name: "invalid signature",
+			function: UserFunction{
+				Signature:  "Normalize",
+				Expression: `1`,
+			},
+			expectedError: `invalid user-defined function signature "Normalize"`,
+		},
+		{
+			name: "invalid parameter",
+			function: UserFunction{
+				Signature:  "Normalize(value string int)",
+				Expression: `1`,
+			},
+			expectedError: `invalid parameter "value string int"`,
+		},
+		{
+			name: "invalid parameter name",
+			function: UserFunction{
+				Signature:  "Normalize(Value)",
+				Expression: `1`,
+			},
+			expectedError: `invalid parameter name "Value"`,
+		},
+		{
+			name: "reserved parameter name",
+			function: UserFunction{
+				Signature:  "Normalize(nil)",
+				Expression: `1`,
+			},
+			expectedError: `invalid parameter name "nil"`,
+		},
+		{
+			name: "invalid parameter type",
+			function: UserFunction{
+				Signature:  "Normalize(value time)",
+				Expression: `1`,
+			},
+			expectedError: `invalid type "time" for parameter "value"`,
+		},
+		{
+			name: "duplicate parameter",
+			function: UserFunction{
+				Signature:  "Normalize(value, value)",
+				Expression: `1`,
+			},
+			expectedError: `duplicate parameter "value"`,
+		},
+		{
+			name: "converter without expression",
+			function: UserFunction{
+				Signature:  "Normalize(value)",
+				Statements: []string{`set(attributes["test"], value)`},
+			},
+			expectedError: "converters require an expression",
+		},
+		{
+			name: "editor without statements",
+			function: UserFunction{
+				Signature:  "normalize(value)",
+				Expression: `value`,
+			},
+			expectedError: "editors require statements",
+		},
+		{
+			name: "expression and statements",
+			function: UserFunction{
+				Signature:  "Normalize(value)",
+				Expression: `value`,
+				Statements: []string{`set(attributes["test"], value)`},
+			},
+			expectedError: "expression and statements cannot be used together",
+		},
+		{
+			name: "invalid expression",
+			function: UserFunction{
+				Signature:  "Normalize(value)",
+				Expression: `value ==`,
+			},
+			expectedError: `user-defined function "Normalize": expression has invalid syntax`,
+		},
+		{
+			name: "invalid statement",
+			function: UserFunction{
+				Signature:  "normalize(value)",
+				Statements: []string{`set(attributes["test"], value`},
+			},
+			expectedError: `user-defined function "normalize": unable to parse OTTL statement`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.function.Validate()
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedError)
+			}
+		})
+	}
+}
+
+func Test_MergeUserFunctions(t *testing.T) {
+	functions := defaultFunctionsForTests()
+	merged, err := MergeUserFunctions(functions, []UserFunction{
+		{
+			Signature:  "Normalize(value string)",
+			Expression: `value`,
+		},
+		{
+			Signature:  "normalize(target, value)",
+			Statements: []string{`testing_getsetter(target, Normalize(value))`},
+		},
+	})
+	require.NoError(t, err)
+	assert.Len(t, merged, len(functions)+2)
+	assert.NotContains(t, functions, "Normalize")
+	assert.Contains(t, merged, "Normalize")
+	assert.Contains(t, merged, "normalize")
+
+	same, err := MergeUserFunctions(functions, nil)
+	require.NoError(t, err)
+	assert.Equal(t, functions, same)
+}
+
+func Test_MergeUserFunctions_errors(t *testing.T) {
+	tests := []struct {
+		name          string
+		functions     []UserFunction
+		expectedError string
+	}{
+		{
+			name: "invalid function",
+			functions: []UserFunction{
+				{Signature: "Normalize("},
+			},
+			expectedError: "invalid user-defined function signature",
+		},
+		{
+			name: "existing function",
+			functions: []UserFunction{
+				{Signature: "testing_string(value)", Statements: []string{`testing_getsetter(value)`}},
+			},
+			expectedError: `user-defined function "testing_string": a function with the same name already exists`,
+		},
+		{
+			name: "duplicate function",
+			functions: []UserFunction{
+				{Signature: "Normalize(value)", Expression: `value`},
+				{Signature: "Normalize()", Expression: `1`},
+			},
+			expectedError: `user-defined function "Normalize": a function with the same name already exists`,
+		},
+		{
+			name: "recursive function",
+			functions: []UserFunction{
+				{Signature: "Normalize(value)", Expression: `Normalize(value)`},
+			},
+			expectedError: "user-defined functions cannot be called recursively: Normalize -> Normalize",
+		},
+		{
+			name: "mutually recursive functions",
+			functions: []UserFunction{
+				{Signature: "A(value)", Expression: `B(value)`},
+				{Signature: "B(value)", Expression: `C(value)`},
+				{Signature: "C(value)", Expression: `A(value)`},
+			},
+			expectedError: "user-defined functions cannot be called recursively: A -> B -> C -> A",
+		},
+		{
+			name: "recursive editor",
+			functions: []UserFunction{
+				{Signature: "normalize(value)", Statements: []string{`testing_getsetter(value) where Normalize(value) != nil`}},
+				{Signature: "Normalize(value)", Expression: `Fix(value)`},
+				{Signature: "Fix(value)", Expression: `Normalize(value)`},
+			},
+			expectedError: "user-defined functions cannot be called recursively: Fix -> Normalize -> Fix",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := MergeUserFunctions(defaultFunctionsForTests(), tt.functions)
+			assert.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
+
+func Test_userFunctionFactory_CreateFunction(t *testing.T) {
+	merged, err := MergeUserFunctions(defaultFunctionsForTests(), []UserFunction{
+		{Signature: "Normalize(value string, count int)", Expression: `value`},
+	})
+	require.NoError(t, err)
+
+	f := merged["Normalize"]
+	assert.Equal(t, "Normalize", f.Name())
+	args := f.CreateDefaultArguments()
+	require.NotNil(t, args)
+	argsType := reflect.TypeOf(args).Elem()
+	require.Equal(t, 2, argsType.NumField())
+	assert.Equal(t, "Value", argsType.Field(0).Name)
+	assert.Equal(t, reflect.TypeFor[StringGetter[any]](), argsType.Field(0).Type)
+	assert.Equal(t, "Count", argsType.Field(1).Name)
+	assert.Equal(t, reflect.TypeFor[IntGetter[any]](), argsType.Field(1).Type)
+
+	_, err = f.CreateFunction(FunctionContext{Set: componenttest.NewNopTelemetrySettings()}, args)
+	assert.ErrorContains(t, err, `user-defined function "Normalize" can only be called by OTTL statements`)
+}
+
+type testUserFunctionLibrary struct {
+	component.StartFunc
+	component.ShutdownFunc
+	functions []UserFunction
+}
+
+func (l testUserFunctionLibrary) UserFunctions() []UserFunction {
+	return l.functions
+}
+
+type testHost struct {
+	component.Host
+	extensions map[component.ID]component.Component
+}
+
+func (h testHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+func Test_UserFunctionLibraryConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		functions     []string
+		expectedError string
+	}{
+		{
+			name:      "valid",
+			functions: []string{"Normalize", "set_route"},
+		},
+		{
+			name:          "no function",
+			expectedError: `function library "library": at least one function must be declared`,
+		},
+		{
+			name:          "invalid name",
+			functions:     []string{"Normalize()"},
+			expectedError: `function library "library": invalid function name "Normalize()"`,
+		},
+		{
+			name:          "duplicate function",
+			functions:     []string{"Normalize", "Normalize"},
+			expectedError: `function library "library": duplicate function "Normalize"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := UserFunctionLibraryConfig{ID: component.MustNewID("library"), Functions: tt.functions}.Validate()
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedError)
+			}
+		})
+	}
+}
+
+func Test_UserFunctionDeclarations(t *testing.T) {
+	declarations := UserFunctionDeclarations([]UserFunctionLibraryConfig{
+		{ID: component.MustNewID("first"), Functions: []string{"Normalize"}},
+		{ID: component.MustNewID("second"), Functions: []string{"normalize"}},
+	})
+	require.Len(t, declarations, 2)
+	for _, declaration := range declarations {
+		assert.NoError(t, declaration.Validate())
+	}
+
+	merged, err := MergeUserFunctions(defaultFunctionsForTests(), declarations)
+	require.NoError(t, err)
+	assert.Contains(t, merged, "Normalize")
+	assert.Contains(t, merged, "normalize")
+	assert.Nil(t, merged["Normalize"].CreateDefaultArguments())
+}
+
+func Test_GetUserFunctionLibraries(t *testing.T) {
+	normalize := UserFunction{Signature: "Normalize(value)", Expression: `value`}
+	fix := UserFunction{Signature: "Fix(value)", Expression: `value`}
+	host := testHost{extensions: map[component.ID]component.Component{
+		component.MustNewID("first"):  testUserFunctionLibrary{functions: []UserFunction{normalize}},
+		component.MustNewID("second"): testUserFunctionLibrary{functions: []UserFunction{fix}},
+		component.MustNewID("other"):  struct{ component.Component }{},
+	}}
+
+	functions, err := GetUserFunctionLibraries(host, []UserFunctionLibraryConfig{
+		{ID: component.MustNewID("second"), Functions: []string{"Fix"}},
+		{ID: component.MustNewID("first"), Functions: []string{"Normalize"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []UserFunction{fix, normalize}, functions)
+
+	_, err = GetUserFunctionLibraries(host, []UserFunctionLibraryConfig{{ID: component.MustNewID("missing")}})
+	assert.ErrorContains(t, err, `function library "missing" not found`)
+
+	_, err = GetUserFunctionLibraries(host, []UserFunctionLibraryConfig{{ID: component.MustNewID("other")}})
+	assert.ErrorContains(t, err, `extension "other" is not an OTTL function library`)
+
+	_, err = GetUserFunctionLibraries(host, []UserFunctionLibraryConfig{{ID: component.MustNewID("first"), Functions: []string{"Fix"}}})
+	assert.ErrorContains(t, err, `function library "first" does not define the function "Fix"`)
+}
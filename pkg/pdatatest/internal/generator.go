@@ -0,0 +1,282 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/internal"
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// hugeStringLength is the length of the huge strings generated as edge cases.
+const hugeStringLength = 64 * 1024
+
+// GenerateConfig holds the settings shared by the generators of all signals.
+type GenerateConfig struct {
+	// ResourceCount is the number of resources.
+	ResourceCount int
+	// ScopeCount is the number of scopes per resource.
+	ScopeCount int
+	// ItemCount is the number of metrics, spans, log records or profiles per scope.
+	ItemCount int
+	// EntryCount is the number of data points per metric, events and links per span
+	// or samples per profile.
+	EntryCount int
+	// AttributeCount is the number of attributes of every resource, scope and item.
+	AttributeCount int
+	// AttributeCardinality is the number of distinct values of every attribute key.
+	// Zero means that every value is generated independently.
+	AttributeCardinality int
+	// EdgeCases enables edge-case values such as NaN and infinite doubles,
+	// empty and huge strings, empty maps and boundary integers.
+	EdgeCases bool
+}
+
+// NewGenerateConfig returns the default generator settings.
+func NewGenerateConfig() GenerateConfig {
+	return GenerateConfig{
+		ResourceCount:        2,
+		ScopeCount:           2,
+		ItemCount:            5,
+		EntryCount:           3,
+		AttributeCount:       4,
+		AttributeCardinality: 10,
+	}
+}
+
+// Generator generates pdata values deterministically from a seed.
+type Generator struct {
+	cfg  GenerateConfig
+	rnd  *rand.Rand
+	pool map[int][]pcommon.Value
+	base time.Time
+}
+
+// NewGenerator returns a Generator producing the same values for the same seed and config.
+func NewGenerator(seed uint64, cfg GenerateConfig) *Generator {
+	rnd := rand.New(rand.NewPCG(seed, seed^0x9e3779b97f4a7c15))
+	return &Generator{
+		cfg:  cfg,
+		rnd:  rnd,
+		pool: make(map[int][]pcommon.Value),
+		// A random instant between 2000 and 2030
+		base: time.Unix(946684800+rnd.Int64N(946080000), 0).UTC(),
+	}
+}
+
+// Config returns the settings of the generator.
+func (g *Generator) Config() GenerateConfig {
+	return g.cfg
+}
+
+// Rand returns the random source of the generator.
+func (g *Generator) Rand() *rand.Rand {
+	return g.rnd
+}
+
+// EdgeCase reports whether the next value should be an edge case.
+// It always returns false when edge cases are disabled.
+func (g *Generator) EdgeCase() bool {
+	return g.cfg.EdgeCases && g.rnd.IntN(4) == 0
+}
+
+// IntN returns a random int in [0, n), or 0 if n is not positive.
+func (g *Generator) IntN(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	return g.rnd.IntN(n)
+}
+
+// String returns a random string. Edge cases are empty, unicode and huge strings.
+func (g *Generator) String(prefix string) string {
+	if g.EdgeCase() {
+		switch g.rnd.IntN(3) {
+		case 0:
+			return ""
+		case 1:
+			return prefix + "-ünïcødé-日本語-🙂"
+		default:
+			return prefix + strings.Repeat("x", hugeStringLength)
+		}
+	}
+	return fmt.Sprintf("%s-%d", prefix, g.rnd.IntN(1000))
+}
+
+// Int returns a random int64. Edge cases are zero and the int64 boundaries.
+func (g *Generator) Int() int64 {
+	if g.EdgeCase() {
+		return []int64{0, math.MinInt64, math.MaxInt64}[g.rnd.IntN(3)]
+	}
+	return g.rnd.Int64N(2000) - 1000
+}
+
+// Uint returns a random uint64. Edge cases are zero and the uint64 maximum.
+func (g *Generator) Uint() uint64 {
+	if g.EdgeCase() {
+		return []uint64{0, math.MaxUint64}[g.rnd.IntN(2)]
+	}
+	return g.rnd.Uint64N(1000)
+}
+
+// Double returns a random float64. Edge cases are infinities, negative zero and the
+// float64 boundaries, as well as NaN if allowNaN is set.
+func (g *Generator) Double(allowNaN bool) float64 {
+	if g.EdgeCase() {
+		values := []float64{math.Inf(1), math.Inf(-1), math.Copysign(0, -1), math.MaxFloat64, math.SmallestNonzeroFloat64}
+		if allowNaN {
+			values = append(values, math.NaN())
+		}
+		return values[g.rnd.IntN(len(values))]
+	}
+	return math.Round((g.rnd.Float64()*2000-1000)*1000) / 1000
+}
+
+// Bytes returns random bytes. The edge case is an empty slice.
+func (g *Generator) Bytes() []byte {
+	if g.EdgeCase() {
+		return []byte{}
+	}
+	b := make([]byte, 1+g.rnd.IntN(16))
+	for i := range b {
+		b[i] = byte(g.rnd.UintN(256))
+	}
+	return b
+}
+
+// Timestamp returns a random timestamp after the base time of the generator.
+// The edge case is the zero timestamp.
+func (g *Generator) Timestamp() pcommon.Timestamp {
+	if g.EdgeCase() {
+		return 0
+	}
+	return pcommon.NewTimestampFromTime(g.base.Add(time.Duration(g.rnd.Int64N(int64(time.Hour)))))
+}
+
+// TimeRange returns random start and end timestamps with start before end.
+func (g *Generator) TimeRange() (pcommon.Timestamp, pcommon.Timestamp) {
+	start := g.Timestamp()
+	if start == 0 {
+		return 0, 0
+	}
+	return start, start + pcommon.Timestamp(g.rnd.Int64N(int64(time.Minute)))
+}
+
+// TraceID returns a random trace ID. The edge case is the empty trace ID.
+func (g *Generator) TraceID() pcommon.TraceID {
+	var id pcommon.TraceID
+	if !g.EdgeCase() {
+		for i := range id {
+			id[i] = byte(g.rnd.UintN(256))
+		}
+		id[0] |= 1
+	}
+	return id
+}
+
+// SpanID returns a random span ID. The edge case is the empty span ID.
+func (g *Generator) SpanID() pcommon.SpanID {
+	var id pcommon.SpanID
+	if !g.EdgeCase() {
+		for i := range id {
+			id[i] = byte(g.rnd.UintN(256))
+		}
+		id[0] |= 1
+	}
+	return id
+}
+
+// Value sets a random value of a random type, nested maps and slices included.
+// Doubles are never NaN so that values stay comparable.
+func (g *Generator) Value(dest pcommon.Value) {
+	g.value(dest, 2)
+}
+
+func (g *Generator) value(dest pcommon.Value, depth int) {
+	kinds := 5
+	if depth > 0 {
+		kinds = 7
+	}
+	switch g.rnd.IntN(kinds) {
+	case 0:
+		dest.SetStr(g.String("value"))
+	case 1:
+		dest.SetInt(g.Int())
+	case 2:
+		dest.SetDouble(g.Double(false))
+	case 3:
+		dest.SetBool(g.rnd.IntN(2) == 0)
+	case 4:
+		dest.SetEmptyBytes().FromRaw(g.Bytes())
+	case 5:
+		m := dest.SetEmptyMap()
+		if !g.EdgeCase() {
+			for i := range 1 + g.rnd.IntN(3) {
+				g.value(m.PutEmpty(fmt.Sprintf("key.%d", i)), depth-1)
+			}
+		}
+	default:
+		s := dest.SetEmptySlice()
+		if !g.EdgeCase() {
+			for range 1 + g.rnd.IntN(3) {
+				g.value(s.AppendEmpty(), depth-1)
+			}
+		}
+	}
+}
+
+// FillAttributes puts the configured number of attributes into dest. Each key draws its
+// values from a pool of AttributeCardinality values, and the edge case is an empty map.
+func (g *Generator) FillAttributes(dest pcommon.Map) {
+	if g.EdgeCase() {
+		return
+	}
+	for i := range g.cfg.AttributeCount {
+		v := dest.PutEmpty(fmt.Sprintf("attr.%d", i))
+		if g.cfg.AttributeCardinality <= 0 {
+			g.Value(v)
+			continue
+		}
+		pool, ok := g.pool[i]
+		if !ok {
+			pool = make([]pcommon.Value, g.cfg.AttributeCardinality)
+			for j := range pool {
+				pool[j] = pcommon.NewValueEmpty()
+				g.Value(pool[j])
+			}
+			g.pool[i] = pool
+		}
+		pool[g.rnd.IntN(len(pool))].CopyTo(v)
+	}
+}
+
+// FillResource sets random attributes on the resource.
+func (g *Generator) FillResource(dest pcommon.Resource) {
+	g.FillAttributes(dest.Attributes())
+	if g.EdgeCase() {
+		dest.SetDroppedAttributesCount(uint32(g.rnd.UintN(10)))
+	}
+}
+
+// FillScope sets a random name, version and attributes on the scope.
+func (g *Generator) FillScope(dest pcommon.InstrumentationScope) {
+	dest.SetName(g.String("scope"))
+	dest.SetVersion(g.String("v"))
+	g.FillAttributes(dest.Attributes())
+	if g.EdgeCase() {
+		dest.SetDroppedAttributesCount(uint32(g.rnd.UintN(10)))
+	}
+}
+
+// SchemaURL returns a random schema URL, or an empty one as edge case.
+func (g *Generator) SchemaURL() string {
+	if g.EdgeCase() {
+		return ""
+	}
+	return fmt.Sprintf("https://opentelemetry.io/schemas/1.%d.0", g.rnd.IntN(30))
+}
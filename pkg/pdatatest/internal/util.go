@@ -5,6 +5,7 @@ package internal // import "github.com/open-telemetry/opentelemetry-collector-co
 
 import (
 	"fmt"
+	"math"
 	"reflect"
 	"regexp"
 	"sort"
@@ -76,6 +77,11 @@ func CompareSchemaURL(expected, actual string) error {
 	return nil
 }
 
+// EqualFloat reports whether two float values are equal. NaN values are only equal to each other if equalNaN is set.
+func EqualFloat(expected, actual float64, equalNaN bool) bool {
+	return expected == actual || (equalNaN && math.IsNaN(expected) && math.IsNaN(actual))
+}
+
 func CompareAttributes(expected, actual pcommon.Map) error {
 	if !reflect.DeepEqual(expected.AsRaw(), actual.AsRaw()) {
 		return fmt.Errorf("attributes don't match expected: %v, actual: %v", expected.AsRaw(), actual.AsRaw())
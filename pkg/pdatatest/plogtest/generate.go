@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package plogtest // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/plogtest"
+
+import (
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/internal"
+)
+
+// GenerateLogsOption can be used to configure the logs created by GenerateLogs.
+type GenerateLogsOption interface {
+	applyOnConfig(cfg *internal.GenerateConfig)
+}
+
+type generateLogsOptionFunc func(cfg *internal.GenerateConfig)
+
+func (f generateLogsOptionFunc) applyOnConfig(cfg *internal.GenerateConfig) {
+	f(cfg)
+}
+
+// WithResourceCount sets the number of resource logs. The default is 2.
+func WithResourceCount(count int) GenerateLogsOption {
+	return generateLogsOptionFunc(func(cfg *internal.GenerateConfig) {
+		cfg.ResourceCount = count
+	})
+}
+
+// WithScopeCount sets the number of scope logs per resource. The default is 2.
+func WithScopeCount(count int) GenerateLogsOption {
+	return generateLogsOptionFunc(func(cfg *internal.GenerateConfig) {
+		cfg.ScopeCount = count
+	})
+}
+
+// WithLogRecordCount sets the number of log records per scope. The default is 5.
+func WithLogRecordCount(count int) GenerateLogsOption {
+	return generateLogsOptionFunc(func(cfg *internal.GenerateConfig) {
+		cfg.ItemCount = count
+	})
+}
+
+// WithAttributeCount sets the number of attributes of every resource, scope and log record.
+// The default is 4.
+func WithAttributeCount(count int) GenerateLogsOption {
+	return generateLogsOptionFunc(func(cfg *internal.GenerateConfig) {
+		cfg.AttributeCount = count
+	})
+}
+
+// WithAttributeCardinality sets the number of distinct values of every attribute key.
+// Zero generates every value independently. The default is 10.
+func WithAttributeCardinality(cardinality int) GenerateLogsOption {
+	return generateLogsOptionFunc(func(cfg *internal.GenerateConfig) {
+		cfg.AttributeCardinality = cardinality
+	})
+}
+
+// WithEdgeCases enables edge-case values: infinite doubles, empty and huge strings,
+// empty attribute maps and bodies, boundary integers, zero timestamps and empty IDs.
+func WithEdgeCases() GenerateLogsOption {
+	return generateLogsOptionFunc(func(cfg *internal.GenerateConfig) {
+		cfg.EdgeCases = true
+	})
+}
+
+// GenerateLogs returns random logs with bodies of every value type. The same seed and
+// options always return the same logs.
+func GenerateLogs(seed uint64, options ...GenerateLogsOption) plog.Logs {
+	cfg := internal.NewGenerateConfig()
+	for _, option := range options {
+		option.applyOnConfig(&cfg)
+	}
+	g := internal.NewGenerator(seed, cfg)
+
+	ld := plog.NewLogs()
+	for range cfg.ResourceCount {
+		rl := ld.ResourceLogs().AppendEmpty()
+		g.FillResource(rl.Resource())
+		rl.SetSchemaUrl(g.SchemaURL())
+		for range cfg.ScopeCount {
+			sl := rl.ScopeLogs().AppendEmpty()
+			g.FillScope(sl.Scope())
+			sl.SetSchemaUrl(g.SchemaURL())
+			for range cfg.ItemCount {
+				generateLogRecord(g, sl.LogRecords().AppendEmpty())
+			}
+		}
+	}
+	return ld
+}
+
+func generateLogRecord(g *internal.Generator, lr plog.LogRecord) {
+	lr.SetTimestamp(g.Timestamp())
+	lr.SetObservedTimestamp(g.Timestamp())
+	lr.SetSeverityNumber(plog.SeverityNumber(g.IntN(25)))
+	lr.SetSeverityText(g.String("severity"))
+	lr.SetEventName(g.String("event"))
+	// The edge case is an empty body
+	if !g.EdgeCase() {
+		g.Value(lr.Body())
+	}
+	g.FillAttributes(lr.Attributes())
+	if g.EdgeCase() {
+		lr.SetDroppedAttributesCount(uint32(g.IntN(10)))
+	}
+	lr.SetFlags(plog.DefaultLogRecordFlags.WithIsSampled(g.IntN(2) == 0))
+	lr.SetTraceID(g.TraceID())
+	lr.SetSpanID(g.SpanID())
+}
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package plogtest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func TestGenerateLogsDeterministic(t *testing.T) {
+	marshaler := &plog.ProtoMarshaler{}
+	first, err := marshaler.MarshalLogs(GenerateLogs(42, WithEdgeCases()))
+	require.NoError(t, err)
+	second, err := marshaler.MarshalLogs(GenerateLogs(42, WithEdgeCases()))
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+
+	other, err := marshaler.MarshalLogs(GenerateLogs(43, WithEdgeCases()))
+	require.NoError(t, err)
+	assert.NotEqual(t, first, other)
+}
+
+func TestGenerateLogsOptions(t *testing.T) {
+	ld := GenerateLogs(1,
+		WithResourceCount(2),
+		WithScopeCount(3),
+		WithLogRecordCount(20),
+		WithAttributeCount(3),
+		WithAttributeCardinality(1),
+	)
+	require.Equal(t, 2, ld.ResourceLogs().Len())
+	assert.Equal(t, 2*3*20, ld.LogRecordCount())
+
+	bodyTypes := map[pcommon.ValueType]bool{}
+	var attributes map[string]any
+	for _, rl := range ld.ResourceLogs().All() {
+		for _, sl := range rl.ScopeLogs().All() {
+			for _, lr := range sl.LogRecords().All() {
+				bodyTypes[lr.Body().Type()] = true
+				// A cardinality of one gives every log record the same attributes
+				if attributes == nil {
+					attributes = lr.Attributes().AsRaw()
+				}
+				assert.Equal(t, attributes, lr.Attributes().AsRaw())
+			}
+		}
+	}
+	assert.Len(t, attributes, 3)
+	assert.Len(t, bodyTypes, 7, "every body type but empty should be generated")
+}
+
+func TestGenerateLogsEdgeCases(t *testing.T) {
+	hasEmptyBody := func(ld plog.Logs) bool {
+		for _, rl := range ld.ResourceLogs().All() {
+			for _, sl := range rl.ScopeLogs().All() {
+				for _, lr := range sl.LogRecords().All() {
+					if lr.Body().Type() == pcommon.ValueTypeEmpty {
+						return true
+					}
+				}
+			}
+		}
+		return false
+	}
+	assert.False(t, hasEmptyBody(GenerateLogs(5)))
+	assert.True(t, hasEmptyBody(GenerateLogs(5, WithEdgeCases())))
+}
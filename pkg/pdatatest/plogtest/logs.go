@@ -16,13 +16,7 @@ import (
 // CompareLogs compares each part of two given Logs and returns
 // an error if they don't match. The error describes what didn't match.
 func CompareLogs(expected, actual plog.Logs, options ...CompareLogsOption) error {
-	exp, act := plog.NewLogs(), plog.NewLogs()
-	expected.CopyTo(exp)
-	actual.CopyTo(act)
-
-	for _, option := range options {
-		option.applyOnLogs(exp, act)
-	}
+	exp, act := ApplyCompareOptions(expected, actual, options...)
 
 	expectedLogs, actualLogs := exp.ResourceLogs(), act.ResourceLogs()
 	if expectedLogs.Len() != actualLogs.Len() {
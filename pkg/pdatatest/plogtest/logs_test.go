@@ -502,3 +502,15 @@ func TestCompareLogRecord(t *testing.T) {
 		})
 	}
 }
+
+func TestApplyCompareOptions(t *testing.T) {
+	expected, actual := plog.NewLogs(), plog.NewLogs()
+	expected.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().SetTimestamp(1)
+	actual.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().SetTimestamp(2)
+
+	exp, act := ApplyCompareOptions(expected, actual, IgnoreTimestamp())
+	assert.Equal(t, exp.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Timestamp(), act.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Timestamp())
+	// the given logs are left untouched
+	assert.Equal(t, pcommon.Timestamp(1), expected.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Timestamp())
+	assert.Equal(t, pcommon.Timestamp(2), actual.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Timestamp())
+}
@@ -25,6 +25,20 @@ func (f compareLogsOptionFunc) applyOnLogs(expected, actual plog.Logs) {
 	f(expected, actual)
 }
 
+// ApplyCompareOptions returns copies of the expected and actual logs, mutated by the options
+// the same way CompareLogs mutates them before comparing. The given logs are not modified,
+// so that tools reporting the differences in their own way can ignore the same fields as CompareLogs.
+func ApplyCompareOptions(expected, actual plog.Logs, options ...CompareLogsOption) (plog.Logs, plog.Logs) {
+	exp, act := plog.NewLogs(), plog.NewLogs()
+	expected.CopyTo(exp)
+	actual.CopyTo(act)
+
+	for _, option := range options {
+		option.applyOnLogs(exp, act)
+	}
+	return exp, act
+}
+
 // IgnoreResourceAttributeValue is a CompareLogsOption that removes a resource attribute
 // from all resources.
 func IgnoreResourceAttributeValue(attributeName string) CompareLogsOption {
@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package plogtest // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/plogtest"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+// CheckLogsRoundTrip converts the logs with to, converts the result back with from and
+// compares the outcome with the original logs. Options can be used to ignore the parts of
+// the logs that the conversion is not expected to preserve. It is meant for translators,
+// for example between pdata and a vendor format.
+func CheckLogsRoundTrip[T any](ld plog.Logs, to func(plog.Logs) (T, error), from func(T) (plog.Logs, error), options ...CompareLogsOption) error {
+	// The conversion may modify its input, so compare against a copy
+	expected := plog.NewLogs()
+	ld.CopyTo(expected)
+
+	converted, err := to(ld)
+	if err != nil {
+		return fmt.Errorf("failed to convert logs: %w", err)
+	}
+	actual, err := from(converted)
+	if err != nil {
+		return fmt.Errorf("failed to convert logs back: %w", err)
+	}
+	if err := CompareLogs(expected, actual, options...); err != nil {
+		return fmt.Errorf("logs changed after round trip: %w", err)
+	}
+	return nil
+}
+
+// CheckLogsMarshalRoundTrip marshals the logs, unmarshals the result and compares the
+// outcome with the original logs. It is meant for encodings, such as the pdata
+// marshalers and encoding extensions.
+func CheckLogsMarshalRoundTrip(ld plog.Logs, marshaler plog.Marshaler, unmarshaler plog.Unmarshaler, options ...CompareLogsOption) error {
+	return CheckLogsRoundTrip(ld, marshaler.MarshalLogs, unmarshaler.UnmarshalLogs, options...)
+}
@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package plogtest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func TestCheckLogsMarshalRoundTrip(t *testing.T) {
+	for seed := range uint64(20) {
+		ld := GenerateLogs(seed, WithEdgeCases())
+		require.NoError(t, CheckLogsMarshalRoundTrip(ld, &plog.ProtoMarshaler{}, &plog.ProtoUnmarshaler{}), "seed %d", seed)
+		require.NoError(t, CheckLogsMarshalRoundTrip(ld, &plog.JSONMarshaler{}, &plog.JSONUnmarshaler{}), "seed %d", seed)
+	}
+}
+
+func TestCheckLogsRoundTrip(t *testing.T) {
+	ld := GenerateLogs(1)
+	identity := func(ld plog.Logs) (plog.Logs, error) {
+		return ld, nil
+	}
+	require.NoError(t, CheckLogsRoundTrip(ld, identity, identity))
+
+	dropTimestamps := func(ld plog.Logs) (plog.Logs, error) {
+		out := plog.NewLogs()
+		ld.CopyTo(out)
+		maskTimestamp(out, 0)
+		return out, nil
+	}
+	err := CheckLogsRoundTrip(ld, dropTimestamps, identity)
+	require.ErrorContains(t, err, "logs changed after round trip: ")
+	assert.ErrorContains(t, err, "timestamp doesn't match expected")
+	require.NoError(t, CheckLogsRoundTrip(ld, dropTimestamps, identity, IgnoreTimestamp()))
+
+	assert.EqualError(t, CheckLogsRoundTrip(ld, func(plog.Logs) (string, error) {
+		return "", errors.New("boom")
+	}, nil), "failed to convert logs: boom")
+}
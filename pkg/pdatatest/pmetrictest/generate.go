@@ -0,0 +1,279 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pmetrictest // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/internal"
+)
+
+// GenerateMetricsOption can be used to configure the metrics created by GenerateMetrics.
+type GenerateMetricsOption interface {
+	applyOnConfig(cfg *internal.GenerateConfig)
+}
+
+type generateMetricsOptionFunc func(cfg *internal.GenerateConfig)
+
+func (f generateMetricsOptionFunc) applyOnConfig(cfg *internal.GenerateConfig) {
+	f(cfg)
+}
+
+// WithResourceCount sets the number of resource metrics. The default is 2.
+func WithResourceCount(count int) GenerateMetricsOption {
+	return generateMetricsOptionFunc(func(cfg *internal.GenerateConfig) {
+		cfg.ResourceCount = count
+	})
+}
+
+// WithScopeCount sets the number of scope metrics per resource. The default is 2.
+func WithScopeCount(count int) GenerateMetricsOption {
+	return generateMetricsOptionFunc(func(cfg *internal.GenerateConfig) {
+		cfg.ScopeCount = count
+	})
+}
+
+// WithMetricCount sets the number of metrics per scope. The metric types are assigned
+// in turn, so five metrics or more cover every type. The default is 5.
+func WithMetricCount(count int) GenerateMetricsOption {
+	return generateMetricsOptionFunc(func(cfg *internal.GenerateConfig) {
+		cfg.ItemCount = count
+	})
+}
+
+// WithDataPointCount sets the number of data points per metric. The default is 3.
+func WithDataPointCount(count int) GenerateMetricsOption {
+	return generateMetricsOptionFunc(func(cfg *internal.GenerateConfig) {
+		cfg.EntryCount = count
+	})
+}
+
+// WithAttributeCount sets the number of attributes of every resource, scope and data point.
+// The default is 4.
+func WithAttributeCount(count int) GenerateMetricsOption {
+	return generateMetricsOptionFunc(func(cfg *internal.GenerateConfig) {
+		cfg.AttributeCount = count
+	})
+}
+
+// WithAttributeCardinality sets the number of distinct values of every attribute key.
+// Zero generates every value independently. The default is 10.
+func WithAttributeCardinality(cardinality int) GenerateMetricsOption {
+	return generateMetricsOptionFunc(func(cfg *internal.GenerateConfig) {
+		cfg.AttributeCardinality = cardinality
+	})
+}
+
+// WithEdgeCases enables edge-case values: NaN and infinite values, empty and huge strings,
+// empty attribute maps, boundary integers, zero timestamps and empty IDs.
+func WithEdgeCases() GenerateMetricsOption {
+	return generateMetricsOptionFunc(func(cfg *internal.GenerateConfig) {
+		cfg.EdgeCases = true
+	})
+}
+
+var generatedMetricTypes = []pmetric.MetricType{
+	pmetric.MetricTypeGauge,
+	pmetric.MetricTypeSum,
+	pmetric.MetricTypeHistogram,
+	pmetric.MetricTypeExponentialHistogram,
+	pmetric.MetricTypeSummary,
+}
+
+// GenerateMetrics returns random metrics of every metric type. The same seed and options
+// always return the same metrics.
+func GenerateMetrics(seed uint64, options ...GenerateMetricsOption) pmetric.Metrics {
+	cfg := internal.NewGenerateConfig()
+	for _, option := range options {
+		option.applyOnConfig(&cfg)
+	}
+	g := internal.NewGenerator(seed, cfg)
+
+	md := pmetric.NewMetrics()
+	for range cfg.ResourceCount {
+		rm := md.ResourceMetrics().AppendEmpty()
+		g.FillResource(rm.Resource())
+		rm.SetSchemaUrl(g.SchemaURL())
+		for range cfg.ScopeCount {
+			sm := rm.ScopeMetrics().AppendEmpty()
+			g.FillScope(sm.Scope())
+			sm.SetSchemaUrl(g.SchemaURL())
+			for i := range cfg.ItemCount {
+				generateMetric(g, sm.Metrics().AppendEmpty(), i)
+			}
+		}
+	}
+	return md
+}
+
+func generateMetric(g *internal.Generator, m pmetric.Metric, index int) {
+	typ := generatedMetricTypes[index%len(generatedMetricTypes)]
+	// Metrics are matched by name, so the names must be unique within a scope
+	m.SetName(fmt.Sprintf("metric.%d.%s", index, typ))
+	m.SetDescription(g.String("description"))
+	m.SetUnit(g.String("unit"))
+
+	//exhaustive:enforce
+	switch typ {
+	case pmetric.MetricTypeGauge:
+		generateNumberDataPoints(g, m.SetEmptyGauge().DataPoints())
+	case pmetric.MetricTypeSum:
+		sum := m.SetEmptySum()
+		sum.SetAggregationTemporality(generateTemporality(g))
+		sum.SetIsMonotonic(g.IntN(2) == 0)
+		generateNumberDataPoints(g, sum.DataPoints())
+	case pmetric.MetricTypeHistogram:
+		histogram := m.SetEmptyHistogram()
+		histogram.SetAggregationTemporality(generateTemporality(g))
+		generateHistogramDataPoints(g, histogram.DataPoints())
+	case pmetric.MetricTypeExponentialHistogram:
+		histogram := m.SetEmptyExponentialHistogram()
+		histogram.SetAggregationTemporality(generateTemporality(g))
+		generateExponentialHistogramDataPoints(g, histogram.DataPoints())
+	case pmetric.MetricTypeSummary:
+		generateSummaryDataPoints(g, m.SetEmptySummary().DataPoints())
+	case pmetric.MetricTypeEmpty:
+	}
+}
+
+func generateTemporality(g *internal.Generator) pmetric.AggregationTemporality {
+	if g.IntN(2) == 0 {
+		return pmetric.AggregationTemporalityCumulative
+	}
+	return pmetric.AggregationTemporalityDelta
+}
+
+func generateFlags(g *internal.Generator) pmetric.DataPointFlags {
+	return pmetric.DefaultDataPointFlags.WithNoRecordedValue(g.EdgeCase())
+}
+
+func generateNumberDataPoints(g *internal.Generator, dps pmetric.NumberDataPointSlice) {
+	for range g.Config().EntryCount {
+		dp := dps.AppendEmpty()
+		g.FillAttributes(dp.Attributes())
+		start, end := g.TimeRange()
+		dp.SetStartTimestamp(start)
+		dp.SetTimestamp(end)
+		dp.SetFlags(generateFlags(g))
+		if g.IntN(2) == 0 {
+			dp.SetIntValue(g.Int())
+		} else {
+			dp.SetDoubleValue(g.Double(true))
+		}
+		generateExemplars(g, dp.Exemplars())
+	}
+}
+
+func generateExemplars(g *internal.Generator, exemplars pmetric.ExemplarSlice) {
+	for range g.IntN(3) {
+		e := exemplars.AppendEmpty()
+		g.FillAttributes(e.FilteredAttributes())
+		e.SetTimestamp(g.Timestamp())
+		e.SetTraceID(g.TraceID())
+		e.SetSpanID(g.SpanID())
+		if g.IntN(2) == 0 {
+			e.SetIntValue(g.Int())
+		} else {
+			e.SetDoubleValue(g.Double(true))
+		}
+	}
+}
+
+func generateHistogramDataPoints(g *internal.Generator, dps pmetric.HistogramDataPointSlice) {
+	for range g.Config().EntryCount {
+		dp := dps.AppendEmpty()
+		g.FillAttributes(dp.Attributes())
+		start, end := g.TimeRange()
+		dp.SetStartTimestamp(start)
+		dp.SetTimestamp(end)
+		dp.SetFlags(generateFlags(g))
+
+		// The edge case is a histogram without buckets
+		if !g.EdgeCase() {
+			bound := float64(g.IntN(100))
+			for range 1 + g.IntN(8) {
+				dp.ExplicitBounds().Append(bound)
+				bound += float64(1 + g.IntN(100))
+			}
+			var count uint64
+			for range dp.ExplicitBounds().Len() + 1 {
+				n := uint64(g.IntN(50))
+				dp.BucketCounts().Append(n)
+				count += n
+			}
+			dp.SetCount(count)
+		}
+		if g.IntN(4) != 0 {
+			dp.SetSum(g.Double(true))
+		}
+		if g.IntN(4) != 0 {
+			dp.SetMin(g.Double(true))
+			dp.SetMax(g.Double(true))
+		}
+		generateExemplars(g, dp.Exemplars())
+	}
+}
+
+func generateExponentialHistogramDataPoints(g *internal.Generator, dps pmetric.ExponentialHistogramDataPointSlice) {
+	for range g.Config().EntryCount {
+		dp := dps.AppendEmpty()
+		g.FillAttributes(dp.Attributes())
+		start, end := g.TimeRange()
+		dp.SetStartTimestamp(start)
+		dp.SetTimestamp(end)
+		dp.SetFlags(generateFlags(g))
+		dp.SetScale(int32(g.IntN(31) - 10))
+		dp.SetZeroThreshold(g.Double(false))
+		dp.SetZeroCount(uint64(g.IntN(10)))
+
+		count := dp.ZeroCount()
+		for _, buckets := range []pmetric.ExponentialHistogramDataPointBuckets{dp.Positive(), dp.Negative()} {
+			// The edge case is an empty bucket range
+			if g.EdgeCase() {
+				continue
+			}
+			buckets.SetOffset(int32(g.IntN(200) - 100))
+			for range 1 + g.IntN(16) {
+				n := uint64(g.IntN(50))
+				buckets.BucketCounts().Append(n)
+				count += n
+			}
+		}
+		dp.SetCount(count)
+		if g.IntN(4) != 0 {
+			dp.SetSum(g.Double(true))
+		}
+		if g.IntN(4) != 0 {
+			dp.SetMin(g.Double(true))
+			dp.SetMax(g.Double(true))
+		}
+		generateExemplars(g, dp.Exemplars())
+	}
+}
+
+func generateSummaryDataPoints(g *internal.Generator, dps pmetric.SummaryDataPointSlice) {
+	for range g.Config().EntryCount {
+		dp := dps.AppendEmpty()
+		g.FillAttributes(dp.Attributes())
+		start, end := g.TimeRange()
+		dp.SetStartTimestamp(start)
+		dp.SetTimestamp(end)
+		dp.SetFlags(generateFlags(g))
+		dp.SetCount(g.Uint())
+		dp.SetSum(g.Double(true))
+
+		// The edge case is a summary without quantiles
+		if g.EdgeCase() {
+			continue
+		}
+		quantiles := []float64{0, 0.5, 0.9, 0.99, 1}
+		for _, q := range quantiles[g.IntN(len(quantiles)):] {
+			qv := dp.QuantileValues().AppendEmpty()
+			qv.SetQuantile(q)
+			qv.SetValue(g.Double(true))
+		}
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pmetrictest
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestGenerateMetricsDeterministic(t *testing.T) {
+	marshaler := &pmetric.ProtoMarshaler{}
+	first, err := marshaler.MarshalMetrics(GenerateMetrics(42, WithEdgeCases()))
+	require.NoError(t, err)
+	second, err := marshaler.MarshalMetrics(GenerateMetrics(42, WithEdgeCases()))
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+
+	other, err := marshaler.MarshalMetrics(GenerateMetrics(43, WithEdgeCases()))
+	require.NoError(t, err)
+	assert.NotEqual(t, first, other)
+}
+
+func TestGenerateMetricsOptions(t *testing.T) {
+	md := GenerateMetrics(1,
+		WithResourceCount(3),
+		WithScopeCount(1),
+		WithMetricCount(7),
+		WithDataPointCount(2),
+		WithAttributeCount(5),
+	)
+	require.Equal(t, 3, md.ResourceMetrics().Len())
+	assert.Equal(t, 3*7*2, md.DataPointCount())
+
+	types := map[pmetric.MetricType]int{}
+	for _, rm := range md.ResourceMetrics().All() {
+		assert.Equal(t, 5, rm.Resource().Attributes().Len())
+		require.Equal(t, 1, rm.ScopeMetrics().Len())
+		metrics := rm.ScopeMetrics().At(0).Metrics()
+		require.Equal(t, 7, metrics.Len())
+		names := map[string]bool{}
+		for _, m := range metrics.All() {
+			types[m.Type()]++
+			assert.False(t, names[m.Name()], "duplicate metric name %s", m.Name())
+			names[m.Name()] = true
+		}
+	}
+	assert.Equal(t, map[pmetric.MetricType]int{
+		pmetric.MetricTypeGauge:                6,
+		pmetric.MetricTypeSum:                  6,
+		pmetric.MetricTypeHistogram:            3,
+		pmetric.MetricTypeExponentialHistogram: 3,
+		pmetric.MetricTypeSummary:              3,
+	}, types)
+}
+
+func TestGenerateMetricsAttributeCardinality(t *testing.T) {
+	md := GenerateMetrics(7, WithMetricCount(5), WithDataPointCount(20), WithAttributeCount(2), WithAttributeCardinality(3))
+	values := map[string]map[string]bool{}
+	forEachDataPointAttributes(md, func(attrs pcommon.Map) {
+		for k, v := range attrs.All() {
+			if values[k] == nil {
+				values[k] = map[string]bool{}
+			}
+			values[k][v.AsString()] = true
+		}
+	})
+	require.Len(t, values, 2)
+	for k, v := range values {
+		assert.LessOrEqual(t, len(v), 3, "attribute %s", k)
+		assert.Greater(t, len(v), 1, "attribute %s", k)
+	}
+}
+
+func TestGenerateMetricsEdgeCases(t *testing.T) {
+	hasNaN := func(md pmetric.Metrics) bool {
+		for _, rm := range md.ResourceMetrics().All() {
+			for _, sm := range rm.ScopeMetrics().All() {
+				for _, m := range sm.Metrics().All() {
+					if m.Type() != pmetric.MetricTypeGauge {
+						continue
+					}
+					for _, dp := range m.Gauge().DataPoints().All() {
+						if dp.ValueType() == pmetric.NumberDataPointValueTypeDouble && math.IsNaN(dp.DoubleValue()) {
+							return true
+						}
+					}
+				}
+			}
+		}
+		return false
+	}
+	hasEmptyAttributes := func(md pmetric.Metrics) bool {
+		var found bool
+		forEachDataPointAttributes(md, func(attrs pcommon.Map) {
+			found = found || attrs.Len() == 0
+		})
+		return found
+	}
+
+	options := []GenerateMetricsOption{WithMetricCount(10), WithDataPointCount(20)}
+	md := GenerateMetrics(3, options...)
+	assert.False(t, hasNaN(md))
+	assert.False(t, hasEmptyAttributes(md))
+
+	md = GenerateMetrics(3, append(options, WithEdgeCases())...)
+	assert.True(t, hasNaN(md))
+	assert.True(t, hasEmptyAttributes(md))
+}
+
+func forEachDataPointAttributes(md pmetric.Metrics, fn func(pcommon.Map)) {
+	for _, rm := range md.ResourceMetrics().All() {
+		for _, sm := range rm.ScopeMetrics().All() {
+			for _, m := range sm.Metrics().All() {
+				switch m.Type() {
+				case pmetric.MetricTypeGauge:
+					for _, dp := range m.Gauge().DataPoints().All() {
+						fn(dp.Attributes())
+					}
+				case pmetric.MetricTypeSum:
+					for _, dp := range m.Sum().DataPoints().All() {
+						fn(dp.Attributes())
+					}
+				case pmetric.MetricTypeHistogram:
+					for _, dp := range m.Histogram().DataPoints().All() {
+						fn(dp.Attributes())
+					}
+				case pmetric.MetricTypeExponentialHistogram:
+					for _, dp := range m.ExponentialHistogram().DataPoints().All() {
+						fn(dp.Attributes())
+					}
+				case pmetric.MetricTypeSummary:
+					for _, dp := range m.Summary().DataPoints().All() {
+						fn(dp.Attributes())
+					}
+				}
+			}
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/multierr"
@@ -15,13 +16,11 @@ import (
 )
 
 func CompareMetrics(expected, actual pmetric.Metrics, options ...CompareMetricsOption) error {
-	exp, act := pmetric.NewMetrics(), pmetric.NewMetrics()
-	expected.CopyTo(exp)
-	actual.CopyTo(act)
-
-	for _, option := range options {
-		option.applyOnMetrics(exp, act)
-	}
+	exp, act := ApplyCompareOptions(expected, actual, options...)
+	equalNaN := slices.ContainsFunc(options, func(option CompareMetricsOption) bool {
+		_, ok := option.(equalNaNOption)
+		return ok
+	})
 
 	expectedMetrics, actualMetrics := exp.ResourceMetrics(), act.ResourceMetrics()
 	if expectedMetrics.Len() != actualMetrics.Len() {
@@ -77,13 +76,17 @@ func CompareMetrics(expected, actual pmetric.Metrics, options ...CompareMetricsO
 
 	for ar, er := range matchingResources {
 		errPrefix := fmt.Sprintf(`resource "%v"`, ar.Resource().Attributes().AsRaw())
-		errs = multierr.Append(errs, internal.AddErrPrefix(errPrefix, CompareResourceMetrics(er, ar)))
+		errs = multierr.Append(errs, internal.AddErrPrefix(errPrefix, compareResourceMetrics(er, ar, equalNaN)))
 	}
 
 	return errs
 }
 
 func CompareResourceMetrics(expected, actual pmetric.ResourceMetrics) error {
+	return compareResourceMetrics(expected, actual, false)
+}
+
+func compareResourceMetrics(expected, actual pmetric.ResourceMetrics, equalNaN bool) error {
 	errs := multierr.Combine(
 		internal.CompareResource(expected.Resource(), actual.Resource()),
 		internal.CompareSchemaURL(expected.SchemaUrl(), actual.SchemaUrl()),
@@ -145,7 +148,7 @@ func CompareResourceMetrics(expected, actual pmetric.ResourceMetrics) error {
 
 	for i := 0; i < esms.Len(); i++ {
 		errPrefix := fmt.Sprintf(`scope %q`, esms.At(i).Scope().Name())
-		errs = multierr.Append(errs, internal.AddErrPrefix(errPrefix, CompareScopeMetrics(esms.At(i), asms.At(i))))
+		errs = multierr.Append(errs, internal.AddErrPrefix(errPrefix, compareScopeMetrics(esms.At(i), asms.At(i), equalNaN)))
 	}
 
 	return errs
@@ -155,6 +158,10 @@ func CompareResourceMetrics(expected, actual pmetric.ResourceMetrics) error {
 // an error if they don't match. The error describes what didn't match. The
 // expected and actual values are clones before options are applied.
 func CompareScopeMetrics(expected, actual pmetric.ScopeMetrics) error {
+	return compareScopeMetrics(expected, actual, false)
+}
+
+func compareScopeMetrics(expected, actual pmetric.ScopeMetrics, equalNaN bool) error {
 	errs := multierr.Combine(
 		internal.CompareInstrumentationScope(expected.Scope(), actual.Scope()),
 		internal.CompareSchemaURL(expected.SchemaUrl(), actual.SchemaUrl()),
@@ -214,12 +221,16 @@ func CompareScopeMetrics(expected, actual pmetric.ScopeMetrics) error {
 
 	for i := range numMetrics {
 		errPrefix := fmt.Sprintf(`metric %q`, ems.At(i).Name())
-		errs = multierr.Append(errs, internal.AddErrPrefix(errPrefix, CompareMetric(ems.At(i), ams.At(i))))
+		errs = multierr.Append(errs, internal.AddErrPrefix(errPrefix, compareMetric(ems.At(i), ams.At(i), equalNaN)))
 	}
 	return errs
 }
 
 func CompareMetric(expected, actual pmetric.Metric) error {
+	return compareMetric(expected, actual, false)
+}
+
+func compareMetric(expected, actual pmetric.Metric, equalNaN bool) error {
 	var errs error
 
 	if actual.Name() != expected.Name() {
@@ -244,7 +255,7 @@ func CompareMetric(expected, actual pmetric.Metric) error {
 	switch actual.Type() {
 	case pmetric.MetricTypeGauge:
 		errs = multierr.Append(errs, compareNumberDataPointSlices(expected.Gauge().DataPoints(),
-			actual.Gauge().DataPoints()))
+			actual.Gauge().DataPoints(), equalNaN))
 	case pmetric.MetricTypeSum:
 		if actual.Sum().AggregationTemporality() != expected.Sum().AggregationTemporality() {
 			errs = multierr.Append(errs, fmt.Errorf("aggregation temporality doesn't match expected: %s, actual: %s",
@@ -254,14 +265,14 @@ func CompareMetric(expected, actual pmetric.Metric) error {
 			errs = multierr.Append(errs, fmt.Errorf("is monotonic doesn't match expected: %t, actual: %t",
 				expected.Sum().IsMonotonic(), actual.Sum().IsMonotonic()))
 		}
-		errs = multierr.Append(errs, compareNumberDataPointSlices(expected.Sum().DataPoints(), actual.Sum().DataPoints()))
+		errs = multierr.Append(errs, compareNumberDataPointSlices(expected.Sum().DataPoints(), actual.Sum().DataPoints(), equalNaN))
 	case pmetric.MetricTypeHistogram:
 		if actual.Histogram().AggregationTemporality() != expected.Histogram().AggregationTemporality() {
 			errs = multierr.Append(errs, fmt.Errorf("aggregation temporality doesn't match expected: %s, actual: %s",
 				expected.Histogram().AggregationTemporality(), actual.Histogram().AggregationTemporality()))
 		}
 		errs = multierr.Append(errs, compareHistogramDataPointSlices(expected.Histogram().DataPoints(),
-			actual.Histogram().DataPoints()))
+			actual.Histogram().DataPoints(), equalNaN))
 	case pmetric.MetricTypeExponentialHistogram:
 		if actual.ExponentialHistogram().AggregationTemporality() != expected.ExponentialHistogram().AggregationTemporality() {
 			errs = multierr.Append(errs, fmt.Errorf("aggregation temporality doesn't match expected: %s, actual: %s",
@@ -269,10 +280,10 @@ func CompareMetric(expected, actual pmetric.Metric) error {
 				actual.ExponentialHistogram().AggregationTemporality()))
 		}
 		errs = multierr.Append(errs, compareExponentialHistogramDataPointSlice(expected.ExponentialHistogram().DataPoints(),
-			actual.ExponentialHistogram().DataPoints()))
+			actual.ExponentialHistogram().DataPoints(), equalNaN))
 	case pmetric.MetricTypeSummary:
 		errs = multierr.Append(errs, compareSummaryDataPointSlices(expected.Summary().DataPoints(),
-			actual.Summary().DataPoints()))
+			actual.Summary().DataPoints(), equalNaN))
 	case pmetric.MetricTypeEmpty:
 	}
 
@@ -281,7 +292,7 @@ func CompareMetric(expected, actual pmetric.Metric) error {
 
 // compareNumberDataPointSlices compares each part of two given NumberDataPointSlices and returns
 // an error if they don't match. The error describes what didn't match.
-func compareNumberDataPointSlices(expected, actual pmetric.NumberDataPointSlice) error {
+func compareNumberDataPointSlices(expected, actual pmetric.NumberDataPointSlice, equalNaN bool) error {
 	if expected.Len() != actual.Len() {
 		return fmt.Errorf("number of datapoints doesn't match expected: %d, actual: %d", expected.Len(), actual.Len())
 	}
@@ -332,7 +343,7 @@ func compareNumberDataPointSlices(expected, actual pmetric.NumberDataPointSlice)
 
 	for adp, edp := range matchingDPS {
 		errPrefix := fmt.Sprintf(`datapoint "%v"`, adp.Attributes().AsRaw())
-		errs = multierr.Append(errs, internal.AddErrPrefix(errPrefix, CompareNumberDataPoint(edp, adp)))
+		errs = multierr.Append(errs, internal.AddErrPrefix(errPrefix, compareNumberDataPoint(edp, adp, equalNaN)))
 	}
 
 	return errs
@@ -341,6 +352,10 @@ func compareNumberDataPointSlices(expected, actual pmetric.NumberDataPointSlice)
 // CompareNumberDataPoint compares each part of two given NumberDataPoints and returns
 // an error if they don't match. The error describes what didn't match.
 func CompareNumberDataPoint(expected, actual pmetric.NumberDataPoint) error {
+	return compareNumberDataPoint(expected, actual, false)
+}
+
+func compareNumberDataPoint(expected, actual pmetric.NumberDataPoint, equalNaN bool) error {
 	errs := internal.CompareAttributes(expected.Attributes(), actual.Attributes())
 	if expected.StartTimestamp() != actual.StartTimestamp() {
 		errs = multierr.Append(errs, fmt.Errorf("start timestamp doesn't match expected: %d, "+
@@ -358,7 +373,7 @@ func CompareNumberDataPoint(expected, actual pmetric.NumberDataPoint) error {
 			errs = multierr.Append(errs, fmt.Errorf("int value doesn't match expected: %d, actual: %d",
 				expected.IntValue(), actual.IntValue()))
 		}
-		if expected.DoubleValue() != actual.DoubleValue() {
+		if !internal.EqualFloat(expected.DoubleValue(), actual.DoubleValue(), equalNaN) {
 			errs = multierr.Append(errs, fmt.Errorf("double value doesn't match expected: %f, actual: %f",
 				expected.DoubleValue(), actual.DoubleValue()))
 		}
@@ -367,13 +382,13 @@ func CompareNumberDataPoint(expected, actual pmetric.NumberDataPoint) error {
 		errs = multierr.Append(errs, fmt.Errorf("flags don't match expected: %d, actual: %d",
 			expected.Flags(), actual.Flags()))
 	}
-	errs = multierr.Append(errs, compareExemplarSlice(expected.Exemplars(), actual.Exemplars()))
+	errs = multierr.Append(errs, compareExemplarSlice(expected.Exemplars(), actual.Exemplars(), equalNaN))
 	return errs
 }
 
 // compareExemplarSlice compares each part of two given ExemplarSlice and returns
 // an error if they don't match. The error describes what didn't match.
-func compareExemplarSlice(expected, actual pmetric.ExemplarSlice) error {
+func compareExemplarSlice(expected, actual pmetric.ExemplarSlice, equalNaN bool) error {
 	if expected.Len() != actual.Len() {
 		return fmt.Errorf("number of exemplars doesn't match expected: %d, actual: %d", expected.Len(), actual.Len())
 	}
@@ -425,7 +440,7 @@ func compareExemplarSlice(expected, actual pmetric.ExemplarSlice) error {
 
 	for aex, eex := range matchingExs {
 		errPrefix := fmt.Sprintf(`exemplar %v`, eex.FilteredAttributes().AsRaw())
-		errs = multierr.Append(errs, internal.AddErrPrefix(errPrefix, CompareExemplar(eex, aex)))
+		errs = multierr.Append(errs, internal.AddErrPrefix(errPrefix, compareExemplar(eex, aex, equalNaN)))
 	}
 	return errs
 }
@@ -433,12 +448,16 @@ func compareExemplarSlice(expected, actual pmetric.ExemplarSlice) error {
 // CompareExemplar compares each part of two given pmetric.Exemplar and returns
 // an error if they don't match. The error describes what didn't match.
 func CompareExemplar(expected, actual pmetric.Exemplar) error {
+	return compareExemplar(expected, actual, false)
+}
+
+func compareExemplar(expected, actual pmetric.Exemplar, equalNaN bool) error {
 	var errs error
 	if expected.ValueType() != actual.ValueType() {
 		errs = multierr.Append(errs, fmt.Errorf("value type doesn't match: expected type: %s, actual type: %s",
 			expected.ValueType(), actual.ValueType()))
 	} else {
-		if expected.DoubleValue() != actual.DoubleValue() {
+		if !internal.EqualFloat(expected.DoubleValue(), actual.DoubleValue(), equalNaN) {
 			errs = multierr.Append(errs, fmt.Errorf("double value doesn't match expected: %f, actual: %f",
 				expected.DoubleValue(), actual.DoubleValue()))
 		}
@@ -464,7 +483,7 @@ func CompareExemplar(expected, actual pmetric.Exemplar) error {
 
 // compareHistogramDataPointSlices compares each part of two given HistogramDataPointSlices and returns
 // an error if they don't match. The error describes what didn't match.
-func compareHistogramDataPointSlices(expected, actual pmetric.HistogramDataPointSlice) error {
+func compareHistogramDataPointSlices(expected, actual pmetric.HistogramDataPointSlice, equalNaN bool) error {
 	if expected.Len() != actual.Len() {
 		return fmt.Errorf("number of datapoints doesn't match expected: %d, actual: %d", expected.Len(), actual.Len())
 	}
@@ -515,7 +534,7 @@ func compareHistogramDataPointSlices(expected, actual pmetric.HistogramDataPoint
 
 	for adp, edp := range matchingDPS {
 		errPrefix := fmt.Sprintf(`datapoint "%v"`, adp.Attributes().AsRaw())
-		errs = multierr.Append(errs, internal.AddErrPrefix(errPrefix, CompareHistogramDataPoints(edp, adp)))
+		errs = multierr.Append(errs, internal.AddErrPrefix(errPrefix, compareHistogramDataPoints(edp, adp, equalNaN)))
 	}
 	return errs
 }
@@ -523,8 +542,12 @@ func compareHistogramDataPointSlices(expected, actual pmetric.HistogramDataPoint
 // CompareHistogramDataPoints compares each part of two given HistogramDataPoints and returns
 // an error if they don't match. The error describes what didn't match.
 func CompareHistogramDataPoints(expected, actual pmetric.HistogramDataPoint) error {
+	return compareHistogramDataPoints(expected, actual, false)
+}
+
+func compareHistogramDataPoints(expected, actual pmetric.HistogramDataPoint, equalNaN bool) error {
 	errs := internal.CompareAttributes(expected.Attributes(), actual.Attributes())
-	if expected.HasSum() != actual.HasSum() || expected.Sum() != actual.Sum() {
+	if expected.HasSum() != actual.HasSum() || !internal.EqualFloat(expected.Sum(), actual.Sum(), equalNaN) {
 		errStr := "sum doesn't match expected: "
 		if expected.HasSum() {
 			errStr += fmt.Sprintf("%f", expected.Sum())
@@ -539,7 +562,7 @@ func CompareHistogramDataPoints(expected, actual pmetric.HistogramDataPoint) err
 		}
 		errs = multierr.Append(errs, errors.New(errStr))
 	}
-	if expected.HasMin() != actual.HasMin() || expected.Min() != actual.Min() {
+	if expected.HasMin() != actual.HasMin() || !internal.EqualFloat(expected.Min(), actual.Min(), equalNaN) {
 		errStr := "min doesn't match expected: "
 		if expected.HasMin() {
 			errStr += fmt.Sprintf("%f", expected.Min())
@@ -554,7 +577,7 @@ func CompareHistogramDataPoints(expected, actual pmetric.HistogramDataPoint) err
 		}
 		errs = multierr.Append(errs, errors.New(errStr))
 	}
-	if expected.HasMax() != actual.HasMax() || expected.Max() != actual.Max() {
+	if expected.HasMax() != actual.HasMax() || !internal.EqualFloat(expected.Max(), actual.Max(), equalNaN) {
 		errStr := "max doesn't match expected: "
 		if expected.HasMax() {
 			errStr += fmt.Sprintf("%f", expected.Min())
@@ -593,13 +616,13 @@ func CompareHistogramDataPoints(expected, actual pmetric.HistogramDataPoint) err
 		errs = multierr.Append(errs, fmt.Errorf("explicit bounds don't match expected: %v, "+
 			"actual: %v", expected.ExplicitBounds().AsRaw(), actual.ExplicitBounds().AsRaw()))
 	}
-	errs = multierr.Append(errs, compareExemplarSlice(expected.Exemplars(), actual.Exemplars()))
+	errs = multierr.Append(errs, compareExemplarSlice(expected.Exemplars(), actual.Exemplars(), equalNaN))
 	return errs
 }
 
 // compareExponentialHistogramDataPointSlice compares each part of two given ExponentialHistogramDataPointSlices and
 // returns an error if they don't match. The error describes what didn't match.
-func compareExponentialHistogramDataPointSlice(expected, actual pmetric.ExponentialHistogramDataPointSlice) error {
+func compareExponentialHistogramDataPointSlice(expected, actual pmetric.ExponentialHistogramDataPointSlice, equalNaN bool) error {
 	if expected.Len() != actual.Len() {
 		return fmt.Errorf("number of datapoints doesn't match expected: %d, actual: %d", expected.Len(), actual.Len())
 	}
@@ -650,7 +673,7 @@ func compareExponentialHistogramDataPointSlice(expected, actual pmetric.Exponent
 
 	for adp, edp := range matchingDPS {
 		errPrefix := fmt.Sprintf(`datapoint "%v"`, adp.Attributes().AsRaw())
-		errs = multierr.Append(errs, internal.AddErrPrefix(errPrefix, CompareExponentialHistogramDataPoint(edp, adp)))
+		errs = multierr.Append(errs, internal.AddErrPrefix(errPrefix, compareExponentialHistogramDataPoint(edp, adp, equalNaN)))
 	}
 	return errs
 }
@@ -658,8 +681,12 @@ func compareExponentialHistogramDataPointSlice(expected, actual pmetric.Exponent
 // CompareExponentialHistogramDataPoint compares each part of two given ExponentialHistogramDataPoints and returns
 // an error if they don't match. The error describes what didn't match.
 func CompareExponentialHistogramDataPoint(expected, actual pmetric.ExponentialHistogramDataPoint) error {
+	return compareExponentialHistogramDataPoint(expected, actual, false)
+}
+
+func compareExponentialHistogramDataPoint(expected, actual pmetric.ExponentialHistogramDataPoint, equalNaN bool) error {
 	errs := internal.CompareAttributes(expected.Attributes(), actual.Attributes())
-	if expected.HasSum() != actual.HasSum() || expected.Sum() != actual.Sum() {
+	if expected.HasSum() != actual.HasSum() || !internal.EqualFloat(expected.Sum(), actual.Sum(), equalNaN) {
 		errStr := "sum doesn't match expected: "
 		if expected.HasSum() {
 			errStr += fmt.Sprintf("%f", expected.Sum())
@@ -674,7 +701,7 @@ func CompareExponentialHistogramDataPoint(expected, actual pmetric.ExponentialHi
 		}
 		errs = multierr.Append(errs, errors.New(errStr))
 	}
-	if expected.HasMin() != actual.HasMin() || expected.Min() != actual.Min() {
+	if expected.HasMin() != actual.HasMin() || !internal.EqualFloat(expected.Min(), actual.Min(), equalNaN) {
 		errStr := "min doesn't match expected: "
 		if expected.HasMin() {
 			errStr += fmt.Sprintf("%f", expected.Min())
@@ -689,7 +716,7 @@ func CompareExponentialHistogramDataPoint(expected, actual pmetric.ExponentialHi
 		}
 		errs = multierr.Append(errs, errors.New(errStr))
 	}
-	if expected.HasMax() != actual.HasMax() || expected.Max() != actual.Max() {
+	if expected.HasMax() != actual.HasMax() || !internal.EqualFloat(expected.Max(), actual.Max(), equalNaN) {
 		errStr := "max doesn't match expected: "
 		if expected.HasMax() {
 			errStr += fmt.Sprintf("%f", expected.Min())
@@ -746,13 +773,13 @@ func CompareExponentialHistogramDataPoint(expected, actual pmetric.ExponentialHi
 		errs = multierr.Append(errs, fmt.Errorf("positive bucket counts don't match expected: %v, "+
 			"actual: %v", expected.Positive().BucketCounts().AsRaw(), actual.Positive().BucketCounts().AsRaw()))
 	}
-	errs = multierr.Append(errs, compareExemplarSlice(expected.Exemplars(), actual.Exemplars()))
+	errs = multierr.Append(errs, compareExemplarSlice(expected.Exemplars(), actual.Exemplars(), equalNaN))
 	return errs
 }
 
 // compareSummaryDataPointSlices compares each part of two given SummaryDataPoint slices and returns
 // an error if they don't match. The error describes what didn't match.
-func compareSummaryDataPointSlices(expected, actual pmetric.SummaryDataPointSlice) error {
+func compareSummaryDataPointSlices(expected, actual pmetric.SummaryDataPointSlice, equalNaN bool) error {
 	numPoints := expected.Len()
 	if numPoints != actual.Len() {
 		return fmt.Errorf("metric datapoint slice length doesn't match expected: %d, actual: %d", numPoints, actual.Len())
@@ -800,7 +827,7 @@ func compareSummaryDataPointSlices(expected, actual pmetric.SummaryDataPointSlic
 
 	for adp, edp := range matchingDPS {
 		errPrefix := fmt.Sprintf(`datapoint "%v"`, adp.Attributes().AsRaw())
-		errs = multierr.Append(errs, internal.AddErrPrefix(errPrefix, CompareSummaryDataPoint(edp, adp)))
+		errs = multierr.Append(errs, internal.AddErrPrefix(errPrefix, compareSummaryDataPoint(edp, adp, equalNaN)))
 	}
 	return errs
 }
@@ -808,13 +835,17 @@ func compareSummaryDataPointSlices(expected, actual pmetric.SummaryDataPointSlic
 // CompareSummaryDataPoint compares each part of two given SummaryDataPoint and returns
 // an error if they don't match. The error describes what didn't match.
 func CompareSummaryDataPoint(expected, actual pmetric.SummaryDataPoint) error {
+	return compareSummaryDataPoint(expected, actual, false)
+}
+
+func compareSummaryDataPoint(expected, actual pmetric.SummaryDataPoint, equalNaN bool) error {
 	errs := internal.CompareAttributes(expected.Attributes(), actual.Attributes())
 
 	if expected.Count() != actual.Count() {
 		errs = multierr.Append(errs, fmt.Errorf("count doesn't match expected: %d, actual: %d",
 			expected.Count(), actual.Count()))
 	}
-	if expected.Sum() != actual.Sum() {
+	if !internal.EqualFloat(expected.Sum(), actual.Sum(), equalNaN) {
 		errs = multierr.Append(errs, fmt.Errorf("sum doesn't match expected: %f, actual: %f",
 			expected.Sum(), actual.Sum()))
 	}
@@ -842,7 +873,7 @@ func CompareSummaryDataPoint(expected, actual pmetric.SummaryDataPoint) error {
 			errs = multierr.Append(errs, fmt.Errorf("quantile doesn't match expected: %f, "+
 				"actual: %f", eqv.Quantile(), acv.Quantile()))
 		}
-		if eqv.Value() != acv.Value() {
+		if !internal.EqualFloat(eqv.Value(), acv.Value(), equalNaN) {
 			errs = multierr.Append(errs, fmt.Errorf("value at quantile %f doesn't match expected: %f, actual: %f",
 				eqv.Quantile(), eqv.Value(), acv.Value()))
 		}
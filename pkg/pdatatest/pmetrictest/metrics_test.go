@@ -5,11 +5,13 @@ package pmetrictest
 
 import (
 	"errors"
+	"math"
 	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/multierr"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
@@ -480,3 +482,38 @@ func TestCompareMetrics(t *testing.T) {
 		})
 	}
 }
+
+func TestApplyCompareOptions(t *testing.T) {
+	expected, actual := pmetric.NewMetrics(), pmetric.NewMetrics()
+	expected.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Scope().SetVersion("1.0.0")
+	actual.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Scope().SetVersion("2.0.0")
+
+	exp, act := ApplyCompareOptions(expected, actual, IgnoreScopeVersion())
+	assert.Empty(t, exp.ResourceMetrics().At(0).ScopeMetrics().At(0).Scope().Version())
+	assert.Empty(t, act.ResourceMetrics().At(0).ScopeMetrics().At(0).Scope().Version())
+	// the given metrics are left untouched
+	assert.Equal(t, "1.0.0", expected.ResourceMetrics().At(0).ScopeMetrics().At(0).Scope().Version())
+	assert.Equal(t, "2.0.0", actual.ResourceMetrics().At(0).ScopeMetrics().At(0).Scope().Version())
+}
+
+func TestCompareMetricsEqualNaN(t *testing.T) {
+	newMetrics := func() pmetric.Metrics {
+		md := pmetric.NewMetrics()
+		m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+		m.SetName("gauge")
+		m.SetEmptyGauge().DataPoints().AppendEmpty().SetDoubleValue(math.NaN())
+		return md
+	}
+
+	// NaN is not equal to NaN by default
+	assert.ErrorContains(t, CompareMetrics(newMetrics(), newMetrics()), "double value doesn't match expected: NaN, actual: NaN")
+	assert.Error(t, CompareNumberDataPoint(
+		newMetrics().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints().At(0),
+		newMetrics().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints().At(0),
+	))
+
+	require.NoError(t, CompareMetrics(newMetrics(), newMetrics(), EqualNaN()))
+	actual := newMetrics()
+	actual.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints().At(0).SetDoubleValue(1)
+	assert.ErrorContains(t, CompareMetrics(newMetrics(), actual, EqualNaN()), "double value doesn't match expected: NaN, actual: 1.000000")
+}
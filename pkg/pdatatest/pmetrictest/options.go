@@ -28,6 +28,32 @@ func (f compareMetricsOptionFunc) applyOnMetrics(expected, actual pmetric.Metric
 	f(expected, actual)
 }
 
+// ApplyCompareOptions returns copies of the expected and actual metrics, mutated by the options
+// the same way CompareMetrics mutates them before comparing. The given metrics are not modified,
+// so that tools reporting the differences in their own way can ignore the same fields as CompareMetrics.
+func ApplyCompareOptions(expected, actual pmetric.Metrics, options ...CompareMetricsOption) (pmetric.Metrics, pmetric.Metrics) {
+	exp, act := pmetric.NewMetrics(), pmetric.NewMetrics()
+	expected.CopyTo(exp)
+	actual.CopyTo(act)
+
+	for _, option := range options {
+		option.applyOnMetrics(exp, act)
+	}
+	return exp, act
+}
+
+// EqualNaN is a CompareMetricsOption that considers NaN values equal to each other, instead of following
+// the IEEE 754 semantics where NaN is not equal to any value. It allows comparing metrics whose values can
+// be NaN, for example after a round trip through an encoding.
+func EqualNaN() CompareMetricsOption {
+	return equalNaNOption{}
+}
+
+// equalNaNOption does not mutate the metrics, CompareMetrics checks for it instead.
+type equalNaNOption struct{}
+
+func (equalNaNOption) applyOnMetrics(_, _ pmetric.Metrics) {}
+
 // IgnoreMetricValues is a CompareMetricsOption that clears all metric values.
 func IgnoreMetricValues(metricNames ...string) CompareMetricsOption {
 	return compareMetricsOptionFunc(func(expected, actual pmetric.Metrics) {
@@ -0,0 +1,41 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pmetrictest // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// CheckMetricsRoundTrip converts the metrics with to, converts the result back with from and
+// compares the outcome with the original metrics. Options can be used to ignore the parts of
+// the metrics that the conversion is not expected to preserve. NaN values are compared with
+// EqualNaN, since they are expected to be preserved. It is meant for translators, for example
+// between pdata and a vendor format.
+func CheckMetricsRoundTrip[T any](md pmetric.Metrics, to func(pmetric.Metrics) (T, error), from func(T) (pmetric.Metrics, error), options ...CompareMetricsOption) error {
+	// The conversion may modify its input, so compare against a copy
+	expected := pmetric.NewMetrics()
+	md.CopyTo(expected)
+
+	converted, err := to(md)
+	if err != nil {
+		return fmt.Errorf("failed to convert metrics: %w", err)
+	}
+	actual, err := from(converted)
+	if err != nil {
+		return fmt.Errorf("failed to convert metrics back: %w", err)
+	}
+	if err := CompareMetrics(expected, actual, append([]CompareMetricsOption{EqualNaN()}, options...)...); err != nil {
+		return fmt.Errorf("metrics changed after round trip: %w", err)
+	}
+	return nil
+}
+
+// CheckMetricsMarshalRoundTrip marshals the metrics, unmarshals the result and compares the
+// outcome with the original metrics. It is meant for encodings, such as the pdata
+// marshalers and encoding extensions.
+func CheckMetricsMarshalRoundTrip(md pmetric.Metrics, marshaler pmetric.Marshaler, unmarshaler pmetric.Unmarshaler, options ...CompareMetricsOption) error {
+	return CheckMetricsRoundTrip(md, marshaler.MarshalMetrics, unmarshaler.UnmarshalMetrics, options...)
+}
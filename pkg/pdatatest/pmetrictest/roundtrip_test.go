@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pmetrictest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestCheckMetricsMarshalRoundTrip(t *testing.T) {
+	for seed := range uint64(20) {
+		md := GenerateMetrics(seed, WithEdgeCases())
+		require.NoError(t, CheckMetricsMarshalRoundTrip(md, &pmetric.ProtoMarshaler{}, &pmetric.ProtoUnmarshaler{}), "seed %d", seed)
+		require.NoError(t, CheckMetricsMarshalRoundTrip(md, &pmetric.JSONMarshaler{}, &pmetric.JSONUnmarshaler{}), "seed %d", seed)
+	}
+}
+
+func TestCheckMetricsRoundTrip(t *testing.T) {
+	md := GenerateMetrics(1)
+	identity := func(md pmetric.Metrics) (pmetric.Metrics, error) {
+		return md, nil
+	}
+
+	// Changes made to the input by the conversion are detected
+	dropUnits := func(md pmetric.Metrics) (pmetric.Metrics, error) {
+		for _, rm := range md.ResourceMetrics().All() {
+			for _, sm := range rm.ScopeMetrics().All() {
+				for _, m := range sm.Metrics().All() {
+					m.SetUnit("")
+				}
+			}
+		}
+		return md, nil
+	}
+	err := CheckMetricsRoundTrip(md, dropUnits, identity)
+	require.ErrorContains(t, err, "metrics changed after round trip: ")
+	assert.ErrorContains(t, err, "unit doesn't match expected")
+
+	// Options ignore the parts that are not preserved
+	require.NoError(t, CheckMetricsRoundTrip(md, identity, identity))
+	require.NoError(t, CheckMetricsRoundTrip(md, func(md pmetric.Metrics) (pmetric.Metrics, error) {
+		out := pmetric.NewMetrics()
+		md.CopyTo(out)
+		maskScopeVersion(out)
+		return out, nil
+	}, identity, IgnoreScopeVersion()))
+
+	assert.EqualError(t, CheckMetricsRoundTrip(md, func(pmetric.Metrics) ([]byte, error) {
+		return nil, errors.New("boom")
+	}, nil), "failed to convert metrics: boom")
+	assert.EqualError(t, CheckMetricsRoundTrip(md, identity, func(pmetric.Metrics) (pmetric.Metrics, error) {
+		return pmetric.Metrics{}, errors.New("boom")
+	}), "failed to convert metrics back: boom")
+}
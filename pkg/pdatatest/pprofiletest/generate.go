@@ -0,0 +1,217 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pprofiletest // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pprofiletest"
+
+import (
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/internal"
+)
+
+// GenerateProfilesOption can be used to configure the profiles created by GenerateProfiles.
+type GenerateProfilesOption interface {
+	applyOnConfig(cfg *internal.GenerateConfig)
+}
+
+type generateProfilesOptionFunc func(cfg *internal.GenerateConfig)
+
+func (f generateProfilesOptionFunc) applyOnConfig(cfg *internal.GenerateConfig) {
+	f(cfg)
+}
+
+// WithResourceCount sets the number of resource profiles. The default is 2.
+func WithResourceCount(count int) GenerateProfilesOption {
+	return generateProfilesOptionFunc(func(cfg *internal.GenerateConfig) {
+		cfg.ResourceCount = count
+	})
+}
+
+// WithScopeCount sets the number of scope profiles per resource. The default is 2.
+func WithScopeCount(count int) GenerateProfilesOption {
+	return generateProfilesOptionFunc(func(cfg *internal.GenerateConfig) {
+		cfg.ScopeCount = count
+	})
+}
+
+// WithProfileCount sets the number of profiles per scope. The default is 5.
+func WithProfileCount(count int) GenerateProfilesOption {
+	return generateProfilesOptionFunc(func(cfg *internal.GenerateConfig) {
+		cfg.ItemCount = count
+	})
+}
+
+// WithSampleCount sets the number of samples per profile. The default is 3.
+func WithSampleCount(count int) GenerateProfilesOption {
+	return generateProfilesOptionFunc(func(cfg *internal.GenerateConfig) {
+		cfg.EntryCount = count
+	})
+}
+
+// WithAttributeCount sets the number of attributes of every resource, scope, profile and sample.
+// The default is 4.
+func WithAttributeCount(count int) GenerateProfilesOption {
+	return generateProfilesOptionFunc(func(cfg *internal.GenerateConfig) {
+		cfg.AttributeCount = count
+	})
+}
+
+// WithAttributeCardinality sets the number of distinct values of every attribute key.
+// Zero generates every value independently. The default is 10.
+func WithAttributeCardinality(cardinality int) GenerateProfilesOption {
+	return generateProfilesOptionFunc(func(cfg *internal.GenerateConfig) {
+		cfg.AttributeCardinality = cardinality
+	})
+}
+
+// WithEdgeCases enables edge-case values: infinite doubles, empty and huge strings,
+// empty attribute maps, boundary integers, zero timestamps and empty IDs.
+func WithEdgeCases() GenerateProfilesOption {
+	return generateProfilesOptionFunc(func(cfg *internal.GenerateConfig) {
+		cfg.EdgeCases = true
+	})
+}
+
+// GenerateProfiles returns random profiles sharing a single dictionary. Every dictionary
+// table starts with its zero value, and the generated profiles pass ValidateProfile.
+// The same seed and options always return the same profiles.
+func GenerateProfiles(seed uint64, options ...GenerateProfilesOption) pprofile.Profiles {
+	cfg := internal.NewGenerateConfig()
+	for _, option := range options {
+		option.applyOnConfig(&cfg)
+	}
+	g := internal.NewGenerator(seed, cfg)
+
+	pd := pprofile.NewProfiles()
+	dic := pd.Dictionary()
+	dic.StringTable().Append("")
+	dic.MappingTable().AppendEmpty()
+	dic.LocationTable().AppendEmpty()
+	dic.FunctionTable().AppendEmpty()
+	dic.LinkTable().AppendEmpty()
+	dic.StackTable().AppendEmpty()
+	dic.AttributeTable().AppendEmpty()
+
+	for range cfg.ResourceCount {
+		rp := pd.ResourceProfiles().AppendEmpty()
+		g.FillResource(rp.Resource())
+		rp.SetSchemaUrl(g.SchemaURL())
+		for range cfg.ScopeCount {
+			sp := rp.ScopeProfiles().AppendEmpty()
+			g.FillScope(sp.Scope())
+			sp.SetSchemaUrl(g.SchemaURL())
+			for range cfg.ItemCount {
+				generateProfile(g, dic, sp.Profiles().AppendEmpty())
+			}
+		}
+	}
+	return pd
+}
+
+func generateProfile(g *internal.Generator, dic pprofile.ProfilesDictionary, p pprofile.Profile) {
+	p.SampleType().SetTypeStrindex(generateString(dic, []string{"samples", "cpu", "alloc_space"}[g.IntN(3)]))
+	p.SampleType().SetUnitStrindex(generateString(dic, []string{"count", "nanoseconds", "bytes"}[g.IntN(3)]))
+	p.PeriodType().SetTypeStrindex(generateString(dic, "cpu"))
+	p.PeriodType().SetUnitStrindex(generateString(dic, "nanoseconds"))
+	p.SetPeriod(int64(1 + g.IntN(int(time.Millisecond))))
+	p.SetTime(g.Timestamp())
+	p.SetDurationNano(uint64(1 + g.IntN(int(time.Minute))))
+	if id := g.TraceID(); !id.IsEmpty() {
+		p.SetProfileID(pprofile.ProfileID(id))
+	}
+	p.SetOriginalPayloadFormat(g.String("format"))
+	p.OriginalPayload().FromRaw(g.Bytes())
+	generateAttributes(g, dic, p)
+	if g.EdgeCase() {
+		p.SetDroppedAttributesCount(uint32(g.IntN(10)))
+	}
+
+	for range g.Config().EntryCount {
+		generateSample(g, dic, p)
+	}
+}
+
+func generateSample(g *internal.Generator, dic pprofile.ProfilesDictionary, p pprofile.Profile) {
+	s := p.Samples().AppendEmpty()
+
+	stack := dic.StackTable().AppendEmpty()
+	for range 1 + g.IntN(4) {
+		stack.LocationIndices().Append(generateLocation(g, dic))
+	}
+	s.SetStackIndex(int32(dic.StackTable().Len() - 1))
+
+	s.Values().Append(g.Int())
+	start := uint64(p.Time())
+	for range g.IntN(3) {
+		s.TimestampsUnixNano().Append(start + uint64(g.IntN(int(p.DurationNano()))))
+	}
+	generateAttributes(g, dic, s)
+
+	if g.IntN(2) == 0 {
+		link := dic.LinkTable().AppendEmpty()
+		link.SetTraceID(g.TraceID())
+		link.SetSpanID(g.SpanID())
+		s.SetLinkIndex(int32(dic.LinkTable().Len() - 1))
+	}
+}
+
+func generateLocation(g *internal.Generator, dic pprofile.ProfilesDictionary) int32 {
+	loc := dic.LocationTable().AppendEmpty()
+	loc.SetAddress(g.Uint())
+
+	if g.IntN(2) == 0 {
+		m := dic.MappingTable().AppendEmpty()
+		m.SetMemoryStart(g.Uint())
+		m.SetMemoryLimit(m.MemoryStart() + uint64(g.IntN(1<<20)))
+		m.SetFileOffset(uint64(g.IntN(1 << 20)))
+		m.SetFilenameStrindex(generateString(dic, g.String("/usr/lib/lib")))
+		generateAttributes(g, dic, m)
+		loc.SetMappingIndex(int32(dic.MappingTable().Len() - 1))
+	}
+
+	for range 1 + g.IntN(2) {
+		fn := dic.FunctionTable().AppendEmpty()
+		fn.SetNameStrindex(generateString(dic, g.String("func")))
+		fn.SetSystemNameStrindex(generateString(dic, g.String("_func")))
+		fn.SetFilenameStrindex(generateString(dic, g.String("main.go")))
+		fn.SetStartLine(int64(g.IntN(1000)))
+
+		line := loc.Lines().AppendEmpty()
+		line.SetFunctionIndex(int32(dic.FunctionTable().Len() - 1))
+		line.SetLine(fn.StartLine() + int64(g.IntN(100)))
+		line.SetColumn(int64(g.IntN(80)))
+	}
+	generateAttributes(g, dic, loc)
+
+	return int32(dic.LocationTable().Len() - 1)
+}
+
+func generateString(dic pprofile.ProfilesDictionary, s string) int32 {
+	idx, err := pprofile.SetString(dic.StringTable(), s)
+	if err != nil {
+		panic(fmt.Sprintf("failed to put string: %v", err))
+	}
+	return idx
+}
+
+func generateAttributes(g *internal.Generator, dic pprofile.ProfilesDictionary, record attributable) {
+	attrs := pcommon.NewMap()
+	g.FillAttributes(attrs)
+	for k, v := range attrs.All() {
+		kvu := pprofile.NewKeyValueAndUnit()
+		kvu.SetKeyStrindex(generateString(dic, k))
+		v.CopyTo(kvu.Value())
+		if g.IntN(4) == 0 {
+			kvu.SetUnitStrindex(generateString(dic, g.String("unit")))
+		}
+		idx, err := pprofile.SetAttribute(dic.AttributeTable(), kvu)
+		if err != nil {
+			panic(fmt.Sprintf("failed to set attribute %s: %v", k, err))
+		}
+		record.AttributeIndices().Append(idx)
+	}
+}
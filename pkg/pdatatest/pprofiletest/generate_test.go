@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pprofiletest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+)
+
+func TestGenerateProfilesDeterministic(t *testing.T) {
+	marshaler := &pprofile.ProtoMarshaler{}
+	first, err := marshaler.MarshalProfiles(GenerateProfiles(42, WithEdgeCases()))
+	require.NoError(t, err)
+	second, err := marshaler.MarshalProfiles(GenerateProfiles(42, WithEdgeCases()))
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+
+	other, err := marshaler.MarshalProfiles(GenerateProfiles(43, WithEdgeCases()))
+	require.NoError(t, err)
+	assert.NotEqual(t, first, other)
+}
+
+func TestGenerateProfilesOptions(t *testing.T) {
+	pd := GenerateProfiles(1,
+		WithResourceCount(1),
+		WithScopeCount(3),
+		WithProfileCount(2),
+		WithSampleCount(4),
+		WithAttributeCount(2),
+	)
+	require.Equal(t, 1, pd.ResourceProfiles().Len())
+	assert.Equal(t, 3*2*4, pd.SampleCount())
+	for _, sp := range pd.ResourceProfiles().At(0).ScopeProfiles().All() {
+		require.Equal(t, 2, sp.Profiles().Len())
+		for _, p := range sp.Profiles().All() {
+			assert.Equal(t, 2, p.AttributeIndices().Len())
+		}
+	}
+}
+
+func TestGenerateProfilesValid(t *testing.T) {
+	for seed := range uint64(20) {
+		pd := GenerateProfiles(seed, WithEdgeCases())
+		dic := pd.Dictionary()
+		for _, rp := range pd.ResourceProfiles().All() {
+			for _, sp := range rp.ScopeProfiles().All() {
+				for _, p := range sp.Profiles().All() {
+					require.NoError(t, ValidateProfile(dic, p), "seed %d", seed)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pprofiletest // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pprofiletest"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pprofile"
+)
+
+// CheckProfilesRoundTrip converts the profiles with to, converts the result back with from and
+// compares the outcome with the original profiles. Options can be used to ignore the parts of
+// the profiles that the conversion is not expected to preserve. It is meant for translators,
+// for example between pdata and a vendor format.
+func CheckProfilesRoundTrip[T any](pd pprofile.Profiles, to func(pprofile.Profiles) (T, error), from func(T) (pprofile.Profiles, error), options ...CompareProfilesOption) error {
+	// The conversion may modify its input, so compare against a copy
+	expected := pprofile.NewProfiles()
+	pd.CopyTo(expected)
+
+	converted, err := to(pd)
+	if err != nil {
+		return fmt.Errorf("failed to convert profiles: %w", err)
+	}
+	actual, err := from(converted)
+	if err != nil {
+		return fmt.Errorf("failed to convert profiles back: %w", err)
+	}
+	if err := CompareProfiles(expected, actual, options...); err != nil {
+		return fmt.Errorf("profiles changed after round trip: %w", err)
+	}
+	return nil
+}
+
+// CheckProfilesMarshalRoundTrip marshals the profiles, unmarshals the result and compares the
+// outcome with the original profiles. It is meant for encodings, such as the pdata
+// marshalers and encoding extensions.
+func CheckProfilesMarshalRoundTrip(pd pprofile.Profiles, marshaler pprofile.Marshaler, unmarshaler pprofile.Unmarshaler, options ...CompareProfilesOption) error {
+	return CheckProfilesRoundTrip(pd, marshaler.MarshalProfiles, unmarshaler.UnmarshalProfiles, options...)
+}
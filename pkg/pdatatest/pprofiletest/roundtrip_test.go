@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pprofiletest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+)
+
+func TestCheckProfilesMarshalRoundTrip(t *testing.T) {
+	for seed := range uint64(20) {
+		pd := GenerateProfiles(seed, WithEdgeCases())
+		require.NoError(t, CheckProfilesMarshalRoundTrip(pd, &pprofile.ProtoMarshaler{}, &pprofile.ProtoUnmarshaler{}), "seed %d", seed)
+		require.NoError(t, CheckProfilesMarshalRoundTrip(pd, &pprofile.JSONMarshaler{}, &pprofile.JSONUnmarshaler{}), "seed %d", seed)
+	}
+}
+
+func TestCheckProfilesRoundTrip(t *testing.T) {
+	pd := GenerateProfiles(1)
+	identity := func(pd pprofile.Profiles) (pprofile.Profiles, error) {
+		return pd, nil
+	}
+	require.NoError(t, CheckProfilesRoundTrip(pd, identity, identity))
+
+	dropResourceAttribute := func(pd pprofile.Profiles) (pprofile.Profiles, error) {
+		out := pprofile.NewProfiles()
+		pd.CopyTo(out)
+		for _, rp := range out.ResourceProfiles().All() {
+			rp.Resource().Attributes().Remove("attr.0")
+		}
+		return out, nil
+	}
+	err := CheckProfilesRoundTrip(pd, dropResourceAttribute, identity)
+	require.ErrorContains(t, err, "profiles changed after round trip: ")
+	assert.ErrorContains(t, err, "missing expected resource")
+	require.NoError(t, CheckProfilesRoundTrip(pd, dropResourceAttribute, identity, IgnoreResourceAttributeValue("attr.0")))
+
+	assert.EqualError(t, CheckProfilesRoundTrip(pd, func(pprofile.Profiles) ([]byte, error) {
+		return nil, errors.New("boom")
+	}, nil), "failed to convert profiles: boom")
+}
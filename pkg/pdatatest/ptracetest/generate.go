@@ -0,0 +1,149 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ptracetest // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/ptracetest"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/internal"
+)
+
+// GenerateTracesOption can be used to configure the traces created by GenerateTraces.
+type GenerateTracesOption interface {
+	applyOnConfig(cfg *internal.GenerateConfig)
+}
+
+type generateTracesOptionFunc func(cfg *internal.GenerateConfig)
+
+func (f generateTracesOptionFunc) applyOnConfig(cfg *internal.GenerateConfig) {
+	f(cfg)
+}
+
+// WithResourceCount sets the number of resource spans. The default is 2.
+func WithResourceCount(count int) GenerateTracesOption {
+	return generateTracesOptionFunc(func(cfg *internal.GenerateConfig) {
+		cfg.ResourceCount = count
+	})
+}
+
+// WithScopeCount sets the number of scope spans per resource. The default is 2.
+func WithScopeCount(count int) GenerateTracesOption {
+	return generateTracesOptionFunc(func(cfg *internal.GenerateConfig) {
+		cfg.ScopeCount = count
+	})
+}
+
+// WithSpanCount sets the number of spans per scope. The default is 5.
+func WithSpanCount(count int) GenerateTracesOption {
+	return generateTracesOptionFunc(func(cfg *internal.GenerateConfig) {
+		cfg.ItemCount = count
+	})
+}
+
+// WithEventCount sets the number of events and the number of links per span. The default is 3.
+func WithEventCount(count int) GenerateTracesOption {
+	return generateTracesOptionFunc(func(cfg *internal.GenerateConfig) {
+		cfg.EntryCount = count
+	})
+}
+
+// WithAttributeCount sets the number of attributes of every resource, scope, span, event and link.
+// The default is 4.
+func WithAttributeCount(count int) GenerateTracesOption {
+	return generateTracesOptionFunc(func(cfg *internal.GenerateConfig) {
+		cfg.AttributeCount = count
+	})
+}
+
+// WithAttributeCardinality sets the number of distinct values of every attribute key.
+// Zero generates every value independently. The default is 10.
+func WithAttributeCardinality(cardinality int) GenerateTracesOption {
+	return generateTracesOptionFunc(func(cfg *internal.GenerateConfig) {
+		cfg.AttributeCardinality = cardinality
+	})
+}
+
+// WithEdgeCases enables edge-case values: infinite doubles, empty and huge strings,
+// empty attribute maps, boundary integers, zero timestamps and empty IDs.
+func WithEdgeCases() GenerateTracesOption {
+	return generateTracesOptionFunc(func(cfg *internal.GenerateConfig) {
+		cfg.EdgeCases = true
+	})
+}
+
+// GenerateTraces returns random traces. The spans of a scope belong to the same trace and
+// every span but the first one is a child of the previous span. The same seed and options
+// always return the same traces.
+func GenerateTraces(seed uint64, options ...GenerateTracesOption) ptrace.Traces {
+	cfg := internal.NewGenerateConfig()
+	for _, option := range options {
+		option.applyOnConfig(&cfg)
+	}
+	g := internal.NewGenerator(seed, cfg)
+
+	td := ptrace.NewTraces()
+	for range cfg.ResourceCount {
+		rs := td.ResourceSpans().AppendEmpty()
+		g.FillResource(rs.Resource())
+		rs.SetSchemaUrl(g.SchemaURL())
+		for range cfg.ScopeCount {
+			ss := rs.ScopeSpans().AppendEmpty()
+			g.FillScope(ss.Scope())
+			ss.SetSchemaUrl(g.SchemaURL())
+			traceID := g.TraceID()
+			var parentSpanID pcommon.SpanID
+			for range cfg.ItemCount {
+				span := ss.Spans().AppendEmpty()
+				generateSpan(g, span, traceID, parentSpanID)
+				parentSpanID = span.SpanID()
+			}
+		}
+	}
+	return td
+}
+
+func generateSpan(g *internal.Generator, span ptrace.Span, traceID pcommon.TraceID, parentSpanID pcommon.SpanID) {
+	span.SetTraceID(traceID)
+	span.SetSpanID(g.SpanID())
+	span.SetParentSpanID(parentSpanID)
+	span.TraceState().FromRaw(generateTraceState(g))
+	span.SetFlags(uint32(g.IntN(2)))
+	span.SetName(g.String("span"))
+	span.SetKind(ptrace.SpanKind(g.IntN(6)))
+	start, end := g.TimeRange()
+	span.SetStartTimestamp(start)
+	span.SetEndTimestamp(end)
+	g.FillAttributes(span.Attributes())
+	if g.EdgeCase() {
+		span.SetDroppedAttributesCount(uint32(g.IntN(10)))
+		span.SetDroppedEventsCount(uint32(g.IntN(10)))
+		span.SetDroppedLinksCount(uint32(g.IntN(10)))
+	}
+	span.Status().SetCode(ptrace.StatusCode(g.IntN(3)))
+	if span.Status().Code() == ptrace.StatusCodeError {
+		span.Status().SetMessage(g.String("error"))
+	}
+
+	for range g.Config().EntryCount {
+		event := span.Events().AppendEmpty()
+		event.SetName(g.String("event"))
+		event.SetTimestamp(g.Timestamp())
+		g.FillAttributes(event.Attributes())
+
+		link := span.Links().AppendEmpty()
+		link.SetTraceID(g.TraceID())
+		link.SetSpanID(g.SpanID())
+		link.TraceState().FromRaw(generateTraceState(g))
+		link.SetFlags(uint32(g.IntN(2)))
+		g.FillAttributes(link.Attributes())
+	}
+}
+
+func generateTraceState(g *internal.Generator) string {
+	if g.IntN(2) == 0 {
+		return ""
+	}
+	return g.String("vendor=value")
+}
@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ptracetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func TestGenerateTracesDeterministic(t *testing.T) {
+	marshaler := &ptrace.ProtoMarshaler{}
+	first, err := marshaler.MarshalTraces(GenerateTraces(42, WithEdgeCases()))
+	require.NoError(t, err)
+	second, err := marshaler.MarshalTraces(GenerateTraces(42, WithEdgeCases()))
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+
+	other, err := marshaler.MarshalTraces(GenerateTraces(43, WithEdgeCases()))
+	require.NoError(t, err)
+	assert.NotEqual(t, first, other)
+}
+
+func TestGenerateTracesOptions(t *testing.T) {
+	td := GenerateTraces(1,
+		WithResourceCount(3),
+		WithScopeCount(2),
+		WithSpanCount(4),
+		WithEventCount(2),
+		WithAttributeCount(1),
+		WithAttributeCardinality(0),
+	)
+	require.Equal(t, 3, td.ResourceSpans().Len())
+	assert.Equal(t, 3*2*4, td.SpanCount())
+	for _, rs := range td.ResourceSpans().All() {
+		for _, ss := range rs.ScopeSpans().All() {
+			spans := ss.Spans()
+			for i, span := range spans.All() {
+				assert.Equal(t, 1, span.Attributes().Len())
+				assert.Equal(t, 2, span.Events().Len())
+				assert.Equal(t, 2, span.Links().Len())
+				assert.Equal(t, spans.At(0).TraceID(), span.TraceID())
+				if i > 0 {
+					assert.Equal(t, spans.At(i-1).SpanID(), span.ParentSpanID())
+				} else {
+					assert.True(t, span.ParentSpanID().IsEmpty())
+				}
+			}
+		}
+	}
+}
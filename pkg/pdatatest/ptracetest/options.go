@@ -25,6 +25,20 @@ func (f compareTracesOptionFunc) applyOnTraces(expected, actual ptrace.Traces) {
 	f(expected, actual)
 }
 
+// ApplyCompareOptions returns copies of the expected and actual traces, mutated by the options
+// the same way CompareTraces mutates them before comparing. The given traces are not modified,
+// so that tools reporting the differences in their own way can ignore the same fields as CompareTraces.
+func ApplyCompareOptions(expected, actual ptrace.Traces, options ...CompareTracesOption) (ptrace.Traces, ptrace.Traces) {
+	exp, act := ptrace.NewTraces(), ptrace.NewTraces()
+	expected.CopyTo(exp)
+	actual.CopyTo(act)
+
+	for _, option := range options {
+		option.applyOnTraces(exp, act)
+	}
+	return exp, act
+}
+
 // IgnoreResourceAttributeValue is a CompareTracesOption that removes a resource attribute
 // from all resources.
 func IgnoreResourceAttributeValue(attributeName string) CompareTracesOption {
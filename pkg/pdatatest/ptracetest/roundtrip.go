@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ptracetest // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/ptracetest"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// CheckTracesRoundTrip converts the traces with to, converts the result back with from and
+// compares the outcome with the original traces. Options can be used to ignore the parts of
+// the traces that the conversion is not expected to preserve. It is meant for translators,
+// for example between pdata and a vendor format.
+func CheckTracesRoundTrip[T any](td ptrace.Traces, to func(ptrace.Traces) (T, error), from func(T) (ptrace.Traces, error), options ...CompareTracesOption) error {
+	// The conversion may modify its input, so compare against a copy
+	expected := ptrace.NewTraces()
+	td.CopyTo(expected)
+
+	converted, err := to(td)
+	if err != nil {
+		return fmt.Errorf("failed to convert traces: %w", err)
+	}
+	actual, err := from(converted)
+	if err != nil {
+		return fmt.Errorf("failed to convert traces back: %w", err)
+	}
+	if err := CompareTraces(expected, actual, options...); err != nil {
+		return fmt.Errorf("traces changed after round trip: %w", err)
+	}
+	return nil
+}
+
+// CheckTracesMarshalRoundTrip marshals the traces, unmarshals the result and compares the
+// outcome with the original traces. It is meant for encodings, such as the pdata
+// marshalers and encoding extensions.
+func CheckTracesMarshalRoundTrip(td ptrace.Traces, marshaler ptrace.Marshaler, unmarshaler ptrace.Unmarshaler, options ...CompareTracesOption) error {
+	return CheckTracesRoundTrip(td, marshaler.MarshalTraces, unmarshaler.UnmarshalTraces, options...)
+}
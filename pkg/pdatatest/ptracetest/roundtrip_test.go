@@ -0,0 +1,45 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ptracetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func TestCheckTracesMarshalRoundTrip(t *testing.T) {
+	for seed := range uint64(20) {
+		td := GenerateTraces(seed, WithEdgeCases())
+		require.NoError(t, CheckTracesMarshalRoundTrip(td, &ptrace.ProtoMarshaler{}, &ptrace.ProtoUnmarshaler{}), "seed %d", seed)
+		require.NoError(t, CheckTracesMarshalRoundTrip(td, &ptrace.JSONMarshaler{}, &ptrace.JSONUnmarshaler{}), "seed %d", seed)
+	}
+}
+
+func TestCheckTracesRoundTrip(t *testing.T) {
+	td := GenerateTraces(1)
+	identity := func(td ptrace.Traces) (ptrace.Traces, error) {
+		return td, nil
+	}
+	require.NoError(t, CheckTracesRoundTrip(td, identity, identity))
+
+	dropSpanIDs := func(td ptrace.Traces) (ptrace.Traces, error) {
+		out := ptrace.NewTraces()
+		td.CopyTo(out)
+		maskSpanID(out, pcommon.NewSpanIDEmpty())
+		return out, nil
+	}
+	err := CheckTracesRoundTrip(td, dropSpanIDs, identity)
+	require.ErrorContains(t, err, "traces changed after round trip: ")
+	assert.ErrorContains(t, err, "span ID doesn't match expected")
+	require.NoError(t, CheckTracesRoundTrip(td, dropSpanIDs, identity, IgnoreSpanID()))
+
+	assert.EqualError(t, CheckTracesRoundTrip(td, identity, func(ptrace.Traces) (ptrace.Traces, error) {
+		return ptrace.Traces{}, errors.New("boom")
+	}), "failed to convert traces back: boom")
+}
@@ -16,13 +16,7 @@ import (
 // CompareTraces compares each part of two given Traces and returns
 // an error if they don't match. The error describes what didn't match.
 func CompareTraces(expected, actual ptrace.Traces, options ...CompareTracesOption) error {
-	exp, act := ptrace.NewTraces(), ptrace.NewTraces()
-	expected.CopyTo(exp)
-	actual.CopyTo(act)
-
-	for _, option := range options {
-		option.applyOnTraces(exp, act)
-	}
+	exp, act := ApplyCompareOptions(expected, actual, options...)
 
 	expectedSpans, actualSpans := exp.ResourceSpans(), act.ResourceSpans()
 	if expectedSpans.Len() != actualSpans.Len() {
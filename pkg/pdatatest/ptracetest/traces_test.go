@@ -10,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/multierr"
 
@@ -639,3 +640,15 @@ func TestCompareSpan(t *testing.T) {
 		})
 	}
 }
+
+func TestApplyCompareOptions(t *testing.T) {
+	expected, actual := ptrace.NewTraces(), ptrace.NewTraces()
+	expected.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetSpanID([8]byte{1})
+	actual.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetSpanID([8]byte{2})
+
+	exp, act := ApplyCompareOptions(expected, actual, IgnoreSpanID())
+	assert.Equal(t, exp.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).SpanID(), act.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).SpanID())
+	// the given traces are left untouched
+	assert.Equal(t, pcommon.SpanID([8]byte{1}), expected.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).SpanID())
+	assert.Equal(t, pcommon.SpanID([8]byte{2}), actual.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).SpanID())
+}
@@ -6,6 +6,7 @@ package filterprocessor // import "github.com/open-telemetry/opentelemetry-colle
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"go.opentelemetry.io/collector/component"
@@ -25,6 +26,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanlink"
 )
 
 // Config defines configuration for Resource processor.
@@ -46,11 +48,19 @@ type Config struct {
 
 	Profiles ProfileFilters `mapstructure:"profiles"`
 
+	// Functions are user-defined OTTL functions that can be called by all the conditions.
+	Functions []ottl.UserFunction `mapstructure:"functions"`
+	// FunctionLibraries are the extensions providing user-defined OTTL functions, such as the ottl_functions
+	// extension, with the names of the functions called by the conditions. Their functions are added to the
+	// Functions once the processor starts.
+	FunctionLibraries []ottl.UserFunctionLibraryConfig `mapstructure:"function_libraries"`
+
 	resourceFunctions  map[string]ottl.Factory[ottlresource.TransformContext]
 	dataPointFunctions map[string]ottl.Factory[*ottldatapoint.TransformContext]
 	logFunctions       map[string]ottl.Factory[*ottllog.TransformContext]
 	metricFunctions    map[string]ottl.Factory[*ottlmetric.TransformContext]
 	spanEventFunctions map[string]ottl.Factory[*ottlspanevent.TransformContext]
+	spanLinkFunctions  map[string]ottl.Factory[*ottlspanlink.TransformContext]
 	spanFunctions      map[string]ottl.Factory[*ottlspan.TransformContext]
 	profileFunctions   map[string]ottl.Factory[ottlprofile.TransformContext]
 }
@@ -102,6 +112,11 @@ type TraceFilters struct {
 	// If any condition resolves to true, the span event will be dropped.
 	// Supports `and`, `or`, and `()`
 	SpanEventConditions []string `mapstructure:"spanevent"`
+
+	// SpanLinkConditions is a list of OTTL conditions for an ottlspanlink context.
+	// If any condition resolves to true, the span link will be dropped.
+	// Supports `and`, `or`, and `()`
+	SpanLinkConditions []string `mapstructure:"spanlink"`
 }
 
 // LogFilters filters by Log properties.
@@ -313,7 +328,7 @@ var _ component.Config = (*Config)(nil)
 
 // Validate checks if the processor configuration is valid
 func (cfg *Config) Validate() error {
-	if (cfg.Traces.ResourceConditions != nil || cfg.Traces.SpanConditions != nil || cfg.Traces.SpanEventConditions != nil) && (cfg.Spans.Include != nil || cfg.Spans.Exclude != nil) {
+	if (cfg.Traces.ResourceConditions != nil || cfg.Traces.SpanConditions != nil || cfg.Traces.SpanEventConditions != nil || cfg.Traces.SpanLinkConditions != nil) && (cfg.Spans.Include != nil || cfg.Spans.Exclude != nil) {
 		return errors.New("cannot use ottl conditions and include/exclude for spans at the same time")
 	}
 	if (cfg.Metrics.ResourceConditions != nil || cfg.Metrics.MetricConditions != nil || cfg.Metrics.DataPointConditions != nil) && (cfg.Metrics.Include != nil || cfg.Metrics.Exclude != nil) {
@@ -325,6 +340,22 @@ func (cfg *Config) Validate() error {
 
 	var errors error
 
+	for _, f := range cfg.Functions {
+		errors = multierr.Append(errors, f.Validate())
+	}
+	for _, library := range cfg.FunctionLibraries {
+		errors = multierr.Append(errors, library.Validate())
+	}
+	if errors != nil {
+		return errors
+	}
+	// The functions of the libraries are only available once the processor starts,
+	// the conditions are validated with the functions declared for each library.
+	cfg, err := cfg.withUserFunctions(append(slices.Clone(cfg.Functions), ottl.UserFunctionDeclarations(cfg.FunctionLibraries)...))
+	if err != nil {
+		return err
+	}
+
 	if cfg.Traces.ResourceConditions != nil {
 		_, err := filterottl.NewBoolExprForResource(cfg.Metrics.ResourceConditions, cfg.resourceFunctions, ottl.PropagateError, component.TelemetrySettings{Logger: zap.NewNop()})
 		errors = multierr.Append(errors, err)
@@ -340,6 +371,11 @@ func (cfg *Config) Validate() error {
 		errors = multierr.Append(errors, err)
 	}
 
+	if cfg.Traces.SpanLinkConditions != nil {
+		_, err := filterottl.NewBoolExprForSpanLink(cfg.Traces.SpanLinkConditions, cfg.spanLinkFunctions, ottl.PropagateError, component.TelemetrySettings{Logger: zap.NewNop()})
+		errors = multierr.Append(errors, err)
+	}
+
 	if cfg.Metrics.ResourceConditions != nil {
 		_, err := filterottl.NewBoolExprForResource(cfg.Metrics.ResourceConditions, cfg.resourceFunctions, ottl.PropagateError, component.TelemetrySettings{Logger: zap.NewNop()})
 		errors = multierr.Append(errors, err)
@@ -375,6 +411,12 @@ func (cfg *Config) Validate() error {
 		errors = multierr.Append(errors, err)
 	}
 
+	return multierr.Append(errors, cfg.validateLogMatchProperties())
+}
+
+func (cfg *Config) validateLogMatchProperties() error {
+	var errors error
+
 	if cfg.Logs.LogConditions != nil && cfg.Logs.Include != nil {
 		errors = multierr.Append(errors, cfg.Logs.Include.validate())
 	}
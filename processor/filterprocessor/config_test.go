@@ -883,6 +883,9 @@ func TestLoadingConfigOTTL(t *testing.T) {
 					SpanEventConditions: []string{
 						`attributes["test"] == "pass"`,
 					},
+					SpanLinkConditions: []string{
+						`attributes["test"] == "pass"`,
+					},
 				},
 				Metrics: MetricFilters{
 					ResourceConditions: []string{
@@ -943,6 +946,9 @@ func TestLoadingConfigOTTL(t *testing.T) {
 		{
 			id: component.NewIDWithName(metadata.Type, "bad_syntax_spanevent"),
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "bad_syntax_spanlink"),
+		},
 		{
 			id: component.NewIDWithName(metadata.Type, "bad_syntax_metric"),
 		},
@@ -952,6 +958,51 @@ func TestLoadingConfigOTTL(t *testing.T) {
 		{
 			id: component.NewIDWithName(metadata.Type, "bad_syntax_log"),
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "user_functions"),
+			expected: &Config{
+				ErrorMode: ottl.PropagateError,
+				Traces: TraceFilters{
+					SpanConditions: []string{
+						`IsHealthCheck(attributes["url.path"])`,
+					},
+				},
+				Logs: LogFilters{
+					LogConditions: []string{
+						`IsHealthCheck(attributes["url.path"])`,
+					},
+				},
+				Functions: []ottl.UserFunction{
+					{
+						Signature:  "IsHealthCheck(path string)",
+						Expression: `IsMatch(path, "^/(health|ready)$")`,
+					},
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "user_functions_unknown_function"),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "function_libraries"),
+			expected: &Config{
+				ErrorMode: ottl.PropagateError,
+				Traces: TraceFilters{
+					SpanConditions: []string{
+						`IsHealthCheck(attributes["url.path"])`,
+					},
+				},
+				FunctionLibraries: []ottl.UserFunctionLibraryConfig{
+					{ID: component.MustNewIDWithName("ottl_functions", "http"), Functions: []string{"IsHealthCheck"}},
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "function_libraries_invalid_condition"),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "function_libraries_undeclared_function"),
+		},
 	}
 
 	for _, tt := range tests {
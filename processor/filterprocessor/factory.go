@@ -9,6 +9,10 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/xconsumer"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/collector/processor/processorhelper"
 	"go.opentelemetry.io/collector/processor/processorhelper/xprocessorhelper"
@@ -23,6 +27,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanlink"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/filterprocessor/internal/metadata"
 )
 
@@ -34,6 +39,7 @@ type filterProcessorFactory struct {
 	logFunctions                        map[string]ottl.Factory[*ottllog.TransformContext]
 	metricFunctions                     map[string]ottl.Factory[*ottlmetric.TransformContext]
 	spanEventFunctions                  map[string]ottl.Factory[*ottlspanevent.TransformContext]
+	spanLinkFunctions                   map[string]ottl.Factory[*ottlspanlink.TransformContext]
 	spanFunctions                       map[string]ottl.Factory[*ottlspan.TransformContext]
 	profileFunctions                    map[string]ottl.Factory[ottlprofile.TransformContext]
 	defaultResourceFunctionsOverridden  bool
@@ -41,6 +47,7 @@ type filterProcessorFactory struct {
 	defaultLogFunctionsOverridden       bool
 	defaultMetricFunctionsOverridden    bool
 	defaultSpanEventFunctionsOverridden bool
+	defaultSpanLinkFunctionsOverridden  bool
 	defaultSpanFunctionsOverridden      bool
 	defaultProfileFunctionsOverridden   bool
 }
@@ -144,6 +151,18 @@ func WithSpanEventFunctionsNew(spanEventFunctions []ottl.Factory[*ottlspanevent.
 	}
 }
 
+// WithSpanLinkFunctions will override the default OTTL spanlink context functions with the provided spanLinkFunctions in the resulting processor.
+// Subsequent uses of WithSpanLinkFunctions will merge the provided spanLinkFunctions with the previously registered functions.
+func WithSpanLinkFunctions(spanLinkFunctions []ottl.Factory[*ottlspanlink.TransformContext]) FactoryOption {
+	return func(factory *filterProcessorFactory) {
+		if !factory.defaultSpanLinkFunctionsOverridden {
+			factory.spanLinkFunctions = map[string]ottl.Factory[*ottlspanlink.TransformContext]{}
+			factory.defaultSpanLinkFunctionsOverridden = true
+		}
+		factory.spanLinkFunctions = mergeFunctionsToMap(factory.spanLinkFunctions, spanLinkFunctions)
+	}
+}
+
 // Deprecated: [v0.142.0] use WithSpanFunctionsNew.
 func WithSpanFunctions(spanFunctions []ottl.Factory[ottlspan.TransformContext]) FactoryOption {
 	newSpanFunctions := make([]ottl.Factory[*ottlspan.TransformContext], 0, len(spanFunctions))
@@ -190,6 +209,7 @@ func NewFactoryWithOptions(options ...FactoryOption) processor.Factory {
 		logFunctions:       defaultLogFunctionsMap(),
 		metricFunctions:    defaultMetricFunctionsMap(),
 		spanEventFunctions: defaultSpanEventFunctionsMap(),
+		spanLinkFunctions:  defaultSpanLinkFunctionsMap(),
 		spanFunctions:      defaultSpanFunctionsMap(),
 		profileFunctions:   defaultProfileFunctionsMap(),
 	}
@@ -215,6 +235,7 @@ func (f *filterProcessorFactory) createDefaultConfig() component.Config {
 		logFunctions:       f.logFunctions,
 		metricFunctions:    f.metricFunctions,
 		spanEventFunctions: f.spanEventFunctions,
+		spanLinkFunctions:  f.spanLinkFunctions,
 		spanFunctions:      f.spanFunctions,
 		profileFunctions:   f.profileFunctions,
 	}
@@ -233,7 +254,9 @@ func (f *filterProcessorFactory) createMetricsProcessor(
 			zap.Bool("datapoint", f.defaultDataPointFunctionsOverridden),
 		)
 	}
-	fp, err := newFilterMetricProcessor(set, cfg.(*Config))
+	fp, err := newUserFunctionsProcessor(cfg.(*Config), func(cfg *Config) (*filterMetricProcessor, error) {
+		return newFilterMetricProcessor(set, cfg)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -242,8 +265,11 @@ func (f *filterProcessorFactory) createMetricsProcessor(
 		set,
 		cfg,
 		nextConsumer,
-		fp.processMetrics,
-		processorhelper.WithCapabilities(processorCapabilities))
+		func(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+			return fp.processor.processMetrics(ctx, md)
+		},
+		processorhelper.WithCapabilities(processorCapabilities),
+		processorhelper.WithStart(fp.start))
 }
 
 func (f *filterProcessorFactory) createLogsProcessor(
@@ -258,7 +284,9 @@ func (f *filterProcessorFactory) createLogsProcessor(
 			zap.Bool("log", f.defaultLogFunctionsOverridden),
 		)
 	}
-	fp, err := newFilterLogsProcessor(set, cfg.(*Config))
+	fp, err := newUserFunctionsProcessor(cfg.(*Config), func(cfg *Config) (*filterLogProcessor, error) {
+		return newFilterLogsProcessor(set, cfg)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -267,8 +295,11 @@ func (f *filterProcessorFactory) createLogsProcessor(
 		set,
 		cfg,
 		nextConsumer,
-		fp.processLogs,
-		processorhelper.WithCapabilities(processorCapabilities))
+		func(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+			return fp.processor.processLogs(ctx, ld)
+		},
+		processorhelper.WithCapabilities(processorCapabilities),
+		processorhelper.WithStart(fp.start))
 }
 
 func (f *filterProcessorFactory) createTracesProcessor(
@@ -277,14 +308,17 @@ func (f *filterProcessorFactory) createTracesProcessor(
 	cfg component.Config,
 	nextConsumer consumer.Traces,
 ) (processor.Traces, error) {
-	if f.defaultResourceFunctionsOverridden || f.defaultSpanEventFunctionsOverridden || f.defaultSpanFunctionsOverridden {
+	if f.defaultResourceFunctionsOverridden || f.defaultSpanEventFunctionsOverridden || f.defaultSpanLinkFunctionsOverridden || f.defaultSpanFunctionsOverridden {
 		set.Logger.Debug("non-default OTTL trace functions have been registered in the \"filter\" processor",
 			zap.Bool("resource", f.defaultResourceFunctionsOverridden),
 			zap.Bool("span", f.defaultSpanFunctionsOverridden),
 			zap.Bool("spanevent", f.defaultSpanEventFunctionsOverridden),
+			zap.Bool("spanlink", f.defaultSpanLinkFunctionsOverridden),
 		)
 	}
-	fp, err := newFilterSpansProcessor(set, cfg.(*Config))
+	fp, err := newUserFunctionsProcessor(cfg.(*Config), func(cfg *Config) (*filterSpanProcessor, error) {
+		return newFilterSpansProcessor(set, cfg)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -293,8 +327,11 @@ func (f *filterProcessorFactory) createTracesProcessor(
 		set,
 		cfg,
 		nextConsumer,
-		fp.processTraces,
-		processorhelper.WithCapabilities(processorCapabilities))
+		func(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+			return fp.processor.processTraces(ctx, td)
+		},
+		processorhelper.WithCapabilities(processorCapabilities),
+		processorhelper.WithStart(fp.start))
 }
 
 func (f *filterProcessorFactory) createProfilesProcessor(
@@ -309,7 +346,9 @@ func (f *filterProcessorFactory) createProfilesProcessor(
 			zap.Bool("profile", f.defaultProfileFunctionsOverridden),
 		)
 	}
-	fp, err := newFilterProfilesProcessor(set, cfg.(*Config))
+	fp, err := newUserFunctionsProcessor(cfg.(*Config), func(cfg *Config) (*filterProfileProcessor, error) {
+		return newFilterProfilesProcessor(set, cfg)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -318,8 +357,11 @@ func (f *filterProcessorFactory) createProfilesProcessor(
 		set,
 		cfg,
 		nextConsumer,
-		fp.processProfiles,
-		xprocessorhelper.WithCapabilities(processorCapabilities))
+		func(ctx context.Context, pd pprofile.Profiles) (pprofile.Profiles, error) {
+			return fp.processor.processProfiles(ctx, pd)
+		},
+		xprocessorhelper.WithCapabilities(processorCapabilities),
+		xprocessorhelper.WithStart(fp.start))
 }
 
 func fromNonPointerFunction[K any](legacy func(fCtx ottl.FunctionContext, args ottl.Arguments) (ottl.ExprFunc[K], error)) func(fCtx ottl.FunctionContext, args ottl.Arguments) (ottl.ExprFunc[*K], error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanlink"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
 )
 
@@ -76,6 +77,10 @@ func DefaultSpanEventFunctionsNew() []ottl.Factory[*ottlspanevent.TransformConte
 	return slices.Collect(maps.Values(defaultSpanEventFunctionsMap()))
 }
 
+func DefaultSpanLinkFunctions() []ottl.Factory[*ottlspanlink.TransformContext] {
+	return slices.Collect(maps.Values(defaultSpanLinkFunctionsMap()))
+}
+
 func DefaultProfileFunctions() []ottl.Factory[ottlprofile.TransformContext] {
 	return slices.Collect(maps.Values(defaultProfileFunctionsMap()))
 }
@@ -104,6 +109,10 @@ func defaultSpanEventFunctionsMap() map[string]ottl.Factory[*ottlspanevent.Trans
 	return filterottl.StandardSpanEventFuncs()
 }
 
+func defaultSpanLinkFunctionsMap() map[string]ottl.Factory[*ottlspanlink.TransformContext] {
+	return filterottl.StandardSpanLinkFuncs()
+}
+
 func defaultProfileFunctionsMap() map[string]ottl.Factory[ottlprofile.TransformContext] {
 	return filterottl.StandardProfileFuncs()
 }
@@ -255,6 +255,24 @@ func TestFilterTraceProcessorWithOTTL(t *testing.T) {
 			},
 			errorMode: ottl.IgnoreError,
 		},
+		{
+			name: "drop span links",
+			conditions: TraceFilters{
+				SpanLinkConditions: []string{
+					`link_index == 1 and span.name == "operationB"`,
+				},
+			},
+			want: func(td ptrace.Traces) {
+				for _, ss := range td.ResourceSpans().At(0).ScopeSpans().All() {
+					linkIndex := -1
+					ss.Spans().At(1).Links().RemoveIf(func(ptrace.SpanLink) bool {
+						linkIndex++
+						return linkIndex == 1
+					})
+				}
+			},
+			errorMode: ottl.IgnoreError,
+		},
 		{
 			name: "multiple conditions",
 			conditions: TraceFilters{
@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filterprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/filterprocessor"
+
+import (
+	"context"
+	"slices"
+
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// withUserFunctions returns a copy of the configuration whose OTTL functions include the given user-defined functions.
+func (cfg *Config) withUserFunctions(userFunctions []ottl.UserFunction) (*Config, error) {
+	if len(userFunctions) == 0 {
+		return cfg, nil
+	}
+	c := *cfg
+	var err error
+	if c.resourceFunctions, err = ottl.MergeUserFunctions(cfg.resourceFunctions, userFunctions); err != nil {
+		return nil, err
+	}
+	if c.dataPointFunctions, err = ottl.MergeUserFunctions(cfg.dataPointFunctions, userFunctions); err != nil {
+		return nil, err
+	}
+	if c.logFunctions, err = ottl.MergeUserFunctions(cfg.logFunctions, userFunctions); err != nil {
+		return nil, err
+	}
+	if c.metricFunctions, err = ottl.MergeUserFunctions(cfg.metricFunctions, userFunctions); err != nil {
+		return nil, err
+	}
+	if c.spanEventFunctions, err = ottl.MergeUserFunctions(cfg.spanEventFunctions, userFunctions); err != nil {
+		return nil, err
+	}
+	if c.spanLinkFunctions, err = ottl.MergeUserFunctions(cfg.spanLinkFunctions, userFunctions); err != nil {
+		return nil, err
+	}
+	if c.spanFunctions, err = ottl.MergeUserFunctions(cfg.spanFunctions, userFunctions); err != nil {
+		return nil, err
+	}
+	if c.profileFunctions, err = ottl.MergeUserFunctions(cfg.profileFunctions, userFunctions); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// userFunctionsProcessor creates the signal processor with the user-defined functions of the configuration.
+// When function libraries are configured, the signal processor is only created once the processor starts,
+// since the libraries are extensions of the host.
+type userFunctionsProcessor[P any] struct {
+	cfg          *Config
+	newProcessor func(cfg *Config) (P, error)
+	processor    P
+}
+
+func newUserFunctionsProcessor[P any](cfg *Config, newProcessor func(cfg *Config) (P, error)) (*userFunctionsProcessor[P], error) {
+	p := &userFunctionsProcessor[P]{cfg: cfg, newProcessor: newProcessor}
+	if len(cfg.FunctionLibraries) > 0 {
+		return p, nil
+	}
+	return p, p.create(cfg.Functions)
+}
+
+func (p *userFunctionsProcessor[P]) create(userFunctions []ottl.UserFunction) error {
+	cfg, err := p.cfg.withUserFunctions(userFunctions)
+	if err != nil {
+		return err
+	}
+	p.processor, err = p.newProcessor(cfg)
+	return err
+}
+
+func (p *userFunctionsProcessor[P]) start(_ context.Context, host component.Host) error {
+	if len(p.cfg.FunctionLibraries) == 0 {
+		return nil
+	}
+	libraryFunctions, err := ottl.GetUserFunctionLibraries(host, p.cfg.FunctionLibraries)
+	if err != nil {
+		return err
+	}
+	return p.create(append(slices.Clone(p.cfg.Functions), libraryFunctions...))
+}
@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filterprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/confmap/xconfmap"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor/processortest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/filterprocessor/internal/metadata"
+)
+
+type testFunctionLibrary struct {
+	component.StartFunc
+	component.ShutdownFunc
+	functions []ottl.UserFunction
+}
+
+func (l testFunctionLibrary) UserFunctions() []ottl.UserFunction {
+	return l.functions
+}
+
+type testLibraryHost struct {
+	component.Host
+	extensions map[component.ID]component.Component
+}
+
+func (h testLibraryHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+func TestUserFunctions(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.Functions = []ottl.UserFunction{
+		{
+			Signature:  "IsHealthCheck(path string)",
+			Expression: `IsMatch(path, "^/(healthz|readyz)$")`,
+		},
+	}
+	cfg.Logs.LogConditions = []string{`IsHealthCheck(attributes["url.path"])`}
+	require.NoError(t, xconfmap.Validate(cfg))
+
+	sink := new(consumertest.LogsSink)
+	lp, err := factory.CreateLogs(t.Context(), processortest.NewNopSettings(metadata.Type), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, lp.Start(t.Context(), componenttest.NewNopHost()))
+
+	ld := plog.NewLogs()
+	logs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	logs.AppendEmpty().Attributes().PutStr("url.path", "/healthz")
+	logs.AppendEmpty().Attributes().PutStr("url.path", "/users")
+	require.NoError(t, lp.ConsumeLogs(t.Context(), ld))
+	require.NoError(t, lp.Shutdown(t.Context()))
+
+	require.Len(t, sink.AllLogs(), 1)
+	got := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 1, got.Len())
+	assert.Equal(t, map[string]any{"url.path": "/users"}, got.At(0).Attributes().AsRaw())
+}
+
+func TestFunctionLibraries(t *testing.T) {
+	libraryID := component.MustNewIDWithName("ottl_functions", "http")
+	host := testLibraryHost{extensions: map[component.ID]component.Component{
+		libraryID: testFunctionLibrary{functions: []ottl.UserFunction{
+			{
+				Signature:  "IsPreflight()",
+				Expression: `IsMatch(attributes["http.request.method"], "^OPTIONS$")`,
+			},
+		}},
+	}}
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.FunctionLibraries = []ottl.UserFunctionLibraryConfig{{ID: libraryID, Functions: []string{"IsPreflight"}}}
+	cfg.Traces.SpanConditions = []string{`IsPreflight()`}
+	// the conditions are validated with the declared functions of the libraries
+	require.NoError(t, xconfmap.Validate(cfg))
+
+	sink := new(consumertest.TracesSink)
+	tp, err := factory.CreateTraces(t.Context(), processortest.NewNopSettings(metadata.Type), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, tp.Start(t.Context(), host))
+
+	td := ptrace.NewTraces()
+	spans := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+	spans.AppendEmpty().Attributes().PutStr("http.request.method", "OPTIONS")
+	spans.AppendEmpty().Attributes().PutStr("http.request.method", "GET")
+	require.NoError(t, tp.ConsumeTraces(t.Context(), td))
+	require.NoError(t, tp.Shutdown(t.Context()))
+
+	require.Len(t, sink.AllTraces(), 1)
+	got := sink.AllTraces()[0].ResourceSpans().At(0).ScopeSpans().At(0).Spans()
+	require.Equal(t, 1, got.Len())
+	assert.Equal(t, map[string]any{"http.request.method": "GET"}, got.At(0).Attributes().AsRaw())
+
+	tp, err = factory.CreateTraces(t.Context(), processortest.NewNopSettings(metadata.Type), cfg, sink)
+	require.NoError(t, err)
+	assert.ErrorContains(t, tp.Start(t.Context(), componenttest.NewNopHost()), `function library "ottl_functions/http" not found`)
+
+	cfg.Traces.SpanConditions = []string{`IsMissing()`}
+	tp, err = factory.CreateTraces(t.Context(), processortest.NewNopSettings(metadata.Type), cfg, sink)
+	require.NoError(t, err)
+	assert.Error(t, tp.Start(t.Context(), host))
+}
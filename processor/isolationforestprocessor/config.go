@@ -8,6 +8,7 @@ package isolationforestprocessor // import "github.com/open-telemetry/openteleme
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -31,6 +32,22 @@ type Config struct {
 
 	// Adaptive window sizing configuration
 	AdaptiveWindow *AdaptiveWindowConfig `mapstructure:"adaptive_window"`
+
+	// Models created on the fly for each distinct combination of resource attribute values
+	DynamicModels DynamicModelsConfig `mapstructure:"dynamic_models"`
+
+	// Attribute naming the feature contributing most to the anomaly score, disabled when empty
+	ExplanationAttribute string `mapstructure:"explanation_attribute"`
+
+	// Storage extension used to persist model snapshots across restarts, disabled when unset
+	Storage *component.ID `mapstructure:"storage"`
+}
+
+// DynamicModelsConfig configures one model per distinct combination of the values of the key
+// resource attributes, e.g. one model per service or per tenant.
+type DynamicModelsConfig struct {
+	KeyAttributes []string `mapstructure:"key_attributes"` // Resource attributes identifying a model
+	MaxModels     int      `mapstructure:"max_models"`     // Least recently used models are evicted beyond this count
 }
 
 // AdaptiveWindowConfig configures automatic window size adjustment based on traffic patterns
@@ -107,6 +124,11 @@ func createDefaultConfig() component.Config {
 			VelocityThreshold:      50,     // Default growth threshold
 			StabilityCheckInterval: "5m",   // Check model stability every 5 minutes
 		},
+
+		// Dynamic models are disabled until key attributes are configured
+		DynamicModels: DynamicModelsConfig{
+			MaxModels: 100,
+		},
 	}
 }
 
@@ -140,6 +162,10 @@ func (cfg *Config) Validate() error {
 	if cfg.ScoreAttribute == cfg.ClassificationAttribute {
 		return errors.New("score_attribute and classification_attribute must be different")
 	}
+	if cfg.ExplanationAttribute != "" &&
+		(cfg.ExplanationAttribute == cfg.ScoreAttribute || cfg.ExplanationAttribute == cfg.ClassificationAttribute) {
+		return errors.New("explanation_attribute must be different from score_attribute and classification_attribute")
+	}
 
 	// Require at least one feature type configured
 	if len(cfg.Features.Traces) == 0 && len(cfg.Features.Metrics) == 0 && len(cfg.Features.Logs) == 0 {
@@ -153,6 +179,17 @@ func (cfg *Config) Validate() error {
 		}
 	}
 
+	if cfg.IsDynamicModelsEnabled() {
+		if cfg.DynamicModels.MaxModels <= 0 {
+			return errors.New("dynamic_models.max_models must be positive")
+		}
+		for _, key := range cfg.DynamicModels.KeyAttributes {
+			if key == "" {
+				return errors.New("dynamic_models.key_attributes must not contain empty attribute names")
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -226,6 +263,34 @@ func (cfg *Config) IsMultiModelMode() bool {
 	return len(cfg.Models) > 0
 }
 
+// IsDynamicModelsEnabled returns true if models are created on the fly from key attributes
+func (cfg *Config) IsDynamicModelsEnabled() bool {
+	return len(cfg.DynamicModels.KeyAttributes) > 0
+}
+
+// GetDynamicModelName returns the name of the dynamic model identified by the values of the key
+// attributes, e.g. "service.name=checkout", and false if none of the key attributes is present.
+func (cfg *Config) GetDynamicModelName(resourceAttrs map[string]any) (string, bool) {
+	if !cfg.IsDynamicModelsEnabled() {
+		return "", false
+	}
+	parts := make([]string, 0, len(cfg.DynamicModels.KeyAttributes))
+	found := false
+	for _, key := range cfg.DynamicModels.KeyAttributes {
+		value, exists := resourceAttrs[key]
+		if exists {
+			found = true
+			parts = append(parts, fmt.Sprintf("%s=%v", key, value))
+		} else {
+			parts = append(parts, key+"=")
+		}
+	}
+	if !found {
+		return "", false
+	}
+	return strings.Join(parts, ","), true
+}
+
 func (cfg *Config) GetModelForAttributes(attributes map[string]any) *ModelConfig {
 	if !cfg.IsMultiModelMode() {
 		return nil
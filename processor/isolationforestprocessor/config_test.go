@@ -226,6 +226,40 @@ func TestConfigurationValidation(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name:         "explanation attribute",
+			modifyConfig: func(cfg *Config) { cfg.ExplanationAttribute = "anomaly.explanation" },
+			expectError:  false,
+		},
+		{
+			name:          "explanation attribute same as score attribute",
+			modifyConfig:  func(cfg *Config) { cfg.ExplanationAttribute = cfg.ScoreAttribute },
+			expectError:   true,
+			errorContains: "explanation_attribute must be different from score_attribute and classification_attribute",
+		},
+		{
+			name: "dynamic models",
+			modifyConfig: func(cfg *Config) {
+				cfg.DynamicModels = DynamicModelsConfig{KeyAttributes: []string{"service.name"}, MaxModels: 10}
+			},
+			expectError: false,
+		},
+		{
+			name: "dynamic models without max models",
+			modifyConfig: func(cfg *Config) {
+				cfg.DynamicModels = DynamicModelsConfig{KeyAttributes: []string{"service.name"}}
+			},
+			expectError:   true,
+			errorContains: "dynamic_models.max_models must be positive",
+		},
+		{
+			name: "dynamic models with empty key attribute",
+			modifyConfig: func(cfg *Config) {
+				cfg.DynamicModels = DynamicModelsConfig{KeyAttributes: []string{""}, MaxModels: 10}
+			},
+			expectError:   true,
+			errorContains: "dynamic_models.key_attributes must not contain empty attribute names",
+		},
 		{
 			name: "features with only logs",
 			modifyConfig: func(cfg *Config) {
@@ -714,6 +748,32 @@ func TestEmptyModelsSlice(t *testing.T) {
 	assert.Nil(t, selectedModel, "Should return nil when models slice is empty")
 }
 
+func TestDynamicModelName(t *testing.T) {
+	raw := createDefaultConfig()
+	cfg, ok := raw.(*Config)
+	require.True(t, ok, "createDefaultConfig should return *Config")
+
+	// Dynamic models are disabled by default
+	assert.False(t, cfg.IsDynamicModelsEnabled())
+	assert.Equal(t, 100, cfg.DynamicModels.MaxModels)
+	_, ok = cfg.GetDynamicModelName(map[string]any{"service.name": "checkout"})
+	assert.False(t, ok, "Should not name a dynamic model when disabled")
+
+	cfg.DynamicModels.KeyAttributes = []string{"tenant.id", "service.name"}
+	assert.True(t, cfg.IsDynamicModelsEnabled())
+
+	name, ok := cfg.GetDynamicModelName(map[string]any{"service.name": "checkout", "tenant.id": int64(42)})
+	assert.True(t, ok)
+	assert.Equal(t, "tenant.id=42,service.name=checkout", name)
+
+	name, ok = cfg.GetDynamicModelName(map[string]any{"service.name": "checkout"})
+	assert.True(t, ok)
+	assert.Equal(t, "tenant.id=,service.name=checkout", name, "Missing key attributes should have empty values")
+
+	_, ok = cfg.GetDynamicModelName(map[string]any{"host.name": "a"})
+	assert.False(t, ok, "Should not name a dynamic model without any key attribute")
+}
+
 func TestAttributeTypeHandling(t *testing.T) {
 	raw := createDefaultConfig()
 	cfg, ok := raw.(*Config)
@@ -14,6 +14,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pipeline"
 	"go.opentelemetry.io/collector/processor"
 	"go.uber.org/zap"
 )
@@ -59,6 +60,8 @@ func createTracesProcessor(
 	if err != nil {
 		return nil, fmt.Errorf("failed to create processor: %w", err)
 	}
+	proc.componentID = set.ID
+	proc.storageName = pipeline.SignalTraces.String()
 
 	return &tracesProcessor{
 		isolationForestProcessor: proc,
@@ -92,6 +95,8 @@ func createMetricsProcessor(
 	if err != nil {
 		return nil, fmt.Errorf("failed to create processor: %w", err)
 	}
+	proc.componentID = set.ID
+	proc.storageName = pipeline.SignalMetrics.String()
 
 	return &metricsProcessor{
 		isolationForestProcessor: proc,
@@ -125,6 +130,8 @@ func createLogsProcessor(
 	if err != nil {
 		return nil, fmt.Errorf("failed to create processor: %w", err)
 	}
+	proc.componentID = set.ID
+	proc.storageName = pipeline.SignalLogs.String()
 
 	return &logsProcessor{
 		isolationForestProcessor: proc,
@@ -267,6 +267,39 @@ func (oif *onlineIsolationForest) calculateAnomalyScore(sample []float64) float6
 	return anomalyScore
 }
 
+// topContributingFeature returns the index of the feature contributing most to the anomaly score
+// of a sample. Every split on the sample's path through each tree credits its feature with the
+// inverse of its depth, as splits close to the root isolate the sample with the fewest steps.
+// It returns false if the sample does not go through any split yet.
+func (oif *onlineIsolationForest) topContributingFeature(sample []float64) (int, bool) {
+	oif.treesMutex.RLock()
+	defer oif.treesMutex.RUnlock()
+
+	contributions := make([]float64, len(sample))
+	for _, tree := range oif.trees {
+		node := tree.root
+		for node != nil && !node.isLeaf && node.left != nil && node.right != nil {
+			if node.featureIndex >= len(sample) {
+				break
+			}
+			contributions[node.featureIndex] += 1.0 / float64(node.depth+1)
+			if sample[node.featureIndex] < node.splitValue {
+				node = node.left
+			} else {
+				node = node.right
+			}
+		}
+	}
+
+	topIndex := -1
+	for i, contribution := range contributions {
+		if contribution > 0 && (topIndex < 0 || contribution > contributions[topIndex]) {
+			topIndex = i
+		}
+	}
+	return topIndex, topIndex >= 0
+}
+
 // updateForest incrementally updates the forest with a new sample.
 func (oif *onlineIsolationForest) updateForest(sample []float64, anomalyScore float64) {
 	// Add sample to sliding window
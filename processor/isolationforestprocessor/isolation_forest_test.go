@@ -415,6 +415,49 @@ func TestTreePathLength(t *testing.T) {
 	assert.Positive(t, rightPath, "Right path should have positive length")
 }
 
+func TestTopContributingFeature(t *testing.T) {
+	forest := newOnlineIsolationForest(2, 16, 4)
+
+	// Trees without splits cannot explain a score
+	_, ok := forest.topContributingFeature([]float64{1.0, 2.0})
+	assert.False(t, ok, "Forest without splits should not explain scores")
+
+	// The first tree splits on feature 1 at the root, then on feature 0 on the left side
+	forest.trees[0].root = &onlineTreeNode{
+		featureIndex: 1,
+		splitValue:   1.5,
+		left: &onlineTreeNode{
+			featureIndex: 0,
+			splitValue:   1.5,
+			depth:        1,
+			left:         &onlineTreeNode{depth: 2, isLeaf: true},
+			right:        &onlineTreeNode{depth: 2, isLeaf: true},
+		},
+		right: &onlineTreeNode{depth: 1, isLeaf: true},
+	}
+	forest.trees[1].root = &onlineTreeNode{isLeaf: true}
+
+	// Splits closer to the root contribute more
+	index, ok := forest.topContributingFeature([]float64{1.0, 1.0})
+	assert.True(t, ok)
+	assert.Equal(t, 1, index, "Root split feature should contribute most")
+
+	index, ok = forest.topContributingFeature([]float64{1.0, 2.0})
+	assert.True(t, ok)
+	assert.Equal(t, 1, index, "Only the root split is on the path of the sample")
+
+	// A second tree splitting on feature 0 at the root outweighs the first one
+	forest.trees[1].root = &onlineTreeNode{
+		featureIndex: 0,
+		splitValue:   0.5,
+		left:         &onlineTreeNode{depth: 1, isLeaf: true},
+		right:        &onlineTreeNode{depth: 1, isLeaf: true},
+	}
+	index, ok = forest.topContributingFeature([]float64{1.0, 1.0})
+	assert.True(t, ok)
+	assert.Equal(t, 0, index, "Feature 0 should contribute most across both trees")
+}
+
 func TestExpectedPathLength(t *testing.T) {
 	forest := newOnlineIsolationForest(10, 100, 6)
 
@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// model_cache.go - LRU cache of the models created on the fly from key attributes
+package isolationforestprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/isolationforestprocessor"
+
+import (
+	"container/list"
+	"sync"
+)
+
+// dynamicModelCache holds the models created for each distinct combination of key attribute values.
+// Once maxModels is reached, the least recently used model is evicted to make room for a new one.
+type dynamicModelCache struct {
+	maxModels int
+	newForest func() *onlineIsolationForest
+
+	models map[string]*list.Element // Model name to its element in order
+	order  *list.List               // Models from most to least recently used
+	mutex  sync.Mutex
+}
+
+// dynamicModel is a named model stored in the cache.
+type dynamicModel struct {
+	name   string
+	forest *onlineIsolationForest
+}
+
+func newDynamicModelCache(maxModels int, newForest func() *onlineIsolationForest) *dynamicModelCache {
+	return &dynamicModelCache{
+		maxModels: maxModels,
+		newForest: newForest,
+		models:    make(map[string]*list.Element),
+		order:     list.New(),
+	}
+}
+
+// getOrCreate returns the model with the given name, creating it if needed. It also returns the
+// name of the model evicted to make room for the new one, if any.
+func (c *dynamicModelCache) getOrCreate(name string) (forest *onlineIsolationForest, evicted string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if elem, exists := c.models[name]; exists {
+		c.order.MoveToFront(elem)
+		return elem.Value.(*dynamicModel).forest, ""
+	}
+	forest = c.newForest()
+	return forest, c.add(name, forest)
+}
+
+// put stores a model under the given name as the most recently used one, replacing any existing
+// model with that name. It returns the name of the evicted model, if any.
+func (c *dynamicModelCache) put(name string, forest *onlineIsolationForest) string {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if elem, exists := c.models[name]; exists {
+		elem.Value.(*dynamicModel).forest = forest
+		c.order.MoveToFront(elem)
+		return ""
+	}
+	return c.add(name, forest)
+}
+
+// add inserts a new model, evicting the least recently used one if the cache is full.
+func (c *dynamicModelCache) add(name string, forest *onlineIsolationForest) string {
+	var evicted string
+	if c.order.Len() >= c.maxModels {
+		if oldest := c.order.Back(); oldest != nil {
+			evicted = c.order.Remove(oldest).(*dynamicModel).name
+			delete(c.models, evicted)
+		}
+	}
+	c.models[name] = c.order.PushFront(&dynamicModel{name: name, forest: forest})
+	return evicted
+}
+
+// entries returns the cached models from the least to the most recently used, so that putting them
+// back in that order restores the same recency.
+func (c *dynamicModelCache) entries() []dynamicModel {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	models := make([]dynamicModel, 0, c.order.Len())
+	for elem := c.order.Back(); elem != nil; elem = elem.Prev() {
+		models = append(models, *elem.Value.(*dynamicModel))
+	}
+	return models
+}
+
+// size returns the number of cached models.
+func (c *dynamicModelCache) size() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.order.Len()
+}
@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// model_cache_test.go - Tests for the LRU cache of dynamic models
+package isolationforestprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func modelNames(models []dynamicModel) []string {
+	names := make([]string, len(models))
+	for i, m := range models {
+		names[i] = m.name
+	}
+	return names
+}
+
+func TestDynamicModelCache_GetOrCreate(t *testing.T) {
+	created := 0
+	cache := newDynamicModelCache(2, func() *onlineIsolationForest {
+		created++
+		return newOnlineIsolationForest(5, 16, 0)
+	})
+
+	a, evicted := cache.getOrCreate("a")
+	assert.Empty(t, evicted)
+	again, evicted := cache.getOrCreate("a")
+	assert.Empty(t, evicted)
+	assert.Same(t, a, again, "existing models should be reused")
+	assert.Equal(t, 1, created)
+
+	_, evicted = cache.getOrCreate("b")
+	assert.Empty(t, evicted)
+	assert.Equal(t, 2, cache.size())
+
+	// "a" was used after "b" was created, so "b" is the least recently used model
+	cache.getOrCreate("a")
+	_, evicted = cache.getOrCreate("c")
+	assert.Equal(t, "b", evicted)
+	assert.Equal(t, 2, cache.size())
+	assert.Equal(t, []string{"a", "c"}, modelNames(cache.entries()))
+}
+
+func TestDynamicModelCache_Put(t *testing.T) {
+	cache := newDynamicModelCache(2, func() *onlineIsolationForest {
+		return newOnlineIsolationForest(5, 16, 0)
+	})
+
+	first := newOnlineIsolationForest(5, 16, 0)
+	assert.Empty(t, cache.put("a", first))
+	assert.Empty(t, cache.put("b", newOnlineIsolationForest(5, 16, 0)))
+
+	// Replacing a model makes it the most recently used one
+	replacement := newOnlineIsolationForest(5, 16, 0)
+	assert.Empty(t, cache.put("a", replacement))
+	forest, _ := cache.getOrCreate("a")
+	assert.Same(t, replacement, forest)
+
+	assert.Equal(t, "b", cache.put("c", newOnlineIsolationForest(5, 16, 0)))
+	assert.Equal(t, []string{"a", "c"}, modelNames(cache.entries()))
+}
@@ -0,0 +1,387 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// persistence.go - Model snapshots saved to and restored from a storage extension
+package isolationforestprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/isolationforestprocessor"
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"fmt"
+	"reflect"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
+	"go.uber.org/zap"
+)
+
+const (
+	// snapshotVersion is bumped whenever the snapshot format changes incompatibly
+	snapshotVersion = 1
+
+	// modelIndexKey is the storage key of the list of persisted models
+	modelIndexKey = "models"
+)
+
+// modelIndex lists the persisted models. Snapshots taken with different features are discarded,
+// as the trees split on feature indexes that would no longer match.
+type modelIndex struct {
+	Version  int
+	Features FeatureConfig
+	Models   []persistedModel // Dynamic models are listed from the least to the most recently used
+}
+
+// persistedModel identifies a persisted model.
+type persistedModel struct {
+	Name    string
+	Dynamic bool
+}
+
+// key returns the storage key of the model snapshot.
+func (m persistedModel) key() string {
+	if m.Dynamic {
+		return "dynamic/" + m.Name
+	}
+	return "model/" + m.Name
+}
+
+// forestSnapshot is the serializable state of an online isolation forest.
+type forestSnapshot struct {
+	NumTrees     int
+	MaxDepth     int
+	Trees        []treeSnapshot
+	DataWindow   [][]float64
+	WindowIndex  int
+	WindowFull   bool
+	ScoreHistory []float64
+	Threshold    float64
+	TotalSamples uint64
+	AnomalyCount uint64
+}
+
+// treeSnapshot is the serializable state of an online isolation tree.
+type treeSnapshot struct {
+	Root        *nodeSnapshot
+	SampleCount int
+	UpdateCount int
+}
+
+// nodeSnapshot is the serializable state of a tree node and its children.
+type nodeSnapshot struct {
+	FeatureIndex   int
+	SplitValue     float64
+	SampleCount    int
+	Depth          int
+	IsLeaf         bool
+	IsolationScore float64
+	Left           *nodeSnapshot
+	Right          *nodeSnapshot
+}
+
+// getStorageClient returns a client of the configured storage extension, or nil if persistence is disabled.
+func getStorageClient(ctx context.Context, host component.Host, storageID *component.ID, componentID component.ID, storageName string) (storage.Client, error) {
+	if storageID == nil {
+		return nil, nil
+	}
+
+	extension, ok := host.GetExtensions()[*storageID]
+	if !ok {
+		return nil, fmt.Errorf("storage extension '%s' not found", storageID)
+	}
+
+	storageExtension, ok := extension.(storage.Extension)
+	if !ok {
+		return nil, fmt.Errorf("non-storage extension '%s' found", storageID)
+	}
+
+	return storageExtension.GetClient(ctx, component.KindProcessor, componentID, storageName)
+}
+
+// snapshot captures the current state of the forest.
+func (oif *onlineIsolationForest) snapshot() forestSnapshot {
+	s := forestSnapshot{
+		NumTrees: oif.numTrees,
+		MaxDepth: oif.maxDepth,
+	}
+
+	oif.treesMutex.RLock()
+	s.Trees = make([]treeSnapshot, len(oif.trees))
+	for i, tree := range oif.trees {
+		s.Trees[i] = treeSnapshot{
+			Root:        snapshotNode(tree.root),
+			SampleCount: tree.sampleCount,
+			UpdateCount: tree.updateCount,
+		}
+	}
+	oif.treesMutex.RUnlock()
+
+	oif.windowMutex.RLock()
+	s.DataWindow = make([][]float64, len(oif.dataWindow))
+	for i, sample := range oif.dataWindow {
+		if sample != nil {
+			s.DataWindow[i] = append([]float64(nil), sample...)
+		}
+	}
+	s.WindowIndex = oif.windowIndex
+	s.WindowFull = oif.windowFull
+	oif.windowMutex.RUnlock()
+
+	oif.thresholdMutex.RLock()
+	s.ScoreHistory = append([]float64(nil), oif.scoreHistory...)
+	s.Threshold = oif.threshold
+	oif.thresholdMutex.RUnlock()
+
+	oif.statsMutex.RLock()
+	s.TotalSamples = oif.totalSamples
+	s.AnomalyCount = oif.anomalyCount
+	oif.statsMutex.RUnlock()
+	return s
+}
+
+// restore replaces the state of the forest with a snapshot taken from a forest of the same size.
+func (oif *onlineIsolationForest) restore(s forestSnapshot) error {
+	if s.NumTrees != oif.numTrees || len(s.Trees) != oif.numTrees {
+		return fmt.Errorf("snapshot has %d trees, expected %d", len(s.Trees), oif.numTrees)
+	}
+	if len(s.DataWindow) == 0 || s.WindowIndex < 0 || s.WindowIndex >= len(s.DataWindow) {
+		return fmt.Errorf("snapshot has an invalid window index %d for a window of %d samples", s.WindowIndex, len(s.DataWindow))
+	}
+
+	now := time.Now()
+	oif.treesMutex.Lock()
+	oif.maxDepth = s.MaxDepth
+	for i, tree := range s.Trees {
+		oif.trees[i] = &onlineIsolationTree{
+			root:           restoreNode(tree.Root),
+			maxDepth:       s.MaxDepth,
+			sampleCount:    tree.SampleCount,
+			updateCount:    tree.UpdateCount,
+			lastUpdateTime: now,
+		}
+	}
+	oif.treesMutex.Unlock()
+
+	oif.thresholdMutex.Lock()
+	oif.scoreHistory = s.ScoreHistory
+	oif.threshold = s.Threshold
+	oif.thresholdMutex.Unlock()
+
+	oif.statsMutex.Lock()
+	oif.totalSamples = s.TotalSamples
+	oif.anomalyCount = s.AnomalyCount
+	oif.statsMutex.Unlock()
+
+	// The window size may have changed since the snapshot, keep the most recent samples that fit
+	windowSize := oif.windowSize
+	if oif.adaptiveConfig != nil && oif.adaptiveConfig.Enabled {
+		windowSize = min(max(len(s.DataWindow), oif.adaptiveConfig.MinWindowSize), oif.adaptiveConfig.MaxWindowSize)
+		oif.adaptiveMutex.Lock()
+		oif.currentWindowSize = windowSize
+		oif.adaptiveMutex.Unlock()
+	}
+
+	oif.windowMutex.Lock()
+	defer oif.windowMutex.Unlock()
+	oif.dataWindow = s.DataWindow
+	for i, sample := range oif.dataWindow {
+		if len(sample) == 0 {
+			oif.dataWindow[i] = nil
+		}
+	}
+	oif.windowIndex = s.WindowIndex
+	oif.windowFull = s.WindowFull
+	oif.resizeDataWindow(windowSize)
+	if oif.windowIndex >= len(oif.dataWindow) {
+		oif.windowIndex = 0
+		oif.windowFull = true
+	}
+	return nil
+}
+
+func snapshotNode(node *onlineTreeNode) *nodeSnapshot {
+	if node == nil {
+		return nil
+	}
+	return &nodeSnapshot{
+		FeatureIndex:   node.featureIndex,
+		SplitValue:     node.splitValue,
+		SampleCount:    node.sampleCount,
+		Depth:          node.depth,
+		IsLeaf:         node.isLeaf,
+		IsolationScore: node.isolationScore,
+		Left:           snapshotNode(node.left),
+		Right:          snapshotNode(node.right),
+	}
+}
+
+func restoreNode(node *nodeSnapshot) *onlineTreeNode {
+	if node == nil {
+		return nil
+	}
+	return &onlineTreeNode{
+		featureIndex:   node.FeatureIndex,
+		splitValue:     node.SplitValue,
+		sampleCount:    node.SampleCount,
+		depth:          node.Depth,
+		isLeaf:         node.IsLeaf,
+		isolationScore: node.IsolationScore,
+		left:           restoreNode(node.Left),
+		right:          restoreNode(node.Right),
+	}
+}
+
+// encodeSnapshot serializes a value with gob, which unlike JSON supports NaN and infinite values.
+func encodeSnapshot(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeSnapshot(data []byte, v any) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+// saveModels persists a snapshot of every model and deletes the snapshots of evicted models.
+func (p *isolationForestProcessor) saveModels(ctx context.Context) error {
+	if p.storageClient == nil {
+		return nil
+	}
+
+	type model struct {
+		persistedModel
+		forest *onlineIsolationForest
+	}
+	var models []model
+	p.forestsMutex.RLock()
+	if p.defaultForest != nil {
+		models = append(models, model{persistedModel{Name: "default"}, p.defaultForest})
+	}
+	for name, forest := range p.modelForests {
+		models = append(models, model{persistedModel{Name: name}, forest})
+	}
+	p.forestsMutex.RUnlock()
+	if p.dynamicModels != nil {
+		for _, m := range p.dynamicModels.entries() {
+			models = append(models, model{persistedModel{Name: m.name, Dynamic: true}, m.forest})
+		}
+	}
+
+	index := modelIndex{
+		Version:  snapshotVersion,
+		Features: p.config.Features,
+		Models:   make([]persistedModel, 0, len(models)),
+	}
+	ops := make([]*storage.Operation, 0, len(models)+len(p.persistedKeys)+1)
+	keys := make(map[string]struct{}, len(models))
+	for _, m := range models {
+		data, err := encodeSnapshot(m.forest.snapshot())
+		if err != nil {
+			return fmt.Errorf("failed to encode model %q: %w", m.Name, err)
+		}
+		index.Models = append(index.Models, m.persistedModel)
+		keys[m.key()] = struct{}{}
+		ops = append(ops, storage.SetOperation(m.key(), data))
+	}
+	for key := range p.persistedKeys {
+		if _, exists := keys[key]; !exists {
+			ops = append(ops, storage.DeleteOperation(key))
+		}
+	}
+	data, err := encodeSnapshot(index)
+	if err != nil {
+		return fmt.Errorf("failed to encode model index: %w", err)
+	}
+	ops = append(ops, storage.SetOperation(modelIndexKey, data))
+
+	if err := p.storageClient.Batch(ctx, ops...); err != nil {
+		return fmt.Errorf("failed to save models: %w", err)
+	}
+	p.persistedKeys = keys
+	p.logger.Debug("Saved model snapshots", zap.Int("models", len(models)))
+	return nil
+}
+
+// restoreModels restores the persisted models matching the current configuration. Models that can
+// no longer be used, e.g. because their forest size changed, are skipped and start cold.
+func (p *isolationForestProcessor) restoreModels(ctx context.Context) error {
+	if p.storageClient == nil {
+		return nil
+	}
+
+	data, err := p.storageClient.Get(ctx, modelIndexKey)
+	if err != nil {
+		return fmt.Errorf("failed to read model index: %w", err)
+	}
+	if data == nil {
+		return nil
+	}
+	var index modelIndex
+	if err = decodeSnapshot(data, &index); err != nil {
+		return fmt.Errorf("failed to decode model index: %w", err)
+	}
+	p.persistedKeys = make(map[string]struct{}, len(index.Models))
+	for _, m := range index.Models {
+		p.persistedKeys[m.key()] = struct{}{}
+	}
+	if index.Version != snapshotVersion || !reflect.DeepEqual(index.Features, p.config.Features) {
+		p.logger.Info("Discarding model snapshots taken with a different version or features")
+		return nil
+	}
+
+	ops := make([]*storage.Operation, len(index.Models))
+	for i, m := range index.Models {
+		ops[i] = storage.GetOperation(m.key())
+	}
+	if err = p.storageClient.Batch(ctx, ops...); err != nil {
+		return fmt.Errorf("failed to read models: %w", err)
+	}
+
+	restored := 0
+	for i, m := range index.Models {
+		if ops[i].Value == nil {
+			continue
+		}
+		var s forestSnapshot
+		if err = decodeSnapshot(ops[i].Value, &s); err != nil {
+			p.logger.Warn("Failed to decode model snapshot", zap.String("model_name", m.Name), zap.Error(err))
+			continue
+		}
+
+		forest := p.restoreTarget(m)
+		if forest == nil {
+			continue
+		}
+		if err = forest.restore(s); err != nil {
+			p.logger.Warn("Skipping incompatible model snapshot", zap.String("model_name", m.Name), zap.Error(err))
+			continue
+		}
+		if m.Dynamic {
+			p.dynamicModels.put(m.Name, forest)
+		}
+		restored++
+	}
+	p.logger.Info("Restored model snapshots", zap.Int("models", restored))
+	return nil
+}
+
+// restoreTarget returns the forest a persisted model should be restored into, or nil if the model
+// is no longer configured.
+func (p *isolationForestProcessor) restoreTarget(m persistedModel) *onlineIsolationForest {
+	if m.Dynamic {
+		if p.dynamicModels == nil {
+			return nil
+		}
+		return p.newForest(p.config.ForestSize)
+	}
+
+	p.forestsMutex.RLock()
+	defer p.forestsMutex.RUnlock()
+	if m.Name == "default" && p.defaultForest != nil {
+		return p.defaultForest
+	}
+	return p.modelForests[m.Name]
+}
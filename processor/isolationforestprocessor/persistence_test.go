@@ -0,0 +1,213 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// persistence_test.go - Tests for model snapshots saved to and restored from storage
+package isolationforestprocessor
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pipeline"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+)
+
+// makeServiceTraces creates a span for each service, or a span without service for an empty name.
+func makeServiceTraces(services ...string) ptrace.Traces {
+	td := ptrace.NewTraces()
+	start := time.Now()
+	for i, service := range services {
+		rs := td.ResourceSpans().AppendEmpty()
+		if service != "" {
+			rs.Resource().Attributes().PutStr("service.name", service)
+		}
+		sp := rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+		sp.SetName("GET /checkout")
+		sp.SetStartTimestamp(pcommon.NewTimestampFromTime(start))
+		sp.SetEndTimestamp(pcommon.NewTimestampFromTime(start.Add(time.Duration(50+i%7) * time.Millisecond)))
+	}
+	return td
+}
+
+// startPersistentProcessor creates and starts a processor persisting its models to the storage of the host.
+func startPersistentProcessor(t *testing.T, cfg *Config, host component.Host) *isolationForestProcessor {
+	p, err := newIsolationForestProcessor(cfg, zaptest.NewLogger(t))
+	require.NoError(t, err)
+	p.componentID = component.MustNewID("isolationforest")
+	p.storageName = pipeline.SignalTraces.String()
+	require.NoError(t, p.Start(t.Context(), host))
+	return p
+}
+
+func persistentTestConfig(t *testing.T) *Config {
+	cfg := baseTestConfig(t)
+	storageID := storagetest.NewStorageID("test")
+	cfg.Storage = &storageID
+	cfg.Features.Traces = []string{"duration"}
+	require.NoError(t, cfg.Validate())
+	return cfg
+}
+
+func TestForestSnapshotRoundTrip(t *testing.T) {
+	forest := newOnlineIsolationForest(10, 64, 0)
+	for i := range 300 {
+		forest.ProcessSample([]float64{float64(i % 17), math.Sin(float64(i))})
+	}
+
+	data, err := encodeSnapshot(forest.snapshot())
+	require.NoError(t, err)
+	var s forestSnapshot
+	require.NoError(t, decodeSnapshot(data, &s))
+
+	restored := newOnlineIsolationForest(10, 64, 0)
+	require.NoError(t, restored.restore(s))
+
+	for _, sample := range [][]float64{{3, 0.5}, {100, -1}, {8, 0}} {
+		assert.Equal(t, forest.calculateAnomalyScore(sample), restored.calculateAnomalyScore(sample))
+	}
+	expected, actual := forest.GetStatistics(), restored.GetStatistics()
+	assert.Equal(t, expected.TotalSamples, actual.TotalSamples)
+	assert.Equal(t, expected.AnomalyCount, actual.AnomalyCount)
+	assert.Equal(t, expected.CurrentThreshold, actual.CurrentThreshold)
+	assert.Equal(t, expected.WindowUtilization, actual.WindowUtilization)
+}
+
+func TestForestSnapshotNaN(t *testing.T) {
+	forest := newOnlineIsolationForest(5, 16, 0)
+	forest.ProcessSample([]float64{math.NaN()})
+	forest.ProcessSample([]float64{math.Inf(1)})
+
+	data, err := encodeSnapshot(forest.snapshot())
+	require.NoError(t, err)
+	var s forestSnapshot
+	require.NoError(t, decodeSnapshot(data, &s))
+	assert.True(t, math.IsNaN(s.DataWindow[0][0]))
+	assert.True(t, math.IsInf(s.DataWindow[1][0], 1))
+}
+
+func TestForestRestoreIncompatibleSnapshot(t *testing.T) {
+	forest := newOnlineIsolationForest(10, 64, 0)
+	forest.ProcessSample([]float64{1})
+
+	err := newOnlineIsolationForest(20, 64, 0).restore(forest.snapshot())
+	require.EqualError(t, err, "snapshot has 10 trees, expected 20")
+
+	// A smaller window keeps the most recent samples
+	restored := newOnlineIsolationForest(10, 16, 0)
+	require.NoError(t, restored.restore(forest.snapshot()))
+	assert.Len(t, restored.dataWindow, 16)
+	restored.ProcessSample([]float64{2})
+}
+
+func TestProcessorPersistsModelsAcrossRestarts(t *testing.T) {
+	cfg := persistentTestConfig(t)
+	cfg.DynamicModels = DynamicModelsConfig{
+		KeyAttributes: []string{"service.name"},
+		MaxModels:     2,
+	}
+	require.NoError(t, cfg.Validate())
+	dir := t.TempDir()
+	ctx := context.WithoutCancel(t.Context())
+
+	// First run: the default model and dynamic models for a and b learn from the spans
+	host := storagetest.NewStorageHost().WithFileBackedStorageExtension("test", dir)
+	p := startPersistentProcessor(t, cfg, host)
+	for range 20 {
+		_, err := p.processTraces(t.Context(), makeServiceTraces("a", "b", ""))
+		require.NoError(t, err)
+	}
+	defaultStats := p.defaultForest.GetStatistics()
+	require.Equal(t, uint64(20), defaultStats.TotalSamples)
+	require.NoError(t, p.Shutdown(ctx))
+
+	// Second run: the models are restored, then c evicts a, the least recently used model
+	host = storagetest.NewStorageHost().WithFileBackedStorageExtension("test", dir)
+	p = startPersistentProcessor(t, cfg, host)
+	assert.Equal(t, defaultStats.TotalSamples, p.defaultForest.GetStatistics().TotalSamples)
+	assert.Equal(t, defaultStats.CurrentThreshold, p.defaultForest.GetStatistics().CurrentThreshold)
+	require.Equal(t, []string{"service.name=a", "service.name=b"}, modelNames(p.dynamicModels.entries()))
+	for _, m := range p.dynamicModels.entries() {
+		assert.Equal(t, uint64(20), m.forest.GetStatistics().TotalSamples, "model %s", m.name)
+	}
+
+	td, err := p.processTraces(t.Context(), makeServiceTraces("b", "c"))
+	require.NoError(t, err)
+	modelName, ok := td.ResourceSpans().At(1).ScopeSpans().At(0).Spans().At(0).Attributes().Get("anomaly.model_name")
+	require.True(t, ok)
+	assert.Equal(t, "service.name=c", modelName.Str())
+	require.NoError(t, p.Shutdown(ctx))
+
+	// The snapshot of the evicted model is deleted
+	client := storagetest.NewFileBackedClient(component.KindProcessor, p.componentID, p.storageName, dir)
+	for key, persisted := range map[string]bool{
+		"model/default":          true,
+		"dynamic/service.name=a": false,
+		"dynamic/service.name=b": true,
+		"dynamic/service.name=c": true,
+	} {
+		data, err := client.Get(ctx, key)
+		require.NoError(t, err)
+		assert.Equal(t, persisted, data != nil, "key %s", key)
+	}
+	require.NoError(t, client.Close(ctx))
+}
+
+func TestProcessorDiscardsSnapshotsWithDifferentFeatures(t *testing.T) {
+	cfg := persistentTestConfig(t)
+	dir := t.TempDir()
+	ctx := context.WithoutCancel(t.Context())
+
+	p := startPersistentProcessor(t, cfg, storagetest.NewStorageHost().WithFileBackedStorageExtension("test", dir))
+	_, err := p.processTraces(t.Context(), makeServiceTraces("a"))
+	require.NoError(t, err)
+	require.NoError(t, p.Shutdown(ctx))
+
+	// The same features restore the model
+	p = startPersistentProcessor(t, cfg, storagetest.NewStorageHost().WithFileBackedStorageExtension("test", dir))
+	assert.Equal(t, uint64(1), p.defaultForest.GetStatistics().TotalSamples)
+	require.NoError(t, p.Shutdown(ctx))
+
+	// Other features start a cold model
+	cfg.Features.Traces = []string{"duration", "error"}
+	p = startPersistentProcessor(t, cfg, storagetest.NewStorageHost().WithFileBackedStorageExtension("test", dir))
+	assert.Equal(t, uint64(0), p.defaultForest.GetStatistics().TotalSamples)
+	require.NoError(t, p.Shutdown(ctx))
+}
+
+func TestProcessorStorageErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		host component.Host
+		err  string
+	}{
+		{
+			name: "missing extension",
+			host: storagetest.NewStorageHost(),
+			err:  "failed to get storage client: storage extension 'test_storage/test' not found",
+		},
+		{
+			name: "non storage extension",
+			host: storagetest.NewStorageHost().WithExtension(storagetest.NewStorageID("test"), storagetest.NewNonStorageExtension("test")),
+			err:  "failed to get storage client: non-storage extension 'test_storage/test' found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := newIsolationForestProcessor(persistentTestConfig(t), zaptest.NewLogger(t))
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				require.NoError(t, p.Shutdown(context.WithoutCancel(t.Context())))
+			})
+			assert.EqualError(t, p.Start(t.Context(), tt.host), tt.err)
+		})
+	}
+}
@@ -6,6 +6,7 @@ package isolationforestprocessor // import "github.com/open-telemetry/openteleme
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"hash/fnv"
 	"maps"
@@ -15,6 +16,7 @@ import (
 	"time"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -32,6 +34,7 @@ type isolationForestProcessor struct {
 	defaultForest *onlineIsolationForest            // Default model for single-model mode
 	modelForests  map[string]*onlineIsolationForest // Named models for multi-model mode
 	forestsMutex  sync.RWMutex                      // Protects forest access
+	dynamicModels *dynamicModelCache                // Models created on the fly from key attributes
 
 	// Feature extraction components
 	traceExtractor   *traceFeatureExtractor
@@ -48,6 +51,12 @@ type isolationForestProcessor struct {
 	updateTicker    *time.Ticker
 	stopChan        chan struct{}
 	shutdownWG      sync.WaitGroup
+
+	// Model persistence, set up in Start when a storage extension is configured
+	componentID   component.ID
+	storageName   string // Distinguishes the models of each signal sharing the component ID
+	storageClient storage.Client
+	persistedKeys map[string]struct{} // Storage keys of the last saved snapshots
 }
 
 // newIsolationForestProcessor creates a new processor instance with the specified configuration.
@@ -71,23 +80,7 @@ func newIsolationForestProcessor(config *Config, logger *zap.Logger) (*isolation
 	if config.IsMultiModelMode() {
 		// Create named models for multi-model configuration
 		for _, modelConfig := range config.Models {
-			// Use adaptive forest creation if adaptive window is enabled
-			var forest *onlineIsolationForest
-			if config.IsAdaptiveWindowEnabled() {
-				forest = newOnlineIsolationForestWithAdaptive(
-					modelConfig.ForestSize,
-					config.Performance.BatchSize, // Use global batch size as initial window size
-					0,                            // Let forest determine max depth automatically
-					config.AdaptiveWindow,        // Pass adaptive configuration
-				)
-			} else {
-				forest = newOnlineIsolationForest(
-					modelConfig.ForestSize,
-					config.Performance.BatchSize,
-					0,
-				)
-			}
-			processor.modelForests[modelConfig.Name] = forest
+			processor.modelForests[modelConfig.Name] = processor.newForest(modelConfig.ForestSize)
 
 			logger.Info("Initialized model",
 				zap.String("model_name", modelConfig.Name),
@@ -98,21 +91,7 @@ func newIsolationForestProcessor(config *Config, logger *zap.Logger) (*isolation
 		}
 	} else {
 		// Create single default model
-		// Use adaptive forest creation if adaptive window is enabled
-		if config.IsAdaptiveWindowEnabled() {
-			processor.defaultForest = newOnlineIsolationForestWithAdaptive(
-				config.ForestSize,
-				config.Performance.BatchSize,
-				0, // Auto-determine max depth
-				config.AdaptiveWindow,
-			)
-		} else {
-			processor.defaultForest = newOnlineIsolationForest(
-				config.ForestSize,
-				config.Performance.BatchSize,
-				0,
-			)
-		}
+		processor.defaultForest = processor.newForest(config.ForestSize)
 
 		logger.Info("Initialized default model",
 			zap.Int("forest_size", config.ForestSize),
@@ -121,6 +100,18 @@ func newIsolationForestProcessor(config *Config, logger *zap.Logger) (*isolation
 		)
 	}
 
+	// Dynamic models are created on demand for each distinct combination of key attribute values
+	if config.IsDynamicModelsEnabled() {
+		processor.dynamicModels = newDynamicModelCache(config.DynamicModels.MaxModels, func() *onlineIsolationForest {
+			return processor.newForest(config.ForestSize)
+		})
+
+		logger.Info("Enabled dynamic models",
+			zap.Strings("key_attributes", config.DynamicModels.KeyAttributes),
+			zap.Int("max_models", config.DynamicModels.MaxModels),
+		)
+	}
+
 	// Start model update ticker for periodic retraining
 	updateFreq, err := config.GetUpdateFrequencyDuration()
 	if err != nil {
@@ -132,10 +123,37 @@ func newIsolationForestProcessor(config *Config, logger *zap.Logger) (*isolation
 	return processor, nil
 }
 
+// newForest creates a forest with the given number of trees, using adaptive window sizing if enabled.
+func (p *isolationForestProcessor) newForest(forestSize int) *onlineIsolationForest {
+	if p.config.IsAdaptiveWindowEnabled() {
+		return newOnlineIsolationForestWithAdaptive(
+			forestSize,
+			p.config.Performance.BatchSize, // Use global batch size as initial window size
+			0,                              // Let forest determine max depth automatically
+			p.config.AdaptiveWindow,        // Pass adaptive configuration
+		)
+	}
+	return newOnlineIsolationForest(
+		forestSize,
+		p.config.Performance.BatchSize,
+		0,
+	)
+}
+
 // Start initializes the processor
-func (p *isolationForestProcessor) Start(_ context.Context, _ component.Host) error {
+func (p *isolationForestProcessor) Start(ctx context.Context, host component.Host) error {
 	p.logger.Info("Starting isolation forest processor")
-	// Any additional initialization logic can go here
+
+	// Restore the models persisted by a previous run, if any
+	client, err := getStorageClient(ctx, host, p.config.Storage, p.componentID, p.storageName)
+	if err != nil {
+		return fmt.Errorf("failed to get storage client: %w", err)
+	}
+	p.storageClient = client
+	if err = p.restoreModels(ctx); err != nil {
+		// Models start cold rather than preventing the collector from starting
+		p.logger.Warn("Failed to restore model snapshots", zap.Error(err))
+	}
 
 	// Start the background model update loop
 	p.shutdownWG.Add(1)
@@ -148,7 +166,7 @@ func (p *isolationForestProcessor) Start(_ context.Context, _ component.Host) er
 }
 
 // Shutdown gracefully stops the processor and cleans up resources.
-func (p *isolationForestProcessor) Shutdown(_ context.Context) error {
+func (p *isolationForestProcessor) Shutdown(ctx context.Context) error {
 	p.logger.Info("Shutting down isolation forest processor")
 
 	// Stop the update ticker
@@ -162,8 +180,15 @@ func (p *isolationForestProcessor) Shutdown(_ context.Context) error {
 	// Wait for all background goroutines to complete
 	p.shutdownWG.Wait()
 
+	// Persist the final state of the models so that the next run starts warm
+	var err error
+	if p.storageClient != nil {
+		err = errors.Join(p.saveModels(ctx), p.storageClient.Close(ctx))
+		p.storageClient = nil
+	}
+
 	p.logger.Info("Isolation forest processor shutdown complete")
-	return nil
+	return err
 }
 
 // modelUpdateLoop runs periodic model updates in the background to adapt to changing patterns.
@@ -221,58 +246,52 @@ func (p *isolationForestProcessor) performModelUpdate() {
 	}
 	p.forestsMutex.RUnlock()
 
+	if p.dynamicModels != nil {
+		p.logger.Debug("Dynamic model statistics", zap.Int("models", p.dynamicModels.size()))
+	}
+
+	if err := p.saveModels(context.Background()); err != nil {
+		p.logger.Warn("Failed to save model snapshots", zap.Error(err))
+	}
+
 	p.lastModelUpdate = time.Now()
 }
 
 // processFeatures is the core method that takes extracted features and runs them through
-// the isolation forest algorithm to compute anomaly scores and classifications.
-func (p *isolationForestProcessor) processFeatures(features map[string][]float64, attributes map[string]any) (float64, bool, string) {
+// the isolation forest algorithm to compute anomaly scores and classifications. It also returns
+// the name of the model used and, if explanations are enabled, the feature contributing most to the score.
+func (p *isolationForestProcessor) processFeatures(features map[string][]float64, resourceAttrs, attributes map[string]any) (float64, bool, string, string) {
 	if len(features) == 0 {
-		return 0.0, false, ""
+		return 0.0, false, "", ""
 	}
 
 	// Determine which model to use based on configuration
-	var forest *onlineIsolationForest
-	var modelName string
-
-	p.forestsMutex.RLock()
-	defer p.forestsMutex.RUnlock()
-
-	if p.config.IsMultiModelMode() {
-		// Find matching model based on attributes
-		if modelConfig := p.config.GetModelForAttributes(attributes); modelConfig != nil {
-			if f, exists := p.modelForests[modelConfig.Name]; exists {
-				forest = f
-				modelName = modelConfig.Name
-			}
-		}
-
-		// Fall back to first available model if no match found
-		if forest == nil && len(p.modelForests) > 0 {
-			for name, f := range p.modelForests {
-				forest = f
-				modelName = name
-				break
-			}
-		}
-	} else {
-		forest = p.defaultForest
-		modelName = "default"
-	}
-
+	forest, modelName := p.selectForest(resourceAttrs, attributes)
 	if forest == nil {
 		p.logger.Warn("No isolation forest available for processing")
-		return 0.0, false, ""
+		return 0.0, false, "", ""
 	}
 
 	// Combine all features into a single feature vector
 	var combinedFeatures []float64
-	for _, featureVector := range features {
+	var featureNames []string
+	for name, featureVector := range features {
 		combinedFeatures = append(combinedFeatures, featureVector...)
+		for range featureVector {
+			featureNames = append(featureNames, name)
+		}
 	}
 
 	if len(combinedFeatures) == 0 {
-		return 0.0, false, modelName
+		return 0.0, false, modelName, ""
+	}
+
+	// Explain the score before the sample is learned by the forest
+	var explanation string
+	if p.config.ExplanationAttribute != "" {
+		if index, ok := forest.topContributingFeature(combinedFeatures); ok {
+			explanation = featureNames[index]
+		}
 	}
 
 	// Process through isolation forest
@@ -286,7 +305,43 @@ func (p *isolationForestProcessor) processFeatures(features map[string][]float64
 	}
 	p.statsMutex.Unlock()
 
-	return anomalyScore, isAnomaly, modelName
+	return anomalyScore, isAnomaly, modelName, explanation
+}
+
+// selectForest returns the model to use: the static model whose selector matches the attributes,
+// else the dynamic model of the key resource attributes, else the first static model or the default one.
+func (p *isolationForestProcessor) selectForest(resourceAttrs, attributes map[string]any) (*onlineIsolationForest, string) {
+	p.forestsMutex.RLock()
+	defer p.forestsMutex.RUnlock()
+
+	// Find matching model based on attributes
+	if modelConfig := p.config.GetModelForAttributes(attributes); modelConfig != nil {
+		if f, exists := p.modelForests[modelConfig.Name]; exists {
+			return f, modelConfig.Name
+		}
+	}
+
+	if p.dynamicModels != nil {
+		if name, ok := p.config.GetDynamicModelName(resourceAttrs); ok {
+			forest, evicted := p.dynamicModels.getOrCreate(name)
+			if evicted != "" {
+				p.logger.Debug("Evicted least recently used dynamic model",
+					zap.String("model_name", evicted),
+					zap.Int("max_models", p.config.DynamicModels.MaxModels),
+				)
+			}
+			return forest, name
+		}
+	}
+
+	if p.config.IsMultiModelMode() {
+		// Fall back to first available model if no match found
+		for name, f := range p.modelForests {
+			return f, name
+		}
+		return nil, ""
+	}
+	return p.defaultForest, "default"
 }
 
 // processTraces processes trace telemetry
@@ -318,7 +373,7 @@ func (p *isolationForestProcessor) processTraces(ctx context.Context, td ptrace.
 				allAttrs := mergeAttributes(resourceAttrs, spanAttrs)
 
 				// Process through isolation forest
-				score, isAnomaly, modelName := p.processFeatures(features, allAttrs)
+				score, isAnomaly, modelName, explanation := p.processFeatures(features, resourceAttrs, allAttrs)
 
 				// Apply processing mode
 				if p.config.Mode == "filter" && !isAnomaly {
@@ -332,11 +387,7 @@ func (p *isolationForestProcessor) processTraces(ctx context.Context, td ptrace.
 
 				// Add anomaly attributes in enrich or both modes
 				if p.config.Mode == "enrich" || p.config.Mode == "both" {
-					newSpan.Attributes().PutDouble(p.config.ScoreAttribute, score)
-					newSpan.Attributes().PutBool(p.config.ClassificationAttribute, isAnomaly)
-					if modelName != "" && modelName != "default" {
-						newSpan.Attributes().PutStr("anomaly.model_name", modelName)
-					}
+					p.putAnomalyAttributes(newSpan.Attributes(), score, isAnomaly, modelName, explanation)
 				}
 			}
 
@@ -373,11 +424,11 @@ func (p *isolationForestProcessor) processMetrics(ctx context.Context, md pmetri
 				features := p.metricsExtractor.ExtractFeatures(metric, resourceAttrs)
 
 				// Process through isolation forest
-				score, isAnomaly, modelName := p.processFeatures(features, resourceAttrs)
+				score, isAnomaly, modelName, explanation := p.processFeatures(features, resourceAttrs, resourceAttrs)
 
 				// Add anomaly attributes to metric data points
 				if p.config.Mode == "enrich" || p.config.Mode == "both" {
-					p.addAnomalyAttributesToMetric(metric, score, isAnomaly, modelName)
+					p.addAnomalyAttributesToMetric(metric, score, isAnomaly, modelName, explanation)
 				}
 			}
 		}
@@ -415,7 +466,7 @@ func (p *isolationForestProcessor) processLogs(ctx context.Context, ld plog.Logs
 				allAttrs := mergeAttributes(resourceAttrs, logAttrs)
 
 				// Process through isolation forest
-				score, isAnomaly, modelName := p.processFeatures(features, allAttrs)
+				score, isAnomaly, modelName, explanation := p.processFeatures(features, resourceAttrs, allAttrs)
 
 				// Apply processing mode
 				if p.config.Mode == "filter" && !isAnomaly {
@@ -429,11 +480,7 @@ func (p *isolationForestProcessor) processLogs(ctx context.Context, ld plog.Logs
 
 				// Add anomaly attributes in enrich or both modes
 				if p.config.Mode == "enrich" || p.config.Mode == "both" {
-					newRecord.Attributes().PutDouble(p.config.ScoreAttribute, score)
-					newRecord.Attributes().PutBool(p.config.ClassificationAttribute, isAnomaly)
-					if modelName != "" && modelName != "default" {
-						newRecord.Attributes().PutStr("anomaly.model_name", modelName)
-					}
+					p.putAnomalyAttributes(newRecord.Attributes(), score, isAnomaly, modelName, explanation)
 				}
 			}
 
@@ -450,62 +497,49 @@ func (p *isolationForestProcessor) processLogs(ctx context.Context, ld plog.Logs
 }
 
 // addAnomalyAttributesToMetric adds anomaly detection results to metric data points
-func (p *isolationForestProcessor) addAnomalyAttributesToMetric(metric pmetric.Metric, score float64, isAnomaly bool, modelName string) {
+func (p *isolationForestProcessor) addAnomalyAttributesToMetric(metric pmetric.Metric, score float64, isAnomaly bool, modelName, explanation string) {
 	// Add attributes to different metric types based on their structure
 	switch metric.Type() {
 	case pmetric.MetricTypeGauge:
 		gauge := metric.Gauge()
 		for i := 0; i < gauge.DataPoints().Len(); i++ {
-			dp := gauge.DataPoints().At(i)
-			dp.Attributes().PutDouble(p.config.ScoreAttribute, score)
-			dp.Attributes().PutBool(p.config.ClassificationAttribute, isAnomaly)
-			if modelName != "" && modelName != "default" {
-				dp.Attributes().PutStr("anomaly.model_name", modelName)
-			}
+			p.putAnomalyAttributes(gauge.DataPoints().At(i).Attributes(), score, isAnomaly, modelName, explanation)
 		}
 	case pmetric.MetricTypeSum:
 		sum := metric.Sum()
 		for i := 0; i < sum.DataPoints().Len(); i++ {
-			dp := sum.DataPoints().At(i)
-			dp.Attributes().PutDouble(p.config.ScoreAttribute, score)
-			dp.Attributes().PutBool(p.config.ClassificationAttribute, isAnomaly)
-			if modelName != "" && modelName != "default" {
-				dp.Attributes().PutStr("anomaly.model_name", modelName)
-			}
+			p.putAnomalyAttributes(sum.DataPoints().At(i).Attributes(), score, isAnomaly, modelName, explanation)
 		}
 	case pmetric.MetricTypeHistogram:
 		histogram := metric.Histogram()
 		for i := 0; i < histogram.DataPoints().Len(); i++ {
-			dp := histogram.DataPoints().At(i)
-			dp.Attributes().PutDouble(p.config.ScoreAttribute, score)
-			dp.Attributes().PutBool(p.config.ClassificationAttribute, isAnomaly)
-			if modelName != "" && modelName != "default" {
-				dp.Attributes().PutStr("anomaly.model_name", modelName)
-			}
+			p.putAnomalyAttributes(histogram.DataPoints().At(i).Attributes(), score, isAnomaly, modelName, explanation)
 		}
 	case pmetric.MetricTypeSummary:
 		summary := metric.Summary()
 		for i := 0; i < summary.DataPoints().Len(); i++ {
-			dp := summary.DataPoints().At(i)
-			dp.Attributes().PutDouble(p.config.ScoreAttribute, score)
-			dp.Attributes().PutBool(p.config.ClassificationAttribute, isAnomaly)
-			if modelName != "" && modelName != "default" {
-				dp.Attributes().PutStr("anomaly.model_name", modelName)
-			}
+			p.putAnomalyAttributes(summary.DataPoints().At(i).Attributes(), score, isAnomaly, modelName, explanation)
 		}
 	case pmetric.MetricTypeExponentialHistogram:
 		exponentialHistogram := metric.ExponentialHistogram()
 		for i := 0; i < exponentialHistogram.DataPoints().Len(); i++ {
-			dp := exponentialHistogram.DataPoints().At(i)
-			dp.Attributes().PutDouble(p.config.ScoreAttribute, score)
-			dp.Attributes().PutBool(p.config.ClassificationAttribute, isAnomaly)
-			if modelName != "" && modelName != "default" {
-				dp.Attributes().PutStr("anomaly.model_name", modelName)
-			}
+			p.putAnomalyAttributes(exponentialHistogram.DataPoints().At(i).Attributes(), score, isAnomaly, modelName, explanation)
 		}
 	}
 }
 
+// putAnomalyAttributes adds anomaly detection results to the attributes of a span, data point or log record
+func (p *isolationForestProcessor) putAnomalyAttributes(attrs pcommon.Map, score float64, isAnomaly bool, modelName, explanation string) {
+	attrs.PutDouble(p.config.ScoreAttribute, score)
+	attrs.PutBool(p.config.ClassificationAttribute, isAnomaly)
+	if modelName != "" && modelName != "default" {
+		attrs.PutStr("anomaly.model_name", modelName)
+	}
+	if p.config.ExplanationAttribute != "" && explanation != "" {
+		attrs.PutStr(p.config.ExplanationAttribute, explanation)
+	}
+}
+
 // Feature extraction components for different signal types
 
 // TraceFeatureExtractor extracts numerical features from trace spans
@@ -237,7 +237,7 @@ func Test_performModelUpdate_WithAdaptiveStatistics(t *testing.T) {
 	attrs := map[string]any{"service.name": "test"}
 
 	for range 5 {
-		p.processFeatures(features, attrs)
+		p.processFeatures(features, attrs, attrs)
 		time.Sleep(10 * time.Millisecond) // Create some velocity
 	}
 
@@ -271,7 +271,7 @@ func Test_processFeatures_SaneOutputs(t *testing.T) {
 		"service.name": "frontend",
 	}
 
-	score, isAnomaly, model := p.processFeatures(features, attrs)
+	score, isAnomaly, model, _ := p.processFeatures(features, attrs, attrs)
 	assert.True(t, score >= 0.0 && score <= 1.0, "score must be in [0,1]")
 	// We don't assert on isAnomaly (model behavior dependent), but ensure it's a boolean by using it
 	if isAnomaly {
@@ -423,7 +423,7 @@ func Test_processFeatures_EmptyFeatures(t *testing.T) {
 	features := map[string][]float64{}
 	attrs := map[string]any{"service.name": "test"}
 
-	score, isAnomaly, model := p.processFeatures(features, attrs)
+	score, isAnomaly, model, _ := p.processFeatures(features, attrs, attrs)
 	assert.Equal(t, 0.0, score)
 	assert.False(t, isAnomaly)
 	assert.Empty(t, model)
@@ -459,7 +459,7 @@ func Test_processFeatures_NoForestAvailable(t *testing.T) {
 	features := map[string][]float64{"duration": {50.0}}
 	attrs := map[string]any{"service.name": "test"}
 
-	score, isAnomaly, model := p.processFeatures(features, attrs)
+	score, isAnomaly, model, _ := p.processFeatures(features, attrs, attrs)
 	assert.Equal(t, 0.0, score)
 	assert.False(t, isAnomaly)
 	assert.Empty(t, model)
@@ -484,7 +484,7 @@ func Test_processFeatures_EmptyCombinedFeatures(t *testing.T) {
 	}
 	attrs := map[string]any{"service.name": "test"}
 
-	score, isAnomaly, model := p.processFeatures(features, attrs)
+	score, isAnomaly, model, _ := p.processFeatures(features, attrs, attrs)
 	assert.Equal(t, 0.0, score)
 	assert.False(t, isAnomaly)
 	assert.Equal(t, "default", model)
@@ -655,6 +655,7 @@ func Test_processMetrics_ContextCanceled(t *testing.T) {
 
 func Test_addAnomalyAttributesToMetric_AllTypes(t *testing.T) {
 	cfg := baseTestConfig(t)
+	cfg.ExplanationAttribute = "anomaly.explanation"
 	logger := zaptest.NewLogger(t)
 
 	p, err := newIsolationForestProcessor(cfg, logger)
@@ -715,7 +716,7 @@ func Test_addAnomalyAttributesToMetric_AllTypes(t *testing.T) {
 	for _, mt := range metricTypes {
 		t.Run(mt.name, func(t *testing.T) {
 			metric := mt.setupFn()
-			p.addAnomalyAttributesToMetric(metric, 0.75, true, "test-model")
+			p.addAnomalyAttributesToMetric(metric, 0.75, true, "test-model", "value")
 
 			// Verify attributes were added based on metric type
 			var attrs pcommon.Map
@@ -743,6 +744,10 @@ func Test_addAnomalyAttributesToMetric_AllTypes(t *testing.T) {
 			modelName, ok := attrs.Get("anomaly.model_name")
 			assert.True(t, ok)
 			assert.Equal(t, "test-model", modelName.Str())
+
+			explanation, ok := attrs.Get(cfg.ExplanationAttribute)
+			assert.True(t, ok)
+			assert.Equal(t, "value", explanation.Str())
 		})
 	}
 }
@@ -765,11 +770,13 @@ func Test_addAnomalyAttributesToMetric_DefaultModel(t *testing.T) {
 	dp.SetDoubleValue(42.0)
 
 	// Test with default model name
-	p.addAnomalyAttributesToMetric(metric, 0.5, false, "default")
+	p.addAnomalyAttributesToMetric(metric, 0.5, false, "default", "value")
 
 	attrs := metric.Gauge().DataPoints().At(0).Attributes()
 	_, ok := attrs.Get("anomaly.model_name")
 	assert.False(t, ok, "should not add model_name for default model")
+	_, ok = attrs.Get("anomaly.explanation")
+	assert.False(t, ok, "should not add an explanation unless explanation_attribute is set")
 }
 
 func Test_traceFeatureExtractor_AllFeatures(t *testing.T) {
@@ -1107,7 +1114,7 @@ func Test_processFeatures_MultiModel_Matching(t *testing.T) {
 	features := map[string][]float64{"duration": {50.0}}
 	attrs := map[string]any{"service.name": "test-service"}
 
-	score, isAnomaly, model := p.processFeatures(features, attrs)
+	score, isAnomaly, model, _ := p.processFeatures(features, attrs, attrs)
 	assert.True(t, score >= 0.0 && score <= 1.0)
 	assert.Equal(t, "matching-model", model)
 	_ = isAnomaly // Use the variable
@@ -1215,3 +1222,88 @@ func Test_attributeMapToGeneric_AllValueTypes(t *testing.T) {
 	assert.Contains(t, genericMap, "map_key")
 	assert.Contains(t, genericMap, "bytes_key")
 }
+
+func Test_processTraces_DynamicModels(t *testing.T) {
+	cfg := baseTestConfig(t)
+	cfg.DynamicModels = DynamicModelsConfig{
+		KeyAttributes: []string{"service.name"},
+		MaxModels:     2,
+	}
+	require.NoError(t, cfg.Validate())
+	logger := zaptest.NewLogger(t)
+
+	p, err := newIsolationForestProcessor(cfg, logger)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		shutdownErr := p.Shutdown(context.WithoutCancel(t.Context()))
+		require.NoError(t, shutdownErr)
+	})
+
+	td := ptrace.NewTraces()
+	for _, service := range []string{"frontend", "checkout", ""} {
+		rs := td.ResourceSpans().AppendEmpty()
+		if service != "" {
+			rs.Resource().Attributes().PutStr("service.name", service)
+		}
+		rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("GET /")
+	}
+
+	tdOut, err := p.processTraces(t.Context(), td)
+	require.NoError(t, err)
+
+	// Each service gets its own model, spans without key attributes use the default model
+	for i, expected := range []string{"service.name=frontend", "service.name=checkout", ""} {
+		attrs := tdOut.ResourceSpans().At(i).ScopeSpans().At(0).Spans().At(0).Attributes()
+		modelName, ok := attrs.Get("anomaly.model_name")
+		assert.Equal(t, expected != "", ok)
+		if ok {
+			assert.Equal(t, expected, modelName.Str())
+		}
+	}
+	assert.Equal(t, 2, p.dynamicModels.size())
+	assert.Equal(t, uint64(1), p.defaultForest.GetStatistics().TotalSamples)
+
+	// A new service evicts the least recently used model
+	_, err = p.processTraces(t.Context(), makeServiceTraces("payment"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"service.name=checkout", "service.name=payment"}, modelNames(p.dynamicModels.entries()))
+}
+
+func Test_processTraces_Explanation(t *testing.T) {
+	cfg := baseTestConfig(t)
+	cfg.ExplanationAttribute = "anomaly.explanation"
+	cfg.Features.Traces = []string{"duration"}
+	require.NoError(t, cfg.Validate())
+	logger := zaptest.NewLogger(t)
+
+	p, err := newIsolationForestProcessor(cfg, logger)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		shutdownErr := p.Shutdown(context.WithoutCancel(t.Context()))
+		require.NoError(t, shutdownErr)
+	})
+
+	// Trees without splits cannot explain a score
+	tdOut, err := p.processTraces(t.Context(), makeTrace())
+	require.NoError(t, err)
+	_, ok := tdOut.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Attributes().Get("anomaly.explanation")
+	assert.False(t, ok, "forest without splits should not explain the score")
+
+	// Every tree splits on the duration at its root
+	for _, tree := range p.defaultForest.trees {
+		tree.root = &onlineTreeNode{
+			featureIndex: 0,
+			splitValue:   50,
+			left:         &onlineTreeNode{depth: 1, isLeaf: true},
+			right:        &onlineTreeNode{depth: 1, isLeaf: true},
+		}
+	}
+
+	tdOut, err = p.processTraces(t.Context(), makeTrace())
+	require.NoError(t, err)
+	explanation, ok := tdOut.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Attributes().Get("anomaly.explanation")
+	require.True(t, ok, "forest with splits should explain the score")
+	assert.Equal(t, "duration", explanation.Str())
+}
@@ -13,8 +13,22 @@ import (
 )
 
 var (
-	errRequiresTargets  = errors.New("requires schema targets")
-	errDuplicateTargets = errors.New("duplicate targets detected")
+	errRequiresTargets         = errors.New("requires schema targets")
+	errDuplicateTargets        = errors.New("duplicate targets detected")
+	errUnknownProvider         = errors.New("unknown schema provider")
+	errDuplicateProviders      = errors.New("duplicate schema providers detected")
+	errRequiresSchemaDirectory = errors.New("requires a schema directory")
+)
+
+// The schema providers that can be used to retrieve schema files.
+const (
+	// fileProvider reads schema files from the schema directory.
+	fileProvider = "file"
+	// embeddedProvider reads the schema files of the released
+	// semantic conventions versions embedded in the collector.
+	embeddedProvider = "embedded"
+	// httpProvider downloads schema files from their schema URL.
+	httpProvider = "http"
 )
 
 // Config defines the user provided values for the Schema Processor
@@ -31,6 +45,21 @@ type Config struct {
 	// translated to, allowing older and newer formats
 	// to conform to the target schema identifier.
 	Targets []string `mapstructure:"targets"`
+
+	// Providers define the order in which the schema providers
+	// are queried to retrieve a schema file, the first provider
+	// returning it is used. The supported providers are "file",
+	// "embedded" and "http". By default, the file provider is
+	// used first when a schema directory is set, followed by the
+	// embedded and http providers. (Optional field)
+	Providers []string `mapstructure:"providers"`
+
+	// SchemaDirectory is the directory the file provider reads
+	// schema files from. The schema file of a schema URL is read
+	// from the path made of the URL host and path, for example
+	// <schema_directory>/opentelemetry.io/schemas/1.9.0 for
+	// https://opentelemetry.io/schemas/1.9.0. (Optional field)
+	SchemaDirectory string `mapstructure:"schema_directory"`
 }
 
 func (c *Config) Validate() error {
@@ -59,5 +88,33 @@ func (c *Config) Validate() error {
 		families[family] = struct{}{}
 	}
 
+	providers := make(map[string]struct{})
+	for _, provider := range c.Providers {
+		switch provider {
+		case fileProvider, embeddedProvider, httpProvider:
+		default:
+			return fmt.Errorf("%q: %w", provider, errUnknownProvider)
+		}
+		if _, exist := providers[provider]; exist {
+			return errDuplicateProviders
+		}
+		providers[provider] = struct{}{}
+	}
+	if _, exist := providers[fileProvider]; exist && c.SchemaDirectory == "" {
+		return fmt.Errorf("file provider: %w", errRequiresSchemaDirectory)
+	}
+
 	return nil
 }
+
+// providers returns the schema providers in the order they are queried.
+func (c *Config) providers() []string {
+	if len(c.Providers) > 0 {
+		return c.Providers
+	}
+	var providers []string
+	if c.SchemaDirectory != "" {
+		providers = append(providers, fileProvider)
+	}
+	return append(providers, embeddedProvider, httpProvider)
+}
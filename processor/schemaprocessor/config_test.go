@@ -43,13 +43,50 @@ func TestLoadConfig(t *testing.T) {
 	}, cfg)
 }
 
+func TestLoadConfigOffline(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yml"))
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "offline").String())
+	require.NoError(t, err)
+	require.NoError(t, sub.Unmarshal(cfg))
+	require.NoError(t, xconfmap.Validate(cfg))
+
+	assert.Equal(t, &Config{
+		ClientConfig: confighttp.NewDefaultClientConfig(),
+		Targets: []string{
+			"https://opentelemetry.io/schemas/1.9.0",
+		},
+		Providers:       []string{"file", "embedded"},
+		SchemaDirectory: "/etc/otelcol/schemas",
+	}, cfg)
+	assert.Equal(t, []string{"file", "embedded"}, cfg.(*Config).providers())
+}
+
+func TestConfigDefaultProviders(t *testing.T) {
+	t.Parallel()
+
+	cfg := &Config{}
+	assert.Equal(t, []string{"embedded", "http"}, cfg.providers())
+
+	cfg.SchemaDirectory = "/etc/otelcol/schemas"
+	assert.Equal(t, []string{"file", "embedded", "http"}, cfg.providers())
+}
+
 func TestConfigurationValidation(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		scenario    string
-		target      []string
-		expectError error
+		scenario        string
+		target          []string
+		providers       []string
+		schemaDirectory string
+		expectError     error
 	}{
 		{scenario: "No targets", target: nil, expectError: errRequiresTargets},
 		{
@@ -77,11 +114,38 @@ func TestConfigurationValidation(t *testing.T) {
 			},
 			expectError: errDuplicateTargets,
 		},
+		{
+			scenario:        "Valid providers",
+			target:          []string{"https://opentelemetry.io/schemas/1.9.0"},
+			providers:       []string{"file", "embedded"},
+			schemaDirectory: "/etc/otel/schemas",
+			expectError:     nil,
+		},
+		{
+			scenario:    "Unknown provider",
+			target:      []string{"https://opentelemetry.io/schemas/1.9.0"},
+			providers:   []string{"embedded", "s3"},
+			expectError: errUnknownProvider,
+		},
+		{
+			scenario:    "Duplicate providers",
+			target:      []string{"https://opentelemetry.io/schemas/1.9.0"},
+			providers:   []string{"embedded", "http", "embedded"},
+			expectError: errDuplicateProviders,
+		},
+		{
+			scenario:    "File provider without schema directory",
+			target:      []string{"https://opentelemetry.io/schemas/1.9.0"},
+			providers:   []string{"file", "http"},
+			expectError: errRequiresSchemaDirectory,
+		},
 	}
 
 	for _, tc := range tests {
 		cfg := &Config{
-			Targets: tc.target,
+			Targets:         tc.target,
+			Providers:       tc.providers,
+			SchemaDirectory: tc.schemaDirectory,
 		}
 
 		assert.ErrorIs(t, xconfmap.Validate(cfg), tc.expectError, tc.scenario)
@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+)
+
+// embeddedSchemas holds the schema files of the semantic conventions versions 1.4.0 to 1.9.0,
+// laid out like the schema URLs they are published at.
+//
+//go:embed schemas
+var embeddedSchemas embed.FS
+
+// fsProvider retrieves schema files from a file system, where the schema file
+// of a schema URL is stored at the path made of the URL host and path.
+// For example, the schema file of https://opentelemetry.io/schemas/1.9.0
+// is read from opentelemetry.io/schemas/1.9.0.
+type fsProvider struct {
+	fsys fs.FS
+}
+
+var _ Provider = (*fsProvider)(nil)
+
+// NewFileProvider creates a Provider reading the schema files from the given directory.
+func NewFileProvider(dir string) Provider {
+	return &fsProvider{fsys: os.DirFS(dir)}
+}
+
+// NewEmbeddedProvider creates a Provider reading the schema files embedded in the collector,
+// which only cover the versions 1.4.0 to 1.9.0 of the OpenTelemetry semantic conventions.
+func NewEmbeddedProvider() Provider {
+	fsys, err := fs.Sub(embeddedSchemas, "schemas")
+	if err != nil {
+		panic("Unable to open the embedded schemas; this is a programming error: " + err.Error())
+	}
+	return &fsProvider{fsys: fsys}
+}
+
+func (fp *fsProvider) Retrieve(_ context.Context, schemaURL string) (string, error) {
+	if schemaURL == "" {
+		return "", errors.New("schema URL cannot be empty")
+	}
+	u, err := url.Parse(schemaURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid schema URL: %w", err)
+	}
+	name := u.Host + u.Path
+	if !fs.ValidPath(name) {
+		return "", fmt.Errorf("invalid schema URL %q: no schema file path can be derived from it", schemaURL)
+	}
+	data, err := fs.ReadFile(fp.fsys, name)
+	if err != nil {
+		return "", fmt.Errorf("failed to read schema file: %w", err)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestFileProvider(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "example.com", "schemas"), 0o700))
+	data := LoadTranslationVersion(t, "complex_changeset.yml")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "example.com", "schemas", "1.7.0"), []byte(data), 0o600))
+
+	tests := []struct {
+		scenario string
+		url      string
+		content  string
+		err      string
+	}{
+		{
+			scenario: "schema file exists",
+			url:      "https://example.com/schemas/1.7.0",
+			content:  data,
+		},
+		{
+			scenario: "scheme is ignored",
+			url:      "http://example.com/schemas/1.7.0",
+			content:  data,
+		},
+		{
+			scenario: "schema file is missing",
+			url:      "https://example.com/schemas/1.8.0",
+			err:      "failed to read schema file",
+		},
+		{
+			scenario: "empty url",
+			url:      "",
+			err:      "schema URL cannot be empty",
+		},
+		{
+			scenario: "path outside of the directory",
+			url:      "https://example.com/../../secret",
+			err:      "no schema file path can be derived from it",
+		},
+		{
+			scenario: "no host",
+			url:      "/schemas/1.7.0",
+			err:      "no schema file path can be derived from it",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.scenario, func(t *testing.T) {
+			p := NewFileProvider(dir)
+			content, err := p.Retrieve(t.Context(), tc.url)
+			if tc.err != "" {
+				assert.ErrorContains(t, err, tc.err)
+				assert.Empty(t, content)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.content, content)
+		})
+	}
+}
+
+func TestEmbeddedProvider(t *testing.T) {
+	t.Parallel()
+
+	p := NewEmbeddedProvider()
+	content, err := p.Retrieve(t.Context(), "https://opentelemetry.io/schemas/1.9.0")
+	require.NoError(t, err)
+	assert.Contains(t, content, "schema_url: https://opentelemetry.io/schemas/1.9.0")
+
+	_, err = p.Retrieve(t.Context(), "https://example.com/schemas/1.9.0")
+	assert.ErrorContains(t, err, "failed to read schema file")
+
+	m, err := NewManager([]string{"https://opentelemetry.io/schemas/1.6.1"}, zaptest.NewLogger(t), p)
+	require.NoError(t, err)
+	tn, err := m.RequestTranslation(t.Context(), "https://opentelemetry.io/schemas/1.8.0")
+	require.NoError(t, err)
+	assert.True(t, tn.SupportedVersion(&Version{1, 6, 1}))
+}
+
+func TestEmbeddedSchemasAreValid(t *testing.T) {
+	t.Parallel()
+
+	entries, err := embeddedSchemas.ReadDir("schemas/opentelemetry.io/schemas")
+	require.NoError(t, err)
+	require.NotEmpty(t, entries)
+	for _, entry := range entries {
+		t.Run(entry.Name(), func(t *testing.T) {
+			schemaURL := "https://opentelemetry.io/schemas/" + entry.Name()
+			content, err := NewEmbeddedProvider().Retrieve(t.Context(), schemaURL)
+			require.NoError(t, err)
+			tn, err := newTranslator(zaptest.NewLogger(t), schemaURL, content)
+			require.NoError(t, err)
+			_, version, err := GetFamilyAndVersion(schemaURL)
+			require.NoError(t, err)
+			assert.True(t, tn.SupportedVersion(version), "schema file must define its own version")
+		})
+	}
+}
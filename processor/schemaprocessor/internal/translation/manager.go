@@ -95,15 +95,17 @@ func (m *manager) RequestTranslation(ctx context.Context, schemaURL string) (Tra
 		return t, nil
 	}
 
+	var errs []error
 	for _, p := range m.providers {
 		content, err := p.Retrieve(ctx, schemaURL)
 		if err != nil {
-			m.log.Error("Failed to lookup schemaURL",
+			m.log.Debug("Failed to lookup schemaURL",
 				zap.Error(err),
 				zap.String("schemaURL", schemaURL),
 			)
 			// If we fail to retrieve the schema, we should
 			// try the next provider
+			errs = append(errs, err)
 			continue
 		}
 		t, err := newTranslator(
@@ -116,6 +118,7 @@ func (m *manager) RequestTranslation(ctx context.Context, schemaURL string) (Tra
 		)
 		if err != nil {
 			m.log.Error("Failed to create translator", zap.Error(err))
+			errs = append(errs, err)
 			continue
 		}
 		m.rw.Lock()
@@ -124,7 +127,12 @@ func (m *manager) RequestTranslation(ctx context.Context, schemaURL string) (Tra
 		return t, nil
 	}
 
-	return nil, fmt.Errorf("failed to retrieve translation for %s", schemaURL)
+	err = errors.Join(errs...)
+	m.log.Error("Failed to lookup schemaURL in all providers",
+		zap.Error(err),
+		zap.String("schemaURL", schemaURL),
+	)
+	return nil, fmt.Errorf("failed to retrieve translation for %s: %w", schemaURL, err)
 }
 
 // AddProvider will add a provider to the Manager
@@ -14,7 +14,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest"
+	"go.uber.org/zap/zaptest/observer"
 )
 
 //go:embed testdata/schema.yaml
@@ -86,3 +88,42 @@ func TestManagerError(t *testing.T) {
 	assert.Error(t, err, "Must error when provider errors")
 	assert.Nil(t, tr, "Must not return a translation")
 }
+
+type contentProvider struct {
+	content string
+}
+
+func (p *contentProvider) Retrieve(_ context.Context, _ string) (string, error) {
+	return p.content, nil
+}
+
+func TestManagerProviderErrorLogs(t *testing.T) {
+	t.Parallel()
+
+	core, logs := observer.New(zap.DebugLevel)
+	m, err := NewManager(
+		[]string{"http://localhost/1.1.0"},
+		zap.New(core),
+		&errorProvider{},
+		&contentProvider{content: string(exampleTranslation)},
+	)
+	require.NoError(t, err, "Must not error when created manager")
+
+	tr, err := m.RequestTranslation(t.Context(), "http://localhost/1.1.0")
+	require.NoError(t, err, "Must not error when the next provider retrieves the schema")
+	assert.NotNil(t, tr, "Must return a translation")
+	assert.Equal(t, 1, logs.FilterMessage("Failed to lookup schemaURL").FilterLevelExact(zap.DebugLevel).Len())
+	assert.Zero(t, logs.FilterLevelExact(zap.ErrorLevel).Len(), "Must not log errors when a provider retrieves the schema")
+
+	m, err = NewManager(
+		[]string{"http://localhost/1.1.0"},
+		zap.New(core),
+		&errorProvider{},
+		&errorProvider{},
+	)
+	require.NoError(t, err, "Must not error when created manager")
+
+	_, err = m.RequestTranslation(t.Context(), "http://localhost/1.1.0")
+	assert.Error(t, err, "Must error when all providers error")
+	assert.Equal(t, 1, logs.FilterMessage("Failed to lookup schemaURL in all providers").FilterLevelExact(zap.ErrorLevel).Len())
+}
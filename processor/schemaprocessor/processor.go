@@ -175,13 +175,22 @@ func (t schemaProcessor) processTraces(ctx context.Context, td ptrace.Traces) (p
 	return td, nil
 }
 
-// start will add HTTP provider to the manager and prefetch schemas
+// start will add the configured providers to the manager and prefetch schemas
 func (t *schemaProcessor) start(ctx context.Context, host component.Host) error {
-	client, err := t.config.ToClient(ctx, host.GetExtensions(), t.telemetry)
-	if err != nil {
-		return err
+	for _, provider := range t.config.providers() {
+		switch provider {
+		case fileProvider:
+			t.manager.AddProvider(translation.NewFileProvider(t.config.SchemaDirectory))
+		case embeddedProvider:
+			t.manager.AddProvider(translation.NewEmbeddedProvider())
+		case httpProvider:
+			client, err := t.config.ToClient(ctx, host.GetExtensions(), t.telemetry)
+			if err != nil {
+				return err
+			}
+			t.manager.AddProvider(translation.NewHTTPProvider(client))
+		}
 	}
-	t.manager.AddProvider(translation.NewHTTPProvider(client))
 
 	go func(ctx context.Context) {
 		for _, schemaURL := range t.config.Prefetch {
@@ -59,6 +59,32 @@ func TestSchemaProcessorStart(t *testing.T) {
 	assert.NoError(t, trans.start(t.Context(), componenttest.NewNopHost()))
 }
 
+func TestSchemaProcessorEmbeddedProvider(t *testing.T) {
+	t.Parallel()
+
+	cfg := &Config{
+		Targets:   []string{"https://opentelemetry.io/schemas/1.7.0"},
+		Providers: []string{embeddedProvider},
+	}
+	trans, err := newSchemaProcessor(t.Context(), cfg, processor.Settings{
+		TelemetrySettings: component.TelemetrySettings{
+			Logger: zaptest.NewLogger(t),
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, trans.start(t.Context(), componenttest.NewNopHost()))
+
+	in := ptrace.NewTraces()
+	rs := in.ResourceSpans().AppendEmpty()
+	rs.SetSchemaUrl("https://opentelemetry.io/schemas/1.9.0")
+	rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("http.request")
+
+	out, err := trans.processTraces(t.Context(), in)
+	require.NoError(t, err)
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.7.0", out.ResourceSpans().At(0).SchemaUrl())
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.7.0", out.ResourceSpans().At(0).ScopeSpans().At(0).SchemaUrl())
+}
+
 func TestSchemaProcessorProcessing(t *testing.T) {
 	t.Parallel()
 	// these tests are just to ensure that the processor does not error out
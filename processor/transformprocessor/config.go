@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/confmap"
@@ -21,6 +22,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlprofile"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanlink"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
 )
 
@@ -48,12 +50,21 @@ type Config struct {
 	ProfileStatements []common.ContextStatements `mapstructure:"profile_statements"`
 
 	FlattenData bool `mapstructure:"flatten_data"`
-	logger      *zap.Logger
+
+	// Functions are user-defined OTTL functions that can be called by all the statements.
+	Functions []ottl.UserFunction `mapstructure:"functions"`
+	// FunctionLibraries are the extensions providing user-defined OTTL functions, such as the ottl_functions
+	// extension, with the names of the functions called by the statements. Their functions are added to the
+	// Functions once the processor starts.
+	FunctionLibraries []ottl.UserFunctionLibraryConfig `mapstructure:"function_libraries"`
+
+	logger *zap.Logger
 
 	dataPointFunctions map[string]ottl.Factory[*ottldatapoint.TransformContext]
 	logFunctions       map[string]ottl.Factory[*ottllog.TransformContext]
 	metricFunctions    map[string]ottl.Factory[*ottlmetric.TransformContext]
 	spanEventFunctions map[string]ottl.Factory[*ottlspanevent.TransformContext]
+	spanLinkFunctions  map[string]ottl.Factory[*ottlspanlink.TransformContext]
 	spanFunctions      map[string]ottl.Factory[*ottlspan.TransformContext]
 	profileFunctions   map[string]ottl.Factory[ottlprofile.TransformContext]
 }
@@ -144,8 +155,38 @@ var _ component.Config = (*Config)(nil)
 func (c *Config) Validate() error {
 	var errors error
 
+	for _, f := range c.Functions {
+		if err := f.Validate(); err != nil {
+			errors = multierr.Append(errors, err)
+		}
+	}
+	for _, library := range c.FunctionLibraries {
+		if err := library.Validate(); err != nil {
+			errors = multierr.Append(errors, err)
+		}
+	}
+	if errors != nil {
+		return errors
+	}
+
+	// The functions of the libraries are only available once the processor starts,
+	// the statements are validated with the functions declared for each library.
+	functions := append(slices.Clone(c.Functions), ottl.UserFunctionDeclarations(c.FunctionLibraries)...)
+
 	if len(c.TraceStatements) > 0 {
-		pc, err := common.NewTraceParserCollection(component.TelemetrySettings{Logger: zap.NewNop()}, common.WithSpanParser(c.spanFunctions), common.WithSpanEventParser(c.spanEventFunctions))
+		spanFunctions, err := ottl.MergeUserFunctions(c.spanFunctions, functions)
+		if err != nil {
+			return err
+		}
+		spanEventFunctions, err := ottl.MergeUserFunctions(c.spanEventFunctions, functions)
+		if err != nil {
+			return err
+		}
+		spanLinkFunctions, err := ottl.MergeUserFunctions(c.spanLinkFunctions, functions)
+		if err != nil {
+			return err
+		}
+		pc, err := common.NewTraceParserCollection(component.TelemetrySettings{Logger: zap.NewNop()}, functions, common.WithSpanParser(spanFunctions), common.WithSpanEventParser(spanEventFunctions), common.WithSpanLinkParser(spanLinkFunctions))
 		if err != nil {
 			return err
 		}
@@ -158,7 +199,15 @@ func (c *Config) Validate() error {
 	}
 
 	if len(c.MetricStatements) > 0 {
-		pc, err := common.NewMetricParserCollection(component.TelemetrySettings{Logger: zap.NewNop()}, common.WithMetricParser(c.metricFunctions), common.WithDataPointParser(c.dataPointFunctions))
+		metricFunctions, err := ottl.MergeUserFunctions(c.metricFunctions, functions)
+		if err != nil {
+			return err
+		}
+		dataPointFunctions, err := ottl.MergeUserFunctions(c.dataPointFunctions, functions)
+		if err != nil {
+			return err
+		}
+		pc, err := common.NewMetricParserCollection(component.TelemetrySettings{Logger: zap.NewNop()}, functions, common.WithMetricParser(metricFunctions), common.WithDataPointParser(dataPointFunctions))
 		if err != nil {
 			return err
 		}
@@ -171,7 +220,11 @@ func (c *Config) Validate() error {
 	}
 
 	if len(c.LogStatements) > 0 {
-		pc, err := common.NewLogParserCollection(component.TelemetrySettings{Logger: zap.NewNop()}, common.WithLogParser(c.logFunctions))
+		logFunctions, err := ottl.MergeUserFunctions(c.logFunctions, functions)
+		if err != nil {
+			return err
+		}
+		pc, err := common.NewLogParserCollection(component.TelemetrySettings{Logger: zap.NewNop()}, functions, common.WithLogParser(logFunctions))
 		if err != nil {
 			return err
 		}
@@ -184,7 +237,11 @@ func (c *Config) Validate() error {
 	}
 
 	if len(c.ProfileStatements) > 0 {
-		pc, err := common.NewProfileParserCollection(component.TelemetrySettings{Logger: zap.NewNop()}, common.WithProfileParser(c.profileFunctions))
+		profileFunctions, err := ottl.MergeUserFunctions(c.profileFunctions, functions)
+		if err != nil {
+			return err
+		}
+		pc, err := common.NewProfileParserCollection(component.TelemetrySettings{Logger: zap.NewNop()}, functions, common.WithProfileParser(profileFunctions))
 		if err != nil {
 			return err
 		}
@@ -335,6 +335,90 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "user_functions"),
+			expected: &Config{
+				ErrorMode: ottl.PropagateError,
+				TraceStatements: []common.ContextStatements{
+					{
+						Statements: []string{`set_method(span.attributes["http.method"])`},
+					},
+				},
+				MetricStatements: []common.ContextStatements{},
+				LogStatements: []common.ContextStatements{
+					{
+						Statements: []string{`set(resource.attributes["method"], NormalizeMethod(resource.attributes["method"]))`},
+					},
+				},
+				ProfileStatements: []common.ContextStatements{},
+				Functions: []ottl.UserFunction{
+					{
+						Signature:  "NormalizeMethod(method string)",
+						Expression: `ToUpperCase(method)`,
+					},
+					{
+						Signature:  "set_method(method)",
+						Statements: []string{`set(span.attributes["http.request.method"], NormalizeMethod(method)) where method != nil`},
+					},
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "user_functions_recursive"),
+			errors: []error{
+				errors.New("user-defined functions cannot be called recursively: Fix -> Normalize -> Fix"),
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "user_functions_invalid_body"),
+			errors: []error{
+				errors.New(`unable to parse the expression of user-defined function "NormalizeName"`),
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "function_libraries"),
+			expected: &Config{
+				ErrorMode: ottl.PropagateError,
+				TraceStatements: []common.ContextStatements{
+					{
+						Statements: []string{
+							`set_route("/users") where span.attributes["url.path"] == "/users"`,
+							`set(span.name, "users") where span.attributes["url.path"] == "/users"`,
+						},
+					},
+				},
+				MetricStatements:  []common.ContextStatements{},
+				LogStatements:     []common.ContextStatements{},
+				ProfileStatements: []common.ContextStatements{},
+				FunctionLibraries: []ottl.UserFunctionLibraryConfig{
+					{ID: component.MustNewIDWithName("ottl_functions", "http"), Functions: []string{"set_route"}},
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "function_libraries_invalid_statement"),
+			errors: []error{
+				errors.New(`unable to parse OTTL statement "set(span.unknown, \"users\")"`),
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "function_libraries_misspelled_function"),
+			errors: []error{
+				errors.New(`inferred context "span" does not support the function "ToUpperCas"`),
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "function_libraries_undeclared_function"),
+			errors: []error{
+				errors.New(`inferred context "span" does not support the function "set_method"`),
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "function_libraries_no_function"),
+			errors: []error{
+				errors.New(`function library "ottl_functions/http": at least one function must be declared`),
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.Name(), func(t *testing.T) {
@@ -5,11 +5,14 @@ package transformprocessor // import "github.com/open-telemetry/opentelemetry-co
 
 import (
 	"context"
-	"fmt"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/xconsumer"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/collector/processor/processorhelper"
 	"go.opentelemetry.io/collector/processor/processorhelper/xprocessorhelper"
@@ -23,6 +26,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlprofile"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanlink"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/logs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/metadata"
@@ -38,12 +42,14 @@ type transformProcessorFactory struct {
 	logFunctions                        map[string]ottl.Factory[*ottllog.TransformContext]
 	metricFunctions                     map[string]ottl.Factory[*ottlmetric.TransformContext]
 	spanEventFunctions                  map[string]ottl.Factory[*ottlspanevent.TransformContext]
+	spanLinkFunctions                   map[string]ottl.Factory[*ottlspanlink.TransformContext]
 	spanFunctions                       map[string]ottl.Factory[*ottlspan.TransformContext]
 	profileFunctions                    map[string]ottl.Factory[ottlprofile.TransformContext]
 	defaultDataPointFunctionsOverridden bool
 	defaultLogFunctionsOverridden       bool
 	defaultMetricFunctionsOverridden    bool
 	defaultSpanEventFunctionsOverridden bool
+	defaultSpanLinkFunctionsOverridden  bool
 	defaultSpanFunctionsOverridden      bool
 	defaultProfileFunctionsOverridden   bool
 }
@@ -135,6 +141,18 @@ func WithSpanEventFunctionsNew(spanEventFunctions []ottl.Factory[*ottlspanevent.
 	}
 }
 
+// WithSpanLinkFunctions will override the default OTTL spanlink context functions with the provided spanLinkFunctions in the resulting processor.
+// Subsequent uses of WithSpanLinkFunctions will merge the provided spanLinkFunctions with the previously registered functions.
+func WithSpanLinkFunctions(spanLinkFunctions []ottl.Factory[*ottlspanlink.TransformContext]) FactoryOption {
+	return func(factory *transformProcessorFactory) {
+		if !factory.defaultSpanLinkFunctionsOverridden {
+			factory.spanLinkFunctions = map[string]ottl.Factory[*ottlspanlink.TransformContext]{}
+			factory.defaultSpanLinkFunctionsOverridden = true
+		}
+		factory.spanLinkFunctions = mergeFunctionsToMap(factory.spanLinkFunctions, spanLinkFunctions)
+	}
+}
+
 // Deprecated: [v0.142.0] use WithSpanFunctionsNew.
 func WithSpanFunctions(spanFunctions []ottl.Factory[ottlspan.TransformContext]) FactoryOption {
 	newSpanFunctions := make([]ottl.Factory[*ottlspan.TransformContext], 0, len(spanFunctions))
@@ -179,6 +197,7 @@ func NewFactoryWithOptions(options ...FactoryOption) processor.Factory {
 		logFunctions:       defaultLogFunctionsMap(),
 		metricFunctions:    defaultMetricFunctionsMap(),
 		spanEventFunctions: defaultSpanEventFunctionsMap(),
+		spanLinkFunctions:  defaultSpanLinkFunctionsMap(),
 		spanFunctions:      defaultSpanFunctionsMap(),
 		profileFunctions:   defaultProfileFunctionsMap(),
 	}
@@ -207,6 +226,7 @@ func (f *transformProcessorFactory) createDefaultConfig() component.Config {
 		logFunctions:       f.logFunctions,
 		metricFunctions:    f.metricFunctions,
 		spanEventFunctions: f.spanEventFunctions,
+		spanLinkFunctions:  f.spanLinkFunctions,
 		spanFunctions:      f.spanFunctions,
 		profileFunctions:   f.profileFunctions,
 	}
@@ -222,17 +242,22 @@ func (f *transformProcessorFactory) createLogsProcessor(
 	if f.defaultLogFunctionsOverridden {
 		set.Logger.Debug("non-default OTTL log functions have been registered in the \"transform\" processor", zap.Bool("log", f.defaultLogFunctionsOverridden))
 	}
-	proc, err := logs.NewProcessor(oCfg.LogStatements, oCfg.ErrorMode, oCfg.FlattenData, set.TelemetrySettings, f.logFunctions)
+	proc, err := newUserFunctionsProcessor(oCfg, func(userFunctions []ottl.UserFunction) (*logs.Processor, error) {
+		return logs.NewProcessor(oCfg.LogStatements, oCfg.ErrorMode, oCfg.FlattenData, set.TelemetrySettings, f.logFunctions, userFunctions)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
+		return nil, err
 	}
 	return processorhelper.NewLogs(
 		ctx,
 		set,
 		cfg,
 		nextConsumer,
-		proc.ProcessLogs,
-		processorhelper.WithCapabilities(processorCapabilities))
+		func(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+			return proc.processor.ProcessLogs(ctx, ld)
+		},
+		processorhelper.WithCapabilities(processorCapabilities),
+		processorhelper.WithStart(proc.start))
 }
 
 func (f *transformProcessorFactory) createTracesProcessor(
@@ -242,23 +267,29 @@ func (f *transformProcessorFactory) createTracesProcessor(
 	nextConsumer consumer.Traces,
 ) (processor.Traces, error) {
 	oCfg := cfg.(*Config)
-	if f.defaultSpanEventFunctionsOverridden || f.defaultSpanFunctionsOverridden {
+	if f.defaultSpanEventFunctionsOverridden || f.defaultSpanLinkFunctionsOverridden || f.defaultSpanFunctionsOverridden {
 		set.Logger.Debug("non-default OTTL trace functions have been registered in the \"transform\" processor",
 			zap.Bool("span", f.defaultSpanFunctionsOverridden),
 			zap.Bool("spanevent", f.defaultSpanEventFunctionsOverridden),
+			zap.Bool("spanlink", f.defaultSpanLinkFunctionsOverridden),
 		)
 	}
-	proc, err := traces.NewProcessor(oCfg.TraceStatements, oCfg.ErrorMode, set.TelemetrySettings, f.spanFunctions, f.spanEventFunctions)
+	proc, err := newUserFunctionsProcessor(oCfg, func(userFunctions []ottl.UserFunction) (*traces.Processor, error) {
+		return traces.NewProcessor(oCfg.TraceStatements, oCfg.ErrorMode, set.TelemetrySettings, f.spanFunctions, f.spanEventFunctions, f.spanLinkFunctions, userFunctions)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
+		return nil, err
 	}
 	return processorhelper.NewTraces(
 		ctx,
 		set,
 		cfg,
 		nextConsumer,
-		proc.ProcessTraces,
-		processorhelper.WithCapabilities(processorCapabilities))
+		func(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+			return proc.processor.ProcessTraces(ctx, td)
+		},
+		processorhelper.WithCapabilities(processorCapabilities),
+		processorhelper.WithStart(proc.start))
 }
 
 func (f *transformProcessorFactory) createMetricsProcessor(
@@ -275,17 +306,22 @@ func (f *transformProcessorFactory) createMetricsProcessor(
 			zap.Bool("metric", f.defaultMetricFunctionsOverridden),
 		)
 	}
-	proc, err := metrics.NewProcessor(oCfg.MetricStatements, oCfg.ErrorMode, set.TelemetrySettings, f.metricFunctions, f.dataPointFunctions)
+	proc, err := newUserFunctionsProcessor(oCfg, func(userFunctions []ottl.UserFunction) (*metrics.Processor, error) {
+		return metrics.NewProcessor(oCfg.MetricStatements, oCfg.ErrorMode, set.TelemetrySettings, f.metricFunctions, f.dataPointFunctions, userFunctions)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
+		return nil, err
 	}
 	return processorhelper.NewMetrics(
 		ctx,
 		set,
 		cfg,
 		nextConsumer,
-		proc.ProcessMetrics,
-		processorhelper.WithCapabilities(processorCapabilities))
+		func(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+			return proc.processor.ProcessMetrics(ctx, md)
+		},
+		processorhelper.WithCapabilities(processorCapabilities),
+		processorhelper.WithStart(proc.start))
 }
 
 func (f *transformProcessorFactory) createProfilesProcessor(
@@ -300,17 +336,22 @@ func (f *transformProcessorFactory) createProfilesProcessor(
 	if f.defaultProfileFunctionsOverridden {
 		set.Logger.Debug("non-default OTTL profile functions have been registered in the \"transform\" processor", zap.Bool("profile", f.defaultProfileFunctionsOverridden))
 	}
-	proc, err := profiles.NewProcessor(oCfg.ProfileStatements, oCfg.ErrorMode, set.TelemetrySettings, f.profileFunctions)
+	proc, err := newUserFunctionsProcessor(oCfg, func(userFunctions []ottl.UserFunction) (*profiles.Processor, error) {
+		return profiles.NewProcessor(oCfg.ProfileStatements, oCfg.ErrorMode, set.TelemetrySettings, f.profileFunctions, userFunctions)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
+		return nil, err
 	}
 	return xprocessorhelper.NewProfiles(
 		ctx,
 		set,
 		cfg,
 		nextConsumer,
-		proc.ProcessProfiles,
-		xprocessorhelper.WithCapabilities(processorCapabilities))
+		func(ctx context.Context, pd pprofile.Profiles) (pprofile.Profiles, error) {
+			return proc.processor.ProcessProfiles(ctx, pd)
+		},
+		xprocessorhelper.WithCapabilities(processorCapabilities),
+		xprocessorhelper.WithStart(proc.start))
 }
 
 func fromNonPointerFunction[K any](legacy func(fCtx ottl.FunctionContext, args ottl.Arguments) (ottl.ExprFunc[K], error)) func(fCtx ottl.FunctionContext, args ottl.Arguments) (ottl.ExprFunc[*K], error) {
@@ -19,6 +19,7 @@ const (
 	Scope     ContextID = "scope"
 	Span      ContextID = "span"
 	SpanEvent ContextID = "spanevent"
+	SpanLink  ContextID = "spanlink"
 	Metric    ContextID = "metric"
 	DataPoint ContextID = "datapoint"
 	Log       ContextID = "log"
@@ -28,7 +29,7 @@ const (
 func (c *ContextID) UnmarshalText(text []byte) error {
 	str := ContextID(strings.ToLower(string(text)))
 	switch str {
-	case Resource, Scope, Span, SpanEvent, Metric, DataPoint, Log, Profile:
+	case Resource, Scope, Span, SpanEvent, SpanLink, Metric, DataPoint, Log, Profile:
 		*c = str
 		return nil
 	default:
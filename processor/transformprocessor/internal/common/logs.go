@@ -74,9 +74,9 @@ func WithLogErrorMode(errorMode ottl.ErrorMode) LogParserCollectionOption {
 	return LogParserCollectionOption(ottl.WithParserCollectionErrorMode[LogsConsumer](errorMode))
 }
 
-func NewLogParserCollection(settings component.TelemetrySettings, options ...LogParserCollectionOption) (*LogParserCollection, error) {
+func NewLogParserCollection(settings component.TelemetrySettings, userFunctions []ottl.UserFunction, options ...LogParserCollectionOption) (*LogParserCollection, error) {
 	pcOptions := []ottl.ParserCollectionOption[LogsConsumer]{
-		withCommonContextParsers[LogsConsumer](),
+		withCommonContextParsers[LogsConsumer](userFunctions),
 		ottl.EnableParserCollectionModifiedPathsLogging[LogsConsumer](true),
 	}
 
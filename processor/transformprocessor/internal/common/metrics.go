@@ -201,9 +201,9 @@ func WithMetricErrorMode(errorMode ottl.ErrorMode) MetricParserCollectionOption
 	return MetricParserCollectionOption(ottl.WithParserCollectionErrorMode[MetricsConsumer](errorMode))
 }
 
-func NewMetricParserCollection(settings component.TelemetrySettings, options ...MetricParserCollectionOption) (*MetricParserCollection, error) {
+func NewMetricParserCollection(settings component.TelemetrySettings, userFunctions []ottl.UserFunction, options ...MetricParserCollectionOption) (*MetricParserCollection, error) {
 	pcOptions := []ottl.ParserCollectionOption[MetricsConsumer]{
-		withCommonContextParsers[MetricsConsumer](),
+		withCommonContextParsers[MetricsConsumer](userFunctions),
 		ottl.EnableParserCollectionModifiedPathsLogging[MetricsConsumer](true),
 	}
 
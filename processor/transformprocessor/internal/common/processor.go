@@ -192,13 +192,23 @@ type baseContext interface {
 	ProfilesConsumer
 }
 
-func withCommonContextParsers[R any]() ottl.ParserCollectionOption[R] {
+// withCommonContextParsers adds the resource and scope parsers, with the given user-defined functions
+// in addition to the standard functions.
+func withCommonContextParsers[R any](userFunctions []ottl.UserFunction) ottl.ParserCollectionOption[R] {
 	return func(pc *ottl.ParserCollection[R]) error {
-		rp, err := ottlresource.NewParser(ResourceFunctions(), pc.Settings, ottlresource.EnablePathContextNames())
+		resourceFunctions, err := ottl.MergeUserFunctions(ResourceFunctions(), userFunctions)
 		if err != nil {
 			return err
 		}
-		sp, err := ottlscope.NewParser(ScopeFunctions(), pc.Settings, ottlscope.EnablePathContextNames())
+		scopeFunctions, err := ottl.MergeUserFunctions(ScopeFunctions(), userFunctions)
+		if err != nil {
+			return err
+		}
+		rp, err := ottlresource.NewParser(resourceFunctions, pc.Settings, ottlresource.EnablePathContextNames())
+		if err != nil {
+			return err
+		}
+		sp, err := ottlscope.NewParser(scopeFunctions, pc.Settings, ottlscope.EnablePathContextNames())
 		if err != nil {
 			return err
 		}
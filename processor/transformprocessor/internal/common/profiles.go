@@ -69,9 +69,9 @@ func WithProfileErrorMode(errorMode ottl.ErrorMode) ProfileParserCollectionOptio
 	return ProfileParserCollectionOption(ottl.WithParserCollectionErrorMode[ProfilesConsumer](errorMode))
 }
 
-func NewProfileParserCollection(settings component.TelemetrySettings, options ...ProfileParserCollectionOption) (*ProfileParserCollection, error) {
+func NewProfileParserCollection(settings component.TelemetrySettings, userFunctions []ottl.UserFunction, options ...ProfileParserCollectionOption) (*ProfileParserCollection, error) {
 	pcOptions := []ottl.ParserCollectionOption[ProfilesConsumer]{
-		withCommonContextParsers[ProfilesConsumer](),
+		withCommonContextParsers[ProfilesConsumer](userFunctions),
 		ottl.EnableParserCollectionModifiedPathsLogging[ProfilesConsumer](true),
 	}
 
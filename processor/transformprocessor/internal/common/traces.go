@@ -14,6 +14,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanlink"
 )
 
 type TracesConsumer interface {
@@ -97,6 +98,46 @@ func (s spanEventStatements) ConsumeTraces(ctx context.Context, td ptrace.Traces
 	return nil
 }
 
+type spanLinkStatements struct {
+	ottl.StatementSequence[*ottlspanlink.TransformContext]
+	expr.BoolExpr[*ottlspanlink.TransformContext]
+}
+
+func (spanLinkStatements) Context() ContextID {
+	return SpanLink
+}
+
+func (s spanLinkStatements) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rspans := td.ResourceSpans().At(i)
+		for j := 0; j < rspans.ScopeSpans().Len(); j++ {
+			sspans := rspans.ScopeSpans().At(j)
+			spans := sspans.Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				spanLinks := span.Links()
+				for n := 0; n < spanLinks.Len(); n++ {
+					tCtx := ottlspanlink.NewTransformContextPtr(spanLinks.At(n), span, sspans.Scope(), rspans.Resource(), sspans, rspans, ottlspanlink.WithLinkIndex(int64(n)))
+					condition, err := s.Eval(ctx, tCtx)
+					if err != nil {
+						tCtx.Close()
+						return err
+					}
+					if condition {
+						err = s.Execute(ctx, tCtx)
+						if err != nil {
+							tCtx.Close()
+							return err
+						}
+					}
+					tCtx.Close()
+				}
+			}
+		}
+	}
+	return nil
+}
+
 type TraceParserCollection ottl.ParserCollection[TracesConsumer]
 
 type TraceParserCollectionOption ottl.ParserCollectionOption[TracesConsumer]
@@ -121,13 +162,23 @@ func WithSpanEventParser(functions map[string]ottl.Factory[*ottlspanevent.Transf
 	}
 }
 
+func WithSpanLinkParser(functions map[string]ottl.Factory[*ottlspanlink.TransformContext]) TraceParserCollectionOption {
+	return func(pc *ottl.ParserCollection[TracesConsumer]) error {
+		parser, err := ottlspanlink.NewParser(functions, pc.Settings, ottlspanlink.EnablePathContextNames())
+		if err != nil {
+			return err
+		}
+		return ottl.WithParserCollectionContext(ottlspanlink.ContextName, &parser, ottl.WithStatementConverter(convertSpanLinkStatements))(pc)
+	}
+}
+
 func WithTraceErrorMode(errorMode ottl.ErrorMode) TraceParserCollectionOption {
 	return TraceParserCollectionOption(ottl.WithParserCollectionErrorMode[TracesConsumer](errorMode))
 }
 
-func NewTraceParserCollection(settings component.TelemetrySettings, options ...TraceParserCollectionOption) (*TraceParserCollection, error) {
+func NewTraceParserCollection(settings component.TelemetrySettings, userFunctions []ottl.UserFunction, options ...TraceParserCollectionOption) (*TraceParserCollection, error) {
 	pcOptions := []ottl.ParserCollectionOption[TracesConsumer]{
-		withCommonContextParsers[TracesConsumer](),
+		withCommonContextParsers[TracesConsumer](userFunctions),
 		ottl.EnableParserCollectionModifiedPathsLogging[TracesConsumer](true),
 	}
 
@@ -186,6 +237,27 @@ func convertSpanEventStatements(pc *ottl.ParserCollection[TracesConsumer], state
 	return spanEventStatements{seStatements, globalExpr}, nil
 }
 
+func convertSpanLinkStatements(pc *ottl.ParserCollection[TracesConsumer], statements ottl.StatementsGetter, parsedStatements []*ottl.Statement[*ottlspanlink.TransformContext]) (TracesConsumer, error) {
+	contextStatements, err := toContextStatements(statements)
+	if err != nil {
+		return nil, err
+	}
+	errorMode := pc.ErrorMode
+	if contextStatements.ErrorMode != "" {
+		errorMode = contextStatements.ErrorMode
+	}
+	var parserOptions []ottl.Option[*ottlspanlink.TransformContext]
+	if contextStatements.Context == "" {
+		parserOptions = append(parserOptions, ottlspanlink.EnablePathContextNames())
+	}
+	globalExpr, errGlobalBoolExpr := parseGlobalExpr(filterottl.NewBoolExprForSpanLinkWithOptions, contextStatements.Conditions, errorMode, pc.Settings, filterottl.StandardSpanLinkFuncs(), parserOptions)
+	if errGlobalBoolExpr != nil {
+		return nil, errGlobalBoolExpr
+	}
+	slStatements := ottlspanlink.NewStatementSequence(parsedStatements, pc.Settings, ottlspanlink.WithStatementSequenceErrorMode(errorMode))
+	return spanLinkStatements{slStatements, globalExpr}, nil
+}
+
 func (tpc *TraceParserCollection) ParseContextStatements(contextStatements ContextStatements) (TracesConsumer, error) {
 	pc := ottl.ParserCollection[TracesConsumer](*tpc)
 	if contextStatements.Context != "" {
@@ -0,0 +1,422 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/metrics"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+const (
+	// missingOperandsDrop skips the data points for which an operand has no data point.
+	missingOperandsDrop = "drop"
+	// missingOperandsZero uses 0 as the value of the operands having no data point.
+	missingOperandsZero = "zero"
+)
+
+type calculateMetricArguments struct {
+	Name            string
+	Expression      string
+	MissingOperands ottl.Optional[string]
+	Unit            ottl.Optional[ottl.StringGetter[*ottlmetric.TransformContext]]
+	Description     ottl.Optional[ottl.StringGetter[*ottlmetric.TransformContext]]
+}
+
+func newCalculateMetricFactory() ottl.Factory[*ottlmetric.TransformContext] {
+	return ottl.NewFactory("calculate_metric", &calculateMetricArguments{}, createCalculateMetricFunction)
+}
+
+func createCalculateMetricFunction(_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[*ottlmetric.TransformContext], error) {
+	args, ok := oArgs.(*calculateMetricArguments)
+
+	if !ok {
+		return nil, errors.New("createCalculateMetricFunction args must be of type *calculateMetricArguments")
+	}
+
+	return calculateMetric(args.Name, args.Expression, args.MissingOperands, args.Unit, args.Description)
+}
+
+func calculateMetric(name, expression string, missingOperands ottl.Optional[string], unit, desc ottl.Optional[ottl.StringGetter[*ottlmetric.TransformContext]]) (ottl.ExprFunc[*ottlmetric.TransformContext], error) {
+	if name == "" {
+		return nil, errors.New("the name of the calculated metric cannot be empty")
+	}
+	expr, operands, err := parseArithmeticExpression(expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expression %q: %w", expression, err)
+	}
+	if slices.Contains(operands, name) {
+		// the metric would be found again once it is added by an earlier call
+		return nil, fmt.Errorf("the expression %q cannot use the calculated metric %q", expression, name)
+	}
+	missing := missingOperandsDrop
+	if !missingOperands.IsEmpty() {
+		missing = missingOperands.Get()
+		if missing != missingOperandsDrop && missing != missingOperandsZero {
+			return nil, fmt.Errorf("invalid missing operands policy %q, must be %q or %q", missing, missingOperandsDrop, missingOperandsZero)
+		}
+	}
+
+	return func(ctx context.Context, tCtx *ottlmetric.TransformContext) (any, error) {
+		joined, err := joinOperands(tCtx.GetResourceMetrics(), operands)
+		if err != nil {
+			return nil, err
+		}
+
+		newMetric := pmetric.NewMetric()
+		newMetric.SetName(name)
+		dps := newMetric.SetEmptyGauge().DataPoints()
+		values := make(map[string]float64, len(operands))
+		var dropped int
+		var droppedErr error
+		for _, point := range joined {
+			if len(point.values) < len(operands) && missing == missingOperandsDrop {
+				continue
+			}
+			for _, operand := range operands {
+				values[operand] = point.values[operand]
+			}
+			value, err := expr.evaluate(values)
+			if err == nil && (math.IsNaN(value) || math.IsInf(value, 0)) {
+				err = fmt.Errorf("non-finite value %v", value)
+			}
+			if err != nil {
+				if dropped == 0 {
+					droppedErr = err
+				}
+				dropped++
+				continue
+			}
+			dp := dps.AppendEmpty()
+			point.attributes.CopyTo(dp.Attributes())
+			dp.SetStartTimestamp(point.startTimestamp)
+			dp.SetTimestamp(point.timestamp)
+			dp.SetDoubleValue(value)
+		}
+		if dropped > 0 {
+			droppedErr = fmt.Errorf("dropped %d data points of the calculated metric %q: %w", dropped, name, droppedErr)
+		}
+		if dps.Len() == 0 {
+			return nil, droppedErr
+		}
+
+		if !unit.IsEmpty() {
+			u, err := unit.Get().Get(ctx, tCtx)
+			if err != nil {
+				return nil, err
+			}
+			newMetric.SetUnit(u)
+		}
+
+		if !desc.IsEmpty() {
+			d, err := desc.Get().Get(ctx, tCtx)
+			if err != nil {
+				return nil, err
+			}
+			newMetric.SetDescription(d)
+		}
+
+		newMetric.MoveTo(tCtx.GetMetrics().AppendEmpty())
+		return nil, droppedErr
+	}, nil
+}
+
+// joinedPoint holds the values of the operands for the data points sharing the same attributes.
+type joinedPoint struct {
+	attributes     pcommon.Map
+	startTimestamp pcommon.Timestamp
+	timestamp      pcommon.Timestamp
+	values         map[string]float64
+}
+
+// joinOperands joins the data points of the operand metrics of the resource on their attributes.
+// An operand made of a single data point without attributes, like a total, is joined with every data point.
+func joinOperands(rm pmetric.ResourceMetrics, operands []string) ([]*joinedPoint, error) {
+	var points []*joinedPoint
+	byAttributes := map[[16]byte]*joinedPoint{}
+	scalars := map[string]pmetric.NumberDataPoint{}
+	var temporality pmetric.AggregationTemporality
+	var temporalityOperand string
+
+	for _, operand := range operands {
+		metric, found := findMetric(rm, operand)
+		if !found {
+			continue
+		}
+		var dps pmetric.NumberDataPointSlice
+		switch metric.Type() {
+		case pmetric.MetricTypeGauge:
+			dps = metric.Gauge().DataPoints()
+		case pmetric.MetricTypeSum:
+			if temporalityOperand == "" {
+				temporality, temporalityOperand = metric.Sum().AggregationTemporality(), operand
+			} else if metric.Sum().AggregationTemporality() != temporality {
+				return nil, fmt.Errorf("the sums %q and %q have different aggregation temporalities: %s and %s",
+					temporalityOperand, operand, temporality, metric.Sum().AggregationTemporality())
+			}
+			dps = metric.Sum().DataPoints()
+		default:
+			return nil, fmt.Errorf("the metric %q is a %s, only gauges and sums are supported", operand, metric.Type())
+		}
+
+		if dps.Len() == 1 && dps.At(0).Attributes().Len() == 0 {
+			scalars[operand] = dps.At(0)
+			continue
+		}
+		for i := range dps.Len() {
+			dp := dps.At(i)
+			key := pdatautil.MapHash(dp.Attributes())
+			point, ok := byAttributes[key]
+			if !ok {
+				point = &joinedPoint{attributes: dp.Attributes(), values: map[string]float64{}}
+				byAttributes[key] = point
+				points = append(points, point)
+			}
+			point.add(operand, dp)
+		}
+	}
+
+	if len(points) == 0 && len(scalars) > 0 {
+		points = append(points, &joinedPoint{attributes: pcommon.NewMap(), values: map[string]float64{}})
+	}
+	for _, point := range points {
+		for _, operand := range operands {
+			if dp, ok := scalars[operand]; ok {
+				point.add(operand, dp)
+			}
+		}
+	}
+	return points, nil
+}
+
+func (p *joinedPoint) add(operand string, dp pmetric.NumberDataPoint) {
+	switch dp.ValueType() {
+	case pmetric.NumberDataPointValueTypeInt:
+		p.values[operand] = float64(dp.IntValue())
+	case pmetric.NumberDataPointValueTypeDouble:
+		p.values[operand] = dp.DoubleValue()
+	default:
+		return
+	}
+	p.startTimestamp = max(p.startTimestamp, dp.StartTimestamp())
+	p.timestamp = max(p.timestamp, dp.Timestamp())
+}
+
+// findMetric returns the first metric with the given name in the scopes of the resource.
+func findMetric(rm pmetric.ResourceMetrics, name string) (pmetric.Metric, bool) {
+	for _, sm := range rm.ScopeMetrics().All() {
+		for _, metric := range sm.Metrics().All() {
+			if metric.Name() == name {
+				return metric, true
+			}
+		}
+	}
+	return pmetric.Metric{}, false
+}
+
+// arithmeticExpression is an arithmetic expression over the values of metrics.
+type arithmeticExpression interface {
+	evaluate(values map[string]float64) (float64, error)
+}
+
+type numberExpression float64
+
+func (n numberExpression) evaluate(map[string]float64) (float64, error) {
+	return float64(n), nil
+}
+
+type operandExpression string
+
+func (o operandExpression) evaluate(values map[string]float64) (float64, error) {
+	return values[string(o)], nil
+}
+
+type negateExpression struct {
+	operand arithmeticExpression
+}
+
+func (n negateExpression) evaluate(values map[string]float64) (float64, error) {
+	v, err := n.operand.evaluate(values)
+	return -v, err
+}
+
+type binaryExpression struct {
+	operator    byte
+	left, right arithmeticExpression
+}
+
+func (b binaryExpression) evaluate(values map[string]float64) (float64, error) {
+	left, err := b.left.evaluate(values)
+	if err != nil {
+		return 0, err
+	}
+	right, err := b.right.evaluate(values)
+	if err != nil {
+		return 0, err
+	}
+	switch b.operator {
+	case '+':
+		return left + right, nil
+	case '-':
+		return left - right, nil
+	case '*':
+		return left * right, nil
+	default:
+		if right == 0 {
+			return 0, errors.New("division by zero")
+		}
+		return left / right, nil
+	}
+}
+
+// parseArithmeticExpression parses an expression made of numbers, metric names, the +, -, * and /
+// operators and parentheses. Metric names containing other characters than letters, digits, '_' and '.'
+// are quoted with backticks. It returns the expression and the names of the metrics it uses.
+func parseArithmeticExpression(s string) (arithmeticExpression, []string, error) {
+	p := &expressionParser{input: s}
+	expr, err := p.parseSum()
+	if err != nil {
+		return nil, nil, err
+	}
+	p.skipSpaces()
+	if p.pos < len(p.input) {
+		return nil, nil, fmt.Errorf("unexpected %q at position %d", p.input[p.pos], p.pos)
+	}
+	if len(p.operands) == 0 {
+		return nil, nil, errors.New("the expression must use at least one metric")
+	}
+	return expr, p.operands, nil
+}
+
+type expressionParser struct {
+	input    string
+	pos      int
+	operands []string
+}
+
+func (p *expressionParser) skipSpaces() {
+	for p.pos < len(p.input) && p.input[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+// parseSum parses the terms separated by + and -.
+func (p *expressionParser) parseSum() (arithmeticExpression, error) {
+	left, err := p.parseProduct()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		p.skipSpaces()
+		if p.pos == len(p.input) || (p.input[p.pos] != '+' && p.input[p.pos] != '-') {
+			return left, nil
+		}
+		operator := p.input[p.pos]
+		p.pos++
+		right, err := p.parseProduct()
+		if err != nil {
+			return nil, err
+		}
+		left = binaryExpression{operator: operator, left: left, right: right}
+	}
+}
+
+// parseProduct parses the factors separated by * and /.
+func (p *expressionParser) parseProduct() (arithmeticExpression, error) {
+	left, err := p.parseFactor()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		p.skipSpaces()
+		if p.pos == len(p.input) || (p.input[p.pos] != '*' && p.input[p.pos] != '/') {
+			return left, nil
+		}
+		operator := p.input[p.pos]
+		p.pos++
+		right, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		left = binaryExpression{operator: operator, left: left, right: right}
+	}
+}
+
+// parseFactor parses a number, a metric name, a negated factor or a parenthesized expression.
+func (p *expressionParser) parseFactor() (arithmeticExpression, error) {
+	p.skipSpaces()
+	if p.pos == len(p.input) {
+		return nil, errors.New("unexpected end of expression")
+	}
+	c := p.input[p.pos]
+	switch {
+	case c == '-':
+		p.pos++
+		operand, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		return negateExpression{operand: operand}, nil
+	case c == '(':
+		p.pos++
+		expr, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpaces()
+		if p.pos == len(p.input) || p.input[p.pos] != ')' {
+			return nil, fmt.Errorf("missing ')' at position %d", p.pos)
+		}
+		p.pos++
+		return expr, nil
+	case c == '`':
+		end := strings.IndexByte(p.input[p.pos+1:], '`')
+		if end <= 0 {
+			return nil, fmt.Errorf("unterminated or empty quoted metric name at position %d", p.pos)
+		}
+		name := p.input[p.pos+1 : p.pos+1+end]
+		p.pos += end + 2
+		return p.operand(name), nil
+	case c >= '0' && c <= '9' || c == '.':
+		start := p.pos
+		for p.pos < len(p.input) && (p.input[p.pos] >= '0' && p.input[p.pos] <= '9' || p.input[p.pos] == '.') {
+			p.pos++
+		}
+		n, err := strconv.ParseFloat(p.input[start:p.pos], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", p.input[start:p.pos])
+		}
+		return numberExpression(n), nil
+	case isNameChar(c):
+		start := p.pos
+		for p.pos < len(p.input) && (isNameChar(p.input[p.pos]) || p.input[p.pos] >= '0' && p.input[p.pos] <= '9' || p.input[p.pos] == '.') {
+			p.pos++
+		}
+		return p.operand(p.input[start:p.pos]), nil
+	default:
+		return nil, fmt.Errorf("unexpected %q at position %d", c, p.pos)
+	}
+}
+
+func (p *expressionParser) operand(name string) arithmeticExpression {
+	if !slices.Contains(p.operands, name) {
+		p.operands = append(p.operands, name)
+	}
+	return operandExpression(name)
+}
+
+func isNameChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_'
+}
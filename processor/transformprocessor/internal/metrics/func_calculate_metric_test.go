@@ -0,0 +1,262 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"context"
+	"maps"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+)
+
+// appendTestSum appends a cumulative sum to the metrics, with a data point for each route and value.
+func appendTestSum(ms pmetric.MetricSlice, name string, values map[string]int64) {
+	metric := ms.AppendEmpty()
+	metric.SetName(name)
+	sum := metric.SetEmptySum()
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	for _, route := range slices.Sorted(maps.Keys(values)) {
+		dp := sum.DataPoints().AppendEmpty()
+		dp.Attributes().PutStr("route", route)
+		dp.SetStartTimestamp(pcommon.Timestamp(1))
+		dp.SetTimestamp(pcommon.Timestamp(10))
+		dp.SetIntValue(values[route])
+	}
+}
+
+func Test_calculateMetric(t *testing.T) {
+	tests := []struct {
+		testName        string
+		expression      string
+		missingOperands ottl.Optional[string]
+		unit            ottl.Optional[ottl.StringGetter[*ottlmetric.TransformContext]]
+		input           func(rm pmetric.ResourceMetrics)
+		want            map[string]float64
+		err             string
+	}{
+		{
+			testName:   "ratio",
+			expression: "http.server.errors / http.server.requests",
+			input: func(rm pmetric.ResourceMetrics) {
+				ms := rm.ScopeMetrics().At(0).Metrics()
+				appendTestSum(ms, "http.server.requests", map[string]int64{"/a": 10, "/b": 20, "/c": 5})
+				appendTestSum(ms, "http.server.errors", map[string]int64{"/a": 1, "/b": 5})
+			},
+			want: map[string]float64{"/a": 0.1, "/b": 0.25},
+		},
+		{
+			testName:        "missing operands are zero",
+			expression:      "http.server.errors / http.server.requests",
+			missingOperands: ottl.NewTestingOptional[string]("zero"),
+			input: func(rm pmetric.ResourceMetrics) {
+				ms := rm.ScopeMetrics().At(0).Metrics()
+				appendTestSum(ms, "http.server.requests", map[string]int64{"/a": 10, "/c": 5})
+				appendTestSum(ms, "http.server.errors", map[string]int64{"/a": 1, "/b": 5})
+			},
+			// the data point of /b is dropped because of the division by zero
+			want: map[string]float64{"/a": 0.1, "/c": 0},
+			err:  `dropped 1 data points of the calculated metric "http.server.ratio": division by zero`,
+		},
+		{
+			testName:   "non-finite values",
+			expression: "http.server.errors * http.server.requests",
+			input: func(rm pmetric.ResourceMetrics) {
+				ms := rm.ScopeMetrics().At(0).Metrics()
+				appendTestSum(ms, "http.server.requests", map[string]int64{"/a": 10, "/b": 20})
+				metric := ms.AppendEmpty()
+				metric.SetName("http.server.errors")
+				dp := metric.SetEmptyGauge().DataPoints().AppendEmpty()
+				dp.Attributes().PutStr("route", "/a")
+				dp.SetDoubleValue(math.Inf(1))
+				dp = metric.Gauge().DataPoints().AppendEmpty()
+				dp.Attributes().PutStr("route", "/b")
+				dp.SetDoubleValue(math.NaN())
+			},
+			err: `dropped 2 data points of the calculated metric "http.server.ratio": non-finite value +Inf`,
+		},
+		{
+			testName:   "operands in other scopes",
+			expression: "100 * (`http.server.requests` - http.server.errors) / http.server.requests",
+			unit: ottl.NewTestingOptional[ottl.StringGetter[*ottlmetric.TransformContext]](ottl.StandardStringGetter[*ottlmetric.TransformContext]{
+				Getter: func(context.Context, *ottlmetric.TransformContext) (any, error) {
+					return "%", nil
+				},
+			}),
+			input: func(rm pmetric.ResourceMetrics) {
+				appendTestSum(rm.ScopeMetrics().At(0).Metrics(), "http.server.requests", map[string]int64{"/a": 10})
+				appendTestSum(rm.ScopeMetrics().AppendEmpty().Metrics(), "http.server.errors", map[string]int64{"/a": 1})
+			},
+			want: map[string]float64{"/a": 90},
+		},
+		{
+			testName:   "operand without attributes is joined with every data point",
+			expression: "http.server.requests / total",
+			input: func(rm pmetric.ResourceMetrics) {
+				ms := rm.ScopeMetrics().At(0).Metrics()
+				appendTestSum(ms, "http.server.requests", map[string]int64{"/a": 10, "/b": 30})
+				total := ms.AppendEmpty()
+				total.SetName("total")
+				total.SetEmptyGauge().DataPoints().AppendEmpty().SetDoubleValue(40)
+			},
+			want: map[string]float64{"/a": 0.25, "/b": 0.75},
+		},
+		{
+			testName:   "missing metric",
+			expression: "http.server.errors / http.server.requests",
+			input: func(rm pmetric.ResourceMetrics) {
+				appendTestSum(rm.ScopeMetrics().At(0).Metrics(), "http.server.requests", map[string]int64{"/a": 10})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			rm := pmetric.NewResourceMetrics()
+			rm.ScopeMetrics().AppendEmpty()
+			tt.input(rm)
+			sm := rm.ScopeMetrics().At(0)
+			numMetrics := sm.Metrics().Len()
+
+			exprFunc, err := calculateMetric("http.server.ratio", tt.expression, tt.missingOperands, tt.unit, ottl.Optional[ottl.StringGetter[*ottlmetric.TransformContext]]{})
+			require.NoError(t, err)
+			tCtx := ottlmetric.NewTransformContextPtr(rm, sm, sm.Metrics().At(0))
+			defer tCtx.Close()
+			_, err = exprFunc(t.Context(), tCtx)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tt.want == nil {
+				assert.Equal(t, numMetrics, sm.Metrics().Len())
+				return
+			}
+			require.Equal(t, numMetrics+1, sm.Metrics().Len())
+			expected := pmetric.NewMetric()
+			expected.SetName("http.server.ratio")
+			if !tt.unit.IsEmpty() {
+				expected.SetUnit("%")
+			}
+			dps := expected.SetEmptyGauge().DataPoints()
+			for _, route := range slices.Sorted(maps.Keys(tt.want)) {
+				dp := dps.AppendEmpty()
+				dp.Attributes().PutStr("route", route)
+				dp.SetStartTimestamp(pcommon.Timestamp(1))
+				dp.SetTimestamp(pcommon.Timestamp(10))
+				dp.SetDoubleValue(tt.want[route])
+			}
+			assert.NoError(t, pmetrictest.CompareMetric(expected, sm.Metrics().At(numMetrics)))
+		})
+	}
+}
+
+func Test_calculateMetric_temporality(t *testing.T) {
+	rm := pmetric.NewResourceMetrics()
+	ms := rm.ScopeMetrics().AppendEmpty().Metrics()
+	appendTestSum(ms, "http.server.requests", map[string]int64{"/a": 10})
+	appendTestSum(ms, "http.server.errors", map[string]int64{"/a": 1})
+	ms.At(1).Sum().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+
+	exprFunc, err := calculateMetric("http.server.ratio", "http.server.errors / http.server.requests", ottl.Optional[string]{}, ottl.Optional[ottl.StringGetter[*ottlmetric.TransformContext]]{}, ottl.Optional[ottl.StringGetter[*ottlmetric.TransformContext]]{})
+	require.NoError(t, err)
+	tCtx := ottlmetric.NewTransformContextPtr(rm, rm.ScopeMetrics().At(0), ms.At(0))
+	defer tCtx.Close()
+	_, err = exprFunc(t.Context(), tCtx)
+	assert.EqualError(t, err, `the sums "http.server.errors" and "http.server.requests" have different aggregation temporalities: Delta and Cumulative`)
+}
+
+func Test_calculateMetric_invalidArguments(t *testing.T) {
+	tests := []struct {
+		testName        string
+		name            string
+		expression      string
+		missingOperands ottl.Optional[string]
+		err             string
+	}{
+		{
+			testName:   "empty name",
+			expression: "a / b",
+			err:        "the name of the calculated metric cannot be empty",
+		},
+		{
+			testName:   "no metric",
+			name:       "ratio",
+			expression: "1 / 2",
+			err:        `invalid expression "1 / 2": the expression must use at least one metric`,
+		},
+		{
+			testName:   "unbalanced parentheses",
+			name:       "ratio",
+			expression: "(a / b",
+			err:        `invalid expression "(a / b": missing ')' at position 6`,
+		},
+		{
+			testName:   "unexpected character",
+			name:       "ratio",
+			expression: "a % b",
+			err:        `invalid expression "a % b": unexpected '%' at position 2`,
+		},
+		{
+			testName:   "missing operand",
+			name:       "ratio",
+			expression: "a /",
+			err:        `invalid expression "a /": unexpected end of expression`,
+		},
+		{
+			testName:   "calculated metric as operand",
+			name:       "ratio",
+			expression: "ratio / b",
+			err:        `the expression "ratio / b" cannot use the calculated metric "ratio"`,
+		},
+		{
+			testName:        "invalid missing operands policy",
+			name:            "ratio",
+			expression:      "a / b",
+			missingOperands: ottl.NewTestingOptional[string]("ignore"),
+			err:             `invalid missing operands policy "ignore", must be "drop" or "zero"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			_, err := calculateMetric(tt.name, tt.expression, tt.missingOperands, ottl.Optional[ottl.StringGetter[*ottlmetric.TransformContext]]{}, ottl.Optional[ottl.StringGetter[*ottlmetric.TransformContext]]{})
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func Test_parseArithmeticExpression(t *testing.T) {
+	tests := []struct {
+		expression string
+		values     map[string]float64
+		want       float64
+		operands   []string
+	}{
+		{expression: "a + b * c", values: map[string]float64{"a": 1, "b": 2, "c": 3}, want: 7, operands: []string{"a", "b", "c"}},
+		{expression: "(a + b) * c", values: map[string]float64{"a": 1, "b": 2, "c": 3}, want: 9, operands: []string{"a", "b", "c"}},
+		{expression: "a - b - c", values: map[string]float64{"a": 10, "b": 2, "c": 3}, want: 5, operands: []string{"a", "b", "c"}},
+		{expression: "-a / 0.5", values: map[string]float64{"a": 2}, want: -4, operands: []string{"a"}},
+		{expression: "`http-requests/total` / a.b_c", values: map[string]float64{"http-requests/total": 6, "a.b_c": 3}, want: 2, operands: []string{"http-requests/total", "a.b_c"}},
+		{expression: "a * a", values: map[string]float64{"a": 3}, want: 9, operands: []string{"a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			expr, operands, err := parseArithmeticExpression(tt.expression)
+			require.NoError(t, err)
+			assert.Equal(t, tt.operands, operands)
+			value, err := expr.evaluate(tt.values)
+			require.NoError(t, err)
+			assert.InDelta(t, tt.want, value, 1e-9)
+		})
+	}
+}
@@ -49,6 +49,7 @@ func MetricFunctions() map[string]ottl.Factory[*ottlmetric.TransformContext] {
 		newconvertExponentialHistToExplicitHistFactory(),
 		newAggregateOnAttributeValueFactory(),
 		newConvertSummaryQuantileValToGaugeFactory(),
+		newCalculateMetricFactory(),
 	)
 
 	maps.Copy(functions, metricFunctions)
@@ -22,8 +22,16 @@ type Processor struct {
 	logger   *zap.Logger
 }
 
-func NewProcessor(contextStatements []common.ContextStatements, errorMode ottl.ErrorMode, settings component.TelemetrySettings, metricFunctions map[string]ottl.Factory[*ottlmetric.TransformContext], dataPointFunctions map[string]ottl.Factory[*ottldatapoint.TransformContext]) (*Processor, error) {
-	pc, err := common.NewMetricParserCollection(settings, common.WithMetricParser(metricFunctions), common.WithDataPointParser(dataPointFunctions), common.WithMetricErrorMode(errorMode))
+func NewProcessor(contextStatements []common.ContextStatements, errorMode ottl.ErrorMode, settings component.TelemetrySettings, metricFunctions map[string]ottl.Factory[*ottlmetric.TransformContext], dataPointFunctions map[string]ottl.Factory[*ottldatapoint.TransformContext], userFunctions []ottl.UserFunction) (*Processor, error) {
+	metricFunctions, err := ottl.MergeUserFunctions(metricFunctions, userFunctions)
+	if err != nil {
+		return nil, err
+	}
+	dataPointFunctions, err = ottl.MergeUserFunctions(dataPointFunctions, userFunctions)
+	if err != nil {
+		return nil, err
+	}
+	pc, err := common.NewMetricParserCollection(settings, userFunctions, common.WithMetricParser(metricFunctions), common.WithDataPointParser(dataPointFunctions), common.WithMetricErrorMode(errorMode))
 	if err != nil {
 		return nil, err
 	}
@@ -21,8 +21,12 @@ type Processor struct {
 	logger   *zap.Logger
 }
 
-func NewProcessor(contextStatements []common.ContextStatements, errorMode ottl.ErrorMode, settings component.TelemetrySettings, profileFunctions map[string]ottl.Factory[ottlprofile.TransformContext]) (*Processor, error) {
-	pc, err := common.NewProfileParserCollection(settings, common.WithProfileParser(profileFunctions), common.WithProfileErrorMode(errorMode))
+func NewProcessor(contextStatements []common.ContextStatements, errorMode ottl.ErrorMode, settings component.TelemetrySettings, profileFunctions map[string]ottl.Factory[ottlprofile.TransformContext], userFunctions []ottl.UserFunction) (*Processor, error) {
+	profileFunctions, err := ottl.MergeUserFunctions(profileFunctions, userFunctions)
+	if err != nil {
+		return nil, err
+	}
+	pc, err := common.NewProfileParserCollection(settings, userFunctions, common.WithProfileParser(profileFunctions), common.WithProfileErrorMode(errorMode))
 	if err != nil {
 		return nil, err
 	}
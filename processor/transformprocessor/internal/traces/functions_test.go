@@ -11,6 +11,7 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanlink"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
 )
 
@@ -34,3 +35,12 @@ func Test_SpanEventFunctions(t *testing.T) {
 		assert.Contains(t, expected, k)
 	}
 }
+
+func Test_SpanLinkFunctions(t *testing.T) {
+	expected := ottlfuncs.StandardFuncs[*ottlspanlink.TransformContext]()
+	actual := SpanLinkFunctions()
+	require.Len(t, actual, len(expected))
+	for k := range actual {
+		assert.Contains(t, expected, k)
+	}
+}
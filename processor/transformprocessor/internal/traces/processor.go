@@ -14,6 +14,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanlink"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
 )
 
@@ -22,8 +23,20 @@ type Processor struct {
 	logger   *zap.Logger
 }
 
-func NewProcessor(contextStatements []common.ContextStatements, errorMode ottl.ErrorMode, settings component.TelemetrySettings, spanFunctions map[string]ottl.Factory[*ottlspan.TransformContext], spanEventFunctions map[string]ottl.Factory[*ottlspanevent.TransformContext]) (*Processor, error) {
-	pc, err := common.NewTraceParserCollection(settings, common.WithSpanParser(spanFunctions), common.WithSpanEventParser(spanEventFunctions), common.WithTraceErrorMode(errorMode))
+func NewProcessor(contextStatements []common.ContextStatements, errorMode ottl.ErrorMode, settings component.TelemetrySettings, spanFunctions map[string]ottl.Factory[*ottlspan.TransformContext], spanEventFunctions map[string]ottl.Factory[*ottlspanevent.TransformContext], spanLinkFunctions map[string]ottl.Factory[*ottlspanlink.TransformContext], userFunctions []ottl.UserFunction) (*Processor, error) {
+	spanFunctions, err := ottl.MergeUserFunctions(spanFunctions, userFunctions)
+	if err != nil {
+		return nil, err
+	}
+	spanEventFunctions, err = ottl.MergeUserFunctions(spanEventFunctions, userFunctions)
+	if err != nil {
+		return nil, err
+	}
+	spanLinkFunctions, err = ottl.MergeUserFunctions(spanLinkFunctions, userFunctions)
+	if err != nil {
+		return nil, err
+	}
+	pc, err := common.NewTraceParserCollection(settings, userFunctions, common.WithSpanParser(spanFunctions), common.WithSpanEventParser(spanEventFunctions), common.WithSpanLinkParser(spanLinkFunctions), common.WithTraceErrorMode(errorMode))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transformprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor"
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// userFunctionsProcessor creates the signal processor with the user-defined functions of the configuration.
+// When function libraries are configured, the signal processor is only created once the processor starts,
+// since the libraries are extensions of the host.
+type userFunctionsProcessor[P any] struct {
+	functions    []ottl.UserFunction
+	libraries    []ottl.UserFunctionLibraryConfig
+	newProcessor func(userFunctions []ottl.UserFunction) (P, error)
+	processor    P
+}
+
+func newUserFunctionsProcessor[P any](cfg *Config, newProcessor func(userFunctions []ottl.UserFunction) (P, error)) (*userFunctionsProcessor[P], error) {
+	p := &userFunctionsProcessor[P]{
+		functions:    cfg.Functions,
+		libraries:    cfg.FunctionLibraries,
+		newProcessor: newProcessor,
+	}
+	if len(p.libraries) > 0 {
+		return p, nil
+	}
+	var err error
+	if p.processor, err = newProcessor(p.functions); err != nil {
+		return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
+	}
+	return p, nil
+}
+
+func (p *userFunctionsProcessor[P]) start(_ context.Context, host component.Host) error {
+	if len(p.libraries) == 0 {
+		return nil
+	}
+	libraryFunctions, err := ottl.GetUserFunctionLibraries(host, p.libraries)
+	if err != nil {
+		return err
+	}
+	if p.processor, err = p.newProcessor(append(slices.Clone(p.functions), libraryFunctions...)); err != nil {
+		return fmt.Errorf("invalid config for \"transform\" processor %w", err)
+	}
+	return nil
+}
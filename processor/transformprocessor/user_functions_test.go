@@ -0,0 +1,154 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transformprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/confmap/xconfmap"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor/processortest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/metadata"
+)
+
+type testFunctionLibrary struct {
+	component.StartFunc
+	component.ShutdownFunc
+	functions []ottl.UserFunction
+}
+
+func (l testFunctionLibrary) UserFunctions() []ottl.UserFunction {
+	return l.functions
+}
+
+type testLibraryHost struct {
+	component.Host
+	extensions map[component.ID]component.Component
+}
+
+func (h testLibraryHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+func TestUserFunctions(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.Functions = []ottl.UserFunction{
+		{
+			Signature:  "NormalizeMethod(method string)",
+			Expression: `ToUpperCase(method)`,
+		},
+		{
+			Signature: "tag(key string, value)",
+			Statements: []string{
+				`set(log.attributes[key], value) where value != nil`,
+			},
+		},
+	}
+	cfg.LogStatements = []common.ContextStatements{
+		{
+			Statements: []string{
+				`tag("http.request.method", NormalizeMethod(log.attributes["http.method"]))`,
+				`set(resource.attributes["service.method"], NormalizeMethod(resource.attributes["service.method"]))`,
+			},
+		},
+	}
+	require.NoError(t, xconfmap.Validate(cfg))
+
+	lp, err := factory.CreateLogs(t.Context(), processortest.NewNopSettings(metadata.Type), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	require.NoError(t, lp.Start(t.Context(), componenttest.NewNopHost()))
+
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.method", "post")
+	log := rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	log.Attributes().PutStr("http.method", "get")
+	require.NoError(t, lp.ConsumeLogs(t.Context(), ld))
+	require.NoError(t, lp.Shutdown(t.Context()))
+
+	assert.Equal(t, map[string]any{"http.method": "get", "http.request.method": "GET"}, log.Attributes().AsRaw())
+	assert.Equal(t, map[string]any{"service.method": "POST"}, rl.Resource().Attributes().AsRaw())
+}
+
+func TestUserFunctions_NameConflict(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.Functions = []ottl.UserFunction{
+		{
+			Signature:  "ToUpperCase(value)",
+			Expression: `value`,
+		},
+	}
+	cfg.LogStatements = []common.ContextStatements{
+		{Statements: []string{`set(log.body, ToUpperCase(log.body))`}},
+	}
+	assert.ErrorContains(t, xconfmap.Validate(cfg), `user-defined function "ToUpperCase": a function with the same name already exists`)
+
+	_, err := factory.CreateLogs(t.Context(), processortest.NewNopSettings(metadata.Type), cfg, consumertest.NewNop())
+	assert.ErrorContains(t, err, `user-defined function "ToUpperCase": a function with the same name already exists`)
+}
+
+func TestFunctionLibraries(t *testing.T) {
+	libraryID := component.MustNewIDWithName("ottl_functions", "http")
+	host := testLibraryHost{extensions: map[component.ID]component.Component{
+		libraryID: testFunctionLibrary{functions: []ottl.UserFunction{
+			{
+				Signature: "set_route(route string)",
+				Statements: []string{
+					`set(span.attributes["http.route"], route)`,
+					`set(span.name, Concat([span.attributes["http.request.method"], route], " "))`,
+				},
+			},
+		}},
+	}}
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.FunctionLibraries = []ottl.UserFunctionLibraryConfig{{ID: libraryID, Functions: []string{"set_route"}}}
+	cfg.TraceStatements = []common.ContextStatements{
+		{
+			Statements: []string{`set_route("/users/{id}") where IsMatch(span.attributes["url.path"], "^/users/[0-9]+$")`},
+		},
+	}
+	// the statements are validated with the declared functions of the libraries
+	require.NoError(t, xconfmap.Validate(cfg))
+
+	tp, err := factory.CreateTraces(t.Context(), processortest.NewNopSettings(metadata.Type), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	require.NoError(t, tp.Start(t.Context(), host))
+
+	td := ptrace.NewTraces()
+	span := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetName("GET")
+	span.Attributes().PutStr("http.request.method", "GET")
+	span.Attributes().PutStr("url.path", "/users/42")
+	require.NoError(t, tp.ConsumeTraces(t.Context(), td))
+	require.NoError(t, tp.Shutdown(t.Context()))
+
+	assert.Equal(t, "GET /users/{id}", span.Name())
+	route, ok := span.Attributes().Get("http.route")
+	require.True(t, ok)
+	assert.Equal(t, "/users/{id}", route.Str())
+
+	tp, err = factory.CreateTraces(t.Context(), processortest.NewNopSettings(metadata.Type), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	assert.ErrorContains(t, tp.Start(t.Context(), componenttest.NewNopHost()), `function library "ottl_functions/http" not found`)
+
+	cfg.TraceStatements = []common.ContextStatements{
+		{Statements: []string{`set_name("/users/{id}")`}},
+	}
+	tp, err = factory.CreateTraces(t.Context(), processortest.NewNopSettings(metadata.Type), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	assert.ErrorContains(t, tp.Start(t.Context(), host), `invalid config for "transform" processor`)
+}
@@ -0,0 +1,208 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cgroupscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cgroupscraper"
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/shirou/gopsutil/v4/common"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/gopsutilenv"
+)
+
+// errNoUnifiedHierarchy is returned when no cgroup v2 hierarchy is mounted.
+var errNoUnifiedHierarchy = errors.New("no cgroup v2 hierarchy found")
+
+// ioStat is a single device line of an io.stat file.
+type ioStat struct {
+	device string
+	rbytes int64
+	wbytes int64
+	rios   int64
+	wios   int64
+}
+
+// hierarchyRoot returns the mount point of the cgroup v2 hierarchy. On hosts
+// in hybrid mode the unified hierarchy is mounted below the v1 controllers.
+func hierarchyRoot(ctx context.Context) (string, error) {
+	mount := gopsutilenv.GetEnvWithContext(ctx, string(common.HostSysEnvKey), "/sys", "fs", "cgroup")
+	for _, root := range []string{mount, filepath.Join(mount, "unified")} {
+		if _, err := os.Stat(filepath.Join(root, "cgroup.controllers")); err == nil {
+			return root, nil
+		}
+	}
+	return "", fmt.Errorf("%w in %s", errNoUnifiedHierarchy, mount)
+}
+
+// walkCgroups calls fn for the cgroup at cgroupPath and for its descendants
+// up to maxDepth levels below it. fn receives the path of each cgroup relative
+// to the hierarchy root and its directory.
+func walkCgroups(root, cgroupPath string, maxDepth int, fn func(cgroupPath, dir string)) error {
+	base := filepath.Join(root, filepath.FromSlash(cgroupPath))
+	return filepath.WalkDir(base, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if p == base {
+				return err
+			}
+			// The cgroup might have been removed while walking the tree.
+			return nil
+		}
+		if !d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(base, p)
+		if err != nil {
+			return err
+		}
+		depth := 0
+		if rel != "." {
+			depth = strings.Count(rel, string(filepath.Separator)) + 1
+		}
+		if depth > maxDepth {
+			return fs.SkipDir
+		}
+
+		fn(path.Join(cgroupPath, filepath.ToSlash(rel)), p)
+		return nil
+	})
+}
+
+// parseFlatKeyed parses cgroup files holding one "key value" pair per line,
+// such as cpu.stat and memory.events.
+func parseFlatKeyed(r io.Reader) (map[string]int64, error) {
+	values := map[string]int64{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid line %q", scanner.Text())
+		}
+		value, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %s: %w", fields[0], err)
+		}
+		values[fields[0]] = value
+	}
+	return values, scanner.Err()
+}
+
+// parseIOStat parses an io.stat file, e.g.:
+//
+//	8:0 rbytes=1459200 wbytes=314773504 rios=192 wios=353 dbytes=0 dios=0
+func parseIOStat(r io.Reader) ([]ioStat, error) {
+	var stats []ioStat
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		stat := ioStat{device: fields[0]}
+		for _, field := range fields[1:] {
+			key, value, ok := strings.Cut(field, "=")
+			if !ok {
+				return nil, fmt.Errorf("invalid field %q", field)
+			}
+
+			var dst *int64
+			switch key {
+			case "rbytes":
+				dst = &stat.rbytes
+			case "wbytes":
+				dst = &stat.wbytes
+			case "rios":
+				dst = &stat.rios
+			case "wios":
+				dst = &stat.wios
+			default:
+				continue
+			}
+
+			var err error
+			if *dst, err = strconv.ParseInt(value, 10, 64); err != nil {
+				return nil, fmt.Errorf("invalid value for %s: %w", key, err)
+			}
+		}
+		stats = append(stats, stat)
+	}
+	return stats, scanner.Err()
+}
+
+// readSingleValue reads a cgroup file holding a single value, such as
+// memory.current. The boolean is false if the value is "max", i.e. unlimited.
+func readSingleValue(file string) (int64, bool, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return 0, false, err
+	}
+	value := string(bytes.TrimSpace(content))
+	if value == "max" {
+		return 0, false, nil
+	}
+	v, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid value in %s: %w", file, err)
+	}
+	return v, true, nil
+}
+
+func readFlatKeyed(file string) (map[string]int64, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	values, err := parseFlatKeyed(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", file, err)
+	}
+	return values, nil
+}
+
+func readIOStat(file string) ([]ioStat, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	stats, err := parseIOStat(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", file, err)
+	}
+	return stats, nil
+}
+
+// deviceName resolves a "major:minor" block device number to its kernel
+// name, e.g. "sda". The number itself is returned if it can't be resolved.
+func deviceName(ctx context.Context, device string) string {
+	uevent := gopsutilenv.GetEnvWithContext(ctx, string(common.HostSysEnvKey), "/sys", "dev", "block", device, "uevent")
+	content, err := os.ReadFile(uevent)
+	if err != nil {
+		return device
+	}
+	for _, line := range strings.Split(string(content), "\n") {
+		if name, ok := strings.CutPrefix(line, "DEVNAME="); ok {
+			return name
+		}
+	}
+	return device
+}
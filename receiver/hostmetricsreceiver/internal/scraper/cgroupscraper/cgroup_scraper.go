@@ -0,0 +1,159 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cgroupscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cgroupscraper"
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/scraper"
+	"go.opentelemetry.io/collector/scraper/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cgroupscraper/internal/metadata"
+)
+
+const (
+	cpuMetricsLen    = 4
+	ioMetricsLen     = 2
+	memoryMetricsLen = 1
+)
+
+// cgroupScraper for cgroup v2 metrics
+type cgroupScraper struct {
+	settings scraper.Settings
+	config   *Config
+	mb       *metadata.MetricsBuilder
+}
+
+// newCgroupScraper creates a metric scraper for cgroup v2 metrics
+func newCgroupScraper(settings scraper.Settings, cfg *Config) *cgroupScraper {
+	return &cgroupScraper{
+		settings: settings,
+		config:   cfg,
+	}
+}
+
+func (s *cgroupScraper) start(context.Context, component.Host) error {
+	s.mb = metadata.NewMetricsBuilder(s.config.MetricsBuilderConfig, s.settings)
+	return nil
+}
+
+func (s *cgroupScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	root, err := hierarchyRoot(ctx)
+	if err != nil {
+		return pmetric.NewMetrics(), err
+	}
+
+	var errs scrapererror.ScrapeErrors
+	now := pcommon.NewTimestampFromTime(time.Now())
+	devices := map[string]string{}
+
+	err = walkCgroups(root, s.config.Path, s.config.MaxDepth, func(cgroupPath, dir string) {
+		s.recordCPUMetrics(now, dir, &errs)
+		s.recordMemoryMetrics(now, dir, &errs)
+		s.recordIOMetrics(ctx, now, dir, devices, &errs)
+
+		rb := s.mb.NewResourceBuilder()
+		rb.SetCgroupPath(cgroupPath)
+		s.mb.EmitForResource(metadata.WithResource(rb.Emit()))
+	})
+	if err != nil {
+		return pmetric.NewMetrics(), err
+	}
+
+	return s.mb.Emit(), errs.Combine()
+}
+
+func (s *cgroupScraper) recordCPUMetrics(now pcommon.Timestamp, dir string, errs *scrapererror.ScrapeErrors) {
+	stat, err := readFlatKeyed(filepath.Join(dir, "cpu.stat"))
+	if err != nil {
+		addPartialUnlessNotExist(errs, cpuMetricsLen, err)
+		return
+	}
+
+	if v, ok := stat["user_usec"]; ok {
+		s.mb.RecordSystemCgroupCPUTimeDataPoint(now, usecToSeconds(v), metadata.AttributeStateUser)
+	}
+	if v, ok := stat["system_usec"]; ok {
+		s.mb.RecordSystemCgroupCPUTimeDataPoint(now, usecToSeconds(v), metadata.AttributeStateSystem)
+	}
+	// Bandwidth statistics are only reported when the cpu controller is
+	// enabled for the cgroup.
+	if v, ok := stat["nr_periods"]; ok {
+		s.mb.RecordSystemCgroupCPUPeriodsDataPoint(now, v)
+	}
+	if v, ok := stat["nr_throttled"]; ok {
+		s.mb.RecordSystemCgroupCPUThrottledPeriodsDataPoint(now, v)
+	}
+	if v, ok := stat["throttled_usec"]; ok {
+		s.mb.RecordSystemCgroupCPUThrottledTimeDataPoint(now, usecToSeconds(v))
+	}
+}
+
+func (s *cgroupScraper) recordMemoryMetrics(now pcommon.Timestamp, dir string, errs *scrapererror.ScrapeErrors) {
+	if current, _, err := readSingleValue(filepath.Join(dir, "memory.current")); err == nil {
+		s.mb.RecordSystemCgroupMemoryUsageDataPoint(now, current)
+	} else {
+		addPartialUnlessNotExist(errs, memoryMetricsLen, err)
+	}
+
+	if limit, limited, err := readSingleValue(filepath.Join(dir, "memory.max")); err == nil {
+		if limited {
+			s.mb.RecordSystemCgroupMemoryLimitDataPoint(now, limit)
+		}
+	} else {
+		addPartialUnlessNotExist(errs, memoryMetricsLen, err)
+	}
+
+	events, err := readFlatKeyed(filepath.Join(dir, "memory.events"))
+	if err != nil {
+		addPartialUnlessNotExist(errs, memoryMetricsLen, err)
+		return
+	}
+	for name, v := range events {
+		if event, ok := metadata.MapAttributeEvent[name]; ok {
+			s.mb.RecordSystemCgroupMemoryEventsDataPoint(now, v, event)
+		}
+	}
+}
+
+func (s *cgroupScraper) recordIOMetrics(ctx context.Context, now pcommon.Timestamp, dir string, devices map[string]string, errs *scrapererror.ScrapeErrors) {
+	stats, err := readIOStat(filepath.Join(dir, "io.stat"))
+	if err != nil {
+		addPartialUnlessNotExist(errs, ioMetricsLen, err)
+		return
+	}
+
+	for _, stat := range stats {
+		device, ok := devices[stat.device]
+		if !ok {
+			device = deviceName(ctx, stat.device)
+			devices[stat.device] = device
+		}
+		s.mb.RecordSystemCgroupIoBytesDataPoint(now, stat.rbytes, device, metadata.AttributeDirectionRead)
+		s.mb.RecordSystemCgroupIoBytesDataPoint(now, stat.wbytes, device, metadata.AttributeDirectionWrite)
+		s.mb.RecordSystemCgroupIoOperationsDataPoint(now, stat.rios, device, metadata.AttributeDirectionRead)
+		s.mb.RecordSystemCgroupIoOperationsDataPoint(now, stat.wios, device, metadata.AttributeDirectionWrite)
+	}
+}
+
+// addPartialUnlessNotExist records err as a partial scrape error. Missing
+// files are ignored since they only mean that the corresponding controller
+// is not enabled for the cgroup, or that the cgroup has been removed.
+func addPartialUnlessNotExist(errs *scrapererror.ScrapeErrors, failed int, err error) {
+	if errors.Is(err, fs.ErrNotExist) {
+		return
+	}
+	errs.AddPartial(failed, err)
+}
+
+func usecToSeconds(usec int64) float64 {
+	return float64(usec) / 1e6
+}
@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package cgroupscraper
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/shirou/gopsutil/v4/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/scraper/scrapertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cgroupscraper/internal/metadata"
+)
+
+func withHostSys(ctx context.Context, sys string) context.Context {
+	return context.WithValue(ctx, common.EnvKey, common.EnvMap{common.HostSysEnvKey: sys})
+}
+
+func TestScrape(t *testing.T) {
+	ctx := withHostSys(t.Context(), filepath.Join("testdata", "sys"))
+	cfg := createDefaultConfig().(*Config)
+	cfg.Path = "/system.slice"
+	scraper := newCgroupScraper(scrapertest.NewNopSettings(metadata.Type), cfg)
+	require.NoError(t, scraper.start(ctx, componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(ctx)
+	require.NoError(t, err)
+
+	byCgroup := map[string]map[string]pmetric.Metric{}
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		path, ok := rms.At(i).Resource().Attributes().Get("cgroup.path")
+		require.True(t, ok)
+		metrics := map[string]pmetric.Metric{}
+		ms := rms.At(i).ScopeMetrics().At(0).Metrics()
+		for j := 0; j < ms.Len(); j++ {
+			metrics[ms.At(j).Name()] = ms.At(j)
+		}
+		byCgroup[path.Str()] = metrics
+	}
+	require.Len(t, byCgroup, 3)
+
+	redis := byCgroup["/system.slice/redis.service"]
+	require.Len(t, redis, 9)
+	cpuTime := redis["system.cgroup.cpu.time"].Sum().DataPoints()
+	require.Equal(t, 2, cpuTime.Len())
+	for i := 0; i < cpuTime.Len(); i++ {
+		state, _ := cpuTime.At(i).Attributes().Get("state")
+		switch state.Str() {
+		case "user":
+			assert.InDelta(t, 1.5, cpuTime.At(i).DoubleValue(), 1e-9)
+		case "system":
+			assert.InDelta(t, 0.5, cpuTime.At(i).DoubleValue(), 1e-9)
+		default:
+			assert.Failf(t, "unexpected state", "%s", state.Str())
+		}
+	}
+	assert.Equal(t, int64(50), redis["system.cgroup.cpu.periods"].Sum().DataPoints().At(0).IntValue())
+	assert.Equal(t, int64(5), redis["system.cgroup.cpu.throttled_periods"].Sum().DataPoints().At(0).IntValue())
+	assert.InDelta(t, 0.2, redis["system.cgroup.cpu.throttled_time"].Sum().DataPoints().At(0).DoubleValue(), 1e-9)
+	assert.Equal(t, int64(52428800), redis["system.cgroup.memory.usage"].Sum().DataPoints().At(0).IntValue())
+	assert.Equal(t, int64(268435456), redis["system.cgroup.memory.limit"].Sum().DataPoints().At(0).IntValue())
+	assert.Equal(t, 6, redis["system.cgroup.memory.events"].Sum().DataPoints().Len())
+	ioBytes := redis["system.cgroup.io.bytes"].Sum().DataPoints()
+	require.Equal(t, 2, ioBytes.Len())
+	device, _ := ioBytes.At(0).Attributes().Get("device")
+	assert.Equal(t, "8:0", device.Str())
+	assert.Equal(t, 2, redis["system.cgroup.io.operations"].Sum().DataPoints().Len())
+
+	// memory.max is "max", so no limit is reported.
+	slice := byCgroup["/system.slice"]
+	assert.Contains(t, slice, "system.cgroup.memory.usage")
+	assert.NotContains(t, slice, "system.cgroup.memory.limit")
+
+	// Only cpu.stat without bandwidth statistics is available.
+	cron := byCgroup["/system.slice/cron.service"]
+	assert.Len(t, cron, 1)
+	assert.Contains(t, cron, "system.cgroup.cpu.time")
+}
+
+func TestScrape_InvalidFile(t *testing.T) {
+	sys := t.TempDir()
+	root := filepath.Join(sys, "fs", "cgroup")
+	require.NoError(t, os.MkdirAll(root, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "cgroup.controllers"), []byte("cpu memory\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "cpu.stat"), []byte("user_usec abc\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "memory.current"), []byte("1024\n"), 0o600))
+
+	ctx := withHostSys(t.Context(), sys)
+	scraper := newCgroupScraper(scrapertest.NewNopSettings(metadata.Type), createDefaultConfig().(*Config))
+	require.NoError(t, scraper.start(ctx, componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(ctx)
+	assert.ErrorContains(t, err, "invalid value for user_usec")
+	assert.Equal(t, 1, md.MetricCount())
+}
+
+func TestHierarchyRoot(t *testing.T) {
+	unified := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(unified, "fs", "cgroup"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(unified, "fs", "cgroup", "cgroup.controllers"), nil, 0o600))
+
+	hybrid := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(hybrid, "fs", "cgroup", "unified"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(hybrid, "fs", "cgroup", "unified", "cgroup.controllers"), nil, 0o600))
+
+	root, err := hierarchyRoot(withHostSys(t.Context(), unified))
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(unified, "fs", "cgroup"), root)
+
+	root, err = hierarchyRoot(withHostSys(t.Context(), hybrid))
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(hybrid, "fs", "cgroup", "unified"), root)
+
+	_, err = hierarchyRoot(withHostSys(t.Context(), t.TempDir()))
+	assert.ErrorIs(t, err, errNoUnifiedHierarchy)
+}
+
+func TestDeviceName(t *testing.T) {
+	sys := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(sys, "dev", "block", "8:0"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(sys, "dev", "block", "8:0", "uevent"), []byte("MAJOR=8\nMINOR=0\nDEVNAME=sda\nDEVTYPE=disk\n"), 0o600))
+
+	ctx := withHostSys(t.Context(), sys)
+	assert.Equal(t, "sda", deviceName(ctx, "8:0"))
+	assert.Equal(t, "8:16", deviceName(ctx, "8:16"))
+}
@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cgroupscraper
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testRoot = filepath.Join("testdata", "sys", "fs", "cgroup")
+
+func TestWalkCgroups(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		maxDepth int
+		expected []string
+	}{
+		{
+			name:     "root only",
+			path:     "/",
+			maxDepth: 0,
+			expected: []string{"/"},
+		},
+		{
+			name:     "root and slices",
+			path:     "/",
+			maxDepth: 1,
+			expected: []string{"/", "/system.slice", "/user.slice"},
+		},
+		{
+			name:     "whole tree",
+			path:     "/",
+			maxDepth: 10,
+			expected: []string{"/", "/system.slice", "/system.slice/cron.service", "/system.slice/redis.service", "/user.slice", "/user.slice/user-1000.slice"},
+		},
+		{
+			name:     "subtree",
+			path:     "/system.slice",
+			maxDepth: 1,
+			expected: []string{"/system.slice", "/system.slice/cron.service", "/system.slice/redis.service"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var visited []string
+			err := walkCgroups(testRoot, tt.path, tt.maxDepth, func(cgroupPath, dir string) {
+				assert.Equal(t, filepath.Join(testRoot, filepath.FromSlash(cgroupPath)), dir)
+				visited = append(visited, cgroupPath)
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, visited)
+		})
+	}
+}
+
+func TestWalkCgroups_MissingPath(t *testing.T) {
+	err := walkCgroups(testRoot, "/missing.slice", 1, func(string, string) {
+		assert.Fail(t, "no cgroup should be visited")
+	})
+	assert.Error(t, err)
+}
+
+func TestParseFlatKeyed(t *testing.T) {
+	values, err := parseFlatKeyed(strings.NewReader("usage_usec 100\nuser_usec 60\n\nsystem_usec 40\n"))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"usage_usec": 100, "user_usec": 60, "system_usec": 40}, values)
+
+	_, err = parseFlatKeyed(strings.NewReader("usage_usec 100 200\n"))
+	assert.ErrorContains(t, err, "invalid line")
+
+	_, err = parseFlatKeyed(strings.NewReader("usage_usec abc\n"))
+	assert.ErrorContains(t, err, "invalid value for usage_usec")
+}
+
+func TestParseIOStat(t *testing.T) {
+	stats, err := parseIOStat(strings.NewReader(
+		"8:0 rbytes=1459200 wbytes=314773504 rios=192 wios=353 dbytes=0 dios=0\n" +
+			"253:1 rbytes=4096 wbytes=0 rios=1 wios=0 dbytes=512 dios=1\n",
+	))
+	require.NoError(t, err)
+	assert.Equal(t, []ioStat{
+		{device: "8:0", rbytes: 1459200, wbytes: 314773504, rios: 192, wios: 353},
+		{device: "253:1", rbytes: 4096, rios: 1},
+	}, stats)
+
+	_, err = parseIOStat(strings.NewReader("8:0 rbytes\n"))
+	assert.ErrorContains(t, err, `invalid field "rbytes"`)
+
+	_, err = parseIOStat(strings.NewReader("8:0 rbytes=-\n"))
+	assert.ErrorContains(t, err, "invalid value for rbytes")
+}
+
+func TestReadSingleValue(t *testing.T) {
+	v, limited, err := readSingleValue(filepath.Join(testRoot, "system.slice", "redis.service", "memory.max"))
+	require.NoError(t, err)
+	assert.True(t, limited)
+	assert.Equal(t, int64(268435456), v)
+
+	_, limited, err = readSingleValue(filepath.Join(testRoot, "system.slice", "memory.max"))
+	require.NoError(t, err)
+	assert.False(t, limited)
+
+	_, _, err = readSingleValue(filepath.Join(testRoot, "system.slice", "missing"))
+	assert.Error(t, err)
+}
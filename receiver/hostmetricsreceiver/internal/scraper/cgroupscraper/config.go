@@ -0,0 +1,37 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cgroupscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cgroupscraper"
+
+import (
+	"errors"
+	"path"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cgroupscraper/internal/metadata"
+)
+
+// Config relating to cgroup Metric Scraper.
+type Config struct {
+	// Path is the cgroup subtree to report on, relative to the root of the
+	// cgroup v2 hierarchy, e.g. "/system.slice".
+	Path string `mapstructure:"path"`
+	// MaxDepth is the number of levels of descendant cgroups below Path to
+	// report on. 0 reports only on Path itself.
+	MaxDepth int `mapstructure:"max_depth"`
+	// MetricsBuilderConfig allows to customize scraped metrics/attributes representation.
+	metadata.MetricsBuilderConfig `mapstructure:",squash"`
+}
+
+func (cfg *Config) Validate() error {
+	if !strings.HasPrefix(cfg.Path, "/") {
+		return errors.New("path must be absolute")
+	}
+	if path.Clean(cfg.Path) != cfg.Path {
+		return errors.New("path must be clean")
+	}
+	if cfg.MaxDepth < 0 {
+		return errors.New("max_depth must not be negative")
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cgroupscraper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		path        string
+		maxDepth    int
+		expectedErr string
+	}{
+		{name: "default", path: "/", maxDepth: 1},
+		{name: "subtree", path: "/system.slice", maxDepth: 0},
+		{name: "relative path", path: "system.slice", expectedErr: "path must be absolute"},
+		{name: "unclean path", path: "/system.slice/", expectedErr: "path must be clean"},
+		{name: "parent path", path: "/system.slice/../..", expectedErr: "path must be clean"},
+		{name: "negative depth", path: "/", maxDepth: -1, expectedErr: "max_depth must not be negative"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Path = tt.path
+			cfg.MaxDepth = tt.maxDepth
+			err := cfg.Validate()
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
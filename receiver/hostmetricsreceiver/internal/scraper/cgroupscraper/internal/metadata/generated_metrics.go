@@ -0,0 +1,849 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/filter"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/scraper"
+	conventions "go.opentelemetry.io/otel/semconv/v1.9.0"
+)
+
+// AttributeDirection specifies the value direction attribute.
+type AttributeDirection int
+
+const (
+	_ AttributeDirection = iota
+	AttributeDirectionRead
+	AttributeDirectionWrite
+)
+
+// String returns the string representation of the AttributeDirection.
+func (av AttributeDirection) String() string {
+	switch av {
+	case AttributeDirectionRead:
+		return "read"
+	case AttributeDirectionWrite:
+		return "write"
+	}
+	return ""
+}
+
+// MapAttributeDirection is a helper map of string to AttributeDirection attribute value.
+var MapAttributeDirection = map[string]AttributeDirection{
+	"read":  AttributeDirectionRead,
+	"write": AttributeDirectionWrite,
+}
+
+// AttributeEvent specifies the value event attribute.
+type AttributeEvent int
+
+const (
+	_ AttributeEvent = iota
+	AttributeEventLow
+	AttributeEventHigh
+	AttributeEventMax
+	AttributeEventOom
+	AttributeEventOomKill
+	AttributeEventOomGroupKill
+)
+
+// String returns the string representation of the AttributeEvent.
+func (av AttributeEvent) String() string {
+	switch av {
+	case AttributeEventLow:
+		return "low"
+	case AttributeEventHigh:
+		return "high"
+	case AttributeEventMax:
+		return "max"
+	case AttributeEventOom:
+		return "oom"
+	case AttributeEventOomKill:
+		return "oom_kill"
+	case AttributeEventOomGroupKill:
+		return "oom_group_kill"
+	}
+	return ""
+}
+
+// MapAttributeEvent is a helper map of string to AttributeEvent attribute value.
+var MapAttributeEvent = map[string]AttributeEvent{
+	"low":            AttributeEventLow,
+	"high":           AttributeEventHigh,
+	"max":            AttributeEventMax,
+	"oom":            AttributeEventOom,
+	"oom_kill":       AttributeEventOomKill,
+	"oom_group_kill": AttributeEventOomGroupKill,
+}
+
+// AttributeState specifies the value state attribute.
+type AttributeState int
+
+const (
+	_ AttributeState = iota
+	AttributeStateSystem
+	AttributeStateUser
+)
+
+// String returns the string representation of the AttributeState.
+func (av AttributeState) String() string {
+	switch av {
+	case AttributeStateSystem:
+		return "system"
+	case AttributeStateUser:
+		return "user"
+	}
+	return ""
+}
+
+// MapAttributeState is a helper map of string to AttributeState attribute value.
+var MapAttributeState = map[string]AttributeState{
+	"system": AttributeStateSystem,
+	"user":   AttributeStateUser,
+}
+
+var MetricsInfo = metricsInfo{
+	SystemCgroupCPUPeriods: metricInfo{
+		Name: "system.cgroup.cpu.periods",
+	},
+	SystemCgroupCPUThrottledPeriods: metricInfo{
+		Name: "system.cgroup.cpu.throttled_periods",
+	},
+	SystemCgroupCPUThrottledTime: metricInfo{
+		Name: "system.cgroup.cpu.throttled_time",
+	},
+	SystemCgroupCPUTime: metricInfo{
+		Name: "system.cgroup.cpu.time",
+	},
+	SystemCgroupIoBytes: metricInfo{
+		Name: "system.cgroup.io.bytes",
+	},
+	SystemCgroupIoOperations: metricInfo{
+		Name: "system.cgroup.io.operations",
+	},
+	SystemCgroupMemoryEvents: metricInfo{
+		Name: "system.cgroup.memory.events",
+	},
+	SystemCgroupMemoryLimit: metricInfo{
+		Name: "system.cgroup.memory.limit",
+	},
+	SystemCgroupMemoryUsage: metricInfo{
+		Name: "system.cgroup.memory.usage",
+	},
+}
+
+type metricsInfo struct {
+	SystemCgroupCPUPeriods          metricInfo
+	SystemCgroupCPUThrottledPeriods metricInfo
+	SystemCgroupCPUThrottledTime    metricInfo
+	SystemCgroupCPUTime             metricInfo
+	SystemCgroupIoBytes             metricInfo
+	SystemCgroupIoOperations        metricInfo
+	SystemCgroupMemoryEvents        metricInfo
+	SystemCgroupMemoryLimit         metricInfo
+	SystemCgroupMemoryUsage         metricInfo
+}
+
+type metricInfo struct {
+	Name string
+}
+
+type metricSystemCgroupCPUPeriods struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.cgroup.cpu.periods metric with initial data.
+func (m *metricSystemCgroupCPUPeriods) init() {
+	m.data.SetName("system.cgroup.cpu.periods")
+	m.data.SetDescription("Number of CPU bandwidth enforcement periods that have elapsed.")
+	m.data.SetUnit("{period}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricSystemCgroupCPUPeriods) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemCgroupCPUPeriods) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemCgroupCPUPeriods) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemCgroupCPUPeriods(cfg MetricConfig) metricSystemCgroupCPUPeriods {
+	m := metricSystemCgroupCPUPeriods{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemCgroupCPUThrottledPeriods struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.cgroup.cpu.throttled_periods metric with initial data.
+func (m *metricSystemCgroupCPUThrottledPeriods) init() {
+	m.data.SetName("system.cgroup.cpu.throttled_periods")
+	m.data.SetDescription("Number of CPU bandwidth enforcement periods in which the cgroup was throttled.")
+	m.data.SetUnit("{period}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricSystemCgroupCPUThrottledPeriods) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemCgroupCPUThrottledPeriods) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemCgroupCPUThrottledPeriods) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemCgroupCPUThrottledPeriods(cfg MetricConfig) metricSystemCgroupCPUThrottledPeriods {
+	m := metricSystemCgroupCPUThrottledPeriods{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemCgroupCPUThrottledTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.cgroup.cpu.throttled_time metric with initial data.
+func (m *metricSystemCgroupCPUThrottledTime) init() {
+	m.data.SetName("system.cgroup.cpu.throttled_time")
+	m.data.SetDescription("Total time the cgroup was throttled for exceeding its CPU bandwidth limit.")
+	m.data.SetUnit("s")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricSystemCgroupCPUThrottledTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemCgroupCPUThrottledTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemCgroupCPUThrottledTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemCgroupCPUThrottledTime(cfg MetricConfig) metricSystemCgroupCPUThrottledTime {
+	m := metricSystemCgroupCPUThrottledTime{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemCgroupCPUTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.cgroup.cpu.time metric with initial data.
+func (m *metricSystemCgroupCPUTime) init() {
+	m.data.SetName("system.cgroup.cpu.time")
+	m.data.SetDescription("Total CPU time consumed by tasks in the cgroup.")
+	m.data.SetUnit("s")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemCgroupCPUTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, stateAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("state", stateAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemCgroupCPUTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemCgroupCPUTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemCgroupCPUTime(cfg MetricConfig) metricSystemCgroupCPUTime {
+	m := metricSystemCgroupCPUTime{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemCgroupIoBytes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.cgroup.io.bytes metric with initial data.
+func (m *metricSystemCgroupIoBytes) init() {
+	m.data.SetName("system.cgroup.io.bytes")
+	m.data.SetDescription("Bytes read from or written to block devices by tasks in the cgroup.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemCgroupIoBytes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, deviceAttributeValue string, directionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("device", deviceAttributeValue)
+	dp.Attributes().PutStr("direction", directionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemCgroupIoBytes) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemCgroupIoBytes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemCgroupIoBytes(cfg MetricConfig) metricSystemCgroupIoBytes {
+	m := metricSystemCgroupIoBytes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemCgroupIoOperations struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.cgroup.io.operations metric with initial data.
+func (m *metricSystemCgroupIoOperations) init() {
+	m.data.SetName("system.cgroup.io.operations")
+	m.data.SetDescription("Read or write operations issued to block devices by tasks in the cgroup.")
+	m.data.SetUnit("{operation}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemCgroupIoOperations) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, deviceAttributeValue string, directionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("device", deviceAttributeValue)
+	dp.Attributes().PutStr("direction", directionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemCgroupIoOperations) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemCgroupIoOperations) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemCgroupIoOperations(cfg MetricConfig) metricSystemCgroupIoOperations {
+	m := metricSystemCgroupIoOperations{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemCgroupMemoryEvents struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.cgroup.memory.events metric with initial data.
+func (m *metricSystemCgroupMemoryEvents) init() {
+	m.data.SetName("system.cgroup.memory.events")
+	m.data.SetDescription("Number of times memory events occurred in the cgroup, as reported in memory.events.")
+	m.data.SetUnit("{event}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemCgroupMemoryEvents) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, eventAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("event", eventAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemCgroupMemoryEvents) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemCgroupMemoryEvents) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemCgroupMemoryEvents(cfg MetricConfig) metricSystemCgroupMemoryEvents {
+	m := metricSystemCgroupMemoryEvents{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemCgroupMemoryLimit struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.cgroup.memory.limit metric with initial data.
+func (m *metricSystemCgroupMemoryLimit) init() {
+	m.data.SetName("system.cgroup.memory.limit")
+	m.data.SetDescription("Memory usage hard limit of the cgroup (memory.max). Not reported if the cgroup has no limit.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricSystemCgroupMemoryLimit) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemCgroupMemoryLimit) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemCgroupMemoryLimit) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemCgroupMemoryLimit(cfg MetricConfig) metricSystemCgroupMemoryLimit {
+	m := metricSystemCgroupMemoryLimit{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemCgroupMemoryUsage struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.cgroup.memory.usage metric with initial data.
+func (m *metricSystemCgroupMemoryUsage) init() {
+	m.data.SetName("system.cgroup.memory.usage")
+	m.data.SetDescription("Total memory currently used by the cgroup and its descendants (memory.current).")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricSystemCgroupMemoryUsage) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemCgroupMemoryUsage) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemCgroupMemoryUsage) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemCgroupMemoryUsage(cfg MetricConfig) metricSystemCgroupMemoryUsage {
+	m := metricSystemCgroupMemoryUsage{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+// MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
+// required to produce metric representation defined in metadata and user config.
+type MetricsBuilder struct {
+	config                                MetricsBuilderConfig // config of the metrics builder.
+	startTime                             pcommon.Timestamp    // start time that will be applied to all recorded data points.
+	metricsCapacity                       int                  // maximum observed number of metrics per resource.
+	metricsBuffer                         pmetric.Metrics      // accumulates metrics data before emitting.
+	buildInfo                             component.BuildInfo  // contains version information.
+	resourceAttributeIncludeFilter        map[string]filter.Filter
+	resourceAttributeExcludeFilter        map[string]filter.Filter
+	metricSystemCgroupCPUPeriods          metricSystemCgroupCPUPeriods
+	metricSystemCgroupCPUThrottledPeriods metricSystemCgroupCPUThrottledPeriods
+	metricSystemCgroupCPUThrottledTime    metricSystemCgroupCPUThrottledTime
+	metricSystemCgroupCPUTime             metricSystemCgroupCPUTime
+	metricSystemCgroupIoBytes             metricSystemCgroupIoBytes
+	metricSystemCgroupIoOperations        metricSystemCgroupIoOperations
+	metricSystemCgroupMemoryEvents        metricSystemCgroupMemoryEvents
+	metricSystemCgroupMemoryLimit         metricSystemCgroupMemoryLimit
+	metricSystemCgroupMemoryUsage         metricSystemCgroupMemoryUsage
+}
+
+// MetricBuilderOption applies changes to default metrics builder.
+type MetricBuilderOption interface {
+	apply(*MetricsBuilder)
+}
+
+type metricBuilderOptionFunc func(mb *MetricsBuilder)
+
+func (mbof metricBuilderOptionFunc) apply(mb *MetricsBuilder) {
+	mbof(mb)
+}
+
+// WithStartTime sets startTime on the metrics builder.
+func WithStartTime(startTime pcommon.Timestamp) MetricBuilderOption {
+	return metricBuilderOptionFunc(func(mb *MetricsBuilder) {
+		mb.startTime = startTime
+	})
+}
+func NewMetricsBuilder(mbc MetricsBuilderConfig, settings scraper.Settings, options ...MetricBuilderOption) *MetricsBuilder {
+	mb := &MetricsBuilder{
+		config:                                mbc,
+		startTime:                             pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                         pmetric.NewMetrics(),
+		buildInfo:                             settings.BuildInfo,
+		metricSystemCgroupCPUPeriods:          newMetricSystemCgroupCPUPeriods(mbc.Metrics.SystemCgroupCPUPeriods),
+		metricSystemCgroupCPUThrottledPeriods: newMetricSystemCgroupCPUThrottledPeriods(mbc.Metrics.SystemCgroupCPUThrottledPeriods),
+		metricSystemCgroupCPUThrottledTime:    newMetricSystemCgroupCPUThrottledTime(mbc.Metrics.SystemCgroupCPUThrottledTime),
+		metricSystemCgroupCPUTime:             newMetricSystemCgroupCPUTime(mbc.Metrics.SystemCgroupCPUTime),
+		metricSystemCgroupIoBytes:             newMetricSystemCgroupIoBytes(mbc.Metrics.SystemCgroupIoBytes),
+		metricSystemCgroupIoOperations:        newMetricSystemCgroupIoOperations(mbc.Metrics.SystemCgroupIoOperations),
+		metricSystemCgroupMemoryEvents:        newMetricSystemCgroupMemoryEvents(mbc.Metrics.SystemCgroupMemoryEvents),
+		metricSystemCgroupMemoryLimit:         newMetricSystemCgroupMemoryLimit(mbc.Metrics.SystemCgroupMemoryLimit),
+		metricSystemCgroupMemoryUsage:         newMetricSystemCgroupMemoryUsage(mbc.Metrics.SystemCgroupMemoryUsage),
+		resourceAttributeIncludeFilter:        make(map[string]filter.Filter),
+		resourceAttributeExcludeFilter:        make(map[string]filter.Filter),
+	}
+	if mbc.ResourceAttributes.CgroupPath.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["cgroup.path"] = filter.CreateFilter(mbc.ResourceAttributes.CgroupPath.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.CgroupPath.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["cgroup.path"] = filter.CreateFilter(mbc.ResourceAttributes.CgroupPath.MetricsExclude)
+	}
+
+	for _, op := range options {
+		op.apply(mb)
+	}
+	return mb
+}
+
+// NewResourceBuilder returns a new resource builder that should be used to build a resource associated with for the emitted metrics.
+func (mb *MetricsBuilder) NewResourceBuilder() *ResourceBuilder {
+	return NewResourceBuilder(mb.config.ResourceAttributes)
+}
+
+// updateCapacity updates max length of metrics and resource attributes that will be used for the slice capacity.
+func (mb *MetricsBuilder) updateCapacity(rm pmetric.ResourceMetrics) {
+	if mb.metricsCapacity < rm.ScopeMetrics().At(0).Metrics().Len() {
+		mb.metricsCapacity = rm.ScopeMetrics().At(0).Metrics().Len()
+	}
+}
+
+// ResourceMetricsOption applies changes to provided resource metrics.
+type ResourceMetricsOption interface {
+	apply(pmetric.ResourceMetrics)
+}
+
+type resourceMetricsOptionFunc func(pmetric.ResourceMetrics)
+
+func (rmof resourceMetricsOptionFunc) apply(rm pmetric.ResourceMetrics) {
+	rmof(rm)
+}
+
+// WithResource sets the provided resource on the emitted ResourceMetrics.
+// It's recommended to use ResourceBuilder to create the resource.
+func WithResource(res pcommon.Resource) ResourceMetricsOption {
+	return resourceMetricsOptionFunc(func(rm pmetric.ResourceMetrics) {
+		res.CopyTo(rm.Resource())
+	})
+}
+
+// WithStartTimeOverride overrides start time for all the resource metrics data points.
+// This option should be only used if different start time has to be set on metrics coming from different resources.
+func WithStartTimeOverride(start pcommon.Timestamp) ResourceMetricsOption {
+	return resourceMetricsOptionFunc(func(rm pmetric.ResourceMetrics) {
+		var dps pmetric.NumberDataPointSlice
+		metrics := rm.ScopeMetrics().At(0).Metrics()
+		for i := 0; i < metrics.Len(); i++ {
+			switch metrics.At(i).Type() {
+			case pmetric.MetricTypeGauge:
+				dps = metrics.At(i).Gauge().DataPoints()
+			case pmetric.MetricTypeSum:
+				dps = metrics.At(i).Sum().DataPoints()
+			}
+			for j := 0; j < dps.Len(); j++ {
+				dps.At(j).SetStartTimestamp(start)
+			}
+		}
+	})
+}
+
+// EmitForResource saves all the generated metrics under a new resource and updates the internal state to be ready for
+// recording another set of data points as part of another resource. This function can be helpful when one scraper
+// needs to emit metrics from several resources. Otherwise calling this function is not required,
+// just `Emit` function can be called instead.
+// Resource attributes should be provided as ResourceMetricsOption arguments.
+func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
+	rm := pmetric.NewResourceMetrics()
+	rm.SetSchemaUrl(conventions.SchemaURL)
+	ils := rm.ScopeMetrics().AppendEmpty()
+	ils.Scope().SetName(ScopeName)
+	ils.Scope().SetVersion(mb.buildInfo.Version)
+	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
+	mb.metricSystemCgroupCPUPeriods.emit(ils.Metrics())
+	mb.metricSystemCgroupCPUThrottledPeriods.emit(ils.Metrics())
+	mb.metricSystemCgroupCPUThrottledTime.emit(ils.Metrics())
+	mb.metricSystemCgroupCPUTime.emit(ils.Metrics())
+	mb.metricSystemCgroupIoBytes.emit(ils.Metrics())
+	mb.metricSystemCgroupIoOperations.emit(ils.Metrics())
+	mb.metricSystemCgroupMemoryEvents.emit(ils.Metrics())
+	mb.metricSystemCgroupMemoryLimit.emit(ils.Metrics())
+	mb.metricSystemCgroupMemoryUsage.emit(ils.Metrics())
+
+	for _, op := range options {
+		op.apply(rm)
+	}
+	for attr, filter := range mb.resourceAttributeIncludeFilter {
+		if val, ok := rm.Resource().Attributes().Get(attr); ok && !filter.Matches(val.AsString()) {
+			return
+		}
+	}
+	for attr, filter := range mb.resourceAttributeExcludeFilter {
+		if val, ok := rm.Resource().Attributes().Get(attr); ok && filter.Matches(val.AsString()) {
+			return
+		}
+	}
+
+	if ils.Metrics().Len() > 0 {
+		mb.updateCapacity(rm)
+		rm.MoveTo(mb.metricsBuffer.ResourceMetrics().AppendEmpty())
+	}
+}
+
+// Emit returns all the metrics accumulated by the metrics builder and updates the internal state to be ready for
+// recording another set of metrics. This function will be responsible for applying all the transformations required to
+// produce metric representation defined in metadata and user config, e.g. delta or cumulative.
+func (mb *MetricsBuilder) Emit(options ...ResourceMetricsOption) pmetric.Metrics {
+	mb.EmitForResource(options...)
+	metrics := mb.metricsBuffer
+	mb.metricsBuffer = pmetric.NewMetrics()
+	return metrics
+}
+
+// RecordSystemCgroupCPUPeriodsDataPoint adds a data point to system.cgroup.cpu.periods metric.
+func (mb *MetricsBuilder) RecordSystemCgroupCPUPeriodsDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSystemCgroupCPUPeriods.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSystemCgroupCPUThrottledPeriodsDataPoint adds a data point to system.cgroup.cpu.throttled_periods metric.
+func (mb *MetricsBuilder) RecordSystemCgroupCPUThrottledPeriodsDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSystemCgroupCPUThrottledPeriods.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSystemCgroupCPUThrottledTimeDataPoint adds a data point to system.cgroup.cpu.throttled_time metric.
+func (mb *MetricsBuilder) RecordSystemCgroupCPUThrottledTimeDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricSystemCgroupCPUThrottledTime.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSystemCgroupCPUTimeDataPoint adds a data point to system.cgroup.cpu.time metric.
+func (mb *MetricsBuilder) RecordSystemCgroupCPUTimeDataPoint(ts pcommon.Timestamp, val float64, stateAttributeValue AttributeState) {
+	mb.metricSystemCgroupCPUTime.recordDataPoint(mb.startTime, ts, val, stateAttributeValue.String())
+}
+
+// RecordSystemCgroupIoBytesDataPoint adds a data point to system.cgroup.io.bytes metric.
+func (mb *MetricsBuilder) RecordSystemCgroupIoBytesDataPoint(ts pcommon.Timestamp, val int64, deviceAttributeValue string, directionAttributeValue AttributeDirection) {
+	mb.metricSystemCgroupIoBytes.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue, directionAttributeValue.String())
+}
+
+// RecordSystemCgroupIoOperationsDataPoint adds a data point to system.cgroup.io.operations metric.
+func (mb *MetricsBuilder) RecordSystemCgroupIoOperationsDataPoint(ts pcommon.Timestamp, val int64, deviceAttributeValue string, directionAttributeValue AttributeDirection) {
+	mb.metricSystemCgroupIoOperations.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue, directionAttributeValue.String())
+}
+
+// RecordSystemCgroupMemoryEventsDataPoint adds a data point to system.cgroup.memory.events metric.
+func (mb *MetricsBuilder) RecordSystemCgroupMemoryEventsDataPoint(ts pcommon.Timestamp, val int64, eventAttributeValue AttributeEvent) {
+	mb.metricSystemCgroupMemoryEvents.recordDataPoint(mb.startTime, ts, val, eventAttributeValue.String())
+}
+
+// RecordSystemCgroupMemoryLimitDataPoint adds a data point to system.cgroup.memory.limit metric.
+func (mb *MetricsBuilder) RecordSystemCgroupMemoryLimitDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSystemCgroupMemoryLimit.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSystemCgroupMemoryUsageDataPoint adds a data point to system.cgroup.memory.usage metric.
+func (mb *MetricsBuilder) RecordSystemCgroupMemoryUsageDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSystemCgroupMemoryUsage.recordDataPoint(mb.startTime, ts, val)
+}
+
+// Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
+// and metrics builder should update its startTime and reset it's internal state accordingly.
+func (mb *MetricsBuilder) Reset(options ...MetricBuilderOption) {
+	mb.startTime = pcommon.NewTimestampFromTime(time.Now())
+	for _, op := range options {
+		op.apply(mb)
+	}
+}
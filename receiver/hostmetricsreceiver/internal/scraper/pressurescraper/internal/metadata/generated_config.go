@@ -0,0 +1,62 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"go.opentelemetry.io/collector/confmap"
+)
+
+// MetricConfig provides common config for a particular metric.
+type MetricConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	enabledSetByUser bool
+}
+
+func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+// MetricsConfig provides config for pressure metrics.
+type MetricsConfig struct {
+	SystemPressureStallRatio10s MetricConfig `mapstructure:"system.pressure.stall_ratio.10s"`
+	SystemPressureStallRatio1m  MetricConfig `mapstructure:"system.pressure.stall_ratio.1m"`
+	SystemPressureStallRatio5m  MetricConfig `mapstructure:"system.pressure.stall_ratio.5m"`
+	SystemPressureStallTime     MetricConfig `mapstructure:"system.pressure.stall_time"`
+}
+
+func DefaultMetricsConfig() MetricsConfig {
+	return MetricsConfig{
+		SystemPressureStallRatio10s: MetricConfig{
+			Enabled: true,
+		},
+		SystemPressureStallRatio1m: MetricConfig{
+			Enabled: true,
+		},
+		SystemPressureStallRatio5m: MetricConfig{
+			Enabled: true,
+		},
+		SystemPressureStallTime: MetricConfig{
+			Enabled: true,
+		},
+	}
+}
+
+// MetricsBuilderConfig is a configuration for pressure metrics builder.
+type MetricsBuilderConfig struct {
+	Metrics MetricsConfig `mapstructure:"metrics"`
+}
+
+func DefaultMetricsBuilderConfig() MetricsBuilderConfig {
+	return MetricsBuilderConfig{
+		Metrics: DefaultMetricsConfig(),
+	}
+}
@@ -0,0 +1,466 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/scraper"
+	conventions "go.opentelemetry.io/otel/semconv/v1.9.0"
+)
+
+// AttributeSystemPressureResource specifies the value system.pressure.resource attribute.
+type AttributeSystemPressureResource int
+
+const (
+	_ AttributeSystemPressureResource = iota
+	AttributeSystemPressureResourceCpu
+	AttributeSystemPressureResourceMemory
+	AttributeSystemPressureResourceIo
+)
+
+// String returns the string representation of the AttributeSystemPressureResource.
+func (av AttributeSystemPressureResource) String() string {
+	switch av {
+	case AttributeSystemPressureResourceCpu:
+		return "cpu"
+	case AttributeSystemPressureResourceMemory:
+		return "memory"
+	case AttributeSystemPressureResourceIo:
+		return "io"
+	}
+	return ""
+}
+
+// MapAttributeSystemPressureResource is a helper map of string to AttributeSystemPressureResource attribute value.
+var MapAttributeSystemPressureResource = map[string]AttributeSystemPressureResource{
+	"cpu":    AttributeSystemPressureResourceCpu,
+	"memory": AttributeSystemPressureResourceMemory,
+	"io":     AttributeSystemPressureResourceIo,
+}
+
+// AttributeSystemPressureStallType specifies the value system.pressure.stall_type attribute.
+type AttributeSystemPressureStallType int
+
+const (
+	_ AttributeSystemPressureStallType = iota
+	AttributeSystemPressureStallTypeSome
+	AttributeSystemPressureStallTypeFull
+)
+
+// String returns the string representation of the AttributeSystemPressureStallType.
+func (av AttributeSystemPressureStallType) String() string {
+	switch av {
+	case AttributeSystemPressureStallTypeSome:
+		return "some"
+	case AttributeSystemPressureStallTypeFull:
+		return "full"
+	}
+	return ""
+}
+
+// MapAttributeSystemPressureStallType is a helper map of string to AttributeSystemPressureStallType attribute value.
+var MapAttributeSystemPressureStallType = map[string]AttributeSystemPressureStallType{
+	"some": AttributeSystemPressureStallTypeSome,
+	"full": AttributeSystemPressureStallTypeFull,
+}
+
+var MetricsInfo = metricsInfo{
+	SystemPressureStallRatio10s: metricInfo{
+		Name: "system.pressure.stall_ratio.10s",
+	},
+	SystemPressureStallRatio1m: metricInfo{
+		Name: "system.pressure.stall_ratio.1m",
+	},
+	SystemPressureStallRatio5m: metricInfo{
+		Name: "system.pressure.stall_ratio.5m",
+	},
+	SystemPressureStallTime: metricInfo{
+		Name: "system.pressure.stall_time",
+	},
+}
+
+type metricsInfo struct {
+	SystemPressureStallRatio10s metricInfo
+	SystemPressureStallRatio1m  metricInfo
+	SystemPressureStallRatio5m  metricInfo
+	SystemPressureStallTime     metricInfo
+}
+
+type metricInfo struct {
+	Name string
+}
+
+type metricSystemPressureStallRatio10s struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.pressure.stall_ratio.10s metric with initial data.
+func (m *metricSystemPressureStallRatio10s) init() {
+	m.data.SetName("system.pressure.stall_ratio.10s")
+	m.data.SetDescription("Fraction of wall time tasks were stalled on the resource, averaged over 10 seconds.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemPressureStallRatio10s) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, systemPressureResourceAttributeValue string, systemPressureStallTypeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("system.pressure.resource", systemPressureResourceAttributeValue)
+	dp.Attributes().PutStr("system.pressure.stall_type", systemPressureStallTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemPressureStallRatio10s) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemPressureStallRatio10s) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemPressureStallRatio10s(cfg MetricConfig) metricSystemPressureStallRatio10s {
+	m := metricSystemPressureStallRatio10s{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemPressureStallRatio1m struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.pressure.stall_ratio.1m metric with initial data.
+func (m *metricSystemPressureStallRatio1m) init() {
+	m.data.SetName("system.pressure.stall_ratio.1m")
+	m.data.SetDescription("Fraction of wall time tasks were stalled on the resource, averaged over 1 minute.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemPressureStallRatio1m) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, systemPressureResourceAttributeValue string, systemPressureStallTypeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("system.pressure.resource", systemPressureResourceAttributeValue)
+	dp.Attributes().PutStr("system.pressure.stall_type", systemPressureStallTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemPressureStallRatio1m) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemPressureStallRatio1m) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemPressureStallRatio1m(cfg MetricConfig) metricSystemPressureStallRatio1m {
+	m := metricSystemPressureStallRatio1m{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemPressureStallRatio5m struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.pressure.stall_ratio.5m metric with initial data.
+func (m *metricSystemPressureStallRatio5m) init() {
+	m.data.SetName("system.pressure.stall_ratio.5m")
+	m.data.SetDescription("Fraction of wall time tasks were stalled on the resource, averaged over 5 minutes.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemPressureStallRatio5m) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, systemPressureResourceAttributeValue string, systemPressureStallTypeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("system.pressure.resource", systemPressureResourceAttributeValue)
+	dp.Attributes().PutStr("system.pressure.stall_type", systemPressureStallTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemPressureStallRatio5m) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemPressureStallRatio5m) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemPressureStallRatio5m(cfg MetricConfig) metricSystemPressureStallRatio5m {
+	m := metricSystemPressureStallRatio5m{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemPressureStallTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.pressure.stall_time metric with initial data.
+func (m *metricSystemPressureStallTime) init() {
+	m.data.SetName("system.pressure.stall_time")
+	m.data.SetDescription("Total time tasks have been stalled waiting on the resource.")
+	m.data.SetUnit("us")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemPressureStallTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, systemPressureResourceAttributeValue string, systemPressureStallTypeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("system.pressure.resource", systemPressureResourceAttributeValue)
+	dp.Attributes().PutStr("system.pressure.stall_type", systemPressureStallTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemPressureStallTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemPressureStallTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemPressureStallTime(cfg MetricConfig) metricSystemPressureStallTime {
+	m := metricSystemPressureStallTime{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+// MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
+// required to produce metric representation defined in metadata and user config.
+type MetricsBuilder struct {
+	config                            MetricsBuilderConfig // config of the metrics builder.
+	startTime                         pcommon.Timestamp    // start time that will be applied to all recorded data points.
+	metricsCapacity                   int                  // maximum observed number of metrics per resource.
+	metricsBuffer                     pmetric.Metrics      // accumulates metrics data before emitting.
+	buildInfo                         component.BuildInfo  // contains version information.
+	metricSystemPressureStallRatio10s metricSystemPressureStallRatio10s
+	metricSystemPressureStallRatio1m  metricSystemPressureStallRatio1m
+	metricSystemPressureStallRatio5m  metricSystemPressureStallRatio5m
+	metricSystemPressureStallTime     metricSystemPressureStallTime
+}
+
+// MetricBuilderOption applies changes to default metrics builder.
+type MetricBuilderOption interface {
+	apply(*MetricsBuilder)
+}
+
+type metricBuilderOptionFunc func(mb *MetricsBuilder)
+
+func (mbof metricBuilderOptionFunc) apply(mb *MetricsBuilder) {
+	mbof(mb)
+}
+
+// WithStartTime sets startTime on the metrics builder.
+func WithStartTime(startTime pcommon.Timestamp) MetricBuilderOption {
+	return metricBuilderOptionFunc(func(mb *MetricsBuilder) {
+		mb.startTime = startTime
+	})
+}
+func NewMetricsBuilder(mbc MetricsBuilderConfig, settings scraper.Settings, options ...MetricBuilderOption) *MetricsBuilder {
+	mb := &MetricsBuilder{
+		config:                            mbc,
+		startTime:                         pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                     pmetric.NewMetrics(),
+		buildInfo:                         settings.BuildInfo,
+		metricSystemPressureStallRatio10s: newMetricSystemPressureStallRatio10s(mbc.Metrics.SystemPressureStallRatio10s),
+		metricSystemPressureStallRatio1m:  newMetricSystemPressureStallRatio1m(mbc.Metrics.SystemPressureStallRatio1m),
+		metricSystemPressureStallRatio5m:  newMetricSystemPressureStallRatio5m(mbc.Metrics.SystemPressureStallRatio5m),
+		metricSystemPressureStallTime:     newMetricSystemPressureStallTime(mbc.Metrics.SystemPressureStallTime),
+	}
+
+	for _, op := range options {
+		op.apply(mb)
+	}
+	return mb
+}
+
+// updateCapacity updates max length of metrics and resource attributes that will be used for the slice capacity.
+func (mb *MetricsBuilder) updateCapacity(rm pmetric.ResourceMetrics) {
+	if mb.metricsCapacity < rm.ScopeMetrics().At(0).Metrics().Len() {
+		mb.metricsCapacity = rm.ScopeMetrics().At(0).Metrics().Len()
+	}
+}
+
+// ResourceMetricsOption applies changes to provided resource metrics.
+type ResourceMetricsOption interface {
+	apply(pmetric.ResourceMetrics)
+}
+
+type resourceMetricsOptionFunc func(pmetric.ResourceMetrics)
+
+func (rmof resourceMetricsOptionFunc) apply(rm pmetric.ResourceMetrics) {
+	rmof(rm)
+}
+
+// WithResource sets the provided resource on the emitted ResourceMetrics.
+// It's recommended to use ResourceBuilder to create the resource.
+func WithResource(res pcommon.Resource) ResourceMetricsOption {
+	return resourceMetricsOptionFunc(func(rm pmetric.ResourceMetrics) {
+		res.CopyTo(rm.Resource())
+	})
+}
+
+// WithStartTimeOverride overrides start time for all the resource metrics data points.
+// This option should be only used if different start time has to be set on metrics coming from different resources.
+func WithStartTimeOverride(start pcommon.Timestamp) ResourceMetricsOption {
+	return resourceMetricsOptionFunc(func(rm pmetric.ResourceMetrics) {
+		var dps pmetric.NumberDataPointSlice
+		metrics := rm.ScopeMetrics().At(0).Metrics()
+		for i := 0; i < metrics.Len(); i++ {
+			switch metrics.At(i).Type() {
+			case pmetric.MetricTypeGauge:
+				dps = metrics.At(i).Gauge().DataPoints()
+			case pmetric.MetricTypeSum:
+				dps = metrics.At(i).Sum().DataPoints()
+			}
+			for j := 0; j < dps.Len(); j++ {
+				dps.At(j).SetStartTimestamp(start)
+			}
+		}
+	})
+}
+
+// EmitForResource saves all the generated metrics under a new resource and updates the internal state to be ready for
+// recording another set of data points as part of another resource. This function can be helpful when one scraper
+// needs to emit metrics from several resources. Otherwise calling this function is not required,
+// just `Emit` function can be called instead.
+// Resource attributes should be provided as ResourceMetricsOption arguments.
+func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
+	rm := pmetric.NewResourceMetrics()
+	rm.SetSchemaUrl(conventions.SchemaURL)
+	ils := rm.ScopeMetrics().AppendEmpty()
+	ils.Scope().SetName(ScopeName)
+	ils.Scope().SetVersion(mb.buildInfo.Version)
+	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
+	mb.metricSystemPressureStallRatio10s.emit(ils.Metrics())
+	mb.metricSystemPressureStallRatio1m.emit(ils.Metrics())
+	mb.metricSystemPressureStallRatio5m.emit(ils.Metrics())
+	mb.metricSystemPressureStallTime.emit(ils.Metrics())
+
+	for _, op := range options {
+		op.apply(rm)
+	}
+
+	if ils.Metrics().Len() > 0 {
+		mb.updateCapacity(rm)
+		rm.MoveTo(mb.metricsBuffer.ResourceMetrics().AppendEmpty())
+	}
+}
+
+// Emit returns all the metrics accumulated by the metrics builder and updates the internal state to be ready for
+// recording another set of metrics. This function will be responsible for applying all the transformations required to
+// produce metric representation defined in metadata and user config, e.g. delta or cumulative.
+func (mb *MetricsBuilder) Emit(options ...ResourceMetricsOption) pmetric.Metrics {
+	mb.EmitForResource(options...)
+	metrics := mb.metricsBuffer
+	mb.metricsBuffer = pmetric.NewMetrics()
+	return metrics
+}
+
+// RecordSystemPressureStallRatio10sDataPoint adds a data point to system.pressure.stall_ratio.10s metric.
+func (mb *MetricsBuilder) RecordSystemPressureStallRatio10sDataPoint(ts pcommon.Timestamp, val float64, systemPressureResourceAttributeValue AttributeSystemPressureResource, systemPressureStallTypeAttributeValue AttributeSystemPressureStallType) {
+	mb.metricSystemPressureStallRatio10s.recordDataPoint(mb.startTime, ts, val, systemPressureResourceAttributeValue.String(), systemPressureStallTypeAttributeValue.String())
+}
+
+// RecordSystemPressureStallRatio1mDataPoint adds a data point to system.pressure.stall_ratio.1m metric.
+func (mb *MetricsBuilder) RecordSystemPressureStallRatio1mDataPoint(ts pcommon.Timestamp, val float64, systemPressureResourceAttributeValue AttributeSystemPressureResource, systemPressureStallTypeAttributeValue AttributeSystemPressureStallType) {
+	mb.metricSystemPressureStallRatio1m.recordDataPoint(mb.startTime, ts, val, systemPressureResourceAttributeValue.String(), systemPressureStallTypeAttributeValue.String())
+}
+
+// RecordSystemPressureStallRatio5mDataPoint adds a data point to system.pressure.stall_ratio.5m metric.
+func (mb *MetricsBuilder) RecordSystemPressureStallRatio5mDataPoint(ts pcommon.Timestamp, val float64, systemPressureResourceAttributeValue AttributeSystemPressureResource, systemPressureStallTypeAttributeValue AttributeSystemPressureStallType) {
+	mb.metricSystemPressureStallRatio5m.recordDataPoint(mb.startTime, ts, val, systemPressureResourceAttributeValue.String(), systemPressureStallTypeAttributeValue.String())
+}
+
+// RecordSystemPressureStallTimeDataPoint adds a data point to system.pressure.stall_time metric.
+func (mb *MetricsBuilder) RecordSystemPressureStallTimeDataPoint(ts pcommon.Timestamp, val int64, systemPressureResourceAttributeValue AttributeSystemPressureResource, systemPressureStallTypeAttributeValue AttributeSystemPressureStallType) {
+	mb.metricSystemPressureStallTime.recordDataPoint(mb.startTime, ts, val, systemPressureResourceAttributeValue.String(), systemPressureStallTypeAttributeValue.String())
+}
+
+// Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
+// and metrics builder should update its startTime and reset it's internal state accordingly.
+func (mb *MetricsBuilder) Reset(options ...MetricBuilderOption) {
+	mb.startTime = pcommon.NewTimestampFromTime(time.Now())
+	for _, op := range options {
+		op.apply(mb)
+	}
+}
@@ -0,0 +1,178 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/scraper/scrapertest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+type testDataSet int
+
+const (
+	testDataSetDefault testDataSet = iota
+	testDataSetAll
+	testDataSetNone
+)
+
+func TestMetricsBuilder(t *testing.T) {
+	tests := []struct {
+		name        string
+		metricsSet  testDataSet
+		resAttrsSet testDataSet
+		expectEmpty bool
+	}{
+		{
+			name: "default",
+		},
+		{
+			name:        "all_set",
+			metricsSet:  testDataSetAll,
+			resAttrsSet: testDataSetAll,
+		},
+		{
+			name:        "none_set",
+			metricsSet:  testDataSetNone,
+			resAttrsSet: testDataSetNone,
+			expectEmpty: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := pcommon.Timestamp(1_000_000_000)
+			ts := pcommon.Timestamp(1_000_001_000)
+			observedZapCore, observedLogs := observer.New(zap.WarnLevel)
+			settings := scrapertest.NewNopSettings(scrapertest.NopType)
+			settings.Logger = zap.New(observedZapCore)
+			mb := NewMetricsBuilder(loadMetricsBuilderConfig(t, tt.name), settings, WithStartTime(start))
+
+			expectedWarnings := 0
+
+			assert.Equal(t, expectedWarnings, observedLogs.Len())
+
+			defaultMetricsCount := 0
+			allMetricsCount := 0
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordSystemPressureStallRatio10sDataPoint(ts, 1, AttributeSystemPressureResourceCpu, AttributeSystemPressureStallTypeSome)
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordSystemPressureStallRatio1mDataPoint(ts, 1, AttributeSystemPressureResourceCpu, AttributeSystemPressureStallTypeSome)
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordSystemPressureStallRatio5mDataPoint(ts, 1, AttributeSystemPressureResourceCpu, AttributeSystemPressureStallTypeSome)
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordSystemPressureStallTimeDataPoint(ts, 1, AttributeSystemPressureResourceCpu, AttributeSystemPressureStallTypeSome)
+
+			res := pcommon.NewResource()
+			metrics := mb.Emit(WithResource(res))
+
+			if tt.expectEmpty {
+				assert.Equal(t, 0, metrics.ResourceMetrics().Len())
+				return
+			}
+
+			assert.Equal(t, 1, metrics.ResourceMetrics().Len())
+			rm := metrics.ResourceMetrics().At(0)
+			assert.Equal(t, res, rm.Resource())
+			assert.Equal(t, 1, rm.ScopeMetrics().Len())
+			ms := rm.ScopeMetrics().At(0).Metrics()
+			if tt.metricsSet == testDataSetDefault {
+				assert.Equal(t, defaultMetricsCount, ms.Len())
+			}
+			if tt.metricsSet == testDataSetAll {
+				assert.Equal(t, allMetricsCount, ms.Len())
+			}
+			validatedMetrics := make(map[string]bool)
+			for i := 0; i < ms.Len(); i++ {
+				switch ms.At(i).Name() {
+				case "system.pressure.stall_ratio.10s":
+					assert.False(t, validatedMetrics["system.pressure.stall_ratio.10s"], "Found a duplicate in the metrics slice: system.pressure.stall_ratio.10s")
+					validatedMetrics["system.pressure.stall_ratio.10s"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Fraction of wall time tasks were stalled on the resource, averaged over 10 seconds.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+					attrVal, ok := dp.Attributes().Get("system.pressure.resource")
+					assert.True(t, ok)
+					assert.Equal(t, "cpu", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("system.pressure.stall_type")
+					assert.True(t, ok)
+					assert.Equal(t, "some", attrVal.Str())
+				case "system.pressure.stall_ratio.1m":
+					assert.False(t, validatedMetrics["system.pressure.stall_ratio.1m"], "Found a duplicate in the metrics slice: system.pressure.stall_ratio.1m")
+					validatedMetrics["system.pressure.stall_ratio.1m"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Fraction of wall time tasks were stalled on the resource, averaged over 1 minute.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+					attrVal, ok := dp.Attributes().Get("system.pressure.resource")
+					assert.True(t, ok)
+					assert.Equal(t, "cpu", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("system.pressure.stall_type")
+					assert.True(t, ok)
+					assert.Equal(t, "some", attrVal.Str())
+				case "system.pressure.stall_ratio.5m":
+					assert.False(t, validatedMetrics["system.pressure.stall_ratio.5m"], "Found a duplicate in the metrics slice: system.pressure.stall_ratio.5m")
+					validatedMetrics["system.pressure.stall_ratio.5m"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Fraction of wall time tasks were stalled on the resource, averaged over 5 minutes.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+					attrVal, ok := dp.Attributes().Get("system.pressure.resource")
+					assert.True(t, ok)
+					assert.Equal(t, "cpu", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("system.pressure.stall_type")
+					assert.True(t, ok)
+					assert.Equal(t, "some", attrVal.Str())
+				case "system.pressure.stall_time":
+					assert.False(t, validatedMetrics["system.pressure.stall_time"], "Found a duplicate in the metrics slice: system.pressure.stall_time")
+					validatedMetrics["system.pressure.stall_time"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Total time tasks have been stalled waiting on the resource.", ms.At(i).Description())
+					assert.Equal(t, "us", ms.At(i).Unit())
+					assert.True(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("system.pressure.resource")
+					assert.True(t, ok)
+					assert.Equal(t, "cpu", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("system.pressure.stall_type")
+					assert.True(t, ok)
+					assert.Equal(t, "some", attrVal.Str())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pressurescraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pressurescraper"
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shirou/gopsutil/v4/common"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/scraper"
+	"go.opentelemetry.io/collector/scraper/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/gopsutilenv"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pressurescraper/internal/metadata"
+)
+
+// number of metrics recorded for each resource
+const metricsLen = 4
+
+var resources = []metadata.AttributeSystemPressureResource{
+	metadata.AttributeSystemPressureResourceCpu,
+	metadata.AttributeSystemPressureResourceMemory,
+	metadata.AttributeSystemPressureResourceIo,
+}
+
+// pressureStat is a single line of a /proc/pressure/<resource> file.
+type pressureStat struct {
+	stallType metadata.AttributeSystemPressureStallType
+	// averages are percentages of wall time over the last 10s, 60s and 300s.
+	avg10  float64
+	avg60  float64
+	avg300 float64
+	// total is the total stall time in microseconds.
+	total int64
+}
+
+// pressureScraper for Pressure Stall Information (PSI) metrics
+type pressureScraper struct {
+	settings scraper.Settings
+	config   *Config
+	mb       *metadata.MetricsBuilder
+
+	// for mocking
+	readPressure func(ctx context.Context, resource string) ([]pressureStat, error)
+}
+
+// newPressureScraper creates a metric scraper for PSI metrics
+func newPressureScraper(settings scraper.Settings, cfg *Config) *pressureScraper {
+	return &pressureScraper{
+		settings:     settings,
+		config:       cfg,
+		readPressure: readPressureFile,
+	}
+}
+
+func (s *pressureScraper) start(context.Context, component.Host) error {
+	s.mb = metadata.NewMetricsBuilder(s.config.MetricsBuilderConfig, s.settings)
+	return nil
+}
+
+func (s *pressureScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	var errs scrapererror.ScrapeErrors
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	for _, resource := range resources {
+		stats, err := s.readPressure(ctx, resource.String())
+		if err != nil {
+			errs.AddPartial(metricsLen, err)
+			continue
+		}
+
+		for _, stat := range stats {
+			s.mb.RecordSystemPressureStallTimeDataPoint(now, stat.total, resource, stat.stallType)
+			s.mb.RecordSystemPressureStallRatio10sDataPoint(now, stat.avg10/100, resource, stat.stallType)
+			s.mb.RecordSystemPressureStallRatio1mDataPoint(now, stat.avg60/100, resource, stat.stallType)
+			s.mb.RecordSystemPressureStallRatio5mDataPoint(now, stat.avg300/100, resource, stat.stallType)
+		}
+	}
+
+	return s.mb.Emit(), errs.Combine()
+}
+
+func readPressureFile(ctx context.Context, resource string) ([]pressureStat, error) {
+	path := gopsutilenv.GetEnvWithContext(ctx, string(common.HostProcEnvKey), "/proc", "pressure", resource)
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pressure stall information: %w", err)
+	}
+	defer f.Close()
+
+	stats, err := parsePressure(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return stats, nil
+}
+
+// parsePressure parses the content of a /proc/pressure/<resource> file, e.g.:
+//
+//	some avg10=0.12 avg60=0.34 avg300=0.56 total=123456
+//	full avg10=0.00 avg60=0.00 avg300=0.00 total=0
+func parsePressure(r io.Reader) ([]pressureStat, error) {
+	var stats []pressureStat
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		stallType, ok := metadata.MapAttributeSystemPressureStallType[fields[0]]
+		if !ok {
+			return nil, fmt.Errorf("unknown stall type %q", fields[0])
+		}
+
+		stat := pressureStat{stallType: stallType}
+		for _, field := range fields[1:] {
+			key, value, ok := strings.Cut(field, "=")
+			if !ok {
+				return nil, fmt.Errorf("invalid field %q", field)
+			}
+
+			var err error
+			switch key {
+			case "avg10":
+				stat.avg10, err = strconv.ParseFloat(value, 64)
+			case "avg60":
+				stat.avg60, err = strconv.ParseFloat(value, 64)
+			case "avg300":
+				stat.avg300, err = strconv.ParseFloat(value, 64)
+			case "total":
+				stat.total, err = strconv.ParseInt(value, 10, 64)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for %s: %w", key, err)
+			}
+		}
+		stats = append(stats, stat)
+	}
+
+	return stats, scanner.Err()
+}
@@ -0,0 +1,86 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package pressurescraper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shirou/gopsutil/v4/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/scraper/scrapertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pressurescraper/internal/metadata"
+)
+
+func TestScrape(t *testing.T) {
+	ctx := context.WithValue(t.Context(), common.EnvKey, common.EnvMap{common.HostProcEnvKey: "testdata/proc"})
+	scraper := newPressureScraper(scrapertest.NewNopSettings(metadata.Type), &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()})
+	require.NoError(t, scraper.start(ctx, componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(ctx)
+	require.NoError(t, err)
+
+	stallTime := map[pressureKey]int64{}
+	ratio10s := map[pressureKey]float64{}
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 4, metrics.Len())
+	for i := 0; i < metrics.Len(); i++ {
+		m := metrics.At(i)
+		switch m.Name() {
+		case "system.pressure.stall_time":
+			assert.True(t, m.Sum().IsMonotonic())
+			dps := m.Sum().DataPoints()
+			require.Equal(t, 6, dps.Len())
+			for j := 0; j < dps.Len(); j++ {
+				stallTime[dataPointKey(dps.At(j).Attributes())] = dps.At(j).IntValue()
+			}
+		case "system.pressure.stall_ratio.10s":
+			dps := m.Gauge().DataPoints()
+			require.Equal(t, 6, dps.Len())
+			for j := 0; j < dps.Len(); j++ {
+				ratio10s[dataPointKey(dps.At(j).Attributes())] = dps.At(j).DoubleValue()
+			}
+		}
+	}
+
+	assert.Equal(t, map[pressureKey]int64{
+		{"cpu", "some"}:    41782732,
+		{"cpu", "full"}:    0,
+		{"memory", "some"}: 123456,
+		{"memory", "full"}: 65432,
+		{"io", "some"}:     987654321,
+		{"io", "full"}:     876543210,
+	}, stallTime)
+	assert.InDeltaMapValues(t, map[pressureKey]float64{
+		{"cpu", "some"}:    0.015,
+		{"cpu", "full"}:    0,
+		{"memory", "some"}: 0.005,
+		{"memory", "full"}: 0.002,
+		{"io", "some"}:     0.1,
+		{"io", "full"}:     0.08,
+	}, ratio10s, 1e-9)
+}
+
+type pressureKey struct{ resource, stallType string }
+
+func dataPointKey(attrs pcommon.Map) pressureKey {
+	resource, _ := attrs.Get("system.pressure.resource")
+	stallType, _ := attrs.Get("system.pressure.stall_type")
+	return pressureKey{resource.Str(), stallType.Str()}
+}
+
+func TestScrape_MissingPressureFiles(t *testing.T) {
+	ctx := context.WithValue(t.Context(), common.EnvKey, common.EnvMap{common.HostProcEnvKey: t.TempDir()})
+	scraper := newPressureScraper(scrapertest.NewNopSettings(metadata.Type), &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()})
+	require.NoError(t, scraper.start(ctx, componenttest.NewNopHost()))
+
+	_, err := scraper.scrape(ctx)
+	assert.ErrorContains(t, err, "failed to read pressure stall information")
+}
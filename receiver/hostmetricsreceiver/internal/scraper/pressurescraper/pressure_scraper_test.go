@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pressurescraper
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/scraper/scrapererror"
+	"go.opentelemetry.io/collector/scraper/scrapertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pressurescraper/internal/metadata"
+)
+
+func TestParsePressure(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		expected    []pressureStat
+		expectedErr string
+	}{
+		{
+			name: "some and full",
+			content: "some avg10=1.50 avg60=2.25 avg300=3.00 total=41782732\n" +
+				"full avg10=0.00 avg60=0.00 avg300=0.00 total=0\n",
+			expected: []pressureStat{
+				{stallType: metadata.AttributeSystemPressureStallTypeSome, avg10: 1.5, avg60: 2.25, avg300: 3, total: 41782732},
+				{stallType: metadata.AttributeSystemPressureStallTypeFull},
+			},
+		},
+		{
+			name:    "some only",
+			content: "some avg10=0.10 avg60=0.20 avg300=0.30 total=100\n",
+			expected: []pressureStat{
+				{stallType: metadata.AttributeSystemPressureStallTypeSome, avg10: 0.1, avg60: 0.2, avg300: 0.3, total: 100},
+			},
+		},
+		{
+			name:        "unknown stall type",
+			content:     "partial avg10=0.10 avg60=0.20 avg300=0.30 total=100\n",
+			expectedErr: `unknown stall type "partial"`,
+		},
+		{
+			name:        "invalid field",
+			content:     "some avg10\n",
+			expectedErr: `invalid field "avg10"`,
+		},
+		{
+			name:        "invalid value",
+			content:     "some avg10=0.10 avg60=0.20 avg300=0.30 total=abc\n",
+			expectedErr: "invalid value for total",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats, err := parsePressure(strings.NewReader(tt.content))
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, stats)
+		})
+	}
+}
+
+func TestScrape_PartialError(t *testing.T) {
+	scraper := newPressureScraper(scrapertest.NewNopSettings(metadata.Type), &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()})
+	scraper.readPressure = func(_ context.Context, resource string) ([]pressureStat, error) {
+		if resource == "memory" {
+			return nil, errors.New("err1")
+		}
+		return []pressureStat{{stallType: metadata.AttributeSystemPressureStallTypeSome, avg10: 50, total: 10}}, nil
+	}
+	require.NoError(t, scraper.start(t.Context(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(t.Context())
+	require.Error(t, err)
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+	var partialErr scrapererror.PartialScrapeError
+	require.ErrorAs(t, err, &partialErr)
+	assert.Equal(t, metricsLen, partialErr.Failed)
+
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 4, metrics.Len())
+	for i := 0; i < metrics.Len(); i++ {
+		// one data point for cpu and one for io
+		dps := metrics.At(i)
+		switch dps.Name() {
+		case "system.pressure.stall_time":
+			assert.Equal(t, 2, dps.Sum().DataPoints().Len())
+		default:
+			assert.Equal(t, 2, dps.Gauge().DataPoints().Len())
+		}
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"regexp"
+	"strings"
 
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/scraper/scraperhelper"
@@ -19,13 +21,21 @@ import (
 var (
 	errInvalidEndpoint = errors.New(`"endpoint" must be in the form of <scheme>://<hostname>[:<port>]`)
 	errMissingEndpoint = errors.New("at least one of 'endpoint' or 'endpoints' must be specified")
+
+	errMissingTransactionName  = errors.New("transaction name must be specified")
+	errMissingTransactionSteps = errors.New("transaction must have at least one step")
+	errMissingStepName         = errors.New("step name must be specified")
+	errMissingStepEndpoint     = errors.New("step endpoint must be specified")
+	errMissingExtractName      = errors.New("extract name must be specified")
+	errInvalidExtractSource    = errors.New("exactly one of 'json_path', 'header' or 'regex' must be specified to extract a value")
 )
 
 // Config defines the configuration for the various elements of the receiver agent.
 type Config struct {
 	scraperhelper.ControllerConfig `mapstructure:",squash"`
 	metadata.MetricsBuilderConfig  `mapstructure:",squash"`
-	Targets                        []*targetConfig `mapstructure:"targets"`
+	Targets                        []*targetConfig      `mapstructure:"targets"`
+	Transactions                   []*transactionConfig `mapstructure:"transactions"`
 
 	// prevent unkeyed literal initialization
 	_ struct{}
@@ -85,13 +95,107 @@ func (cfg *targetConfig) Validate() error {
 	return err
 }
 
-// Validate validates the top-level Config by checking each targetConfig.
+// transactionConfig defines a synthetic transaction: a sequence of HTTP requests
+// where values extracted from a response can be used by the following steps.
+type transactionConfig struct {
+	confighttp.ClientConfig `mapstructure:",squash"`
+	Name                    string       `mapstructure:"name"`  // Name of the transaction, used as metric attribute and span name
+	Steps                   []stepConfig `mapstructure:"steps"` // Steps executed in order, stopping at the first failure
+}
+
+// stepConfig defines a single request of a transaction. The endpoint, headers and body
+// may reference values extracted by previous steps with {{name}}.
+type stepConfig struct {
+	Name            string             `mapstructure:"name"`
+	Method          string             `mapstructure:"method"`
+	Endpoint        string             `mapstructure:"endpoint"`
+	Headers         map[string]string  `mapstructure:"headers"`
+	Body            string             `mapstructure:"body"`
+	AutoContentType bool               `mapstructure:"auto_content_type"`
+	Validations     []validationConfig `mapstructure:"validations"`
+	Extract         []extractConfig    `mapstructure:"extract"`
+}
+
+// extractConfig defines a value to extract from a step response.
+type extractConfig struct {
+	// Name of the variable the value is stored in.
+	Name string `mapstructure:"name"`
+	// JSONPath extracts the value at the given path of a JSON response body.
+	JSONPath string `mapstructure:"json_path"`
+	// Header extracts the value of a response header.
+	Header string `mapstructure:"header"`
+	// Regex extracts the first capture group, or the whole match if there is none, from the response body.
+	Regex string `mapstructure:"regex"`
+}
+
+// Validate validates a transactionConfig.
+func (cfg *transactionConfig) Validate() error {
+	var err error
+
+	if cfg.Name == "" {
+		err = multierr.Append(err, errMissingTransactionName)
+	}
+	if len(cfg.Steps) == 0 {
+		err = multierr.Append(err, errMissingTransactionSteps)
+	}
+
+	stepNames := map[string]bool{}
+	for _, step := range cfg.Steps {
+		if step.Name == "" {
+			err = multierr.Append(err, errMissingStepName)
+		} else if stepNames[step.Name] {
+			err = multierr.Append(err, fmt.Errorf("duplicate step name %q in transaction %q", step.Name, cfg.Name))
+		}
+		stepNames[step.Name] = true
+
+		if step.Endpoint == "" {
+			err = multierr.Append(err, errMissingStepEndpoint)
+		} else if !strings.Contains(step.Endpoint, "{{") {
+			// Endpoints using variables can only be validated once the variables are known.
+			if _, parseErr := url.ParseRequestURI(step.Endpoint); parseErr != nil {
+				err = multierr.Append(err, fmt.Errorf("%s: %w", errInvalidEndpoint.Error(), parseErr))
+			}
+		}
+
+		for _, extract := range step.Extract {
+			err = multierr.Append(err, extract.validate())
+		}
+	}
+
+	return err
+}
+
+func (cfg *extractConfig) validate() error {
+	var err error
+	if cfg.Name == "" {
+		err = multierr.Append(err, errMissingExtractName)
+	}
+
+	sources := 0
+	for _, source := range []string{cfg.JSONPath, cfg.Header, cfg.Regex} {
+		if source != "" {
+			sources++
+		}
+	}
+	if sources != 1 {
+		err = multierr.Append(err, errInvalidExtractSource)
+	}
+
+	if cfg.Regex != "" {
+		if _, regexErr := regexp.Compile(cfg.Regex); regexErr != nil {
+			err = multierr.Append(err, fmt.Errorf("invalid regex for extracting %q: %w", cfg.Name, regexErr))
+		}
+	}
+	return err
+}
+
+// Validate validates the top-level Config by checking each targetConfig and transactionConfig.
 func (cfg *Config) Validate() error {
 	var err error
 
-	// Ensure at least one target is configured.
-	if len(cfg.Targets) == 0 {
-		err = multierr.Append(err, errors.New("no targets configured"))
+	// Ensure at least one target or transaction is configured.
+	if len(cfg.Targets) == 0 && len(cfg.Transactions) == 0 {
+		err = multierr.Append(err, errors.New("no targets or transactions configured"))
 	}
 
 	// Validate each targetConfig.
@@ -99,5 +203,14 @@ func (cfg *Config) Validate() error {
 		err = multierr.Append(err, target.Validate())
 	}
 
+	transactionNames := map[string]bool{}
+	for _, transaction := range cfg.Transactions {
+		if transaction.Name != "" && transactionNames[transaction.Name] {
+			err = multierr.Append(err, fmt.Errorf("duplicate transaction name %q", transaction.Name))
+		}
+		transactionNames[transaction.Name] = true
+		err = multierr.Append(err, transaction.Validate())
+	}
+
 	return err
 }
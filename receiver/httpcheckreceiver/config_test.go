@@ -4,6 +4,7 @@
 package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver"
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -245,6 +246,91 @@ func TestValidate(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		{
+			desc: "no targets or transactions",
+			cfg: &Config{
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+			},
+			expectedErr: errors.New("no targets or transactions configured"),
+		},
+		{
+			desc: "valid transaction",
+			cfg: &Config{
+				Transactions: []*transactionConfig{
+					{
+						Name: "checkout",
+						Steps: []stepConfig{
+							{
+								Name:     "login",
+								Method:   "POST",
+								Endpoint: "https://opentelemetry.io/login",
+								Extract: []extractConfig{
+									{Name: "token", JSONPath: "token"},
+								},
+							},
+							{
+								Name:     "order",
+								Endpoint: "https://opentelemetry.io/orders/{{order_id}}",
+								Headers:  map[string]string{"Authorization": "Bearer {{token}}"},
+							},
+						},
+					},
+				},
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+			},
+			expectedErr: nil,
+		},
+		{
+			desc: "invalid transaction",
+			cfg: &Config{
+				Transactions: []*transactionConfig{
+					{
+						Steps: []stepConfig{
+							{
+								Name:     "login",
+								Endpoint: "www.opentelemetry.io/login",
+								Extract: []extractConfig{
+									{JSONPath: "token", Header: "X-Token"},
+									{Name: "id", Regex: "("},
+								},
+							},
+							{
+								Name: "login",
+							},
+						},
+					},
+				},
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+			},
+			expectedErr: multierr.Combine(
+				errMissingTransactionName,
+				fmt.Errorf("%w: %s", errInvalidEndpoint, `parse "www.opentelemetry.io/login": invalid URI for request`),
+				errMissingExtractName,
+				errInvalidExtractSource,
+				fmt.Errorf(`invalid regex for extracting "id": %w`, errors.New("error parsing regexp: missing closing ): `(`")),
+				errors.New(`duplicate step name "login" in transaction ""`),
+				errMissingStepEndpoint,
+			),
+		},
+		{
+			desc: "transaction without steps and duplicate names",
+			cfg: &Config{
+				Transactions: []*transactionConfig{
+					{
+						Name:  "checkout",
+						Steps: []stepConfig{{Name: "home", Endpoint: "https://opentelemetry.io"}},
+					},
+					{
+						Name: "checkout",
+					},
+				},
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+			},
+			expectedErr: multierr.Combine(
+				errors.New(`duplicate transaction name "checkout"`),
+				errMissingTransactionSteps,
+			),
+		},
 	}
 
 	for _, tc := range testCases {
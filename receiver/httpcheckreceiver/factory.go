@@ -11,9 +11,9 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver"
-	"go.opentelemetry.io/collector/scraper"
 	"go.opentelemetry.io/collector/scraper/scraperhelper"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver/internal/metadata"
 )
 
@@ -24,7 +24,8 @@ func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		metadata.Type,
 		createDefaultConfig,
-		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability))
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithTraces(createTracesReceiver, metadata.TracesStability))
 }
 
 func createDefaultConfig() component.Config {
@@ -44,11 +45,38 @@ func createMetricsReceiver(_ context.Context, params receiver.Settings, rConf co
 		return nil, errConfigNotHTTPCheck
 	}
 
-	httpcheckScraper := newScraper(cfg, params)
-	s, err := scraper.NewMetrics(httpcheckScraper.scrape, scraper.WithStart(httpcheckScraper.start))
+	var err error
+	r := receivers.GetOrAdd(cfg, func() (rcv component.Component) {
+		rcv, err = newReceiver(cfg, params)
+		return rcv
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return scraperhelper.NewMetricsController(&cfg.ControllerConfig, params, consumer, scraperhelper.AddScraper(metadata.Type, s))
+	r.Unwrap().(*httpcheckReceiver).nextMetrics = consumer
+	return r, nil
 }
+
+func createTracesReceiver(_ context.Context, params receiver.Settings, rConf component.Config, consumer consumer.Traces) (receiver.Traces, error) {
+	cfg, ok := rConf.(*Config)
+	if !ok {
+		return nil, errConfigNotHTTPCheck
+	}
+
+	var err error
+	r := receivers.GetOrAdd(cfg, func() (rcv component.Component) {
+		rcv, err = newReceiver(cfg, params)
+		return rcv
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.Unwrap().(*httpcheckReceiver).scraper.nextTraces = consumer
+	return r, nil
+}
+
+// receivers share a single scraper between the metrics and traces receivers
+// created from the same config, so that transactions are only run once.
+var receivers = sharedcomponent.NewSharedComponents()
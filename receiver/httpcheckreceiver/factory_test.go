@@ -72,6 +72,41 @@ func TestNewFactory(t *testing.T) {
 				require.ErrorIs(t, err, errConfigNotHTTPCheck)
 			},
 		},
+		{
+			desc: "creates a new factory and CreateTraces shares the metrics receiver",
+			testFunc: func(t *testing.T) {
+				factory := NewFactory()
+				cfg := factory.CreateDefaultConfig()
+				metricsReceiver, err := factory.CreateMetrics(
+					t.Context(),
+					receivertest.NewNopSettings(metadata.Type),
+					cfg,
+					consumertest.NewNop(),
+				)
+				require.NoError(t, err)
+				tracesReceiver, err := factory.CreateTraces(
+					t.Context(),
+					receivertest.NewNopSettings(metadata.Type),
+					cfg,
+					consumertest.NewNop(),
+				)
+				require.NoError(t, err)
+				require.Same(t, metricsReceiver, tracesReceiver)
+			},
+		},
+		{
+			desc: "creates a new factory and CreateTraces returns error with incorrect config",
+			testFunc: func(t *testing.T) {
+				factory := NewFactory()
+				_, err := factory.CreateTraces(
+					t.Context(),
+					receivertest.NewNopSettings(metadata.Type),
+					nil,
+					consumertest.NewNop(),
+				)
+				require.ErrorIs(t, err, errConfigNotHTTPCheck)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -37,6 +37,10 @@ type MetricsConfig struct {
 	HttpcheckStatus                   MetricConfig `mapstructure:"httpcheck.status"`
 	HttpcheckTLSCertRemaining         MetricConfig `mapstructure:"httpcheck.tls.cert_remaining"`
 	HttpcheckTLSHandshakeDuration     MetricConfig `mapstructure:"httpcheck.tls.handshake.duration"`
+	HttpcheckTransactionDuration      MetricConfig `mapstructure:"httpcheck.transaction.duration"`
+	HttpcheckTransactionStepDuration  MetricConfig `mapstructure:"httpcheck.transaction.step.duration"`
+	HttpcheckTransactionStepSuccess   MetricConfig `mapstructure:"httpcheck.transaction.step.success"`
+	HttpcheckTransactionSuccess       MetricConfig `mapstructure:"httpcheck.transaction.success"`
 	HttpcheckValidationFailed         MetricConfig `mapstructure:"httpcheck.validation.failed"`
 	HttpcheckValidationPassed         MetricConfig `mapstructure:"httpcheck.validation.passed"`
 }
@@ -73,6 +77,18 @@ func DefaultMetricsConfig() MetricsConfig {
 		HttpcheckTLSHandshakeDuration: MetricConfig{
 			Enabled: false,
 		},
+		HttpcheckTransactionDuration: MetricConfig{
+			Enabled: true,
+		},
+		HttpcheckTransactionStepDuration: MetricConfig{
+			Enabled: true,
+		},
+		HttpcheckTransactionStepSuccess: MetricConfig{
+			Enabled: true,
+		},
+		HttpcheckTransactionSuccess: MetricConfig{
+			Enabled: true,
+		},
 		HttpcheckValidationFailed: MetricConfig{
 			Enabled: false,
 		},
@@ -37,6 +37,10 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					HttpcheckStatus:                   MetricConfig{Enabled: true},
 					HttpcheckTLSCertRemaining:         MetricConfig{Enabled: true},
 					HttpcheckTLSHandshakeDuration:     MetricConfig{Enabled: true},
+					HttpcheckTransactionDuration:      MetricConfig{Enabled: true},
+					HttpcheckTransactionStepDuration:  MetricConfig{Enabled: true},
+					HttpcheckTransactionStepSuccess:   MetricConfig{Enabled: true},
+					HttpcheckTransactionSuccess:       MetricConfig{Enabled: true},
 					HttpcheckValidationFailed:         MetricConfig{Enabled: true},
 					HttpcheckValidationPassed:         MetricConfig{Enabled: true},
 				},
@@ -56,6 +60,10 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					HttpcheckStatus:                   MetricConfig{Enabled: false},
 					HttpcheckTLSCertRemaining:         MetricConfig{Enabled: false},
 					HttpcheckTLSHandshakeDuration:     MetricConfig{Enabled: false},
+					HttpcheckTransactionDuration:      MetricConfig{Enabled: false},
+					HttpcheckTransactionStepDuration:  MetricConfig{Enabled: false},
+					HttpcheckTransactionStepSuccess:   MetricConfig{Enabled: false},
+					HttpcheckTransactionSuccess:       MetricConfig{Enabled: false},
 					HttpcheckValidationFailed:         MetricConfig{Enabled: false},
 					HttpcheckValidationPassed:         MetricConfig{Enabled: false},
 				},
@@ -42,6 +42,18 @@ var MetricsInfo = metricsInfo{
 	HttpcheckTLSHandshakeDuration: metricInfo{
 		Name: "httpcheck.tls.handshake.duration",
 	},
+	HttpcheckTransactionDuration: metricInfo{
+		Name: "httpcheck.transaction.duration",
+	},
+	HttpcheckTransactionStepDuration: metricInfo{
+		Name: "httpcheck.transaction.step.duration",
+	},
+	HttpcheckTransactionStepSuccess: metricInfo{
+		Name: "httpcheck.transaction.step.success",
+	},
+	HttpcheckTransactionSuccess: metricInfo{
+		Name: "httpcheck.transaction.success",
+	},
 	HttpcheckValidationFailed: metricInfo{
 		Name: "httpcheck.validation.failed",
 	},
@@ -61,6 +73,10 @@ type metricsInfo struct {
 	HttpcheckStatus                   metricInfo
 	HttpcheckTLSCertRemaining         metricInfo
 	HttpcheckTLSHandshakeDuration     metricInfo
+	HttpcheckTransactionDuration      metricInfo
+	HttpcheckTransactionStepDuration  metricInfo
+	HttpcheckTransactionStepSuccess   metricInfo
+	HttpcheckTransactionSuccess       metricInfo
 	HttpcheckValidationFailed         metricInfo
 	HttpcheckValidationPassed         metricInfo
 }
@@ -591,6 +607,212 @@ func newMetricHttpcheckTLSHandshakeDuration(cfg MetricConfig) metricHttpcheckTLS
 	return m
 }
 
+type metricHttpcheckTransactionDuration struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills httpcheck.transaction.duration metric with initial data.
+func (m *metricHttpcheckTransactionDuration) init() {
+	m.data.SetName("httpcheck.transaction.duration")
+	m.data.SetDescription("Measures the duration of the synthetic transaction, up to the last step executed.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHttpcheckTransactionDuration) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, transactionNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("transaction.name", transactionNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHttpcheckTransactionDuration) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHttpcheckTransactionDuration) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHttpcheckTransactionDuration(cfg MetricConfig) metricHttpcheckTransactionDuration {
+	m := metricHttpcheckTransactionDuration{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricHttpcheckTransactionStepDuration struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills httpcheck.transaction.step.duration metric with initial data.
+func (m *metricHttpcheckTransactionStepDuration) init() {
+	m.data.SetName("httpcheck.transaction.step.duration")
+	m.data.SetDescription("Measures the duration of a step of the synthetic transaction.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHttpcheckTransactionStepDuration) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, transactionNameAttributeValue string, transactionStepNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("transaction.name", transactionNameAttributeValue)
+	dp.Attributes().PutStr("transaction.step.name", transactionStepNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHttpcheckTransactionStepDuration) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHttpcheckTransactionStepDuration) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHttpcheckTransactionStepDuration(cfg MetricConfig) metricHttpcheckTransactionStepDuration {
+	m := metricHttpcheckTransactionStepDuration{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricHttpcheckTransactionStepSuccess struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills httpcheck.transaction.step.success metric with initial data.
+func (m *metricHttpcheckTransactionStepSuccess) init() {
+	m.data.SetName("httpcheck.transaction.step.success")
+	m.data.SetDescription("1 if the step of the synthetic transaction succeeded, otherwise 0. Steps following a failed step are not executed and not reported.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHttpcheckTransactionStepSuccess) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, transactionNameAttributeValue string, transactionStepNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("transaction.name", transactionNameAttributeValue)
+	dp.Attributes().PutStr("transaction.step.name", transactionStepNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHttpcheckTransactionStepSuccess) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHttpcheckTransactionStepSuccess) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHttpcheckTransactionStepSuccess(cfg MetricConfig) metricHttpcheckTransactionStepSuccess {
+	m := metricHttpcheckTransactionStepSuccess{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricHttpcheckTransactionSuccess struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills httpcheck.transaction.success metric with initial data.
+func (m *metricHttpcheckTransactionSuccess) init() {
+	m.data.SetName("httpcheck.transaction.success")
+	m.data.SetDescription("1 if all steps of the synthetic transaction succeeded, otherwise 0.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHttpcheckTransactionSuccess) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, transactionNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("transaction.name", transactionNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHttpcheckTransactionSuccess) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHttpcheckTransactionSuccess) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHttpcheckTransactionSuccess(cfg MetricConfig) metricHttpcheckTransactionSuccess {
+	m := metricHttpcheckTransactionSuccess{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricHttpcheckValidationFailed struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -717,6 +939,10 @@ type MetricsBuilder struct {
 	metricHttpcheckStatus                   metricHttpcheckStatus
 	metricHttpcheckTLSCertRemaining         metricHttpcheckTLSCertRemaining
 	metricHttpcheckTLSHandshakeDuration     metricHttpcheckTLSHandshakeDuration
+	metricHttpcheckTransactionDuration      metricHttpcheckTransactionDuration
+	metricHttpcheckTransactionStepDuration  metricHttpcheckTransactionStepDuration
+	metricHttpcheckTransactionStepSuccess   metricHttpcheckTransactionStepSuccess
+	metricHttpcheckTransactionSuccess       metricHttpcheckTransactionSuccess
 	metricHttpcheckValidationFailed         metricHttpcheckValidationFailed
 	metricHttpcheckValidationPassed         metricHttpcheckValidationPassed
 }
@@ -754,6 +980,10 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		metricHttpcheckStatus:                   newMetricHttpcheckStatus(mbc.Metrics.HttpcheckStatus),
 		metricHttpcheckTLSCertRemaining:         newMetricHttpcheckTLSCertRemaining(mbc.Metrics.HttpcheckTLSCertRemaining),
 		metricHttpcheckTLSHandshakeDuration:     newMetricHttpcheckTLSHandshakeDuration(mbc.Metrics.HttpcheckTLSHandshakeDuration),
+		metricHttpcheckTransactionDuration:      newMetricHttpcheckTransactionDuration(mbc.Metrics.HttpcheckTransactionDuration),
+		metricHttpcheckTransactionStepDuration:  newMetricHttpcheckTransactionStepDuration(mbc.Metrics.HttpcheckTransactionStepDuration),
+		metricHttpcheckTransactionStepSuccess:   newMetricHttpcheckTransactionStepSuccess(mbc.Metrics.HttpcheckTransactionStepSuccess),
+		metricHttpcheckTransactionSuccess:       newMetricHttpcheckTransactionSuccess(mbc.Metrics.HttpcheckTransactionSuccess),
 		metricHttpcheckValidationFailed:         newMetricHttpcheckValidationFailed(mbc.Metrics.HttpcheckValidationFailed),
 		metricHttpcheckValidationPassed:         newMetricHttpcheckValidationPassed(mbc.Metrics.HttpcheckValidationPassed),
 	}
@@ -831,6 +1061,10 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricHttpcheckStatus.emit(ils.Metrics())
 	mb.metricHttpcheckTLSCertRemaining.emit(ils.Metrics())
 	mb.metricHttpcheckTLSHandshakeDuration.emit(ils.Metrics())
+	mb.metricHttpcheckTransactionDuration.emit(ils.Metrics())
+	mb.metricHttpcheckTransactionStepDuration.emit(ils.Metrics())
+	mb.metricHttpcheckTransactionStepSuccess.emit(ils.Metrics())
+	mb.metricHttpcheckTransactionSuccess.emit(ils.Metrics())
 	mb.metricHttpcheckValidationFailed.emit(ils.Metrics())
 	mb.metricHttpcheckValidationPassed.emit(ils.Metrics())
 
@@ -904,6 +1138,26 @@ func (mb *MetricsBuilder) RecordHttpcheckTLSHandshakeDurationDataPoint(ts pcommo
 	mb.metricHttpcheckTLSHandshakeDuration.recordDataPoint(mb.startTime, ts, val, httpURLAttributeValue)
 }
 
+// RecordHttpcheckTransactionDurationDataPoint adds a data point to httpcheck.transaction.duration metric.
+func (mb *MetricsBuilder) RecordHttpcheckTransactionDurationDataPoint(ts pcommon.Timestamp, val int64, transactionNameAttributeValue string) {
+	mb.metricHttpcheckTransactionDuration.recordDataPoint(mb.startTime, ts, val, transactionNameAttributeValue)
+}
+
+// RecordHttpcheckTransactionStepDurationDataPoint adds a data point to httpcheck.transaction.step.duration metric.
+func (mb *MetricsBuilder) RecordHttpcheckTransactionStepDurationDataPoint(ts pcommon.Timestamp, val int64, transactionNameAttributeValue string, transactionStepNameAttributeValue string) {
+	mb.metricHttpcheckTransactionStepDuration.recordDataPoint(mb.startTime, ts, val, transactionNameAttributeValue, transactionStepNameAttributeValue)
+}
+
+// RecordHttpcheckTransactionStepSuccessDataPoint adds a data point to httpcheck.transaction.step.success metric.
+func (mb *MetricsBuilder) RecordHttpcheckTransactionStepSuccessDataPoint(ts pcommon.Timestamp, val int64, transactionNameAttributeValue string, transactionStepNameAttributeValue string) {
+	mb.metricHttpcheckTransactionStepSuccess.recordDataPoint(mb.startTime, ts, val, transactionNameAttributeValue, transactionStepNameAttributeValue)
+}
+
+// RecordHttpcheckTransactionSuccessDataPoint adds a data point to httpcheck.transaction.success metric.
+func (mb *MetricsBuilder) RecordHttpcheckTransactionSuccessDataPoint(ts pcommon.Timestamp, val int64, transactionNameAttributeValue string) {
+	mb.metricHttpcheckTransactionSuccess.recordDataPoint(mb.startTime, ts, val, transactionNameAttributeValue)
+}
+
 // RecordHttpcheckValidationFailedDataPoint adds a data point to httpcheck.validation.failed metric.
 func (mb *MetricsBuilder) RecordHttpcheckValidationFailedDataPoint(ts pcommon.Timestamp, val int64, httpURLAttributeValue string, validationTypeAttributeValue string) {
 	mb.metricHttpcheckValidationFailed.recordDataPoint(mb.startTime, ts, val, httpURLAttributeValue, validationTypeAttributeValue)
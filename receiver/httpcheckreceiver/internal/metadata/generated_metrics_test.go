@@ -92,6 +92,22 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordHttpcheckTLSHandshakeDurationDataPoint(ts, 1, "http.url-val")
 
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordHttpcheckTransactionDurationDataPoint(ts, 1, "transaction.name-val")
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordHttpcheckTransactionStepDurationDataPoint(ts, 1, "transaction.name-val", "transaction.step.name-val")
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordHttpcheckTransactionStepSuccessDataPoint(ts, 1, "transaction.name-val", "transaction.step.name-val")
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordHttpcheckTransactionSuccessDataPoint(ts, 1, "transaction.name-val")
+
 			allMetricsCount++
 			mb.RecordHttpcheckValidationFailedDataPoint(ts, 1, "http.url-val", "validation.type-val")
 
@@ -298,6 +314,72 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("http.url")
 					assert.True(t, ok)
 					assert.Equal(t, "http.url-val", attrVal.Str())
+				case "httpcheck.transaction.duration":
+					assert.False(t, validatedMetrics["httpcheck.transaction.duration"], "Found a duplicate in the metrics slice: httpcheck.transaction.duration")
+					validatedMetrics["httpcheck.transaction.duration"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Measures the duration of the synthetic transaction, up to the last step executed.", ms.At(i).Description())
+					assert.Equal(t, "ms", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("transaction.name")
+					assert.True(t, ok)
+					assert.Equal(t, "transaction.name-val", attrVal.Str())
+				case "httpcheck.transaction.step.duration":
+					assert.False(t, validatedMetrics["httpcheck.transaction.step.duration"], "Found a duplicate in the metrics slice: httpcheck.transaction.step.duration")
+					validatedMetrics["httpcheck.transaction.step.duration"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Measures the duration of a step of the synthetic transaction.", ms.At(i).Description())
+					assert.Equal(t, "ms", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("transaction.name")
+					assert.True(t, ok)
+					assert.Equal(t, "transaction.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("transaction.step.name")
+					assert.True(t, ok)
+					assert.Equal(t, "transaction.step.name-val", attrVal.Str())
+				case "httpcheck.transaction.step.success":
+					assert.False(t, validatedMetrics["httpcheck.transaction.step.success"], "Found a duplicate in the metrics slice: httpcheck.transaction.step.success")
+					validatedMetrics["httpcheck.transaction.step.success"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "1 if the step of the synthetic transaction succeeded, otherwise 0. Steps following a failed step are not executed and not reported.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("transaction.name")
+					assert.True(t, ok)
+					assert.Equal(t, "transaction.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("transaction.step.name")
+					assert.True(t, ok)
+					assert.Equal(t, "transaction.step.name-val", attrVal.Str())
+				case "httpcheck.transaction.success":
+					assert.False(t, validatedMetrics["httpcheck.transaction.success"], "Found a duplicate in the metrics slice: httpcheck.transaction.success")
+					validatedMetrics["httpcheck.transaction.success"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "1 if all steps of the synthetic transaction succeeded, otherwise 0.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("transaction.name")
+					assert.True(t, ok)
+					assert.Equal(t, "transaction.name-val", attrVal.Str())
 				case "httpcheck.validation.failed":
 					assert.False(t, validatedMetrics["httpcheck.validation.failed"], "Found a duplicate in the metrics slice: httpcheck.validation.failed")
 					validatedMetrics["httpcheck.validation.failed"] = true
@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/scraper"
+	"go.opentelemetry.io/collector/scraper/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver/internal/metadata"
+)
+
+// httpcheckReceiver runs the scraper on the collection interval. Metrics are sent
+// to the metrics pipeline, and transaction spans to the traces pipeline, if any.
+type httpcheckReceiver struct {
+	component.Component
+	scraper     *httpcheckScraper
+	nextMetrics consumer.Metrics
+}
+
+func newReceiver(cfg *Config, params receiver.Settings) (*httpcheckReceiver, error) {
+	r := &httpcheckReceiver{
+		scraper: newScraper(cfg, params),
+	}
+
+	s, err := scraper.NewMetrics(r.scraper.scrape, scraper.WithStart(r.scraper.start))
+	if err != nil {
+		return nil, err
+	}
+
+	metricsConsumer, err := consumer.NewMetrics(r.consumeMetrics)
+	if err != nil {
+		return nil, err
+	}
+
+	r.Component, err = scraperhelper.NewMetricsController(&cfg.ControllerConfig, params, metricsConsumer, scraperhelper.AddScraper(metadata.Type, s))
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *httpcheckReceiver) consumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	if r.nextMetrics == nil {
+		return nil
+	}
+	return r.nextMetrics.ConsumeMetrics(ctx, md)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"crypto/tls"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptrace"
@@ -18,6 +19,7 @@ import (
 
 	"github.com/tidwall/gjson"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
@@ -84,10 +86,15 @@ func (t *timingInfo) getDurations() (dnsMs, tcpMs, tlsMs, requestMs, responseMs
 }
 
 type httpcheckScraper struct {
-	clients  []*http.Client
-	cfg      *Config
-	settings component.TelemetrySettings
-	mb       *metadata.MetricsBuilder
+	clients            []*http.Client
+	transactionClients []*http.Client
+	cfg                *Config
+	settings           component.TelemetrySettings
+	buildInfo          component.BuildInfo
+	mb                 *metadata.MetricsBuilder
+	nextTraces         consumer.Traces
+	// extractPatterns holds the compiled regexes of the transaction extractions, by pattern.
+	extractPatterns map[string]*regexp.Regexp
 }
 
 // extractTLSInfo extracts TLS certificate information from the connection state
@@ -235,12 +242,45 @@ func (h *httpcheckScraper) start(ctx context.Context, host component.Host) (err
 	}
 
 	h.cfg.Targets = expandedTargets // Replace targets with expanded targets
+
+	// Each transaction uses a single client for all of its steps.
+	for _, transaction := range h.cfg.Transactions {
+		// Copy the client config so that the default timeout isn't written to the shared config.
+		clientConfig := transaction.ClientConfig
+		if clientConfig.Timeout == 0 {
+			clientConfig.Timeout = 30 * time.Second
+		}
+		client, clientErr := clientConfig.ToClient(ctx, host.GetExtensions(), h.settings)
+		if clientErr != nil {
+			h.settings.Logger.Error("failed to initialize HTTP client", zap.String("transaction", transaction.Name), zap.Error(clientErr))
+			err = multierr.Append(err, clientErr)
+			client = nil
+		}
+		h.transactionClients = append(h.transactionClients, client)
+
+		for _, step := range transaction.Steps {
+			for _, extract := range step.Extract {
+				if extract.Regex == "" || h.extractPatterns[extract.Regex] != nil {
+					continue
+				}
+				pattern, regexErr := regexp.Compile(extract.Regex)
+				if regexErr != nil {
+					err = multierr.Append(err, fmt.Errorf("invalid regex for extracting %q: %w", extract.Name, regexErr))
+					continue
+				}
+				if h.extractPatterns == nil {
+					h.extractPatterns = map[string]*regexp.Regexp{}
+				}
+				h.extractPatterns[extract.Regex] = pattern
+			}
+		}
+	}
 	return err
 }
 
 // scrape performs the HTTP checks and records metrics based on responses.
 func (h *httpcheckScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
-	if len(h.clients) == 0 {
+	if len(h.clients) == 0 && len(h.transactionClients) == 0 {
 		return pmetric.NewMetrics(), errClientNotInit
 	}
 
@@ -248,6 +288,27 @@ func (h *httpcheckScraper) scrape(ctx context.Context) (pmetric.Metrics, error)
 	wg.Add(len(h.clients))
 	var mux sync.Mutex
 
+	td, spans := h.newTraces()
+	for idx, client := range h.transactionClients {
+		if client == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(transactionClient *http.Client, transaction *transactionConfig) {
+			defer wg.Done()
+
+			results := h.runTransaction(ctx, transactionClient, transaction)
+			now := pcommon.NewTimestampFromTime(time.Now())
+
+			mux.Lock()
+			defer mux.Unlock()
+			h.recordTransaction(now, transaction, results)
+			if h.nextTraces != nil {
+				appendTransactionSpans(spans, transaction, results)
+			}
+		}(client, h.cfg.Transactions[idx])
+	}
+
 	for idx, client := range h.clients {
 		go func(targetClient *http.Client, targetIndex int) {
 			defer wg.Done()
@@ -311,15 +372,7 @@ func (h *httpcheckScraper) scrape(ctx context.Context) (pmetric.Metrics, error)
 
 			// Set Content-Type header automatically if body is present, no Content-Type is set, and auto_content_type is enabled
 			if h.cfg.Targets[targetIndex].Body != "" && req.Header.Get("Content-Type") == "" && h.cfg.Targets[targetIndex].AutoContentType {
-				body := strings.TrimSpace(h.cfg.Targets[targetIndex].Body)
-				switch {
-				case strings.HasPrefix(body, "{") || strings.HasPrefix(body, "["):
-					req.Header.Set("Content-Type", "application/json")
-				case strings.Contains(h.cfg.Targets[targetIndex].Body, "="):
-					req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-				default:
-					req.Header.Set("Content-Type", "text/plain")
-				}
+				req.Header.Set("Content-Type", detectContentType(h.cfg.Targets[targetIndex].Body))
 			}
 
 			// Send the request and measure response time
@@ -456,6 +509,12 @@ func (h *httpcheckScraper) scrape(ctx context.Context) (pmetric.Metrics, error)
 
 	wg.Wait()
 
+	if h.nextTraces != nil && spans.Len() > 0 {
+		if err := h.nextTraces.ConsumeTraces(ctx, td); err != nil {
+			h.settings.Logger.Error("failed to consume transaction spans", zap.Error(err))
+		}
+	}
+
 	// Emit metrics and post-process to remove http.status_code when value is 0
 	metrics := h.mb.Emit()
 	removeStatusCodeForZeroValues(metrics)
@@ -463,6 +522,19 @@ func (h *httpcheckScraper) scrape(ctx context.Context) (pmetric.Metrics, error)
 	return metrics, nil
 }
 
+// detectContentType guesses the Content-Type of a request body.
+func detectContentType(body string) string {
+	trimmed := strings.TrimSpace(body)
+	switch {
+	case strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "["):
+		return "application/json"
+	case strings.Contains(body, "="):
+		return "application/x-www-form-urlencoded"
+	default:
+		return "text/plain"
+	}
+}
+
 // removeStatusCodeForZeroValues removes the http.status_code attribute from httpcheck.status metrics
 func removeStatusCodeForZeroValues(metrics pmetric.Metrics) {
 	rms := metrics.ResourceMetrics()
@@ -494,8 +566,9 @@ func removeStatusCodeForZeroValues(metrics pmetric.Metrics) {
 
 func newScraper(conf *Config, settings receiver.Settings) *httpcheckScraper {
 	return &httpcheckScraper{
-		cfg:      conf,
-		settings: settings.TelemetrySettings,
-		mb:       metadata.NewMetricsBuilder(conf.MetricsBuilderConfig, settings),
+		cfg:       conf,
+		settings:  settings.TelemetrySettings,
+		buildInfo: settings.BuildInfo,
+		mb:        metadata.NewMetricsBuilder(conf.MetricsBuilderConfig, settings),
 	}
 }
@@ -0,0 +1,309 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver"
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/tidwall/gjson"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver/internal/metadata"
+)
+
+// variablePattern matches references to extracted values, e.g. {{token}}.
+var variablePattern = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_.-]+)\s*\}\}`)
+
+// stepResult holds the outcome of a single transaction step.
+type stepResult struct {
+	name       string
+	method     string
+	url        string
+	statusCode int
+	start      time.Time
+	end        time.Time
+	err        error
+}
+
+// runTransaction executes the steps of a transaction in order, stopping at the first failing step.
+func (h *httpcheckScraper) runTransaction(ctx context.Context, client *http.Client, transaction *transactionConfig) []stepResult {
+	// Each run gets its own cookie jar so that sessions do not leak between runs.
+	runClient := *client
+	if jar, err := cookiejar.New(nil); err == nil {
+		runClient.Jar = jar
+	}
+
+	variables := map[string]string{}
+	results := make([]stepResult, 0, len(transaction.Steps))
+	for i := range transaction.Steps {
+		result := h.runStep(ctx, &runClient, &transaction.Steps[i], variables)
+		results = append(results, result)
+		if result.err != nil {
+			h.settings.Logger.Debug("transaction step failed",
+				zap.String("transaction", transaction.Name),
+				zap.String("step", result.name),
+				zap.Error(result.err))
+			break
+		}
+	}
+	return results
+}
+
+func (h *httpcheckScraper) runStep(ctx context.Context, client *http.Client, step *stepConfig, variables map[string]string) (result stepResult) {
+	result = stepResult{
+		name:   step.Name,
+		method: step.Method,
+		start:  time.Now(),
+	}
+	if result.method == "" {
+		result.method = http.MethodGet
+	}
+	defer func() {
+		result.end = time.Now()
+	}()
+
+	endpoint, err := expandVariables(step.Endpoint, variables)
+	if err != nil {
+		result.err = err
+		return result
+	}
+	result.url = redactURL(endpoint)
+	body, err := expandVariables(step.Body, variables)
+	if err != nil {
+		result.err = err
+		return result
+	}
+
+	var requestBody io.Reader = http.NoBody
+	if body != "" {
+		requestBody = strings.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, result.method, endpoint, requestBody)
+	if err != nil {
+		result.err = err
+		return result
+	}
+	for key, value := range step.Headers {
+		expanded, expandErr := expandVariables(value, variables)
+		if expandErr != nil {
+			result.err = expandErr
+			return result
+		}
+		req.Header.Set(key, expanded)
+	}
+	if body != "" && req.Header.Get("Content-Type") == "" && step.AutoContentType {
+		req.Header.Set("Content-Type", detectContentType(body))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		result.err = err
+		return result
+	}
+	defer func() { _ = resp.Body.Close() }()
+	result.statusCode = resp.StatusCode
+
+	responseBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		result.err = fmt.Errorf("failed to read response body: %w", err)
+		return result
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		result.err = fmt.Errorf("unexpected status code %d", resp.StatusCode)
+		return result
+	}
+
+	if len(step.Validations) > 0 {
+		_, failed := validateResponse(responseBody, step.Validations)
+		if len(failed) > 0 {
+			failedTypes := make([]string, 0, len(failed))
+			for validationType := range failed {
+				failedTypes = append(failedTypes, validationType)
+			}
+			slices.Sort(failedTypes)
+			result.err = fmt.Errorf("failed validations: %s", strings.Join(failedTypes, ", "))
+			return result
+		}
+	}
+
+	for _, extract := range step.Extract {
+		value, ok := extractValue(extract, h.extractPatterns[extract.Regex], resp.Header, responseBody)
+		if !ok {
+			result.err = fmt.Errorf("failed to extract %q from response", extract.Name)
+			return result
+		}
+		variables[extract.Name] = value
+	}
+	return result
+}
+
+// expandVariables replaces references to extracted values in s.
+func expandVariables(s string, variables map[string]string) (string, error) {
+	var errs error
+	expanded := variablePattern.ReplaceAllStringFunc(s, func(match string) string {
+		name := variablePattern.FindStringSubmatch(match)[1]
+		value, ok := variables[name]
+		if !ok {
+			errs = multierr.Append(errs, fmt.Errorf("undefined variable %q", name))
+			return match
+		}
+		return value
+	})
+	return expanded, errs
+}
+
+// extractValue extracts a value from a response as configured. The pattern is the
+// compiled regex of the extractConfig, if any.
+func extractValue(extract extractConfig, pattern *regexp.Regexp, headers http.Header, body []byte) (string, bool) {
+	switch {
+	case extract.JSONPath != "":
+		result := gjson.GetBytes(body, extract.JSONPath)
+		return result.String(), result.Exists()
+	case extract.Header != "":
+		values := headers.Values(extract.Header)
+		if len(values) == 0 {
+			return "", false
+		}
+		return values[0], true
+	case extract.Regex != "":
+		if pattern == nil {
+			return "", false
+		}
+		matches := pattern.FindSubmatch(body)
+		switch len(matches) {
+		case 0:
+			return "", false
+		case 1:
+			return string(matches[0]), true
+		default:
+			return string(matches[1]), true
+		}
+	}
+	return "", false
+}
+
+// redactURL removes the credentials and the query parameter values of a URL before it is
+// recorded, as they may hold secrets, for example values extracted by previous steps.
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	if u.User != nil {
+		u.User = url.UserPassword("REDACTED", "REDACTED")
+	}
+	if u.RawQuery != "" {
+		query := u.Query()
+		for key, values := range query {
+			for i := range values {
+				values[i] = "REDACTED"
+			}
+			query[key] = values
+		}
+		u.RawQuery = query.Encode()
+	}
+	return u.String()
+}
+
+// recordTransaction records the metrics of a transaction run. Must be called with the scraper lock held.
+func (h *httpcheckScraper) recordTransaction(now pcommon.Timestamp, transaction *transactionConfig, results []stepResult) {
+	success := int64(1)
+	for _, result := range results {
+		stepSuccess := int64(1)
+		if result.err != nil {
+			stepSuccess = 0
+			success = 0
+		}
+		h.mb.RecordHttpcheckTransactionStepDurationDataPoint(now, result.end.Sub(result.start).Milliseconds(), transaction.Name, result.name)
+		h.mb.RecordHttpcheckTransactionStepSuccessDataPoint(now, stepSuccess, transaction.Name, result.name)
+	}
+
+	var duration int64
+	if len(results) > 0 {
+		duration = results[len(results)-1].end.Sub(results[0].start).Milliseconds()
+	}
+	h.mb.RecordHttpcheckTransactionDurationDataPoint(now, duration, transaction.Name)
+	h.mb.RecordHttpcheckTransactionSuccessDataPoint(now, success, transaction.Name)
+}
+
+// appendTransactionSpans appends a trace for a transaction run, with a root span
+// for the transaction and a child span per step.
+func appendTransactionSpans(dest ptrace.SpanSlice, transaction *transactionConfig, results []stepResult) {
+	if len(results) == 0 {
+		return
+	}
+
+	traceID := newTraceID()
+	root := dest.AppendEmpty()
+	root.SetTraceID(traceID)
+	root.SetSpanID(newSpanID())
+	root.SetName(transaction.Name)
+	root.SetKind(ptrace.SpanKindInternal)
+	root.SetStartTimestamp(pcommon.NewTimestampFromTime(results[0].start))
+	root.SetEndTimestamp(pcommon.NewTimestampFromTime(results[len(results)-1].end))
+	root.Attributes().PutStr("transaction.name", transaction.Name)
+	root.Status().SetCode(ptrace.StatusCodeOk)
+
+	for _, result := range results {
+		span := dest.AppendEmpty()
+		span.SetTraceID(traceID)
+		span.SetSpanID(newSpanID())
+		span.SetParentSpanID(root.SpanID())
+		span.SetName(result.name)
+		span.SetKind(ptrace.SpanKindClient)
+		span.SetStartTimestamp(pcommon.NewTimestampFromTime(result.start))
+		span.SetEndTimestamp(pcommon.NewTimestampFromTime(result.end))
+		span.Attributes().PutStr("transaction.name", transaction.Name)
+		span.Attributes().PutStr("transaction.step.name", result.name)
+		span.Attributes().PutStr("http.request.method", result.method)
+		if result.url != "" {
+			span.Attributes().PutStr("url.full", result.url)
+		}
+		if result.statusCode != 0 {
+			span.Attributes().PutInt("http.response.status_code", int64(result.statusCode))
+		}
+		if result.err != nil {
+			span.Status().SetCode(ptrace.StatusCodeError)
+			span.Status().SetMessage(result.err.Error())
+			root.Status().SetCode(ptrace.StatusCodeError)
+			root.Status().SetMessage(fmt.Sprintf("step %q failed", result.name))
+		} else {
+			span.Status().SetCode(ptrace.StatusCodeOk)
+		}
+	}
+}
+
+// newTraces creates the traces holding the spans of the transactions run during a scrape.
+func (h *httpcheckScraper) newTraces() (ptrace.Traces, ptrace.SpanSlice) {
+	td := ptrace.NewTraces()
+	ss := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty()
+	ss.Scope().SetName(metadata.ScopeName)
+	ss.Scope().SetVersion(h.buildInfo.Version)
+	return td, ss.Spans()
+}
+
+func newTraceID() pcommon.TraceID {
+	var id [16]byte
+	_, _ = rand.Read(id[:])
+	return id
+}
+
+func newSpanID() pcommon.SpanID {
+	var id [8]byte
+	_, _ = rand.Read(id[:])
+	return id
+}
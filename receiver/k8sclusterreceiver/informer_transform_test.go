@@ -111,6 +111,24 @@ func TestTransformObject(t *testing.T) {
 			},
 			same: false,
 		},
+		{
+			name:   "persistentvolume",
+			object: testutils.NewPersistentVolume("1"),
+			want:   testutils.NewPersistentVolume("1"),
+			same:   false,
+		},
+		{
+			name:   "persistentvolumeclaim",
+			object: testutils.NewPersistentVolumeClaim("1"),
+			want:   testutils.NewPersistentVolumeClaim("1"),
+			same:   false,
+		},
+		{
+			name:   "ingress",
+			object: testutils.NewIngress("1"),
+			want:   testutils.NewIngress("1"),
+			same:   false,
+		},
 		{
 			// This is a case where we don't transform the object.
 			name:   "hpa",
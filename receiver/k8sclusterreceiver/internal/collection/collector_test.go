@@ -109,7 +109,42 @@ func TestCollectMetricData(t *testing.T) {
 	})
 	expectedRMs++
 
-	dc := NewDataCollector(receivertest.NewNopSettings(metadata.Type), ms, metadata.DefaultMetricsBuilderConfig(), []string{"Ready"}, nil)
+	ms.Setup(gvk.PersistentVolume, metadata.ClusterWideInformerKey, &testutils.MockStore{
+		Cache: map[string]any{
+			"persistentvolume1-uid": testutils.NewPersistentVolume("1"),
+		},
+	})
+	expectedRMs++
+
+	ms.Setup(gvk.PersistentVolumeClaim, metadata.ClusterWideInformerKey, &testutils.MockStore{
+		Cache: map[string]any{
+			"persistentvolumeclaim1-uid": testutils.NewPersistentVolumeClaim("1"),
+		},
+	})
+	expectedRMs++
+
+	ms.Setup(gvk.PodDisruptionBudget, metadata.ClusterWideInformerKey, &testutils.MockStore{
+		Cache: map[string]any{
+			"poddisruptionbudget1-uid": testutils.NewPodDisruptionBudget("1"),
+		},
+	})
+	expectedRMs++
+
+	ms.Setup(gvk.Ingress, metadata.ClusterWideInformerKey, &testutils.MockStore{
+		Cache: map[string]any{
+			"ingress1-uid": testutils.NewIngress("1"),
+		},
+	})
+	expectedRMs++
+
+	// The metrics of these kinds are disabled by default
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sPersistentvolumePhase.Enabled = true
+	mbc.Metrics.K8sPersistentvolumeclaimPhase.Enabled = true
+	mbc.Metrics.K8sPoddisruptionbudgetCurrentHealthy.Enabled = true
+	mbc.Metrics.K8sIngressRules.Enabled = true
+
+	dc := NewDataCollector(receivertest.NewNopSettings(metadata.Type), ms, mbc, []string{"Ready"}, nil)
 	m1 := dc.CollectMetricData(time.Now())
 
 	// Verify number of resource metrics only, content is tested in other tests.
@@ -32,6 +32,10 @@ const (
 	K8sKindReplicationController = "ReplicationController"
 	K8sKindReplicaSet            = "ReplicaSet"
 	K8sStatefulSet               = "StatefulSet"
+	K8sKindPersistentVolume      = "PersistentVolume"
+	K8sKindPersistentVolumeClaim = "PersistentVolumeClaim"
+	K8sKindPodDisruptionBudget   = "PodDisruptionBudget"
+	K8sKindIngress               = "Ingress"
 )
 
 // Keys for K8s metadata
@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ingress // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/ingress"
+
+import (
+	"slices"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	networkingv1 "k8s.io/api/networking/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/constants"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+const (
+	// Keys for ingress metadata and entity attributes.
+	ingressKeyName            = "k8s.ingress.name"
+	ingressKeyClassName       = "k8s.ingress.class_name"
+	ingressKeyBackendServices = "k8s.ingress.backend_services"
+)
+
+// Transform transforms the ingress to remove the fields that we don't use to reduce RAM utilization.
+// IMPORTANT: Make sure to update this function before using new ingress fields.
+func Transform(ing *networkingv1.Ingress) *networkingv1.Ingress {
+	return &networkingv1.Ingress{
+		ObjectMeta: metadata.TransformObjectMeta(ing.ObjectMeta),
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: ing.Spec.IngressClassName,
+			DefaultBackend:   ing.Spec.DefaultBackend,
+			Rules:            ing.Spec.Rules,
+		},
+	}
+}
+
+func RecordMetrics(mb *metadata.MetricsBuilder, ing *networkingv1.Ingress, ts pcommon.Timestamp) {
+	mb.RecordK8sIngressRulesDataPoint(ts, int64(len(ing.Spec.Rules)))
+	mb.RecordK8sIngressBackendServicesDataPoint(ts, int64(len(backendServices(ing))))
+
+	rb := mb.NewResourceBuilder()
+	rb.SetK8sIngressUID(string(ing.UID))
+	rb.SetK8sIngressName(ing.Name)
+	rb.SetK8sNamespaceName(ing.Namespace)
+	mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
+
+// backendServices returns the sorted, de-duplicated names of the services referenced
+// by the default backend and by the HTTP paths of all rules.
+func backendServices(ing *networkingv1.Ingress) []string {
+	var services []string
+	if b := ing.Spec.DefaultBackend; b != nil && b.Service != nil {
+		services = append(services, b.Service.Name)
+	}
+	for _, rule := range ing.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			if path.Backend.Service != nil {
+				services = append(services, path.Backend.Service.Name)
+			}
+		}
+	}
+	slices.Sort(services)
+	return slices.Compact(services)
+}
+
+func GetMetadata(ing *networkingv1.Ingress) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+	rm := metadata.GetGenericMetadata(&ing.ObjectMeta, constants.K8sKindIngress)
+	rm.Metadata[ingressKeyName] = ing.Name
+	if ing.Spec.IngressClassName != nil {
+		rm.Metadata[ingressKeyClassName] = *ing.Spec.IngressClassName
+	}
+	if services := backendServices(ing); len(services) > 0 {
+		rm.Metadata[ingressKeyBackendServices] = strings.Join(services, ",")
+	}
+	return map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{experimentalmetricmetadata.ResourceID(ing.UID): rm}
+}
@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ingress
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	networkingv1 "k8s.io/api/networking/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
+)
+
+func TestIngressMetrics(t *testing.T) {
+	ing := testutils.NewIngress("1")
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mb := metadata.NewMetricsBuilder(newMetricsBuilderConfig(), receivertest.NewNopSettings(metadata.Type))
+	RecordMetrics(mb, ing, ts)
+	m := mb.Emit()
+
+	expected, err := golden.ReadMetrics(filepath.Join("testdata", "expected.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, pmetrictest.CompareMetrics(expected, m,
+		pmetrictest.IgnoreTimestamp(),
+		pmetrictest.IgnoreStartTimestamp(),
+		pmetrictest.IgnoreResourceMetricsOrder(),
+		pmetrictest.IgnoreMetricsOrder(),
+		pmetrictest.IgnoreScopeMetricsOrder(),
+	),
+	)
+}
+
+func TestBackendServices(t *testing.T) {
+	assert.Equal(t, []string{"default-service", "service-a", "service-b"}, backendServices(testutils.NewIngress("1")))
+
+	// Rules without HTTP values and resource backends are ignored.
+	ing := &networkingv1.Ingress{
+		Spec: networkingv1.IngressSpec{
+			DefaultBackend: &networkingv1.IngressBackend{},
+			Rules:          []networkingv1.IngressRule{{Host: "a.example.com"}},
+		},
+	}
+	assert.Empty(t, backendServices(ing))
+}
+
+func TestIngressMetadata(t *testing.T) {
+	ing := testutils.NewIngress("1")
+
+	actualMetadata := GetMetadata(ing)
+
+	require.Len(t, actualMetadata, 1)
+	require.Equal(t,
+		metadata.KubernetesMetadata{
+			EntityType:    "k8s.ingress",
+			ResourceIDKey: "k8s.ingress.uid",
+			ResourceID:    "test-ingress-1-uid",
+			Metadata: map[string]string{
+				"ingress.creation_timestamp":   "0001-01-01T00:00:00Z",
+				"foo":                          "bar",
+				"foo1":                         "",
+				"k8s.workload.kind":            "Ingress",
+				"k8s.workload.name":            "test-ingress-1",
+				"k8s.namespace.name":           "test-namespace",
+				"k8s.ingress.name":             "test-ingress-1",
+				"k8s.ingress.class_name":       "nginx",
+				"k8s.ingress.backend_services": "default-service,service-a,service-b",
+			},
+		},
+		*actualMetadata["test-ingress-1-uid"],
+	)
+}
+
+// newMetricsBuilderConfig returns the default config with the optional metrics of the kind enabled.
+func newMetricsBuilderConfig() metadata.MetricsBuilderConfig {
+	cfg := metadata.DefaultMetricsBuilderConfig()
+	cfg.ResourceAttributes.K8sIngressName.Enabled = true
+	cfg.ResourceAttributes.K8sIngressUID.Enabled = true
+	cfg.Metrics.K8sIngressBackendServices.Enabled = true
+	cfg.Metrics.K8sIngressRules.Enabled = true
+	return cfg
+}
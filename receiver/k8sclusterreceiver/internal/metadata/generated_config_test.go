@@ -27,52 +27,62 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "all_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					K8sContainerCPULimit:                MetricConfig{Enabled: true},
-					K8sContainerCPURequest:              MetricConfig{Enabled: true},
-					K8sContainerEphemeralstorageLimit:   MetricConfig{Enabled: true},
-					K8sContainerEphemeralstorageRequest: MetricConfig{Enabled: true},
-					K8sContainerMemoryLimit:             MetricConfig{Enabled: true},
-					K8sContainerMemoryRequest:           MetricConfig{Enabled: true},
-					K8sContainerReady:                   MetricConfig{Enabled: true},
-					K8sContainerRestarts:                MetricConfig{Enabled: true},
-					K8sContainerStatusReason:            MetricConfig{Enabled: true},
-					K8sContainerStatusState:             MetricConfig{Enabled: true},
-					K8sContainerStorageLimit:            MetricConfig{Enabled: true},
-					K8sContainerStorageRequest:          MetricConfig{Enabled: true},
-					K8sCronjobActiveJobs:                MetricConfig{Enabled: true},
-					K8sDaemonsetCurrentScheduledNodes:   MetricConfig{Enabled: true},
-					K8sDaemonsetDesiredScheduledNodes:   MetricConfig{Enabled: true},
-					K8sDaemonsetMisscheduledNodes:       MetricConfig{Enabled: true},
-					K8sDaemonsetReadyNodes:              MetricConfig{Enabled: true},
-					K8sDeploymentAvailable:              MetricConfig{Enabled: true},
-					K8sDeploymentDesired:                MetricConfig{Enabled: true},
-					K8sHpaCurrentReplicas:               MetricConfig{Enabled: true},
-					K8sHpaDesiredReplicas:               MetricConfig{Enabled: true},
-					K8sHpaMaxReplicas:                   MetricConfig{Enabled: true},
-					K8sHpaMinReplicas:                   MetricConfig{Enabled: true},
-					K8sJobActivePods:                    MetricConfig{Enabled: true},
-					K8sJobDesiredSuccessfulPods:         MetricConfig{Enabled: true},
-					K8sJobFailedPods:                    MetricConfig{Enabled: true},
-					K8sJobMaxParallelPods:               MetricConfig{Enabled: true},
-					K8sJobSuccessfulPods:                MetricConfig{Enabled: true},
-					K8sNamespacePhase:                   MetricConfig{Enabled: true},
-					K8sNodeCondition:                    MetricConfig{Enabled: true},
-					K8sPodPhase:                         MetricConfig{Enabled: true},
-					K8sPodStatusReason:                  MetricConfig{Enabled: true},
-					K8sReplicasetAvailable:              MetricConfig{Enabled: true},
-					K8sReplicasetDesired:                MetricConfig{Enabled: true},
-					K8sReplicationControllerAvailable:   MetricConfig{Enabled: true},
-					K8sReplicationControllerDesired:     MetricConfig{Enabled: true},
-					K8sResourceQuotaHardLimit:           MetricConfig{Enabled: true},
-					K8sResourceQuotaUsed:                MetricConfig{Enabled: true},
-					K8sStatefulsetCurrentPods:           MetricConfig{Enabled: true},
-					K8sStatefulsetDesiredPods:           MetricConfig{Enabled: true},
-					K8sStatefulsetReadyPods:             MetricConfig{Enabled: true},
-					K8sStatefulsetUpdatedPods:           MetricConfig{Enabled: true},
-					OpenshiftAppliedclusterquotaLimit:   MetricConfig{Enabled: true},
-					OpenshiftAppliedclusterquotaUsed:    MetricConfig{Enabled: true},
-					OpenshiftClusterquotaLimit:          MetricConfig{Enabled: true},
-					OpenshiftClusterquotaUsed:           MetricConfig{Enabled: true},
+					K8sContainerCPULimit:                     MetricConfig{Enabled: true},
+					K8sContainerCPURequest:                   MetricConfig{Enabled: true},
+					K8sContainerEphemeralstorageLimit:        MetricConfig{Enabled: true},
+					K8sContainerEphemeralstorageRequest:      MetricConfig{Enabled: true},
+					K8sContainerMemoryLimit:                  MetricConfig{Enabled: true},
+					K8sContainerMemoryRequest:                MetricConfig{Enabled: true},
+					K8sContainerReady:                        MetricConfig{Enabled: true},
+					K8sContainerRestarts:                     MetricConfig{Enabled: true},
+					K8sContainerStatusReason:                 MetricConfig{Enabled: true},
+					K8sContainerStatusState:                  MetricConfig{Enabled: true},
+					K8sContainerStorageLimit:                 MetricConfig{Enabled: true},
+					K8sContainerStorageRequest:               MetricConfig{Enabled: true},
+					K8sCronjobActiveJobs:                     MetricConfig{Enabled: true},
+					K8sDaemonsetCurrentScheduledNodes:        MetricConfig{Enabled: true},
+					K8sDaemonsetDesiredScheduledNodes:        MetricConfig{Enabled: true},
+					K8sDaemonsetMisscheduledNodes:            MetricConfig{Enabled: true},
+					K8sDaemonsetReadyNodes:                   MetricConfig{Enabled: true},
+					K8sDeploymentAvailable:                   MetricConfig{Enabled: true},
+					K8sDeploymentDesired:                     MetricConfig{Enabled: true},
+					K8sHpaCurrentReplicas:                    MetricConfig{Enabled: true},
+					K8sHpaDesiredReplicas:                    MetricConfig{Enabled: true},
+					K8sHpaMaxReplicas:                        MetricConfig{Enabled: true},
+					K8sHpaMinReplicas:                        MetricConfig{Enabled: true},
+					K8sIngressBackendServices:                MetricConfig{Enabled: true},
+					K8sIngressRules:                          MetricConfig{Enabled: true},
+					K8sJobActivePods:                         MetricConfig{Enabled: true},
+					K8sJobDesiredSuccessfulPods:              MetricConfig{Enabled: true},
+					K8sJobFailedPods:                         MetricConfig{Enabled: true},
+					K8sJobMaxParallelPods:                    MetricConfig{Enabled: true},
+					K8sJobSuccessfulPods:                     MetricConfig{Enabled: true},
+					K8sNamespacePhase:                        MetricConfig{Enabled: true},
+					K8sNodeCondition:                         MetricConfig{Enabled: true},
+					K8sPersistentvolumePhase:                 MetricConfig{Enabled: true},
+					K8sPersistentvolumeStorageCapacity:       MetricConfig{Enabled: true},
+					K8sPersistentvolumeclaimPhase:            MetricConfig{Enabled: true},
+					K8sPersistentvolumeclaimStorageCapacity:  MetricConfig{Enabled: true},
+					K8sPersistentvolumeclaimStorageRequest:   MetricConfig{Enabled: true},
+					K8sPodPhase:                              MetricConfig{Enabled: true},
+					K8sPodStatusReason:                       MetricConfig{Enabled: true},
+					K8sPoddisruptionbudgetCurrentHealthy:     MetricConfig{Enabled: true},
+					K8sPoddisruptionbudgetDesiredHealthy:     MetricConfig{Enabled: true},
+					K8sPoddisruptionbudgetDisruptionsAllowed: MetricConfig{Enabled: true},
+					K8sReplicasetAvailable:                   MetricConfig{Enabled: true},
+					K8sReplicasetDesired:                     MetricConfig{Enabled: true},
+					K8sReplicationControllerAvailable:        MetricConfig{Enabled: true},
+					K8sReplicationControllerDesired:          MetricConfig{Enabled: true},
+					K8sResourceQuotaHardLimit:                MetricConfig{Enabled: true},
+					K8sResourceQuotaUsed:                     MetricConfig{Enabled: true},
+					K8sStatefulsetCurrentPods:                MetricConfig{Enabled: true},
+					K8sStatefulsetDesiredPods:                MetricConfig{Enabled: true},
+					K8sStatefulsetReadyPods:                  MetricConfig{Enabled: true},
+					K8sStatefulsetUpdatedPods:                MetricConfig{Enabled: true},
+					OpenshiftAppliedclusterquotaLimit:        MetricConfig{Enabled: true},
+					OpenshiftAppliedclusterquotaUsed:         MetricConfig{Enabled: true},
+					OpenshiftClusterquotaLimit:               MetricConfig{Enabled: true},
+					OpenshiftClusterquotaUsed:                MetricConfig{Enabled: true},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					ContainerID:                            ResourceAttributeConfig{Enabled: true},
@@ -93,6 +103,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					K8sHpaScaletargetrefKind:               ResourceAttributeConfig{Enabled: true},
 					K8sHpaScaletargetrefName:               ResourceAttributeConfig{Enabled: true},
 					K8sHpaUID:                              ResourceAttributeConfig{Enabled: true},
+					K8sIngressName:                         ResourceAttributeConfig{Enabled: true},
+					K8sIngressUID:                          ResourceAttributeConfig{Enabled: true},
 					K8sJobName:                             ResourceAttributeConfig{Enabled: true},
 					K8sJobUID:                              ResourceAttributeConfig{Enabled: true},
 					K8sKubeletVersion:                      ResourceAttributeConfig{Enabled: true},
@@ -100,9 +112,15 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					K8sNamespaceUID:                        ResourceAttributeConfig{Enabled: true},
 					K8sNodeName:                            ResourceAttributeConfig{Enabled: true},
 					K8sNodeUID:                             ResourceAttributeConfig{Enabled: true},
+					K8sPersistentvolumeName:                ResourceAttributeConfig{Enabled: true},
+					K8sPersistentvolumeUID:                 ResourceAttributeConfig{Enabled: true},
+					K8sPersistentvolumeclaimName:           ResourceAttributeConfig{Enabled: true},
+					K8sPersistentvolumeclaimUID:            ResourceAttributeConfig{Enabled: true},
 					K8sPodName:                             ResourceAttributeConfig{Enabled: true},
 					K8sPodQosClass:                         ResourceAttributeConfig{Enabled: true},
 					K8sPodUID:                              ResourceAttributeConfig{Enabled: true},
+					K8sPoddisruptionbudgetName:             ResourceAttributeConfig{Enabled: true},
+					K8sPoddisruptionbudgetUID:              ResourceAttributeConfig{Enabled: true},
 					K8sReplicasetName:                      ResourceAttributeConfig{Enabled: true},
 					K8sReplicasetUID:                       ResourceAttributeConfig{Enabled: true},
 					K8sReplicationcontrollerName:           ResourceAttributeConfig{Enabled: true},
@@ -111,6 +129,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					K8sResourcequotaUID:                    ResourceAttributeConfig{Enabled: true},
 					K8sStatefulsetName:                     ResourceAttributeConfig{Enabled: true},
 					K8sStatefulsetUID:                      ResourceAttributeConfig{Enabled: true},
+					K8sStorageclassName:                    ResourceAttributeConfig{Enabled: true},
 					OpenshiftClusterquotaName:              ResourceAttributeConfig{Enabled: true},
 					OpenshiftClusterquotaUID:               ResourceAttributeConfig{Enabled: true},
 					OsDescription:                          ResourceAttributeConfig{Enabled: true},
@@ -122,52 +141,62 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "none_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					K8sContainerCPULimit:                MetricConfig{Enabled: false},
-					K8sContainerCPURequest:              MetricConfig{Enabled: false},
-					K8sContainerEphemeralstorageLimit:   MetricConfig{Enabled: false},
-					K8sContainerEphemeralstorageRequest: MetricConfig{Enabled: false},
-					K8sContainerMemoryLimit:             MetricConfig{Enabled: false},
-					K8sContainerMemoryRequest:           MetricConfig{Enabled: false},
-					K8sContainerReady:                   MetricConfig{Enabled: false},
-					K8sContainerRestarts:                MetricConfig{Enabled: false},
-					K8sContainerStatusReason:            MetricConfig{Enabled: false},
-					K8sContainerStatusState:             MetricConfig{Enabled: false},
-					K8sContainerStorageLimit:            MetricConfig{Enabled: false},
-					K8sContainerStorageRequest:          MetricConfig{Enabled: false},
-					K8sCronjobActiveJobs:                MetricConfig{Enabled: false},
-					K8sDaemonsetCurrentScheduledNodes:   MetricConfig{Enabled: false},
-					K8sDaemonsetDesiredScheduledNodes:   MetricConfig{Enabled: false},
-					K8sDaemonsetMisscheduledNodes:       MetricConfig{Enabled: false},
-					K8sDaemonsetReadyNodes:              MetricConfig{Enabled: false},
-					K8sDeploymentAvailable:              MetricConfig{Enabled: false},
-					K8sDeploymentDesired:                MetricConfig{Enabled: false},
-					K8sHpaCurrentReplicas:               MetricConfig{Enabled: false},
-					K8sHpaDesiredReplicas:               MetricConfig{Enabled: false},
-					K8sHpaMaxReplicas:                   MetricConfig{Enabled: false},
-					K8sHpaMinReplicas:                   MetricConfig{Enabled: false},
-					K8sJobActivePods:                    MetricConfig{Enabled: false},
-					K8sJobDesiredSuccessfulPods:         MetricConfig{Enabled: false},
-					K8sJobFailedPods:                    MetricConfig{Enabled: false},
-					K8sJobMaxParallelPods:               MetricConfig{Enabled: false},
-					K8sJobSuccessfulPods:                MetricConfig{Enabled: false},
-					K8sNamespacePhase:                   MetricConfig{Enabled: false},
-					K8sNodeCondition:                    MetricConfig{Enabled: false},
-					K8sPodPhase:                         MetricConfig{Enabled: false},
-					K8sPodStatusReason:                  MetricConfig{Enabled: false},
-					K8sReplicasetAvailable:              MetricConfig{Enabled: false},
-					K8sReplicasetDesired:                MetricConfig{Enabled: false},
-					K8sReplicationControllerAvailable:   MetricConfig{Enabled: false},
-					K8sReplicationControllerDesired:     MetricConfig{Enabled: false},
-					K8sResourceQuotaHardLimit:           MetricConfig{Enabled: false},
-					K8sResourceQuotaUsed:                MetricConfig{Enabled: false},
-					K8sStatefulsetCurrentPods:           MetricConfig{Enabled: false},
-					K8sStatefulsetDesiredPods:           MetricConfig{Enabled: false},
-					K8sStatefulsetReadyPods:             MetricConfig{Enabled: false},
-					K8sStatefulsetUpdatedPods:           MetricConfig{Enabled: false},
-					OpenshiftAppliedclusterquotaLimit:   MetricConfig{Enabled: false},
-					OpenshiftAppliedclusterquotaUsed:    MetricConfig{Enabled: false},
-					OpenshiftClusterquotaLimit:          MetricConfig{Enabled: false},
-					OpenshiftClusterquotaUsed:           MetricConfig{Enabled: false},
+					K8sContainerCPULimit:                     MetricConfig{Enabled: false},
+					K8sContainerCPURequest:                   MetricConfig{Enabled: false},
+					K8sContainerEphemeralstorageLimit:        MetricConfig{Enabled: false},
+					K8sContainerEphemeralstorageRequest:      MetricConfig{Enabled: false},
+					K8sContainerMemoryLimit:                  MetricConfig{Enabled: false},
+					K8sContainerMemoryRequest:                MetricConfig{Enabled: false},
+					K8sContainerReady:                        MetricConfig{Enabled: false},
+					K8sContainerRestarts:                     MetricConfig{Enabled: false},
+					K8sContainerStatusReason:                 MetricConfig{Enabled: false},
+					K8sContainerStatusState:                  MetricConfig{Enabled: false},
+					K8sContainerStorageLimit:                 MetricConfig{Enabled: false},
+					K8sContainerStorageRequest:               MetricConfig{Enabled: false},
+					K8sCronjobActiveJobs:                     MetricConfig{Enabled: false},
+					K8sDaemonsetCurrentScheduledNodes:        MetricConfig{Enabled: false},
+					K8sDaemonsetDesiredScheduledNodes:        MetricConfig{Enabled: false},
+					K8sDaemonsetMisscheduledNodes:            MetricConfig{Enabled: false},
+					K8sDaemonsetReadyNodes:                   MetricConfig{Enabled: false},
+					K8sDeploymentAvailable:                   MetricConfig{Enabled: false},
+					K8sDeploymentDesired:                     MetricConfig{Enabled: false},
+					K8sHpaCurrentReplicas:                    MetricConfig{Enabled: false},
+					K8sHpaDesiredReplicas:                    MetricConfig{Enabled: false},
+					K8sHpaMaxReplicas:                        MetricConfig{Enabled: false},
+					K8sHpaMinReplicas:                        MetricConfig{Enabled: false},
+					K8sIngressBackendServices:                MetricConfig{Enabled: false},
+					K8sIngressRules:                          MetricConfig{Enabled: false},
+					K8sJobActivePods:                         MetricConfig{Enabled: false},
+					K8sJobDesiredSuccessfulPods:              MetricConfig{Enabled: false},
+					K8sJobFailedPods:                         MetricConfig{Enabled: false},
+					K8sJobMaxParallelPods:                    MetricConfig{Enabled: false},
+					K8sJobSuccessfulPods:                     MetricConfig{Enabled: false},
+					K8sNamespacePhase:                        MetricConfig{Enabled: false},
+					K8sNodeCondition:                         MetricConfig{Enabled: false},
+					K8sPersistentvolumePhase:                 MetricConfig{Enabled: false},
+					K8sPersistentvolumeStorageCapacity:       MetricConfig{Enabled: false},
+					K8sPersistentvolumeclaimPhase:            MetricConfig{Enabled: false},
+					K8sPersistentvolumeclaimStorageCapacity:  MetricConfig{Enabled: false},
+					K8sPersistentvolumeclaimStorageRequest:   MetricConfig{Enabled: false},
+					K8sPodPhase:                              MetricConfig{Enabled: false},
+					K8sPodStatusReason:                       MetricConfig{Enabled: false},
+					K8sPoddisruptionbudgetCurrentHealthy:     MetricConfig{Enabled: false},
+					K8sPoddisruptionbudgetDesiredHealthy:     MetricConfig{Enabled: false},
+					K8sPoddisruptionbudgetDisruptionsAllowed: MetricConfig{Enabled: false},
+					K8sReplicasetAvailable:                   MetricConfig{Enabled: false},
+					K8sReplicasetDesired:                     MetricConfig{Enabled: false},
+					K8sReplicationControllerAvailable:        MetricConfig{Enabled: false},
+					K8sReplicationControllerDesired:          MetricConfig{Enabled: false},
+					K8sResourceQuotaHardLimit:                MetricConfig{Enabled: false},
+					K8sResourceQuotaUsed:                     MetricConfig{Enabled: false},
+					K8sStatefulsetCurrentPods:                MetricConfig{Enabled: false},
+					K8sStatefulsetDesiredPods:                MetricConfig{Enabled: false},
+					K8sStatefulsetReadyPods:                  MetricConfig{Enabled: false},
+					K8sStatefulsetUpdatedPods:                MetricConfig{Enabled: false},
+					OpenshiftAppliedclusterquotaLimit:        MetricConfig{Enabled: false},
+					OpenshiftAppliedclusterquotaUsed:         MetricConfig{Enabled: false},
+					OpenshiftClusterquotaLimit:               MetricConfig{Enabled: false},
+					OpenshiftClusterquotaUsed:                MetricConfig{Enabled: false},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					ContainerID:                            ResourceAttributeConfig{Enabled: false},
@@ -188,6 +217,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					K8sHpaScaletargetrefKind:               ResourceAttributeConfig{Enabled: false},
 					K8sHpaScaletargetrefName:               ResourceAttributeConfig{Enabled: false},
 					K8sHpaUID:                              ResourceAttributeConfig{Enabled: false},
+					K8sIngressName:                         ResourceAttributeConfig{Enabled: false},
+					K8sIngressUID:                          ResourceAttributeConfig{Enabled: false},
 					K8sJobName:                             ResourceAttributeConfig{Enabled: false},
 					K8sJobUID:                              ResourceAttributeConfig{Enabled: false},
 					K8sKubeletVersion:                      ResourceAttributeConfig{Enabled: false},
@@ -195,9 +226,15 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					K8sNamespaceUID:                        ResourceAttributeConfig{Enabled: false},
 					K8sNodeName:                            ResourceAttributeConfig{Enabled: false},
 					K8sNodeUID:                             ResourceAttributeConfig{Enabled: false},
+					K8sPersistentvolumeName:                ResourceAttributeConfig{Enabled: false},
+					K8sPersistentvolumeUID:                 ResourceAttributeConfig{Enabled: false},
+					K8sPersistentvolumeclaimName:           ResourceAttributeConfig{Enabled: false},
+					K8sPersistentvolumeclaimUID:            ResourceAttributeConfig{Enabled: false},
 					K8sPodName:                             ResourceAttributeConfig{Enabled: false},
 					K8sPodQosClass:                         ResourceAttributeConfig{Enabled: false},
 					K8sPodUID:                              ResourceAttributeConfig{Enabled: false},
+					K8sPoddisruptionbudgetName:             ResourceAttributeConfig{Enabled: false},
+					K8sPoddisruptionbudgetUID:              ResourceAttributeConfig{Enabled: false},
 					K8sReplicasetName:                      ResourceAttributeConfig{Enabled: false},
 					K8sReplicasetUID:                       ResourceAttributeConfig{Enabled: false},
 					K8sReplicationcontrollerName:           ResourceAttributeConfig{Enabled: false},
@@ -206,6 +243,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					K8sResourcequotaUID:                    ResourceAttributeConfig{Enabled: false},
 					K8sStatefulsetName:                     ResourceAttributeConfig{Enabled: false},
 					K8sStatefulsetUID:                      ResourceAttributeConfig{Enabled: false},
+					K8sStorageclassName:                    ResourceAttributeConfig{Enabled: false},
 					OpenshiftClusterquotaName:              ResourceAttributeConfig{Enabled: false},
 					OpenshiftClusterquotaUID:               ResourceAttributeConfig{Enabled: false},
 					OsDescription:                          ResourceAttributeConfig{Enabled: false},
@@ -263,6 +301,8 @@ func TestResourceAttributesConfig(t *testing.T) {
 				K8sHpaScaletargetrefKind:               ResourceAttributeConfig{Enabled: true},
 				K8sHpaScaletargetrefName:               ResourceAttributeConfig{Enabled: true},
 				K8sHpaUID:                              ResourceAttributeConfig{Enabled: true},
+				K8sIngressName:                         ResourceAttributeConfig{Enabled: true},
+				K8sIngressUID:                          ResourceAttributeConfig{Enabled: true},
 				K8sJobName:                             ResourceAttributeConfig{Enabled: true},
 				K8sJobUID:                              ResourceAttributeConfig{Enabled: true},
 				K8sKubeletVersion:                      ResourceAttributeConfig{Enabled: true},
@@ -270,9 +310,15 @@ func TestResourceAttributesConfig(t *testing.T) {
 				K8sNamespaceUID:                        ResourceAttributeConfig{Enabled: true},
 				K8sNodeName:                            ResourceAttributeConfig{Enabled: true},
 				K8sNodeUID:                             ResourceAttributeConfig{Enabled: true},
+				K8sPersistentvolumeName:                ResourceAttributeConfig{Enabled: true},
+				K8sPersistentvolumeUID:                 ResourceAttributeConfig{Enabled: true},
+				K8sPersistentvolumeclaimName:           ResourceAttributeConfig{Enabled: true},
+				K8sPersistentvolumeclaimUID:            ResourceAttributeConfig{Enabled: true},
 				K8sPodName:                             ResourceAttributeConfig{Enabled: true},
 				K8sPodQosClass:                         ResourceAttributeConfig{Enabled: true},
 				K8sPodUID:                              ResourceAttributeConfig{Enabled: true},
+				K8sPoddisruptionbudgetName:             ResourceAttributeConfig{Enabled: true},
+				K8sPoddisruptionbudgetUID:              ResourceAttributeConfig{Enabled: true},
 				K8sReplicasetName:                      ResourceAttributeConfig{Enabled: true},
 				K8sReplicasetUID:                       ResourceAttributeConfig{Enabled: true},
 				K8sReplicationcontrollerName:           ResourceAttributeConfig{Enabled: true},
@@ -281,6 +327,7 @@ func TestResourceAttributesConfig(t *testing.T) {
 				K8sResourcequotaUID:                    ResourceAttributeConfig{Enabled: true},
 				K8sStatefulsetName:                     ResourceAttributeConfig{Enabled: true},
 				K8sStatefulsetUID:                      ResourceAttributeConfig{Enabled: true},
+				K8sStorageclassName:                    ResourceAttributeConfig{Enabled: true},
 				OpenshiftClusterquotaName:              ResourceAttributeConfig{Enabled: true},
 				OpenshiftClusterquotaUID:               ResourceAttributeConfig{Enabled: true},
 				OsDescription:                          ResourceAttributeConfig{Enabled: true},
@@ -308,6 +355,8 @@ func TestResourceAttributesConfig(t *testing.T) {
 				K8sHpaScaletargetrefKind:               ResourceAttributeConfig{Enabled: false},
 				K8sHpaScaletargetrefName:               ResourceAttributeConfig{Enabled: false},
 				K8sHpaUID:                              ResourceAttributeConfig{Enabled: false},
+				K8sIngressName:                         ResourceAttributeConfig{Enabled: false},
+				K8sIngressUID:                          ResourceAttributeConfig{Enabled: false},
 				K8sJobName:                             ResourceAttributeConfig{Enabled: false},
 				K8sJobUID:                              ResourceAttributeConfig{Enabled: false},
 				K8sKubeletVersion:                      ResourceAttributeConfig{Enabled: false},
@@ -315,9 +364,15 @@ func TestResourceAttributesConfig(t *testing.T) {
 				K8sNamespaceUID:                        ResourceAttributeConfig{Enabled: false},
 				K8sNodeName:                            ResourceAttributeConfig{Enabled: false},
 				K8sNodeUID:                             ResourceAttributeConfig{Enabled: false},
+				K8sPersistentvolumeName:                ResourceAttributeConfig{Enabled: false},
+				K8sPersistentvolumeUID:                 ResourceAttributeConfig{Enabled: false},
+				K8sPersistentvolumeclaimName:           ResourceAttributeConfig{Enabled: false},
+				K8sPersistentvolumeclaimUID:            ResourceAttributeConfig{Enabled: false},
 				K8sPodName:                             ResourceAttributeConfig{Enabled: false},
 				K8sPodQosClass:                         ResourceAttributeConfig{Enabled: false},
 				K8sPodUID:                              ResourceAttributeConfig{Enabled: false},
+				K8sPoddisruptionbudgetName:             ResourceAttributeConfig{Enabled: false},
+				K8sPoddisruptionbudgetUID:              ResourceAttributeConfig{Enabled: false},
 				K8sReplicasetName:                      ResourceAttributeConfig{Enabled: false},
 				K8sReplicasetUID:                       ResourceAttributeConfig{Enabled: false},
 				K8sReplicationcontrollerName:           ResourceAttributeConfig{Enabled: false},
@@ -326,6 +381,7 @@ func TestResourceAttributesConfig(t *testing.T) {
 				K8sResourcequotaUID:                    ResourceAttributeConfig{Enabled: false},
 				K8sStatefulsetName:                     ResourceAttributeConfig{Enabled: false},
 				K8sStatefulsetUID:                      ResourceAttributeConfig{Enabled: false},
+				K8sStorageclassName:                    ResourceAttributeConfig{Enabled: false},
 				OpenshiftClusterquotaName:              ResourceAttributeConfig{Enabled: false},
 				OpenshiftClusterquotaUID:               ResourceAttributeConfig{Enabled: false},
 				OsDescription:                          ResourceAttributeConfig{Enabled: false},
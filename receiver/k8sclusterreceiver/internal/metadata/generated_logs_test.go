@@ -39,6 +39,8 @@ func TestLogsBuilderAppendLogRecord(t *testing.T) {
 	rb.SetK8sHpaScaletargetrefKind("k8s.hpa.scaletargetref.kind-val")
 	rb.SetK8sHpaScaletargetrefName("k8s.hpa.scaletargetref.name-val")
 	rb.SetK8sHpaUID("k8s.hpa.uid-val")
+	rb.SetK8sIngressName("k8s.ingress.name-val")
+	rb.SetK8sIngressUID("k8s.ingress.uid-val")
 	rb.SetK8sJobName("k8s.job.name-val")
 	rb.SetK8sJobUID("k8s.job.uid-val")
 	rb.SetK8sKubeletVersion("k8s.kubelet.version-val")
@@ -46,9 +48,15 @@ func TestLogsBuilderAppendLogRecord(t *testing.T) {
 	rb.SetK8sNamespaceUID("k8s.namespace.uid-val")
 	rb.SetK8sNodeName("k8s.node.name-val")
 	rb.SetK8sNodeUID("k8s.node.uid-val")
+	rb.SetK8sPersistentvolumeName("k8s.persistentvolume.name-val")
+	rb.SetK8sPersistentvolumeUID("k8s.persistentvolume.uid-val")
+	rb.SetK8sPersistentvolumeclaimName("k8s.persistentvolumeclaim.name-val")
+	rb.SetK8sPersistentvolumeclaimUID("k8s.persistentvolumeclaim.uid-val")
 	rb.SetK8sPodName("k8s.pod.name-val")
 	rb.SetK8sPodQosClass("k8s.pod.qos_class-val")
 	rb.SetK8sPodUID("k8s.pod.uid-val")
+	rb.SetK8sPoddisruptionbudgetName("k8s.poddisruptionbudget.name-val")
+	rb.SetK8sPoddisruptionbudgetUID("k8s.poddisruptionbudget.uid-val")
 	rb.SetK8sReplicasetName("k8s.replicaset.name-val")
 	rb.SetK8sReplicasetUID("k8s.replicaset.uid-val")
 	rb.SetK8sReplicationcontrollerName("k8s.replicationcontroller.name-val")
@@ -57,6 +65,7 @@ func TestLogsBuilderAppendLogRecord(t *testing.T) {
 	rb.SetK8sResourcequotaUID("k8s.resourcequota.uid-val")
 	rb.SetK8sStatefulsetName("k8s.statefulset.name-val")
 	rb.SetK8sStatefulsetUID("k8s.statefulset.uid-val")
+	rb.SetK8sStorageclassName("k8s.storageclass.name-val")
 	rb.SetOpenshiftClusterquotaName("openshift.clusterquota.name-val")
 	rb.SetOpenshiftClusterquotaUID("openshift.clusterquota.uid-val")
 	rb.SetOsDescription("os.description-val")
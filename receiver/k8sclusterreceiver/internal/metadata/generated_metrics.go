@@ -167,6 +167,12 @@ var MetricsInfo = metricsInfo{
 	K8sHpaMinReplicas: metricInfo{
 		Name: "k8s.hpa.min_replicas",
 	},
+	K8sIngressBackendServices: metricInfo{
+		Name: "k8s.ingress.backend_services",
+	},
+	K8sIngressRules: metricInfo{
+		Name: "k8s.ingress.rules",
+	},
 	K8sJobActivePods: metricInfo{
 		Name: "k8s.job.active_pods",
 	},
@@ -188,12 +194,36 @@ var MetricsInfo = metricsInfo{
 	K8sNodeCondition: metricInfo{
 		Name: "k8s.node.condition",
 	},
+	K8sPersistentvolumePhase: metricInfo{
+		Name: "k8s.persistentvolume.phase",
+	},
+	K8sPersistentvolumeStorageCapacity: metricInfo{
+		Name: "k8s.persistentvolume.storage.capacity",
+	},
+	K8sPersistentvolumeclaimPhase: metricInfo{
+		Name: "k8s.persistentvolumeclaim.phase",
+	},
+	K8sPersistentvolumeclaimStorageCapacity: metricInfo{
+		Name: "k8s.persistentvolumeclaim.storage.capacity",
+	},
+	K8sPersistentvolumeclaimStorageRequest: metricInfo{
+		Name: "k8s.persistentvolumeclaim.storage.request",
+	},
 	K8sPodPhase: metricInfo{
 		Name: "k8s.pod.phase",
 	},
 	K8sPodStatusReason: metricInfo{
 		Name: "k8s.pod.status_reason",
 	},
+	K8sPoddisruptionbudgetCurrentHealthy: metricInfo{
+		Name: "k8s.poddisruptionbudget.current_healthy",
+	},
+	K8sPoddisruptionbudgetDesiredHealthy: metricInfo{
+		Name: "k8s.poddisruptionbudget.desired_healthy",
+	},
+	K8sPoddisruptionbudgetDisruptionsAllowed: metricInfo{
+		Name: "k8s.poddisruptionbudget.disruptions_allowed",
+	},
 	K8sReplicasetAvailable: metricInfo{
 		Name: "k8s.replicaset.available",
 	},
@@ -239,52 +269,62 @@ var MetricsInfo = metricsInfo{
 }
 
 type metricsInfo struct {
-	K8sContainerCPULimit                metricInfo
-	K8sContainerCPURequest              metricInfo
-	K8sContainerEphemeralstorageLimit   metricInfo
-	K8sContainerEphemeralstorageRequest metricInfo
-	K8sContainerMemoryLimit             metricInfo
-	K8sContainerMemoryRequest           metricInfo
-	K8sContainerReady                   metricInfo
-	K8sContainerRestarts                metricInfo
-	K8sContainerStatusReason            metricInfo
-	K8sContainerStatusState             metricInfo
-	K8sContainerStorageLimit            metricInfo
-	K8sContainerStorageRequest          metricInfo
-	K8sCronjobActiveJobs                metricInfo
-	K8sDaemonsetCurrentScheduledNodes   metricInfo
-	K8sDaemonsetDesiredScheduledNodes   metricInfo
-	K8sDaemonsetMisscheduledNodes       metricInfo
-	K8sDaemonsetReadyNodes              metricInfo
-	K8sDeploymentAvailable              metricInfo
-	K8sDeploymentDesired                metricInfo
-	K8sHpaCurrentReplicas               metricInfo
-	K8sHpaDesiredReplicas               metricInfo
-	K8sHpaMaxReplicas                   metricInfo
-	K8sHpaMinReplicas                   metricInfo
-	K8sJobActivePods                    metricInfo
-	K8sJobDesiredSuccessfulPods         metricInfo
-	K8sJobFailedPods                    metricInfo
-	K8sJobMaxParallelPods               metricInfo
-	K8sJobSuccessfulPods                metricInfo
-	K8sNamespacePhase                   metricInfo
-	K8sNodeCondition                    metricInfo
-	K8sPodPhase                         metricInfo
-	K8sPodStatusReason                  metricInfo
-	K8sReplicasetAvailable              metricInfo
-	K8sReplicasetDesired                metricInfo
-	K8sReplicationControllerAvailable   metricInfo
-	K8sReplicationControllerDesired     metricInfo
-	K8sResourceQuotaHardLimit           metricInfo
-	K8sResourceQuotaUsed                metricInfo
-	K8sStatefulsetCurrentPods           metricInfo
-	K8sStatefulsetDesiredPods           metricInfo
-	K8sStatefulsetReadyPods             metricInfo
-	K8sStatefulsetUpdatedPods           metricInfo
-	OpenshiftAppliedclusterquotaLimit   metricInfo
-	OpenshiftAppliedclusterquotaUsed    metricInfo
-	OpenshiftClusterquotaLimit          metricInfo
-	OpenshiftClusterquotaUsed           metricInfo
+	K8sContainerCPULimit                     metricInfo
+	K8sContainerCPURequest                   metricInfo
+	K8sContainerEphemeralstorageLimit        metricInfo
+	K8sContainerEphemeralstorageRequest      metricInfo
+	K8sContainerMemoryLimit                  metricInfo
+	K8sContainerMemoryRequest                metricInfo
+	K8sContainerReady                        metricInfo
+	K8sContainerRestarts                     metricInfo
+	K8sContainerStatusReason                 metricInfo
+	K8sContainerStatusState                  metricInfo
+	K8sContainerStorageLimit                 metricInfo
+	K8sContainerStorageRequest               metricInfo
+	K8sCronjobActiveJobs                     metricInfo
+	K8sDaemonsetCurrentScheduledNodes        metricInfo
+	K8sDaemonsetDesiredScheduledNodes        metricInfo
+	K8sDaemonsetMisscheduledNodes            metricInfo
+	K8sDaemonsetReadyNodes                   metricInfo
+	K8sDeploymentAvailable                   metricInfo
+	K8sDeploymentDesired                     metricInfo
+	K8sHpaCurrentReplicas                    metricInfo
+	K8sHpaDesiredReplicas                    metricInfo
+	K8sHpaMaxReplicas                        metricInfo
+	K8sHpaMinReplicas                        metricInfo
+	K8sIngressBackendServices                metricInfo
+	K8sIngressRules                          metricInfo
+	K8sJobActivePods                         metricInfo
+	K8sJobDesiredSuccessfulPods              metricInfo
+	K8sJobFailedPods                         metricInfo
+	K8sJobMaxParallelPods                    metricInfo
+	K8sJobSuccessfulPods                     metricInfo
+	K8sNamespacePhase                        metricInfo
+	K8sNodeCondition                         metricInfo
+	K8sPersistentvolumePhase                 metricInfo
+	K8sPersistentvolumeStorageCapacity       metricInfo
+	K8sPersistentvolumeclaimPhase            metricInfo
+	K8sPersistentvolumeclaimStorageCapacity  metricInfo
+	K8sPersistentvolumeclaimStorageRequest   metricInfo
+	K8sPodPhase                              metricInfo
+	K8sPodStatusReason                       metricInfo
+	K8sPoddisruptionbudgetCurrentHealthy     metricInfo
+	K8sPoddisruptionbudgetDesiredHealthy     metricInfo
+	K8sPoddisruptionbudgetDisruptionsAllowed metricInfo
+	K8sReplicasetAvailable                   metricInfo
+	K8sReplicasetDesired                     metricInfo
+	K8sReplicationControllerAvailable        metricInfo
+	K8sReplicationControllerDesired          metricInfo
+	K8sResourceQuotaHardLimit                metricInfo
+	K8sResourceQuotaUsed                     metricInfo
+	K8sStatefulsetCurrentPods                metricInfo
+	K8sStatefulsetDesiredPods                metricInfo
+	K8sStatefulsetReadyPods                  metricInfo
+	K8sStatefulsetUpdatedPods                metricInfo
+	OpenshiftAppliedclusterquotaLimit        metricInfo
+	OpenshiftAppliedclusterquotaUsed         metricInfo
+	OpenshiftClusterquotaLimit               metricInfo
+	OpenshiftClusterquotaUsed                metricInfo
 }
 
 type metricInfo struct {
@@ -1426,6 +1466,104 @@ func newMetricK8sHpaMinReplicas(cfg MetricConfig) metricK8sHpaMinReplicas {
 	return m
 }
 
+type metricK8sIngressBackendServices struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.ingress.backend_services metric with initial data.
+func (m *metricK8sIngressBackendServices) init() {
+	m.data.SetName("k8s.ingress.backend_services")
+	m.data.SetDescription("The number of distinct services referenced as backends by the ingress, including the default backend")
+	m.data.SetUnit("{service}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sIngressBackendServices) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sIngressBackendServices) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sIngressBackendServices) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sIngressBackendServices(cfg MetricConfig) metricK8sIngressBackendServices {
+	m := metricK8sIngressBackendServices{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sIngressRules struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.ingress.rules metric with initial data.
+func (m *metricK8sIngressRules) init() {
+	m.data.SetName("k8s.ingress.rules")
+	m.data.SetDescription("The number of rules defined in the ingress")
+	m.data.SetUnit("{rule}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sIngressRules) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sIngressRules) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sIngressRules) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sIngressRules(cfg MetricConfig) metricK8sIngressRules {
+	m := metricK8sIngressRules{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricK8sJobActivePods struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1771,6 +1909,251 @@ func newMetricK8sNodeCondition(cfg MetricConfig) metricK8sNodeCondition {
 	return m
 }
 
+type metricK8sPersistentvolumePhase struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.persistentvolume.phase metric with initial data.
+func (m *metricK8sPersistentvolumePhase) init() {
+	m.data.SetName("k8s.persistentvolume.phase")
+	m.data.SetDescription("Current phase of the persistent volume (1 - Pending, 2 - Available, 3 - Bound, 4 - Released, 5 - Failed)")
+	m.data.SetUnit("")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPersistentvolumePhase) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPersistentvolumePhase) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPersistentvolumePhase) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPersistentvolumePhase(cfg MetricConfig) metricK8sPersistentvolumePhase {
+	m := metricK8sPersistentvolumePhase{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sPersistentvolumeStorageCapacity struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.persistentvolume.storage.capacity metric with initial data.
+func (m *metricK8sPersistentvolumeStorageCapacity) init() {
+	m.data.SetName("k8s.persistentvolume.storage.capacity")
+	m.data.SetDescription("The storage capacity of the persistent volume")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPersistentvolumeStorageCapacity) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPersistentvolumeStorageCapacity) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPersistentvolumeStorageCapacity) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPersistentvolumeStorageCapacity(cfg MetricConfig) metricK8sPersistentvolumeStorageCapacity {
+	m := metricK8sPersistentvolumeStorageCapacity{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sPersistentvolumeclaimPhase struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.persistentvolumeclaim.phase metric with initial data.
+func (m *metricK8sPersistentvolumeclaimPhase) init() {
+	m.data.SetName("k8s.persistentvolumeclaim.phase")
+	m.data.SetDescription("Current phase of the persistent volume claim (1 - Pending, 2 - Bound, 3 - Lost)")
+	m.data.SetUnit("")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPersistentvolumeclaimPhase) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPersistentvolumeclaimPhase) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPersistentvolumeclaimPhase) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPersistentvolumeclaimPhase(cfg MetricConfig) metricK8sPersistentvolumeclaimPhase {
+	m := metricK8sPersistentvolumeclaimPhase{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sPersistentvolumeclaimStorageCapacity struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.persistentvolumeclaim.storage.capacity metric with initial data.
+func (m *metricK8sPersistentvolumeclaimStorageCapacity) init() {
+	m.data.SetName("k8s.persistentvolumeclaim.storage.capacity")
+	m.data.SetDescription("The storage capacity of the volume bound to the persistent volume claim. Will only be sent once the claim is bound")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPersistentvolumeclaimStorageCapacity) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPersistentvolumeclaimStorageCapacity) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPersistentvolumeclaimStorageCapacity) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPersistentvolumeclaimStorageCapacity(cfg MetricConfig) metricK8sPersistentvolumeclaimStorageCapacity {
+	m := metricK8sPersistentvolumeclaimStorageCapacity{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sPersistentvolumeclaimStorageRequest struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.persistentvolumeclaim.storage.request metric with initial data.
+func (m *metricK8sPersistentvolumeclaimStorageRequest) init() {
+	m.data.SetName("k8s.persistentvolumeclaim.storage.request")
+	m.data.SetDescription("The storage requested by the persistent volume claim")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPersistentvolumeclaimStorageRequest) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPersistentvolumeclaimStorageRequest) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPersistentvolumeclaimStorageRequest) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPersistentvolumeclaimStorageRequest(cfg MetricConfig) metricK8sPersistentvolumeclaimStorageRequest {
+	m := metricK8sPersistentvolumeclaimStorageRequest{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricK8sPodPhase struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1869,6 +2252,153 @@ func newMetricK8sPodStatusReason(cfg MetricConfig) metricK8sPodStatusReason {
 	return m
 }
 
+type metricK8sPoddisruptionbudgetCurrentHealthy struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.poddisruptionbudget.current_healthy metric with initial data.
+func (m *metricK8sPoddisruptionbudgetCurrentHealthy) init() {
+	m.data.SetName("k8s.poddisruptionbudget.current_healthy")
+	m.data.SetDescription("The number of currently healthy pods selected by the pod disruption budget")
+	m.data.SetUnit("{pod}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPoddisruptionbudgetCurrentHealthy) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPoddisruptionbudgetCurrentHealthy) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPoddisruptionbudgetCurrentHealthy) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPoddisruptionbudgetCurrentHealthy(cfg MetricConfig) metricK8sPoddisruptionbudgetCurrentHealthy {
+	m := metricK8sPoddisruptionbudgetCurrentHealthy{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sPoddisruptionbudgetDesiredHealthy struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.poddisruptionbudget.desired_healthy metric with initial data.
+func (m *metricK8sPoddisruptionbudgetDesiredHealthy) init() {
+	m.data.SetName("k8s.poddisruptionbudget.desired_healthy")
+	m.data.SetDescription("The minimum number of healthy pods desired by the pod disruption budget")
+	m.data.SetUnit("{pod}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPoddisruptionbudgetDesiredHealthy) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPoddisruptionbudgetDesiredHealthy) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPoddisruptionbudgetDesiredHealthy) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPoddisruptionbudgetDesiredHealthy(cfg MetricConfig) metricK8sPoddisruptionbudgetDesiredHealthy {
+	m := metricK8sPoddisruptionbudgetDesiredHealthy{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sPoddisruptionbudgetDisruptionsAllowed struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.poddisruptionbudget.disruptions_allowed metric with initial data.
+func (m *metricK8sPoddisruptionbudgetDisruptionsAllowed) init() {
+	m.data.SetName("k8s.poddisruptionbudget.disruptions_allowed")
+	m.data.SetDescription("The number of pod disruptions that are currently allowed by the pod disruption budget")
+	m.data.SetUnit("{pod}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPoddisruptionbudgetDisruptionsAllowed) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPoddisruptionbudgetDisruptionsAllowed) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPoddisruptionbudgetDisruptionsAllowed) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPoddisruptionbudgetDisruptionsAllowed(cfg MetricConfig) metricK8sPoddisruptionbudgetDisruptionsAllowed {
+	m := metricK8sPoddisruptionbudgetDisruptionsAllowed{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricK8sReplicasetAvailable struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -2572,59 +3102,69 @@ func newMetricOpenshiftClusterquotaUsed(cfg MetricConfig) metricOpenshiftCluster
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
-	config                                    MetricsBuilderConfig // config of the metrics builder.
-	startTime                                 pcommon.Timestamp    // start time that will be applied to all recorded data points.
-	metricsCapacity                           int                  // maximum observed number of metrics per resource.
-	metricsBuffer                             pmetric.Metrics      // accumulates metrics data before emitting.
-	buildInfo                                 component.BuildInfo  // contains version information.
-	resourceAttributeIncludeFilter            map[string]filter.Filter
-	resourceAttributeExcludeFilter            map[string]filter.Filter
-	metricK8sContainerCPULimit                metricK8sContainerCPULimit
-	metricK8sContainerCPURequest              metricK8sContainerCPURequest
-	metricK8sContainerEphemeralstorageLimit   metricK8sContainerEphemeralstorageLimit
-	metricK8sContainerEphemeralstorageRequest metricK8sContainerEphemeralstorageRequest
-	metricK8sContainerMemoryLimit             metricK8sContainerMemoryLimit
-	metricK8sContainerMemoryRequest           metricK8sContainerMemoryRequest
-	metricK8sContainerReady                   metricK8sContainerReady
-	metricK8sContainerRestarts                metricK8sContainerRestarts
-	metricK8sContainerStatusReason            metricK8sContainerStatusReason
-	metricK8sContainerStatusState             metricK8sContainerStatusState
-	metricK8sContainerStorageLimit            metricK8sContainerStorageLimit
-	metricK8sContainerStorageRequest          metricK8sContainerStorageRequest
-	metricK8sCronjobActiveJobs                metricK8sCronjobActiveJobs
-	metricK8sDaemonsetCurrentScheduledNodes   metricK8sDaemonsetCurrentScheduledNodes
-	metricK8sDaemonsetDesiredScheduledNodes   metricK8sDaemonsetDesiredScheduledNodes
-	metricK8sDaemonsetMisscheduledNodes       metricK8sDaemonsetMisscheduledNodes
-	metricK8sDaemonsetReadyNodes              metricK8sDaemonsetReadyNodes
-	metricK8sDeploymentAvailable              metricK8sDeploymentAvailable
-	metricK8sDeploymentDesired                metricK8sDeploymentDesired
-	metricK8sHpaCurrentReplicas               metricK8sHpaCurrentReplicas
-	metricK8sHpaDesiredReplicas               metricK8sHpaDesiredReplicas
-	metricK8sHpaMaxReplicas                   metricK8sHpaMaxReplicas
-	metricK8sHpaMinReplicas                   metricK8sHpaMinReplicas
-	metricK8sJobActivePods                    metricK8sJobActivePods
-	metricK8sJobDesiredSuccessfulPods         metricK8sJobDesiredSuccessfulPods
-	metricK8sJobFailedPods                    metricK8sJobFailedPods
-	metricK8sJobMaxParallelPods               metricK8sJobMaxParallelPods
-	metricK8sJobSuccessfulPods                metricK8sJobSuccessfulPods
-	metricK8sNamespacePhase                   metricK8sNamespacePhase
-	metricK8sNodeCondition                    metricK8sNodeCondition
-	metricK8sPodPhase                         metricK8sPodPhase
-	metricK8sPodStatusReason                  metricK8sPodStatusReason
-	metricK8sReplicasetAvailable              metricK8sReplicasetAvailable
-	metricK8sReplicasetDesired                metricK8sReplicasetDesired
-	metricK8sReplicationControllerAvailable   metricK8sReplicationControllerAvailable
-	metricK8sReplicationControllerDesired     metricK8sReplicationControllerDesired
-	metricK8sResourceQuotaHardLimit           metricK8sResourceQuotaHardLimit
-	metricK8sResourceQuotaUsed                metricK8sResourceQuotaUsed
-	metricK8sStatefulsetCurrentPods           metricK8sStatefulsetCurrentPods
-	metricK8sStatefulsetDesiredPods           metricK8sStatefulsetDesiredPods
-	metricK8sStatefulsetReadyPods             metricK8sStatefulsetReadyPods
-	metricK8sStatefulsetUpdatedPods           metricK8sStatefulsetUpdatedPods
-	metricOpenshiftAppliedclusterquotaLimit   metricOpenshiftAppliedclusterquotaLimit
-	metricOpenshiftAppliedclusterquotaUsed    metricOpenshiftAppliedclusterquotaUsed
-	metricOpenshiftClusterquotaLimit          metricOpenshiftClusterquotaLimit
-	metricOpenshiftClusterquotaUsed           metricOpenshiftClusterquotaUsed
+	config                                         MetricsBuilderConfig // config of the metrics builder.
+	startTime                                      pcommon.Timestamp    // start time that will be applied to all recorded data points.
+	metricsCapacity                                int                  // maximum observed number of metrics per resource.
+	metricsBuffer                                  pmetric.Metrics      // accumulates metrics data before emitting.
+	buildInfo                                      component.BuildInfo  // contains version information.
+	resourceAttributeIncludeFilter                 map[string]filter.Filter
+	resourceAttributeExcludeFilter                 map[string]filter.Filter
+	metricK8sContainerCPULimit                     metricK8sContainerCPULimit
+	metricK8sContainerCPURequest                   metricK8sContainerCPURequest
+	metricK8sContainerEphemeralstorageLimit        metricK8sContainerEphemeralstorageLimit
+	metricK8sContainerEphemeralstorageRequest      metricK8sContainerEphemeralstorageRequest
+	metricK8sContainerMemoryLimit                  metricK8sContainerMemoryLimit
+	metricK8sContainerMemoryRequest                metricK8sContainerMemoryRequest
+	metricK8sContainerReady                        metricK8sContainerReady
+	metricK8sContainerRestarts                     metricK8sContainerRestarts
+	metricK8sContainerStatusReason                 metricK8sContainerStatusReason
+	metricK8sContainerStatusState                  metricK8sContainerStatusState
+	metricK8sContainerStorageLimit                 metricK8sContainerStorageLimit
+	metricK8sContainerStorageRequest               metricK8sContainerStorageRequest
+	metricK8sCronjobActiveJobs                     metricK8sCronjobActiveJobs
+	metricK8sDaemonsetCurrentScheduledNodes        metricK8sDaemonsetCurrentScheduledNodes
+	metricK8sDaemonsetDesiredScheduledNodes        metricK8sDaemonsetDesiredScheduledNodes
+	metricK8sDaemonsetMisscheduledNodes            metricK8sDaemonsetMisscheduledNodes
+	metricK8sDaemonsetReadyNodes                   metricK8sDaemonsetReadyNodes
+	metricK8sDeploymentAvailable                   metricK8sDeploymentAvailable
+	metricK8sDeploymentDesired                     metricK8sDeploymentDesired
+	metricK8sHpaCurrentReplicas                    metricK8sHpaCurrentReplicas
+	metricK8sHpaDesiredReplicas                    metricK8sHpaDesiredReplicas
+	metricK8sHpaMaxReplicas                        metricK8sHpaMaxReplicas
+	metricK8sHpaMinReplicas                        metricK8sHpaMinReplicas
+	metricK8sIngressBackendServices                metricK8sIngressBackendServices
+	metricK8sIngressRules                          metricK8sIngressRules
+	metricK8sJobActivePods                         metricK8sJobActivePods
+	metricK8sJobDesiredSuccessfulPods              metricK8sJobDesiredSuccessfulPods
+	metricK8sJobFailedPods                         metricK8sJobFailedPods
+	metricK8sJobMaxParallelPods                    metricK8sJobMaxParallelPods
+	metricK8sJobSuccessfulPods                     metricK8sJobSuccessfulPods
+	metricK8sNamespacePhase                        metricK8sNamespacePhase
+	metricK8sNodeCondition                         metricK8sNodeCondition
+	metricK8sPersistentvolumePhase                 metricK8sPersistentvolumePhase
+	metricK8sPersistentvolumeStorageCapacity       metricK8sPersistentvolumeStorageCapacity
+	metricK8sPersistentvolumeclaimPhase            metricK8sPersistentvolumeclaimPhase
+	metricK8sPersistentvolumeclaimStorageCapacity  metricK8sPersistentvolumeclaimStorageCapacity
+	metricK8sPersistentvolumeclaimStorageRequest   metricK8sPersistentvolumeclaimStorageRequest
+	metricK8sPodPhase                              metricK8sPodPhase
+	metricK8sPodStatusReason                       metricK8sPodStatusReason
+	metricK8sPoddisruptionbudgetCurrentHealthy     metricK8sPoddisruptionbudgetCurrentHealthy
+	metricK8sPoddisruptionbudgetDesiredHealthy     metricK8sPoddisruptionbudgetDesiredHealthy
+	metricK8sPoddisruptionbudgetDisruptionsAllowed metricK8sPoddisruptionbudgetDisruptionsAllowed
+	metricK8sReplicasetAvailable                   metricK8sReplicasetAvailable
+	metricK8sReplicasetDesired                     metricK8sReplicasetDesired
+	metricK8sReplicationControllerAvailable        metricK8sReplicationControllerAvailable
+	metricK8sReplicationControllerDesired          metricK8sReplicationControllerDesired
+	metricK8sResourceQuotaHardLimit                metricK8sResourceQuotaHardLimit
+	metricK8sResourceQuotaUsed                     metricK8sResourceQuotaUsed
+	metricK8sStatefulsetCurrentPods                metricK8sStatefulsetCurrentPods
+	metricK8sStatefulsetDesiredPods                metricK8sStatefulsetDesiredPods
+	metricK8sStatefulsetReadyPods                  metricK8sStatefulsetReadyPods
+	metricK8sStatefulsetUpdatedPods                metricK8sStatefulsetUpdatedPods
+	metricOpenshiftAppliedclusterquotaLimit        metricOpenshiftAppliedclusterquotaLimit
+	metricOpenshiftAppliedclusterquotaUsed         metricOpenshiftAppliedclusterquotaUsed
+	metricOpenshiftClusterquotaLimit               metricOpenshiftClusterquotaLimit
+	metricOpenshiftClusterquotaUsed                metricOpenshiftClusterquotaUsed
 }
 
 // MetricBuilderOption applies changes to default metrics builder.
@@ -2653,51 +3193,61 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		metricK8sContainerCPULimit:              newMetricK8sContainerCPULimit(mbc.Metrics.K8sContainerCPULimit),
 		metricK8sContainerCPURequest:            newMetricK8sContainerCPURequest(mbc.Metrics.K8sContainerCPURequest),
 		metricK8sContainerEphemeralstorageLimit: newMetricK8sContainerEphemeralstorageLimit(mbc.Metrics.K8sContainerEphemeralstorageLimit),
-		metricK8sContainerEphemeralstorageRequest: newMetricK8sContainerEphemeralstorageRequest(mbc.Metrics.K8sContainerEphemeralstorageRequest),
-		metricK8sContainerMemoryLimit:             newMetricK8sContainerMemoryLimit(mbc.Metrics.K8sContainerMemoryLimit),
-		metricK8sContainerMemoryRequest:           newMetricK8sContainerMemoryRequest(mbc.Metrics.K8sContainerMemoryRequest),
-		metricK8sContainerReady:                   newMetricK8sContainerReady(mbc.Metrics.K8sContainerReady),
-		metricK8sContainerRestarts:                newMetricK8sContainerRestarts(mbc.Metrics.K8sContainerRestarts),
-		metricK8sContainerStatusReason:            newMetricK8sContainerStatusReason(mbc.Metrics.K8sContainerStatusReason),
-		metricK8sContainerStatusState:             newMetricK8sContainerStatusState(mbc.Metrics.K8sContainerStatusState),
-		metricK8sContainerStorageLimit:            newMetricK8sContainerStorageLimit(mbc.Metrics.K8sContainerStorageLimit),
-		metricK8sContainerStorageRequest:          newMetricK8sContainerStorageRequest(mbc.Metrics.K8sContainerStorageRequest),
-		metricK8sCronjobActiveJobs:                newMetricK8sCronjobActiveJobs(mbc.Metrics.K8sCronjobActiveJobs),
-		metricK8sDaemonsetCurrentScheduledNodes:   newMetricK8sDaemonsetCurrentScheduledNodes(mbc.Metrics.K8sDaemonsetCurrentScheduledNodes),
-		metricK8sDaemonsetDesiredScheduledNodes:   newMetricK8sDaemonsetDesiredScheduledNodes(mbc.Metrics.K8sDaemonsetDesiredScheduledNodes),
-		metricK8sDaemonsetMisscheduledNodes:       newMetricK8sDaemonsetMisscheduledNodes(mbc.Metrics.K8sDaemonsetMisscheduledNodes),
-		metricK8sDaemonsetReadyNodes:              newMetricK8sDaemonsetReadyNodes(mbc.Metrics.K8sDaemonsetReadyNodes),
-		metricK8sDeploymentAvailable:              newMetricK8sDeploymentAvailable(mbc.Metrics.K8sDeploymentAvailable),
-		metricK8sDeploymentDesired:                newMetricK8sDeploymentDesired(mbc.Metrics.K8sDeploymentDesired),
-		metricK8sHpaCurrentReplicas:               newMetricK8sHpaCurrentReplicas(mbc.Metrics.K8sHpaCurrentReplicas),
-		metricK8sHpaDesiredReplicas:               newMetricK8sHpaDesiredReplicas(mbc.Metrics.K8sHpaDesiredReplicas),
-		metricK8sHpaMaxReplicas:                   newMetricK8sHpaMaxReplicas(mbc.Metrics.K8sHpaMaxReplicas),
-		metricK8sHpaMinReplicas:                   newMetricK8sHpaMinReplicas(mbc.Metrics.K8sHpaMinReplicas),
-		metricK8sJobActivePods:                    newMetricK8sJobActivePods(mbc.Metrics.K8sJobActivePods),
-		metricK8sJobDesiredSuccessfulPods:         newMetricK8sJobDesiredSuccessfulPods(mbc.Metrics.K8sJobDesiredSuccessfulPods),
-		metricK8sJobFailedPods:                    newMetricK8sJobFailedPods(mbc.Metrics.K8sJobFailedPods),
-		metricK8sJobMaxParallelPods:               newMetricK8sJobMaxParallelPods(mbc.Metrics.K8sJobMaxParallelPods),
-		metricK8sJobSuccessfulPods:                newMetricK8sJobSuccessfulPods(mbc.Metrics.K8sJobSuccessfulPods),
-		metricK8sNamespacePhase:                   newMetricK8sNamespacePhase(mbc.Metrics.K8sNamespacePhase),
-		metricK8sNodeCondition:                    newMetricK8sNodeCondition(mbc.Metrics.K8sNodeCondition),
-		metricK8sPodPhase:                         newMetricK8sPodPhase(mbc.Metrics.K8sPodPhase),
-		metricK8sPodStatusReason:                  newMetricK8sPodStatusReason(mbc.Metrics.K8sPodStatusReason),
-		metricK8sReplicasetAvailable:              newMetricK8sReplicasetAvailable(mbc.Metrics.K8sReplicasetAvailable),
-		metricK8sReplicasetDesired:                newMetricK8sReplicasetDesired(mbc.Metrics.K8sReplicasetDesired),
-		metricK8sReplicationControllerAvailable:   newMetricK8sReplicationControllerAvailable(mbc.Metrics.K8sReplicationControllerAvailable),
-		metricK8sReplicationControllerDesired:     newMetricK8sReplicationControllerDesired(mbc.Metrics.K8sReplicationControllerDesired),
-		metricK8sResourceQuotaHardLimit:           newMetricK8sResourceQuotaHardLimit(mbc.Metrics.K8sResourceQuotaHardLimit),
-		metricK8sResourceQuotaUsed:                newMetricK8sResourceQuotaUsed(mbc.Metrics.K8sResourceQuotaUsed),
-		metricK8sStatefulsetCurrentPods:           newMetricK8sStatefulsetCurrentPods(mbc.Metrics.K8sStatefulsetCurrentPods),
-		metricK8sStatefulsetDesiredPods:           newMetricK8sStatefulsetDesiredPods(mbc.Metrics.K8sStatefulsetDesiredPods),
-		metricK8sStatefulsetReadyPods:             newMetricK8sStatefulsetReadyPods(mbc.Metrics.K8sStatefulsetReadyPods),
-		metricK8sStatefulsetUpdatedPods:           newMetricK8sStatefulsetUpdatedPods(mbc.Metrics.K8sStatefulsetUpdatedPods),
-		metricOpenshiftAppliedclusterquotaLimit:   newMetricOpenshiftAppliedclusterquotaLimit(mbc.Metrics.OpenshiftAppliedclusterquotaLimit),
-		metricOpenshiftAppliedclusterquotaUsed:    newMetricOpenshiftAppliedclusterquotaUsed(mbc.Metrics.OpenshiftAppliedclusterquotaUsed),
-		metricOpenshiftClusterquotaLimit:          newMetricOpenshiftClusterquotaLimit(mbc.Metrics.OpenshiftClusterquotaLimit),
-		metricOpenshiftClusterquotaUsed:           newMetricOpenshiftClusterquotaUsed(mbc.Metrics.OpenshiftClusterquotaUsed),
-		resourceAttributeIncludeFilter:            make(map[string]filter.Filter),
-		resourceAttributeExcludeFilter:            make(map[string]filter.Filter),
+		metricK8sContainerEphemeralstorageRequest:      newMetricK8sContainerEphemeralstorageRequest(mbc.Metrics.K8sContainerEphemeralstorageRequest),
+		metricK8sContainerMemoryLimit:                  newMetricK8sContainerMemoryLimit(mbc.Metrics.K8sContainerMemoryLimit),
+		metricK8sContainerMemoryRequest:                newMetricK8sContainerMemoryRequest(mbc.Metrics.K8sContainerMemoryRequest),
+		metricK8sContainerReady:                        newMetricK8sContainerReady(mbc.Metrics.K8sContainerReady),
+		metricK8sContainerRestarts:                     newMetricK8sContainerRestarts(mbc.Metrics.K8sContainerRestarts),
+		metricK8sContainerStatusReason:                 newMetricK8sContainerStatusReason(mbc.Metrics.K8sContainerStatusReason),
+		metricK8sContainerStatusState:                  newMetricK8sContainerStatusState(mbc.Metrics.K8sContainerStatusState),
+		metricK8sContainerStorageLimit:                 newMetricK8sContainerStorageLimit(mbc.Metrics.K8sContainerStorageLimit),
+		metricK8sContainerStorageRequest:               newMetricK8sContainerStorageRequest(mbc.Metrics.K8sContainerStorageRequest),
+		metricK8sCronjobActiveJobs:                     newMetricK8sCronjobActiveJobs(mbc.Metrics.K8sCronjobActiveJobs),
+		metricK8sDaemonsetCurrentScheduledNodes:        newMetricK8sDaemonsetCurrentScheduledNodes(mbc.Metrics.K8sDaemonsetCurrentScheduledNodes),
+		metricK8sDaemonsetDesiredScheduledNodes:        newMetricK8sDaemonsetDesiredScheduledNodes(mbc.Metrics.K8sDaemonsetDesiredScheduledNodes),
+		metricK8sDaemonsetMisscheduledNodes:            newMetricK8sDaemonsetMisscheduledNodes(mbc.Metrics.K8sDaemonsetMisscheduledNodes),
+		metricK8sDaemonsetReadyNodes:                   newMetricK8sDaemonsetReadyNodes(mbc.Metrics.K8sDaemonsetReadyNodes),
+		metricK8sDeploymentAvailable:                   newMetricK8sDeploymentAvailable(mbc.Metrics.K8sDeploymentAvailable),
+		metricK8sDeploymentDesired:                     newMetricK8sDeploymentDesired(mbc.Metrics.K8sDeploymentDesired),
+		metricK8sHpaCurrentReplicas:                    newMetricK8sHpaCurrentReplicas(mbc.Metrics.K8sHpaCurrentReplicas),
+		metricK8sHpaDesiredReplicas:                    newMetricK8sHpaDesiredReplicas(mbc.Metrics.K8sHpaDesiredReplicas),
+		metricK8sHpaMaxReplicas:                        newMetricK8sHpaMaxReplicas(mbc.Metrics.K8sHpaMaxReplicas),
+		metricK8sHpaMinReplicas:                        newMetricK8sHpaMinReplicas(mbc.Metrics.K8sHpaMinReplicas),
+		metricK8sIngressBackendServices:                newMetricK8sIngressBackendServices(mbc.Metrics.K8sIngressBackendServices),
+		metricK8sIngressRules:                          newMetricK8sIngressRules(mbc.Metrics.K8sIngressRules),
+		metricK8sJobActivePods:                         newMetricK8sJobActivePods(mbc.Metrics.K8sJobActivePods),
+		metricK8sJobDesiredSuccessfulPods:              newMetricK8sJobDesiredSuccessfulPods(mbc.Metrics.K8sJobDesiredSuccessfulPods),
+		metricK8sJobFailedPods:                         newMetricK8sJobFailedPods(mbc.Metrics.K8sJobFailedPods),
+		metricK8sJobMaxParallelPods:                    newMetricK8sJobMaxParallelPods(mbc.Metrics.K8sJobMaxParallelPods),
+		metricK8sJobSuccessfulPods:                     newMetricK8sJobSuccessfulPods(mbc.Metrics.K8sJobSuccessfulPods),
+		metricK8sNamespacePhase:                        newMetricK8sNamespacePhase(mbc.Metrics.K8sNamespacePhase),
+		metricK8sNodeCondition:                         newMetricK8sNodeCondition(mbc.Metrics.K8sNodeCondition),
+		metricK8sPersistentvolumePhase:                 newMetricK8sPersistentvolumePhase(mbc.Metrics.K8sPersistentvolumePhase),
+		metricK8sPersistentvolumeStorageCapacity:       newMetricK8sPersistentvolumeStorageCapacity(mbc.Metrics.K8sPersistentvolumeStorageCapacity),
+		metricK8sPersistentvolumeclaimPhase:            newMetricK8sPersistentvolumeclaimPhase(mbc.Metrics.K8sPersistentvolumeclaimPhase),
+		metricK8sPersistentvolumeclaimStorageCapacity:  newMetricK8sPersistentvolumeclaimStorageCapacity(mbc.Metrics.K8sPersistentvolumeclaimStorageCapacity),
+		metricK8sPersistentvolumeclaimStorageRequest:   newMetricK8sPersistentvolumeclaimStorageRequest(mbc.Metrics.K8sPersistentvolumeclaimStorageRequest),
+		metricK8sPodPhase:                              newMetricK8sPodPhase(mbc.Metrics.K8sPodPhase),
+		metricK8sPodStatusReason:                       newMetricK8sPodStatusReason(mbc.Metrics.K8sPodStatusReason),
+		metricK8sPoddisruptionbudgetCurrentHealthy:     newMetricK8sPoddisruptionbudgetCurrentHealthy(mbc.Metrics.K8sPoddisruptionbudgetCurrentHealthy),
+		metricK8sPoddisruptionbudgetDesiredHealthy:     newMetricK8sPoddisruptionbudgetDesiredHealthy(mbc.Metrics.K8sPoddisruptionbudgetDesiredHealthy),
+		metricK8sPoddisruptionbudgetDisruptionsAllowed: newMetricK8sPoddisruptionbudgetDisruptionsAllowed(mbc.Metrics.K8sPoddisruptionbudgetDisruptionsAllowed),
+		metricK8sReplicasetAvailable:                   newMetricK8sReplicasetAvailable(mbc.Metrics.K8sReplicasetAvailable),
+		metricK8sReplicasetDesired:                     newMetricK8sReplicasetDesired(mbc.Metrics.K8sReplicasetDesired),
+		metricK8sReplicationControllerAvailable:        newMetricK8sReplicationControllerAvailable(mbc.Metrics.K8sReplicationControllerAvailable),
+		metricK8sReplicationControllerDesired:          newMetricK8sReplicationControllerDesired(mbc.Metrics.K8sReplicationControllerDesired),
+		metricK8sResourceQuotaHardLimit:                newMetricK8sResourceQuotaHardLimit(mbc.Metrics.K8sResourceQuotaHardLimit),
+		metricK8sResourceQuotaUsed:                     newMetricK8sResourceQuotaUsed(mbc.Metrics.K8sResourceQuotaUsed),
+		metricK8sStatefulsetCurrentPods:                newMetricK8sStatefulsetCurrentPods(mbc.Metrics.K8sStatefulsetCurrentPods),
+		metricK8sStatefulsetDesiredPods:                newMetricK8sStatefulsetDesiredPods(mbc.Metrics.K8sStatefulsetDesiredPods),
+		metricK8sStatefulsetReadyPods:                  newMetricK8sStatefulsetReadyPods(mbc.Metrics.K8sStatefulsetReadyPods),
+		metricK8sStatefulsetUpdatedPods:                newMetricK8sStatefulsetUpdatedPods(mbc.Metrics.K8sStatefulsetUpdatedPods),
+		metricOpenshiftAppliedclusterquotaLimit:        newMetricOpenshiftAppliedclusterquotaLimit(mbc.Metrics.OpenshiftAppliedclusterquotaLimit),
+		metricOpenshiftAppliedclusterquotaUsed:         newMetricOpenshiftAppliedclusterquotaUsed(mbc.Metrics.OpenshiftAppliedclusterquotaUsed),
+		metricOpenshiftClusterquotaLimit:               newMetricOpenshiftClusterquotaLimit(mbc.Metrics.OpenshiftClusterquotaLimit),
+		metricOpenshiftClusterquotaUsed:                newMetricOpenshiftClusterquotaUsed(mbc.Metrics.OpenshiftClusterquotaUsed),
+		resourceAttributeIncludeFilter:                 make(map[string]filter.Filter),
+		resourceAttributeExcludeFilter:                 make(map[string]filter.Filter),
 	}
 	if mbc.ResourceAttributes.ContainerID.MetricsInclude != nil {
 		mb.resourceAttributeIncludeFilter["container.id"] = filter.CreateFilter(mbc.ResourceAttributes.ContainerID.MetricsInclude)
@@ -2807,6 +3357,18 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 	if mbc.ResourceAttributes.K8sHpaUID.MetricsExclude != nil {
 		mb.resourceAttributeExcludeFilter["k8s.hpa.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sHpaUID.MetricsExclude)
 	}
+	if mbc.ResourceAttributes.K8sIngressName.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.ingress.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sIngressName.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sIngressName.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.ingress.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sIngressName.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.K8sIngressUID.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.ingress.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sIngressUID.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sIngressUID.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.ingress.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sIngressUID.MetricsExclude)
+	}
 	if mbc.ResourceAttributes.K8sJobName.MetricsInclude != nil {
 		mb.resourceAttributeIncludeFilter["k8s.job.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sJobName.MetricsInclude)
 	}
@@ -2849,6 +3411,30 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 	if mbc.ResourceAttributes.K8sNodeUID.MetricsExclude != nil {
 		mb.resourceAttributeExcludeFilter["k8s.node.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sNodeUID.MetricsExclude)
 	}
+	if mbc.ResourceAttributes.K8sPersistentvolumeName.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.persistentvolume.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sPersistentvolumeName.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sPersistentvolumeName.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.persistentvolume.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sPersistentvolumeName.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.K8sPersistentvolumeUID.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.persistentvolume.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sPersistentvolumeUID.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sPersistentvolumeUID.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.persistentvolume.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sPersistentvolumeUID.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.K8sPersistentvolumeclaimName.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.persistentvolumeclaim.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sPersistentvolumeclaimName.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sPersistentvolumeclaimName.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.persistentvolumeclaim.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sPersistentvolumeclaimName.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.K8sPersistentvolumeclaimUID.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.persistentvolumeclaim.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sPersistentvolumeclaimUID.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sPersistentvolumeclaimUID.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.persistentvolumeclaim.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sPersistentvolumeclaimUID.MetricsExclude)
+	}
 	if mbc.ResourceAttributes.K8sPodName.MetricsInclude != nil {
 		mb.resourceAttributeIncludeFilter["k8s.pod.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sPodName.MetricsInclude)
 	}
@@ -2867,6 +3453,18 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 	if mbc.ResourceAttributes.K8sPodUID.MetricsExclude != nil {
 		mb.resourceAttributeExcludeFilter["k8s.pod.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sPodUID.MetricsExclude)
 	}
+	if mbc.ResourceAttributes.K8sPoddisruptionbudgetName.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.poddisruptionbudget.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sPoddisruptionbudgetName.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sPoddisruptionbudgetName.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.poddisruptionbudget.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sPoddisruptionbudgetName.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.K8sPoddisruptionbudgetUID.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.poddisruptionbudget.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sPoddisruptionbudgetUID.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sPoddisruptionbudgetUID.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.poddisruptionbudget.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sPoddisruptionbudgetUID.MetricsExclude)
+	}
 	if mbc.ResourceAttributes.K8sReplicasetName.MetricsInclude != nil {
 		mb.resourceAttributeIncludeFilter["k8s.replicaset.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sReplicasetName.MetricsInclude)
 	}
@@ -2915,6 +3513,12 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 	if mbc.ResourceAttributes.K8sStatefulsetUID.MetricsExclude != nil {
 		mb.resourceAttributeExcludeFilter["k8s.statefulset.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sStatefulsetUID.MetricsExclude)
 	}
+	if mbc.ResourceAttributes.K8sStorageclassName.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.storageclass.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sStorageclassName.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sStorageclassName.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.storageclass.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sStorageclassName.MetricsExclude)
+	}
 	if mbc.ResourceAttributes.OpenshiftClusterquotaName.MetricsInclude != nil {
 		mb.resourceAttributeIncludeFilter["openshift.clusterquota.name"] = filter.CreateFilter(mbc.ResourceAttributes.OpenshiftClusterquotaName.MetricsInclude)
 	}
@@ -3032,6 +3636,8 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricK8sHpaDesiredReplicas.emit(ils.Metrics())
 	mb.metricK8sHpaMaxReplicas.emit(ils.Metrics())
 	mb.metricK8sHpaMinReplicas.emit(ils.Metrics())
+	mb.metricK8sIngressBackendServices.emit(ils.Metrics())
+	mb.metricK8sIngressRules.emit(ils.Metrics())
 	mb.metricK8sJobActivePods.emit(ils.Metrics())
 	mb.metricK8sJobDesiredSuccessfulPods.emit(ils.Metrics())
 	mb.metricK8sJobFailedPods.emit(ils.Metrics())
@@ -3039,8 +3645,16 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricK8sJobSuccessfulPods.emit(ils.Metrics())
 	mb.metricK8sNamespacePhase.emit(ils.Metrics())
 	mb.metricK8sNodeCondition.emit(ils.Metrics())
+	mb.metricK8sPersistentvolumePhase.emit(ils.Metrics())
+	mb.metricK8sPersistentvolumeStorageCapacity.emit(ils.Metrics())
+	mb.metricK8sPersistentvolumeclaimPhase.emit(ils.Metrics())
+	mb.metricK8sPersistentvolumeclaimStorageCapacity.emit(ils.Metrics())
+	mb.metricK8sPersistentvolumeclaimStorageRequest.emit(ils.Metrics())
 	mb.metricK8sPodPhase.emit(ils.Metrics())
 	mb.metricK8sPodStatusReason.emit(ils.Metrics())
+	mb.metricK8sPoddisruptionbudgetCurrentHealthy.emit(ils.Metrics())
+	mb.metricK8sPoddisruptionbudgetDesiredHealthy.emit(ils.Metrics())
+	mb.metricK8sPoddisruptionbudgetDisruptionsAllowed.emit(ils.Metrics())
 	mb.metricK8sReplicasetAvailable.emit(ils.Metrics())
 	mb.metricK8sReplicasetDesired.emit(ils.Metrics())
 	mb.metricK8sReplicationControllerAvailable.emit(ils.Metrics())
@@ -3201,6 +3815,16 @@ func (mb *MetricsBuilder) RecordK8sHpaMinReplicasDataPoint(ts pcommon.Timestamp,
 	mb.metricK8sHpaMinReplicas.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sIngressBackendServicesDataPoint adds a data point to k8s.ingress.backend_services metric.
+func (mb *MetricsBuilder) RecordK8sIngressBackendServicesDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sIngressBackendServices.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sIngressRulesDataPoint adds a data point to k8s.ingress.rules metric.
+func (mb *MetricsBuilder) RecordK8sIngressRulesDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sIngressRules.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sJobActivePodsDataPoint adds a data point to k8s.job.active_pods metric.
 func (mb *MetricsBuilder) RecordK8sJobActivePodsDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sJobActivePods.recordDataPoint(mb.startTime, ts, val)
@@ -3236,6 +3860,31 @@ func (mb *MetricsBuilder) RecordK8sNodeConditionDataPoint(ts pcommon.Timestamp,
 	mb.metricK8sNodeCondition.recordDataPoint(mb.startTime, ts, val, conditionAttributeValue)
 }
 
+// RecordK8sPersistentvolumePhaseDataPoint adds a data point to k8s.persistentvolume.phase metric.
+func (mb *MetricsBuilder) RecordK8sPersistentvolumePhaseDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPersistentvolumePhase.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sPersistentvolumeStorageCapacityDataPoint adds a data point to k8s.persistentvolume.storage.capacity metric.
+func (mb *MetricsBuilder) RecordK8sPersistentvolumeStorageCapacityDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPersistentvolumeStorageCapacity.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sPersistentvolumeclaimPhaseDataPoint adds a data point to k8s.persistentvolumeclaim.phase metric.
+func (mb *MetricsBuilder) RecordK8sPersistentvolumeclaimPhaseDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPersistentvolumeclaimPhase.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sPersistentvolumeclaimStorageCapacityDataPoint adds a data point to k8s.persistentvolumeclaim.storage.capacity metric.
+func (mb *MetricsBuilder) RecordK8sPersistentvolumeclaimStorageCapacityDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPersistentvolumeclaimStorageCapacity.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sPersistentvolumeclaimStorageRequestDataPoint adds a data point to k8s.persistentvolumeclaim.storage.request metric.
+func (mb *MetricsBuilder) RecordK8sPersistentvolumeclaimStorageRequestDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPersistentvolumeclaimStorageRequest.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sPodPhaseDataPoint adds a data point to k8s.pod.phase metric.
 func (mb *MetricsBuilder) RecordK8sPodPhaseDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sPodPhase.recordDataPoint(mb.startTime, ts, val)
@@ -3246,6 +3895,21 @@ func (mb *MetricsBuilder) RecordK8sPodStatusReasonDataPoint(ts pcommon.Timestamp
 	mb.metricK8sPodStatusReason.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sPoddisruptionbudgetCurrentHealthyDataPoint adds a data point to k8s.poddisruptionbudget.current_healthy metric.
+func (mb *MetricsBuilder) RecordK8sPoddisruptionbudgetCurrentHealthyDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPoddisruptionbudgetCurrentHealthy.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sPoddisruptionbudgetDesiredHealthyDataPoint adds a data point to k8s.poddisruptionbudget.desired_healthy metric.
+func (mb *MetricsBuilder) RecordK8sPoddisruptionbudgetDesiredHealthyDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPoddisruptionbudgetDesiredHealthy.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sPoddisruptionbudgetDisruptionsAllowedDataPoint adds a data point to k8s.poddisruptionbudget.disruptions_allowed metric.
+func (mb *MetricsBuilder) RecordK8sPoddisruptionbudgetDisruptionsAllowedDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPoddisruptionbudgetDisruptionsAllowed.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sReplicasetAvailableDataPoint adds a data point to k8s.replicaset.available metric.
 func (mb *MetricsBuilder) RecordK8sReplicasetAvailableDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sReplicasetAvailable.recordDataPoint(mb.startTime, ts, val)
@@ -158,6 +158,12 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordK8sHpaMinReplicasDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordK8sIngressBackendServicesDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sIngressRulesDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sJobActivePodsDataPoint(ts, 1)
@@ -185,6 +191,21 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordK8sNodeConditionDataPoint(ts, 1, "condition-val")
 
+			allMetricsCount++
+			mb.RecordK8sPersistentvolumePhaseDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sPersistentvolumeStorageCapacityDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sPersistentvolumeclaimPhaseDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sPersistentvolumeclaimStorageCapacityDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sPersistentvolumeclaimStorageRequestDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sPodPhaseDataPoint(ts, 1)
@@ -192,6 +213,15 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordK8sPodStatusReasonDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordK8sPoddisruptionbudgetCurrentHealthyDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sPoddisruptionbudgetDesiredHealthyDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sPoddisruptionbudgetDisruptionsAllowedDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sReplicasetAvailableDataPoint(ts, 1)
@@ -267,6 +297,8 @@ func TestMetricsBuilder(t *testing.T) {
 			rb.SetK8sHpaScaletargetrefKind("k8s.hpa.scaletargetref.kind-val")
 			rb.SetK8sHpaScaletargetrefName("k8s.hpa.scaletargetref.name-val")
 			rb.SetK8sHpaUID("k8s.hpa.uid-val")
+			rb.SetK8sIngressName("k8s.ingress.name-val")
+			rb.SetK8sIngressUID("k8s.ingress.uid-val")
 			rb.SetK8sJobName("k8s.job.name-val")
 			rb.SetK8sJobUID("k8s.job.uid-val")
 			rb.SetK8sKubeletVersion("k8s.kubelet.version-val")
@@ -274,9 +306,15 @@ func TestMetricsBuilder(t *testing.T) {
 			rb.SetK8sNamespaceUID("k8s.namespace.uid-val")
 			rb.SetK8sNodeName("k8s.node.name-val")
 			rb.SetK8sNodeUID("k8s.node.uid-val")
+			rb.SetK8sPersistentvolumeName("k8s.persistentvolume.name-val")
+			rb.SetK8sPersistentvolumeUID("k8s.persistentvolume.uid-val")
+			rb.SetK8sPersistentvolumeclaimName("k8s.persistentvolumeclaim.name-val")
+			rb.SetK8sPersistentvolumeclaimUID("k8s.persistentvolumeclaim.uid-val")
 			rb.SetK8sPodName("k8s.pod.name-val")
 			rb.SetK8sPodQosClass("k8s.pod.qos_class-val")
 			rb.SetK8sPodUID("k8s.pod.uid-val")
+			rb.SetK8sPoddisruptionbudgetName("k8s.poddisruptionbudget.name-val")
+			rb.SetK8sPoddisruptionbudgetUID("k8s.poddisruptionbudget.uid-val")
 			rb.SetK8sReplicasetName("k8s.replicaset.name-val")
 			rb.SetK8sReplicasetUID("k8s.replicaset.uid-val")
 			rb.SetK8sReplicationcontrollerName("k8s.replicationcontroller.name-val")
@@ -285,6 +323,7 @@ func TestMetricsBuilder(t *testing.T) {
 			rb.SetK8sResourcequotaUID("k8s.resourcequota.uid-val")
 			rb.SetK8sStatefulsetName("k8s.statefulset.name-val")
 			rb.SetK8sStatefulsetUID("k8s.statefulset.uid-val")
+			rb.SetK8sStorageclassName("k8s.storageclass.name-val")
 			rb.SetOpenshiftClusterquotaName("openshift.clusterquota.name-val")
 			rb.SetOpenshiftClusterquotaUID("openshift.clusterquota.uid-val")
 			rb.SetOsDescription("os.description-val")
@@ -597,6 +636,30 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.ingress.backend_services":
+					assert.False(t, validatedMetrics["k8s.ingress.backend_services"], "Found a duplicate in the metrics slice: k8s.ingress.backend_services")
+					validatedMetrics["k8s.ingress.backend_services"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The number of distinct services referenced as backends by the ingress, including the default backend", ms.At(i).Description())
+					assert.Equal(t, "{service}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.ingress.rules":
+					assert.False(t, validatedMetrics["k8s.ingress.rules"], "Found a duplicate in the metrics slice: k8s.ingress.rules")
+					validatedMetrics["k8s.ingress.rules"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The number of rules defined in the ingress", ms.At(i).Description())
+					assert.Equal(t, "{rule}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "k8s.job.active_pods":
 					assert.False(t, validatedMetrics["k8s.job.active_pods"], "Found a duplicate in the metrics slice: k8s.job.active_pods")
 					validatedMetrics["k8s.job.active_pods"] = true
@@ -684,6 +747,66 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("condition")
 					assert.True(t, ok)
 					assert.Equal(t, "condition-val", attrVal.Str())
+				case "k8s.persistentvolume.phase":
+					assert.False(t, validatedMetrics["k8s.persistentvolume.phase"], "Found a duplicate in the metrics slice: k8s.persistentvolume.phase")
+					validatedMetrics["k8s.persistentvolume.phase"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Current phase of the persistent volume (1 - Pending, 2 - Available, 3 - Bound, 4 - Released, 5 - Failed)", ms.At(i).Description())
+					assert.Empty(t, ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.persistentvolume.storage.capacity":
+					assert.False(t, validatedMetrics["k8s.persistentvolume.storage.capacity"], "Found a duplicate in the metrics slice: k8s.persistentvolume.storage.capacity")
+					validatedMetrics["k8s.persistentvolume.storage.capacity"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The storage capacity of the persistent volume", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.persistentvolumeclaim.phase":
+					assert.False(t, validatedMetrics["k8s.persistentvolumeclaim.phase"], "Found a duplicate in the metrics slice: k8s.persistentvolumeclaim.phase")
+					validatedMetrics["k8s.persistentvolumeclaim.phase"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Current phase of the persistent volume claim (1 - Pending, 2 - Bound, 3 - Lost)", ms.At(i).Description())
+					assert.Empty(t, ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.persistentvolumeclaim.storage.capacity":
+					assert.False(t, validatedMetrics["k8s.persistentvolumeclaim.storage.capacity"], "Found a duplicate in the metrics slice: k8s.persistentvolumeclaim.storage.capacity")
+					validatedMetrics["k8s.persistentvolumeclaim.storage.capacity"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The storage capacity of the volume bound to the persistent volume claim. Will only be sent once the claim is bound", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.persistentvolumeclaim.storage.request":
+					assert.False(t, validatedMetrics["k8s.persistentvolumeclaim.storage.request"], "Found a duplicate in the metrics slice: k8s.persistentvolumeclaim.storage.request")
+					validatedMetrics["k8s.persistentvolumeclaim.storage.request"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The storage requested by the persistent volume claim", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "k8s.pod.phase":
 					assert.False(t, validatedMetrics["k8s.pod.phase"], "Found a duplicate in the metrics slice: k8s.pod.phase")
 					validatedMetrics["k8s.pod.phase"] = true
@@ -708,6 +831,42 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.poddisruptionbudget.current_healthy":
+					assert.False(t, validatedMetrics["k8s.poddisruptionbudget.current_healthy"], "Found a duplicate in the metrics slice: k8s.poddisruptionbudget.current_healthy")
+					validatedMetrics["k8s.poddisruptionbudget.current_healthy"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The number of currently healthy pods selected by the pod disruption budget", ms.At(i).Description())
+					assert.Equal(t, "{pod}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.poddisruptionbudget.desired_healthy":
+					assert.False(t, validatedMetrics["k8s.poddisruptionbudget.desired_healthy"], "Found a duplicate in the metrics slice: k8s.poddisruptionbudget.desired_healthy")
+					validatedMetrics["k8s.poddisruptionbudget.desired_healthy"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The minimum number of healthy pods desired by the pod disruption budget", ms.At(i).Description())
+					assert.Equal(t, "{pod}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.poddisruptionbudget.disruptions_allowed":
+					assert.False(t, validatedMetrics["k8s.poddisruptionbudget.disruptions_allowed"], "Found a duplicate in the metrics slice: k8s.poddisruptionbudget.disruptions_allowed")
+					validatedMetrics["k8s.poddisruptionbudget.disruptions_allowed"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The number of pod disruptions that are currently allowed by the pod disruption budget", ms.At(i).Description())
+					assert.Equal(t, "{pod}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "k8s.replicaset.available":
 					assert.False(t, validatedMetrics["k8s.replicaset.available"], "Found a duplicate in the metrics slice: k8s.replicaset.available")
 					validatedMetrics["k8s.replicaset.available"] = true
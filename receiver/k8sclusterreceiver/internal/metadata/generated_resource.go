@@ -147,6 +147,20 @@ func (rb *ResourceBuilder) SetK8sHpaUID(val string) {
 	}
 }
 
+// SetK8sIngressName sets provided value as "k8s.ingress.name" attribute.
+func (rb *ResourceBuilder) SetK8sIngressName(val string) {
+	if rb.config.K8sIngressName.Enabled {
+		rb.res.Attributes().PutStr("k8s.ingress.name", val)
+	}
+}
+
+// SetK8sIngressUID sets provided value as "k8s.ingress.uid" attribute.
+func (rb *ResourceBuilder) SetK8sIngressUID(val string) {
+	if rb.config.K8sIngressUID.Enabled {
+		rb.res.Attributes().PutStr("k8s.ingress.uid", val)
+	}
+}
+
 // SetK8sJobName sets provided value as "k8s.job.name" attribute.
 func (rb *ResourceBuilder) SetK8sJobName(val string) {
 	if rb.config.K8sJobName.Enabled {
@@ -196,6 +210,34 @@ func (rb *ResourceBuilder) SetK8sNodeUID(val string) {
 	}
 }
 
+// SetK8sPersistentvolumeName sets provided value as "k8s.persistentvolume.name" attribute.
+func (rb *ResourceBuilder) SetK8sPersistentvolumeName(val string) {
+	if rb.config.K8sPersistentvolumeName.Enabled {
+		rb.res.Attributes().PutStr("k8s.persistentvolume.name", val)
+	}
+}
+
+// SetK8sPersistentvolumeUID sets provided value as "k8s.persistentvolume.uid" attribute.
+func (rb *ResourceBuilder) SetK8sPersistentvolumeUID(val string) {
+	if rb.config.K8sPersistentvolumeUID.Enabled {
+		rb.res.Attributes().PutStr("k8s.persistentvolume.uid", val)
+	}
+}
+
+// SetK8sPersistentvolumeclaimName sets provided value as "k8s.persistentvolumeclaim.name" attribute.
+func (rb *ResourceBuilder) SetK8sPersistentvolumeclaimName(val string) {
+	if rb.config.K8sPersistentvolumeclaimName.Enabled {
+		rb.res.Attributes().PutStr("k8s.persistentvolumeclaim.name", val)
+	}
+}
+
+// SetK8sPersistentvolumeclaimUID sets provided value as "k8s.persistentvolumeclaim.uid" attribute.
+func (rb *ResourceBuilder) SetK8sPersistentvolumeclaimUID(val string) {
+	if rb.config.K8sPersistentvolumeclaimUID.Enabled {
+		rb.res.Attributes().PutStr("k8s.persistentvolumeclaim.uid", val)
+	}
+}
+
 // SetK8sPodName sets provided value as "k8s.pod.name" attribute.
 func (rb *ResourceBuilder) SetK8sPodName(val string) {
 	if rb.config.K8sPodName.Enabled {
@@ -217,6 +259,20 @@ func (rb *ResourceBuilder) SetK8sPodUID(val string) {
 	}
 }
 
+// SetK8sPoddisruptionbudgetName sets provided value as "k8s.poddisruptionbudget.name" attribute.
+func (rb *ResourceBuilder) SetK8sPoddisruptionbudgetName(val string) {
+	if rb.config.K8sPoddisruptionbudgetName.Enabled {
+		rb.res.Attributes().PutStr("k8s.poddisruptionbudget.name", val)
+	}
+}
+
+// SetK8sPoddisruptionbudgetUID sets provided value as "k8s.poddisruptionbudget.uid" attribute.
+func (rb *ResourceBuilder) SetK8sPoddisruptionbudgetUID(val string) {
+	if rb.config.K8sPoddisruptionbudgetUID.Enabled {
+		rb.res.Attributes().PutStr("k8s.poddisruptionbudget.uid", val)
+	}
+}
+
 // SetK8sReplicasetName sets provided value as "k8s.replicaset.name" attribute.
 func (rb *ResourceBuilder) SetK8sReplicasetName(val string) {
 	if rb.config.K8sReplicasetName.Enabled {
@@ -273,6 +329,13 @@ func (rb *ResourceBuilder) SetK8sStatefulsetUID(val string) {
 	}
 }
 
+// SetK8sStorageclassName sets provided value as "k8s.storageclass.name" attribute.
+func (rb *ResourceBuilder) SetK8sStorageclassName(val string) {
+	if rb.config.K8sStorageclassName.Enabled {
+		rb.res.Attributes().PutStr("k8s.storageclass.name", val)
+	}
+}
+
 // SetOpenshiftClusterquotaName sets provided value as "openshift.clusterquota.name" attribute.
 func (rb *ResourceBuilder) SetOpenshiftClusterquotaName(val string) {
 	if rb.config.OpenshiftClusterquotaName.Enabled {
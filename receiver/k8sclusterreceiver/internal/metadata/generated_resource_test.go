@@ -31,6 +31,8 @@ func TestResourceBuilder(t *testing.T) {
 			rb.SetK8sHpaScaletargetrefKind("k8s.hpa.scaletargetref.kind-val")
 			rb.SetK8sHpaScaletargetrefName("k8s.hpa.scaletargetref.name-val")
 			rb.SetK8sHpaUID("k8s.hpa.uid-val")
+			rb.SetK8sIngressName("k8s.ingress.name-val")
+			rb.SetK8sIngressUID("k8s.ingress.uid-val")
 			rb.SetK8sJobName("k8s.job.name-val")
 			rb.SetK8sJobUID("k8s.job.uid-val")
 			rb.SetK8sKubeletVersion("k8s.kubelet.version-val")
@@ -38,9 +40,15 @@ func TestResourceBuilder(t *testing.T) {
 			rb.SetK8sNamespaceUID("k8s.namespace.uid-val")
 			rb.SetK8sNodeName("k8s.node.name-val")
 			rb.SetK8sNodeUID("k8s.node.uid-val")
+			rb.SetK8sPersistentvolumeName("k8s.persistentvolume.name-val")
+			rb.SetK8sPersistentvolumeUID("k8s.persistentvolume.uid-val")
+			rb.SetK8sPersistentvolumeclaimName("k8s.persistentvolumeclaim.name-val")
+			rb.SetK8sPersistentvolumeclaimUID("k8s.persistentvolumeclaim.uid-val")
 			rb.SetK8sPodName("k8s.pod.name-val")
 			rb.SetK8sPodQosClass("k8s.pod.qos_class-val")
 			rb.SetK8sPodUID("k8s.pod.uid-val")
+			rb.SetK8sPoddisruptionbudgetName("k8s.poddisruptionbudget.name-val")
+			rb.SetK8sPoddisruptionbudgetUID("k8s.poddisruptionbudget.uid-val")
 			rb.SetK8sReplicasetName("k8s.replicaset.name-val")
 			rb.SetK8sReplicasetUID("k8s.replicaset.uid-val")
 			rb.SetK8sReplicationcontrollerName("k8s.replicationcontroller.name-val")
@@ -49,6 +57,7 @@ func TestResourceBuilder(t *testing.T) {
 			rb.SetK8sResourcequotaUID("k8s.resourcequota.uid-val")
 			rb.SetK8sStatefulsetName("k8s.statefulset.name-val")
 			rb.SetK8sStatefulsetUID("k8s.statefulset.uid-val")
+			rb.SetK8sStorageclassName("k8s.storageclass.name-val")
 			rb.SetOpenshiftClusterquotaName("openshift.clusterquota.name-val")
 			rb.SetOpenshiftClusterquotaUID("openshift.clusterquota.uid-val")
 			rb.SetOsDescription("os.description-val")
@@ -61,7 +70,7 @@ func TestResourceBuilder(t *testing.T) {
 			case "default":
 				assert.Equal(t, 30, res.Attributes().Len())
 			case "all_set":
-				assert.Equal(t, 40, res.Attributes().Len())
+				assert.Equal(t, 49, res.Attributes().Len())
 			case "none_set":
 				assert.Equal(t, 0, res.Attributes().Len())
 				return
@@ -159,6 +168,16 @@ func TestResourceBuilder(t *testing.T) {
 			if ok {
 				assert.Equal(t, "k8s.hpa.uid-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("k8s.ingress.name")
+			assert.Equal(t, tt == "all_set", ok)
+			if ok {
+				assert.Equal(t, "k8s.ingress.name-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.ingress.uid")
+			assert.Equal(t, tt == "all_set", ok)
+			if ok {
+				assert.Equal(t, "k8s.ingress.uid-val", val.Str())
+			}
 			val, ok = res.Attributes().Get("k8s.job.name")
 			assert.True(t, ok)
 			if ok {
@@ -194,6 +213,26 @@ func TestResourceBuilder(t *testing.T) {
 			if ok {
 				assert.Equal(t, "k8s.node.uid-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("k8s.persistentvolume.name")
+			assert.Equal(t, tt == "all_set", ok)
+			if ok {
+				assert.Equal(t, "k8s.persistentvolume.name-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.persistentvolume.uid")
+			assert.Equal(t, tt == "all_set", ok)
+			if ok {
+				assert.Equal(t, "k8s.persistentvolume.uid-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.persistentvolumeclaim.name")
+			assert.Equal(t, tt == "all_set", ok)
+			if ok {
+				assert.Equal(t, "k8s.persistentvolumeclaim.name-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.persistentvolumeclaim.uid")
+			assert.Equal(t, tt == "all_set", ok)
+			if ok {
+				assert.Equal(t, "k8s.persistentvolumeclaim.uid-val", val.Str())
+			}
 			val, ok = res.Attributes().Get("k8s.pod.name")
 			assert.True(t, ok)
 			if ok {
@@ -209,6 +248,16 @@ func TestResourceBuilder(t *testing.T) {
 			if ok {
 				assert.Equal(t, "k8s.pod.uid-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("k8s.poddisruptionbudget.name")
+			assert.Equal(t, tt == "all_set", ok)
+			if ok {
+				assert.Equal(t, "k8s.poddisruptionbudget.name-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.poddisruptionbudget.uid")
+			assert.Equal(t, tt == "all_set", ok)
+			if ok {
+				assert.Equal(t, "k8s.poddisruptionbudget.uid-val", val.Str())
+			}
 			val, ok = res.Attributes().Get("k8s.replicaset.name")
 			assert.True(t, ok)
 			if ok {
@@ -249,6 +298,11 @@ func TestResourceBuilder(t *testing.T) {
 			if ok {
 				assert.Equal(t, "k8s.statefulset.uid-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("k8s.storageclass.name")
+			assert.Equal(t, tt == "all_set", ok)
+			if ok {
+				assert.Equal(t, "k8s.storageclass.name-val", val.Str())
+			}
 			val, ok = res.Attributes().Get("openshift.clusterquota.name")
 			assert.True(t, ok)
 			if ok {
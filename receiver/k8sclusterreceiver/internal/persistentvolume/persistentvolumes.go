@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package persistentvolume // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/persistentvolume"
+
+import (
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/constants"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+const (
+	// Keys for persistentvolume metadata and entity attributes.
+	pvKeyName          = "k8s.persistentvolume.name"
+	pvKeyPhase         = "k8s.persistentvolume.phase"
+	pvKeyStorageClass  = "k8s.storageclass.name"
+	pvKeyReclaimPolicy = "k8s.persistentvolume.reclaim_policy"
+	pvKeyClaimName     = "k8s.persistentvolumeclaim.name"
+	pvKeyClaimUID      = "k8s.persistentvolumeclaim.uid"
+)
+
+// Transform transforms the persistent volume to remove the fields that we don't use to reduce RAM utilization.
+// IMPORTANT: Make sure to update this function before using new persistent volume fields.
+func Transform(pv *corev1.PersistentVolume) *corev1.PersistentVolume {
+	return &corev1.PersistentVolume{
+		ObjectMeta: metadata.TransformObjectMeta(pv.ObjectMeta),
+		Spec: corev1.PersistentVolumeSpec{
+			Capacity:                      pv.Spec.Capacity,
+			ClaimRef:                      pv.Spec.ClaimRef,
+			PersistentVolumeReclaimPolicy: pv.Spec.PersistentVolumeReclaimPolicy,
+			StorageClassName:              pv.Spec.StorageClassName,
+		},
+		Status: corev1.PersistentVolumeStatus{
+			Phase: pv.Status.Phase,
+		},
+	}
+}
+
+func RecordMetrics(mb *metadata.MetricsBuilder, pv *corev1.PersistentVolume, ts pcommon.Timestamp) {
+	mb.RecordK8sPersistentvolumePhaseDataPoint(ts, int64(phaseToInt(pv.Status.Phase)))
+	if capacity, ok := pv.Spec.Capacity[corev1.ResourceStorage]; ok {
+		mb.RecordK8sPersistentvolumeStorageCapacityDataPoint(ts, capacity.Value())
+	}
+
+	rb := mb.NewResourceBuilder()
+	rb.SetK8sPersistentvolumeUID(string(pv.UID))
+	rb.SetK8sPersistentvolumeName(pv.Name)
+	if pv.Spec.StorageClassName != "" {
+		rb.SetK8sStorageclassName(pv.Spec.StorageClassName)
+	}
+	mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
+
+func phaseToInt(phase corev1.PersistentVolumePhase) int32 {
+	switch phase {
+	case corev1.VolumePending:
+		return 1
+	case corev1.VolumeAvailable:
+		return 2
+	case corev1.VolumeBound:
+		return 3
+	case corev1.VolumeReleased:
+		return 4
+	case corev1.VolumeFailed:
+		return 5
+	default:
+		// If phase is blank or unknown for some reason, send as -1.
+		return -1
+	}
+}
+
+func GetMetadata(pv *corev1.PersistentVolume) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+	rm := metadata.GetGenericMetadata(&pv.ObjectMeta, constants.K8sKindPersistentVolume)
+	// Persistent volumes are cluster-scoped.
+	delete(rm.Metadata, constants.K8sKeyNamespaceName)
+	rm.Metadata[pvKeyName] = pv.Name
+	if pv.Status.Phase == "" {
+		rm.Metadata[pvKeyPhase] = "unknown"
+	} else {
+		rm.Metadata[pvKeyPhase] = strings.ToLower(string(pv.Status.Phase))
+	}
+	if pv.Spec.StorageClassName != "" {
+		rm.Metadata[pvKeyStorageClass] = pv.Spec.StorageClassName
+	}
+	if pv.Spec.PersistentVolumeReclaimPolicy != "" {
+		rm.Metadata[pvKeyReclaimPolicy] = string(pv.Spec.PersistentVolumeReclaimPolicy)
+	}
+	if ref := pv.Spec.ClaimRef; ref != nil {
+		rm.Metadata[pvKeyClaimName] = ref.Name
+		rm.Metadata[pvKeyClaimUID] = string(ref.UID)
+	}
+	return map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{experimentalmetricmetadata.ResourceID(pv.UID): rm}
+}
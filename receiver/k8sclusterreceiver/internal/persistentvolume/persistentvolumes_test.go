@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package persistentvolume
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
+)
+
+func TestPersistentVolumeMetrics(t *testing.T) {
+	pv := testutils.NewPersistentVolume("1")
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	cfg := metadata.DefaultMetricsBuilderConfig()
+	cfg.ResourceAttributes.K8sStorageclassName.Enabled = true
+	cfg.ResourceAttributes.K8sPersistentvolumeName.Enabled = true
+	cfg.ResourceAttributes.K8sPersistentvolumeUID.Enabled = true
+	cfg.Metrics.K8sPersistentvolumePhase.Enabled = true
+	cfg.Metrics.K8sPersistentvolumeStorageCapacity.Enabled = true
+	mb := metadata.NewMetricsBuilder(cfg, receivertest.NewNopSettings(metadata.Type))
+	RecordMetrics(mb, pv, ts)
+	m := mb.Emit()
+
+	expected, err := golden.ReadMetrics(filepath.Join("testdata", "expected.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, pmetrictest.CompareMetrics(expected, m,
+		pmetrictest.IgnoreTimestamp(),
+		pmetrictest.IgnoreStartTimestamp(),
+		pmetrictest.IgnoreResourceMetricsOrder(),
+		pmetrictest.IgnoreMetricsOrder(),
+		pmetrictest.IgnoreScopeMetricsOrder(),
+	),
+	)
+}
+
+func TestPhaseToInt(t *testing.T) {
+	tests := []struct {
+		phase corev1.PersistentVolumePhase
+		want  int32
+	}{
+		{phase: corev1.VolumePending, want: 1},
+		{phase: corev1.VolumeAvailable, want: 2},
+		{phase: corev1.VolumeBound, want: 3},
+		{phase: corev1.VolumeReleased, want: 4},
+		{phase: corev1.VolumeFailed, want: 5},
+		{phase: "", want: -1},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.phase), func(t *testing.T) {
+			assert.Equal(t, tt.want, phaseToInt(tt.phase))
+		})
+	}
+}
+
+func TestPersistentVolumeMetadata(t *testing.T) {
+	pv := testutils.NewPersistentVolume("1")
+
+	actualMetadata := GetMetadata(pv)
+
+	require.Len(t, actualMetadata, 1)
+	require.Equal(t,
+		metadata.KubernetesMetadata{
+			EntityType:    "k8s.persistentvolume",
+			ResourceIDKey: "k8s.persistentvolume.uid",
+			ResourceID:    "test-persistentvolume-1-uid",
+			Metadata: map[string]string{
+				"persistentvolume.creation_timestamp": "0001-01-01T00:00:00Z",
+				"foo":                                 "bar",
+				"foo1":                                "",
+				"k8s.workload.kind":                   "PersistentVolume",
+				"k8s.workload.name":                   "test-persistentvolume-1",
+				"k8s.persistentvolume.name":           "test-persistentvolume-1",
+				"k8s.persistentvolume.phase":          "bound",
+				"k8s.persistentvolume.reclaim_policy": "Delete",
+				"k8s.persistentvolumeclaim.name":      "test-persistentvolumeclaim-1",
+				"k8s.persistentvolumeclaim.uid":       "test-persistentvolumeclaim-1-uid",
+				"k8s.storageclass.name":               "standard",
+			},
+		},
+		*actualMetadata["test-persistentvolume-1-uid"],
+	)
+}
@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package persistentvolumeclaim // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/persistentvolumeclaim"
+
+import (
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/constants"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+const (
+	// Keys for persistentvolumeclaim metadata and entity attributes.
+	pvcKeyName         = "k8s.persistentvolumeclaim.name"
+	pvcKeyPhase        = "k8s.persistentvolumeclaim.phase"
+	pvcKeyVolumeName   = "k8s.persistentvolume.name"
+	pvcKeyStorageClass = "k8s.storageclass.name"
+)
+
+// Transform transforms the persistent volume claim to remove the fields that we don't use to reduce RAM utilization.
+// IMPORTANT: Make sure to update this function before using new persistent volume claim fields.
+func Transform(pvc *corev1.PersistentVolumeClaim) *corev1.PersistentVolumeClaim {
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metadata.TransformObjectMeta(pvc.ObjectMeta),
+		Spec: corev1.PersistentVolumeClaimSpec{
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: pvc.Spec.Resources.Requests,
+			},
+			VolumeName:       pvc.Spec.VolumeName,
+			StorageClassName: pvc.Spec.StorageClassName,
+		},
+		Status: corev1.PersistentVolumeClaimStatus{
+			Phase:    pvc.Status.Phase,
+			Capacity: pvc.Status.Capacity,
+		},
+	}
+}
+
+func RecordMetrics(mb *metadata.MetricsBuilder, pvc *corev1.PersistentVolumeClaim, ts pcommon.Timestamp) {
+	mb.RecordK8sPersistentvolumeclaimPhaseDataPoint(ts, int64(phaseToInt(pvc.Status.Phase)))
+	if request, ok := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; ok {
+		mb.RecordK8sPersistentvolumeclaimStorageRequestDataPoint(ts, request.Value())
+	}
+	if capacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]; ok {
+		mb.RecordK8sPersistentvolumeclaimStorageCapacityDataPoint(ts, capacity.Value())
+	}
+
+	rb := mb.NewResourceBuilder()
+	rb.SetK8sPersistentvolumeclaimUID(string(pvc.UID))
+	rb.SetK8sPersistentvolumeclaimName(pvc.Name)
+	rb.SetK8sNamespaceName(pvc.Namespace)
+	if pvc.Spec.VolumeName != "" {
+		rb.SetK8sPersistentvolumeName(pvc.Spec.VolumeName)
+	}
+	if pvc.Spec.StorageClassName != nil {
+		rb.SetK8sStorageclassName(*pvc.Spec.StorageClassName)
+	}
+	mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
+
+func phaseToInt(phase corev1.PersistentVolumeClaimPhase) int32 {
+	switch phase {
+	case corev1.ClaimPending:
+		return 1
+	case corev1.ClaimBound:
+		return 2
+	case corev1.ClaimLost:
+		return 3
+	default:
+		// If phase is blank or unknown for some reason, send as -1.
+		return -1
+	}
+}
+
+func GetMetadata(pvc *corev1.PersistentVolumeClaim) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+	rm := metadata.GetGenericMetadata(&pvc.ObjectMeta, constants.K8sKindPersistentVolumeClaim)
+	rm.Metadata[pvcKeyName] = pvc.Name
+	if pvc.Status.Phase == "" {
+		rm.Metadata[pvcKeyPhase] = "unknown"
+	} else {
+		rm.Metadata[pvcKeyPhase] = strings.ToLower(string(pvc.Status.Phase))
+	}
+	if pvc.Spec.VolumeName != "" {
+		rm.Metadata[pvcKeyVolumeName] = pvc.Spec.VolumeName
+	}
+	if pvc.Spec.StorageClassName != nil {
+		rm.Metadata[pvcKeyStorageClass] = *pvc.Spec.StorageClassName
+	}
+	return map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{experimentalmetricmetadata.ResourceID(pvc.UID): rm}
+}
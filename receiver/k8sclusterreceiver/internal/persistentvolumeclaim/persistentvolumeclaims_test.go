@@ -0,0 +1,116 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package persistentvolumeclaim
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
+)
+
+func TestPersistentVolumeClaimMetrics(t *testing.T) {
+	pvc := testutils.NewPersistentVolumeClaim("1")
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mb := metadata.NewMetricsBuilder(newMetricsBuilderConfig(), receivertest.NewNopSettings(metadata.Type))
+	RecordMetrics(mb, pvc, ts)
+	m := mb.Emit()
+
+	expected, err := golden.ReadMetrics(filepath.Join("testdata", "expected.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, pmetrictest.CompareMetrics(expected, m,
+		pmetrictest.IgnoreTimestamp(),
+		pmetrictest.IgnoreStartTimestamp(),
+		pmetrictest.IgnoreResourceMetricsOrder(),
+		pmetrictest.IgnoreMetricsOrder(),
+		pmetrictest.IgnoreScopeMetricsOrder(),
+	),
+	)
+}
+
+func TestPersistentVolumeClaimMetricsPending(t *testing.T) {
+	pvc := testutils.NewPersistentVolumeClaim("1")
+	pvc.Spec.VolumeName = ""
+	pvc.Status = corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimPending}
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mb := metadata.NewMetricsBuilder(newMetricsBuilderConfig(), receivertest.NewNopSettings(metadata.Type))
+	RecordMetrics(mb, pvc, ts)
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	rm := m.ResourceMetrics().At(0)
+	_, ok := rm.Resource().Attributes().Get("k8s.persistentvolume.name")
+	assert.False(t, ok)
+
+	// The capacity is unknown until the claim is bound.
+	metrics := rm.ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+	for i := 0; i < metrics.Len(); i++ {
+		assert.NotEqual(t, "k8s.persistentvolumeclaim.storage.capacity", metrics.At(i).Name())
+		if metrics.At(i).Name() == "k8s.persistentvolumeclaim.phase" {
+			assert.Equal(t, int64(1), metrics.At(i).Gauge().DataPoints().At(0).IntValue())
+		}
+	}
+}
+
+func TestPersistentVolumeClaimMetadata(t *testing.T) {
+	pvc := testutils.NewPersistentVolumeClaim("1")
+
+	actualMetadata := GetMetadata(pvc)
+
+	require.Len(t, actualMetadata, 1)
+	require.Equal(t,
+		metadata.KubernetesMetadata{
+			EntityType:    "k8s.persistentvolumeclaim",
+			ResourceIDKey: "k8s.persistentvolumeclaim.uid",
+			ResourceID:    "test-persistentvolumeclaim-1-uid",
+			Metadata: map[string]string{
+				"persistentvolumeclaim.creation_timestamp": "0001-01-01T00:00:00Z",
+				"foo":                             "bar",
+				"foo1":                            "",
+				"k8s.workload.kind":               "PersistentVolumeClaim",
+				"k8s.workload.name":               "test-persistentvolumeclaim-1",
+				"k8s.namespace.name":              "test-namespace",
+				"k8s.persistentvolumeclaim.name":  "test-persistentvolumeclaim-1",
+				"k8s.persistentvolumeclaim.phase": "bound",
+				"k8s.persistentvolume.name":       "test-persistentvolume-1",
+				"k8s.storageclass.name":           "standard",
+			},
+		},
+		*actualMetadata["test-persistentvolumeclaim-1-uid"],
+	)
+}
+
+func TestTransform(t *testing.T) {
+	originalPVC := testutils.NewPersistentVolumeClaim("1")
+	originalPVC.Spec.AccessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}
+	originalPVC.Status.AccessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}
+
+	wantPVC := testutils.NewPersistentVolumeClaim("1")
+	assert.Equal(t, wantPVC, Transform(originalPVC))
+}
+
+// newMetricsBuilderConfig returns the default config with the optional metrics of the kind enabled.
+func newMetricsBuilderConfig() metadata.MetricsBuilderConfig {
+	cfg := metadata.DefaultMetricsBuilderConfig()
+	cfg.ResourceAttributes.K8sPersistentvolumeclaimName.Enabled = true
+	cfg.ResourceAttributes.K8sPersistentvolumeclaimUID.Enabled = true
+	cfg.ResourceAttributes.K8sPersistentvolumeName.Enabled = true
+	cfg.Metrics.K8sPersistentvolumeclaimPhase.Enabled = true
+	cfg.Metrics.K8sPersistentvolumeclaimStorageCapacity.Enabled = true
+	cfg.Metrics.K8sPersistentvolumeclaimStorageRequest.Enabled = true
+	return cfg
+}
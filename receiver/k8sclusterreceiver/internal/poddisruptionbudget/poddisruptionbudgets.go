@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package poddisruptionbudget // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/poddisruptionbudget"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	policyv1 "k8s.io/api/policy/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/constants"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+const (
+	// Keys for poddisruptionbudget metadata and entity attributes.
+	pdbKeyName           = "k8s.poddisruptionbudget.name"
+	pdbKeyMinAvailable   = "min_available"
+	pdbKeyMaxUnavailable = "max_unavailable"
+)
+
+func RecordMetrics(mb *metadata.MetricsBuilder, pdb *policyv1.PodDisruptionBudget, ts pcommon.Timestamp) {
+	mb.RecordK8sPoddisruptionbudgetCurrentHealthyDataPoint(ts, int64(pdb.Status.CurrentHealthy))
+	mb.RecordK8sPoddisruptionbudgetDesiredHealthyDataPoint(ts, int64(pdb.Status.DesiredHealthy))
+	mb.RecordK8sPoddisruptionbudgetDisruptionsAllowedDataPoint(ts, int64(pdb.Status.DisruptionsAllowed))
+
+	rb := mb.NewResourceBuilder()
+	rb.SetK8sPoddisruptionbudgetUID(string(pdb.UID))
+	rb.SetK8sPoddisruptionbudgetName(pdb.Name)
+	rb.SetK8sNamespaceName(pdb.Namespace)
+	mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
+
+func GetMetadata(pdb *policyv1.PodDisruptionBudget) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+	rm := metadata.GetGenericMetadata(&pdb.ObjectMeta, constants.K8sKindPodDisruptionBudget)
+	rm.Metadata[pdbKeyName] = pdb.Name
+	if pdb.Spec.MinAvailable != nil {
+		rm.Metadata[pdbKeyMinAvailable] = pdb.Spec.MinAvailable.String()
+	}
+	if pdb.Spec.MaxUnavailable != nil {
+		rm.Metadata[pdbKeyMaxUnavailable] = pdb.Spec.MaxUnavailable.String()
+	}
+	return map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{experimentalmetricmetadata.ResourceID(pdb.UID): rm}
+}
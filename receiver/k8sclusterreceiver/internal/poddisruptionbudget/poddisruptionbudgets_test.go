@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package poddisruptionbudget
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
+)
+
+func TestPodDisruptionBudgetMetrics(t *testing.T) {
+	pdb := testutils.NewPodDisruptionBudget("1")
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mb := metadata.NewMetricsBuilder(newMetricsBuilderConfig(), receivertest.NewNopSettings(metadata.Type))
+	RecordMetrics(mb, pdb, ts)
+	m := mb.Emit()
+
+	expected, err := golden.ReadMetrics(filepath.Join("testdata", "expected.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, pmetrictest.CompareMetrics(expected, m,
+		pmetrictest.IgnoreTimestamp(),
+		pmetrictest.IgnoreStartTimestamp(),
+		pmetrictest.IgnoreResourceMetricsOrder(),
+		pmetrictest.IgnoreMetricsOrder(),
+		pmetrictest.IgnoreScopeMetricsOrder(),
+	),
+	)
+}
+
+func TestPodDisruptionBudgetMetadata(t *testing.T) {
+	pdb := testutils.NewPodDisruptionBudget("1")
+
+	actualMetadata := GetMetadata(pdb)
+
+	require.Len(t, actualMetadata, 1)
+	require.Equal(t,
+		metadata.KubernetesMetadata{
+			EntityType:    "k8s.poddisruptionbudget",
+			ResourceIDKey: "k8s.poddisruptionbudget.uid",
+			ResourceID:    "test-poddisruptionbudget-1-uid",
+			Metadata: map[string]string{
+				"poddisruptionbudget.creation_timestamp": "0001-01-01T00:00:00Z",
+				"foo":                                    "bar",
+				"foo1":                                   "",
+				"k8s.workload.kind":                      "PodDisruptionBudget",
+				"k8s.workload.name":                      "test-poddisruptionbudget-1",
+				"k8s.namespace.name":                     "test-namespace",
+				"k8s.poddisruptionbudget.name":           "test-poddisruptionbudget-1",
+				"min_available":                          "2",
+			},
+		},
+		*actualMetadata["test-poddisruptionbudget-1-uid"],
+	)
+}
+
+// newMetricsBuilderConfig returns the default config with the optional metrics of the kind enabled.
+func newMetricsBuilderConfig() metadata.MetricsBuilderConfig {
+	cfg := metadata.DefaultMetricsBuilderConfig()
+	cfg.ResourceAttributes.K8sPoddisruptionbudgetName.Enabled = true
+	cfg.ResourceAttributes.K8sPoddisruptionbudgetUID.Enabled = true
+	cfg.Metrics.K8sPoddisruptionbudgetCurrentHealthy.Enabled = true
+	cfg.Metrics.K8sPoddisruptionbudgetDesiredHealthy.Enabled = true
+	cfg.Metrics.K8sPoddisruptionbudgetDisruptionsAllowed.Enabled = true
+	return cfg
+}
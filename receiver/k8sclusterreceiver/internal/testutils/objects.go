@@ -11,9 +11,12 @@ import (
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func NewHPA(id string) *autoscalingv2.HorizontalPodAutoscaler {
@@ -414,3 +417,138 @@ func NewCronJob(id string) *batchv1.CronJob {
 		},
 	}
 }
+
+func NewPersistentVolume(id string) *corev1.PersistentVolume {
+	return &corev1.PersistentVolume{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "test-persistentvolume-" + id,
+			UID:  types.UID("test-persistentvolume-" + id + "-uid"),
+			Labels: map[string]string{
+				"foo":  "bar",
+				"foo1": "",
+			},
+		},
+		Spec: corev1.PersistentVolumeSpec{
+			Capacity: corev1.ResourceList{
+				corev1.ResourceStorage: *resource.NewQuantity(10*1024*1024*1024, resource.BinarySI),
+			},
+			ClaimRef: &corev1.ObjectReference{
+				Kind:      "PersistentVolumeClaim",
+				Namespace: "test-namespace",
+				Name:      "test-persistentvolumeclaim-" + id,
+				UID:       types.UID("test-persistentvolumeclaim-" + id + "-uid"),
+			},
+			PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimDelete,
+			StorageClassName:              "standard",
+		},
+		Status: corev1.PersistentVolumeStatus{
+			Phase: corev1.VolumeBound,
+		},
+	}
+}
+
+func NewPersistentVolumeClaim(id string) *corev1.PersistentVolumeClaim {
+	storageClass := "standard"
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-persistentvolumeclaim-" + id,
+			Namespace: "test-namespace",
+			UID:       types.UID("test-persistentvolumeclaim-" + id + "-uid"),
+			Labels: map[string]string{
+				"foo":  "bar",
+				"foo1": "",
+			},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: *resource.NewQuantity(8*1024*1024*1024, resource.BinarySI),
+				},
+			},
+			VolumeName:       "test-persistentvolume-" + id,
+			StorageClassName: &storageClass,
+		},
+		Status: corev1.PersistentVolumeClaimStatus{
+			Phase: corev1.ClaimBound,
+			Capacity: corev1.ResourceList{
+				corev1.ResourceStorage: *resource.NewQuantity(10*1024*1024*1024, resource.BinarySI),
+			},
+		},
+	}
+}
+
+func NewPodDisruptionBudget(id string) *policyv1.PodDisruptionBudget {
+	minAvailable := intstr.FromInt32(2)
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-poddisruptionbudget-" + id,
+			Namespace: "test-namespace",
+			UID:       types.UID("test-poddisruptionbudget-" + id + "-uid"),
+			Labels: map[string]string{
+				"foo":  "bar",
+				"foo1": "",
+			},
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MinAvailable: &minAvailable,
+		},
+		Status: policyv1.PodDisruptionBudgetStatus{
+			CurrentHealthy:     3,
+			DesiredHealthy:     2,
+			DisruptionsAllowed: 1,
+			ExpectedPods:       3,
+		},
+	}
+}
+
+func NewIngress(id string) *networkingv1.Ingress {
+	className := "nginx"
+	pathType := networkingv1.PathTypePrefix
+	backend := func(service string) networkingv1.IngressBackend {
+		return networkingv1.IngressBackend{
+			Service: &networkingv1.IngressServiceBackend{
+				Name: service,
+				Port: networkingv1.ServiceBackendPort{Number: 80},
+			},
+		}
+	}
+	defaultBackend := backend("default-service")
+	return &networkingv1.Ingress{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-ingress-" + id,
+			Namespace: "test-namespace",
+			UID:       types.UID("test-ingress-" + id + "-uid"),
+			Labels: map[string]string{
+				"foo":  "bar",
+				"foo1": "",
+			},
+		},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: &className,
+			DefaultBackend:   &defaultBackend,
+			Rules: []networkingv1.IngressRule{
+				{
+					Host: "a.example.com",
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{
+								{Path: "/", PathType: &pathType, Backend: backend("service-a")},
+								{Path: "/api", PathType: &pathType, Backend: backend("service-b")},
+							},
+						},
+					},
+				},
+				{
+					Host: "b.example.com",
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{
+								{Path: "/", PathType: &pathType, Backend: backend("service-a")},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/gvk"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
 )
 
 type nopHost struct {
@@ -281,6 +282,63 @@ func TestReceiverWithManyResources(t *testing.T) {
 	require.NoError(t, r.Shutdown(ctx))
 }
 
+func TestReceiverWithStorageAndNetworkingResources(t *testing.T) {
+	tt := componenttest.NewTelemetry()
+	defer func() {
+		require.NoError(t, tt.Shutdown(t.Context()))
+	}()
+
+	client := newFakeClientWithAllResources()
+	sink := new(consumertest.MetricsSink)
+	logsConsumer := new(consumertest.LogsSink)
+
+	// The metrics of these kinds are disabled by default, and so are their informers.
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sPersistentvolumePhase.Enabled = true
+	mbc.Metrics.K8sPersistentvolumeStorageCapacity.Enabled = true
+	mbc.Metrics.K8sPersistentvolumeclaimPhase.Enabled = true
+	mbc.Metrics.K8sPersistentvolumeclaimStorageCapacity.Enabled = true
+	mbc.Metrics.K8sPersistentvolumeclaimStorageRequest.Enabled = true
+	mbc.Metrics.K8sPoddisruptionbudgetCurrentHealthy.Enabled = true
+	mbc.Metrics.K8sPoddisruptionbudgetDesiredHealthy.Enabled = true
+	mbc.Metrics.K8sPoddisruptionbudgetDisruptionsAllowed.Enabled = true
+	mbc.Metrics.K8sIngressBackendServices.Enabled = true
+	mbc.Metrics.K8sIngressRules.Enabled = true
+	config := &Config{
+		CollectionInterval:   1 * time.Second,
+		Distribution:         distributionKubernetes,
+		MetricsBuilderConfig: mbc,
+	}
+	r := setupReceiverWithConfig(client, nil, sink, logsConsumer, 10*time.Second, tt, config)
+
+	ctx := t.Context()
+	_, err := client.CoreV1().PersistentVolumes().Create(ctx, testutils.NewPersistentVolume("1"), v1.CreateOptions{})
+	require.NoError(t, err)
+	_, err = client.CoreV1().PersistentVolumeClaims("test-namespace").Create(ctx, testutils.NewPersistentVolumeClaim("1"), v1.CreateOptions{})
+	require.NoError(t, err)
+	_, err = client.PolicyV1().PodDisruptionBudgets("test-namespace").Create(ctx, testutils.NewPodDisruptionBudget("1"), v1.CreateOptions{})
+	require.NoError(t, err)
+	_, err = client.NetworkingV1().Ingresses("test-namespace").Create(ctx, testutils.NewIngress("1"), v1.CreateOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(ctx, newNopHost()))
+
+	// 2 points from the persistent volume, 3 from the claim, 3 from the
+	// pod disruption budget and 2 from the ingress.
+	require.Eventually(t, func() bool {
+		return sink.DataPointCount() == 10
+	}, 10*time.Second, 100*time.Millisecond,
+		"metrics not collected")
+
+	// One entity event for each created resource.
+	require.Eventually(t, func() bool {
+		return logsConsumer.LogRecordCount() == 4
+	}, 10*time.Second, 100*time.Millisecond,
+		"entity events not collected")
+
+	require.NoError(t, r.Shutdown(ctx))
+}
+
 var (
 	numCalls                  *atomic.Int32
 	consumeMetadataInvocation = func() {
@@ -377,6 +435,10 @@ func setupReceiver(client *fake.Clientset, osQuotaClient quotaclientset.Interfac
 		config.K8sLeaderElector = &leaderElector
 	}
 
+	return setupReceiverWithConfig(client, osQuotaClient, metricsConsumer, logsConsumer, initialSyncTimeout, tt, config)
+}
+
+func setupReceiverWithConfig(client *fake.Clientset, osQuotaClient quotaclientset.Interface, metricsConsumer consumer.Metrics, logsConsumer consumer.Logs, initialSyncTimeout time.Duration, tt *componenttest.Telemetry, config *Config) *kubernetesReceiver {
 	r, _ := newReceiver(context.Background(), receiver.Settings{ID: component.NewID(metadata.Type), TelemetrySettings: tt.NewTelemetrySettings(), BuildInfo: component.NewDefaultBuildInfo()}, config)
 	kr := r.(*kubernetesReceiver)
 	kr.metricsConsumer = metricsConsumer
@@ -403,6 +465,8 @@ func newFakeClientWithAllResources() *fake.Clientset {
 				gvkToAPIResource(gvk.ReplicationController),
 				gvkToAPIResource(gvk.ResourceQuota),
 				gvkToAPIResource(gvk.Service),
+				gvkToAPIResource(gvk.PersistentVolume),
+				gvkToAPIResource(gvk.PersistentVolumeClaim),
 			},
 		},
 		{
@@ -427,6 +491,18 @@ func newFakeClientWithAllResources() *fake.Clientset {
 				gvkToAPIResource(gvk.HorizontalPodAutoscaler),
 			},
 		},
+		{
+			GroupVersion: "policy/v1",
+			APIResources: []v1.APIResource{
+				gvkToAPIResource(gvk.PodDisruptionBudget),
+			},
+		},
+		{
+			GroupVersion: "networking.k8s.io/v1",
+			APIResources: []v1.APIResource{
+				gvkToAPIResource(gvk.Ingress),
+			},
+		},
 	}
 	return client
 }
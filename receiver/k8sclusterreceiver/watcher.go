@@ -22,6 +22,8 @@ import (
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/informers"
@@ -35,11 +37,15 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/deployment"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/gvk"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/hpa"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/ingress"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/jobs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/namespace"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/node"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/persistentvolume"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/persistentvolumeclaim"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/pod"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/poddisruptionbudget"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/replicaset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/replicationcontroller"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/statefulset"
@@ -127,9 +133,17 @@ func (rw *resourceWatcher) prepareSharedInformerFactory() error {
 		"Job":                     {gvk.Job},
 		"CronJob":                 {gvk.CronJob},
 		"HorizontalPodAutoscaler": {gvk.HorizontalPodAutoscaler},
+		"PersistentVolume":        {gvk.PersistentVolume},
+		"PersistentVolumeClaim":   {gvk.PersistentVolumeClaim},
+		"PodDisruptionBudget":     {gvk.PodDisruptionBudget},
+		"Ingress":                 {gvk.Ingress},
 	}
 
 	for kind, gvks := range supportedKinds {
+		if !rw.isKindEnabled(kind) {
+			rw.logger.Debug("No metrics enabled for the kind, skipping its informer", zap.String("kind", kind))
+			continue
+		}
 		anySupported := false
 		for _, gvk := range gvks {
 			supported, err := rw.isKindSupported(gvk)
@@ -195,6 +209,26 @@ func (rw *resourceWatcher) getInformerFactories() map[string]informers.SharedInf
 	return factories
 }
 
+// isKindEnabled returns false for kinds that are only observed for optional metrics, if none of
+// these metrics is enabled. This way the receiver doesn't require permissions to list and watch them.
+func (rw *resourceWatcher) isKindEnabled(kind string) bool {
+	metrics := rw.config.Metrics
+	switch kind {
+	case "PersistentVolume":
+		return metrics.K8sPersistentvolumePhase.Enabled || metrics.K8sPersistentvolumeStorageCapacity.Enabled
+	case "PersistentVolumeClaim":
+		return metrics.K8sPersistentvolumeclaimPhase.Enabled || metrics.K8sPersistentvolumeclaimStorageCapacity.Enabled ||
+			metrics.K8sPersistentvolumeclaimStorageRequest.Enabled
+	case "PodDisruptionBudget":
+		return metrics.K8sPoddisruptionbudgetCurrentHealthy.Enabled || metrics.K8sPoddisruptionbudgetDesiredHealthy.Enabled ||
+			metrics.K8sPoddisruptionbudgetDisruptionsAllowed.Enabled
+	case "Ingress":
+		return metrics.K8sIngressBackendServices.Enabled || metrics.K8sIngressRules.Enabled
+	default:
+		return true
+	}
+}
+
 func (rw *resourceWatcher) isKindSupported(gvk schema.GroupVersionKind) (bool, error) {
 	resources, err := rw.client.Discovery().ServerResourcesForGroupVersion(gvk.GroupVersion().String())
 	if err != nil {
@@ -277,6 +311,25 @@ func (rw *resourceWatcher) setupInformerForKind(kind schema.GroupVersionKind, fa
 		for ns, factory := range factories {
 			rw.setupInformer(kind, ns, factory.Autoscaling().V2().HorizontalPodAutoscalers().Informer())
 		}
+	case gvk.PersistentVolume:
+		if len(rw.config.Namespaces) == 0 && rw.config.Namespace == "" && len(factories) >= 1 {
+			// persistent volumes are cluster-scoped, so they are only observed by the cluster wide informer factory
+			if factory, ok := factories[metadata.ClusterWideInformerKey]; ok {
+				rw.setupInformer(kind, metadata.ClusterWideInformerKey, factory.Core().V1().PersistentVolumes().Informer())
+			}
+		}
+	case gvk.PersistentVolumeClaim:
+		for ns, factory := range factories {
+			rw.setupInformer(kind, ns, factory.Core().V1().PersistentVolumeClaims().Informer())
+		}
+	case gvk.PodDisruptionBudget:
+		for ns, factory := range factories {
+			rw.setupInformer(kind, ns, factory.Policy().V1().PodDisruptionBudgets().Informer())
+		}
+	case gvk.Ingress:
+		for ns, factory := range factories {
+			rw.setupInformer(kind, ns, factory.Networking().V1().Ingresses().Informer())
+		}
 	default:
 		rw.logger.Error("Could not setup an informer for provided group version kind",
 			zap.String("group version kind", kind.String()))
@@ -381,6 +434,14 @@ func (rw *resourceWatcher) objMetadata(obj any) map[experimentalmetricmetadata.R
 		return hpa.GetMetadata(o)
 	case *corev1.Namespace:
 		return namespace.GetMetadata(o)
+	case *corev1.PersistentVolume:
+		return persistentvolume.GetMetadata(o)
+	case *corev1.PersistentVolumeClaim:
+		return persistentvolumeclaim.GetMetadata(o)
+	case *policyv1.PodDisruptionBudget:
+		return poddisruptionbudget.GetMetadata(o)
+	case *networkingv1.Ingress:
+		return ingress.GetMetadata(o)
 	}
 	return nil
 }
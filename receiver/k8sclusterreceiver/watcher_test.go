@@ -221,6 +221,35 @@ func TestPrepareSharedInformerFactory(t *testing.T) {
 	}
 }
 
+func TestPrepareSharedInformerFactoryOptionalKinds(t *testing.T) {
+	optionalKinds := []schema.GroupVersionKind{gvk.PersistentVolume, gvk.PersistentVolumeClaim, gvk.PodDisruptionBudget, gvk.Ingress}
+
+	// Informers for the optional kinds are not set up with the default metrics
+	cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()}
+	rw := &resourceWatcher{
+		client:        newFakeClientWithAllResources(),
+		logger:        zap.NewNop(),
+		metadataStore: metadata.NewStore(),
+		config:        cfg,
+	}
+	require.NoError(t, rw.prepareSharedInformerFactory())
+	for _, kind := range optionalKinds {
+		assert.Nil(t, rw.metadataStore.Get(kind), "kind %s", kind.Kind)
+	}
+	assert.NotNil(t, rw.metadataStore.Get(gvk.Pod))
+
+	// A single enabled metric sets up the informer of its kind
+	cfg.Metrics.K8sPersistentvolumePhase.Enabled = true
+	cfg.Metrics.K8sPersistentvolumeclaimStorageRequest.Enabled = true
+	cfg.Metrics.K8sPoddisruptionbudgetDisruptionsAllowed.Enabled = true
+	cfg.Metrics.K8sIngressRules.Enabled = true
+	rw.metadataStore = metadata.NewStore()
+	require.NoError(t, rw.prepareSharedInformerFactory())
+	for _, kind := range optionalKinds {
+		assert.NotNil(t, rw.metadataStore.Get(kind), "kind %s", kind.Kind)
+	}
+}
+
 func TestSetupInformerForKind(t *testing.T) {
 	obs, logs := observer.New(zap.WarnLevel)
 	obsLogger := zap.New(obs)
@@ -490,6 +519,28 @@ func TestObjMetadata(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:          "PodDisruptionBudget simple case",
+			metadataStore: &metadata.Store{},
+			resource:      testutils.NewPodDisruptionBudget("1"),
+			want: map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{
+				experimentalmetricmetadata.ResourceID("test-poddisruptionbudget-1-uid"): {
+					EntityType:    "k8s.poddisruptionbudget",
+					ResourceIDKey: "k8s.poddisruptionbudget.uid",
+					ResourceID:    "test-poddisruptionbudget-1-uid",
+					Metadata: map[string]string{
+						"foo":                                    "bar",
+						"foo1":                                   "",
+						"k8s.workload.kind":                      "PodDisruptionBudget",
+						"k8s.workload.name":                      "test-poddisruptionbudget-1",
+						"k8s.poddisruptionbudget.name":           "test-poddisruptionbudget-1",
+						"poddisruptionbudget.creation_timestamp": "0001-01-01T00:00:00Z",
+						"k8s.namespace.name":                     "test-namespace",
+						"min_available":                          "2",
+					},
+				},
+			},
+		},
 		{
 			name:          "Job simple case",
 			metadataStore: &metadata.Store{},
@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// aggregationKey identifies the repeated events of an object
+type aggregationKey struct {
+	namespace string
+	kind      string
+	name      string
+	uid       types.UID
+	reason    string
+}
+
+type aggregatedEvent struct {
+	// latest is the last event received
+	latest *corev1.Event
+	// counts are the counts of the merged events by event UID, as an event is updated every time it repeats
+	counts map[types.UID]int32
+}
+
+// eventAggregator merges the events with the same reason and involved object
+// until they are flushed at the end of the aggregation window
+type eventAggregator struct {
+	maxEntries int
+
+	mu     sync.Mutex
+	events map[aggregationKey]*aggregatedEvent
+	order  []aggregationKey
+}
+
+func newEventAggregator(cfg AggregationConfig) *eventAggregator {
+	return &eventAggregator{
+		maxEntries: cfg.MaxEntries,
+		events:     map[aggregationKey]*aggregatedEvent{},
+	}
+}
+
+// add merges an event with the previous events of the same key.
+// It returns false if the event could not be aggregated because the aggregator is full.
+func (a *eventAggregator) add(ev *corev1.Event) bool {
+	key := aggregationKey{
+		namespace: ev.InvolvedObject.Namespace,
+		kind:      ev.InvolvedObject.Kind,
+		name:      ev.InvolvedObject.Name,
+		uid:       ev.InvolvedObject.UID,
+		reason:    ev.Reason,
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	aggregated, ok := a.events[key]
+	if !ok {
+		if len(a.events) >= a.maxEntries {
+			return false
+		}
+		aggregated = &aggregatedEvent{counts: map[types.UID]int32{}}
+		a.events[key] = aggregated
+		a.order = append(a.order, key)
+	}
+	aggregated.latest = ev
+	aggregated.counts[ev.UID] = max(ev.Count, 1)
+	return true
+}
+
+// flush returns the aggregated events in the order they were first received and resets the aggregator.
+// The count of an aggregated event is the sum of the counts of the merged events.
+func (a *eventAggregator) flush() []*corev1.Event {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	events := make([]*corev1.Event, 0, len(a.order))
+	for _, key := range a.order {
+		aggregated := a.events[key]
+		ev := aggregated.latest.DeepCopy()
+		ev.Count = 0
+		for _, count := range aggregated.counts {
+			ev.Count += count
+		}
+		events = append(events, ev)
+	}
+	a.events = map[aggregationKey]*aggregatedEvent{}
+	a.order = nil
+	return events
+}
@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestEventAggregator(t *testing.T) {
+	a := newEventAggregator(AggregationConfig{Window: time.Minute, MaxEntries: 2})
+
+	backOff := getEvent("Warning")
+	backOff.Reason = "BackOff"
+	backOff.Count = 3
+	require.True(t, a.add(backOff))
+
+	// the same event updated with a higher count replaces the previous count
+	updated := backOff.DeepCopy()
+	updated.Count = 5
+	updated.Message = "updated message"
+	require.True(t, a.add(updated))
+
+	// another event with the same reason and involved object is merged
+	repeated := backOff.DeepCopy()
+	repeated.UID = types.UID("7c1d2e3f-0a1b")
+	repeated.Count = 0
+	require.True(t, a.add(repeated))
+
+	scheduling := getEvent("Warning")
+	scheduling.Reason = "FailedScheduling"
+	require.True(t, a.add(scheduling))
+
+	// the aggregator is full, events of new keys are not aggregated
+	otherPod := getEvent("Warning")
+	otherPod.InvolvedObject.Name = "other-pod"
+	assert.False(t, a.add(otherPod))
+	// but repeated events of the aggregated keys still are
+	assert.True(t, a.add(scheduling))
+
+	events := a.flush()
+	require.Len(t, events, 2)
+	assert.Equal(t, "BackOff", events[0].Reason)
+	assert.Equal(t, int32(6), events[0].Count)
+	assert.Equal(t, "FailedScheduling", events[1].Reason)
+	assert.Equal(t, int32(2), events[1].Count)
+	// the flushed events are copies of the latest received events
+	assert.Equal(t, int32(0), repeated.Count)
+
+	assert.Empty(t, a.flush())
+	assert.True(t, a.add(otherPod))
+}
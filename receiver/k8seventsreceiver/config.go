@@ -4,6 +4,9 @@
 package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
 
 import (
+	"errors"
+	"time"
+
 	"go.opentelemetry.io/collector/component"
 	k8s "k8s.io/client-go/kubernetes"
 
@@ -19,12 +22,71 @@ type Config struct {
 
 	K8sLeaderElector *component.ID `mapstructure:"k8s_leader_elector"`
 
+	// Filter selects the events to collect.
+	Filter FilterConfig `mapstructure:"filter"`
+
+	// Aggregation merges the repeated events of an object.
+	Aggregation AggregationConfig `mapstructure:"aggregation"`
+
+	// Enrichment adds the metadata of the involved objects to the events.
+	Enrichment EnrichmentConfig `mapstructure:"enrichment"`
+
 	// For mocking
 	makeClient func(apiConf k8sconfig.APIConfig) (k8s.Interface, error)
 }
 
+// FilterConfig selects the events to collect.
+// An event is collected if it matches the include rule and does not match the exclude rule.
+type FilterConfig struct {
+	// Include is the rule events must match to be collected. An empty rule matches all the events.
+	Include EventMatchConfig `mapstructure:"include"`
+
+	// Exclude is the rule of the events to drop. An empty rule matches no events.
+	Exclude EventMatchConfig `mapstructure:"exclude"`
+}
+
+// EventMatchConfig matches events by type, reason and namespace of the involved object.
+// An event matches if it matches all the non-empty lists.
+type EventMatchConfig struct {
+	// Types of the events, e.g. Warning. Types are matched case-insensitively.
+	Types []string `mapstructure:"types"`
+
+	// Reasons of the events, e.g. BackOff.
+	Reasons []string `mapstructure:"reasons"`
+
+	// Namespaces of the involved objects.
+	Namespaces []string `mapstructure:"namespaces"`
+}
+
+// AggregationConfig merges the repeated events of an object.
+type AggregationConfig struct {
+	// Window is the duration during which the events with the same reason and
+	// involved object are merged into a single log record. Aggregation is disabled if zero.
+	Window time.Duration `mapstructure:"window"`
+
+	// MaxEntries is the maximum number of aggregated events held during a window.
+	// Events beyond this limit are emitted without aggregation.
+	MaxEntries int `mapstructure:"max_entries"`
+}
+
+// EnrichmentConfig adds the metadata of the involved objects to the events.
+type EnrichmentConfig struct {
+	// Pods adds the labels and the owner workload of the involved pods.
+	Pods bool `mapstructure:"pods"`
+
+	// Nodes adds the labels of the involved nodes.
+	Nodes bool `mapstructure:"nodes"`
+}
+
 func (cfg *Config) Validate() error {
-	return cfg.APIConfig.Validate()
+	var errs []error
+	if cfg.Aggregation.Window < 0 {
+		errs = append(errs, errors.New("aggregation window must not be negative"))
+	}
+	if cfg.Aggregation.Window > 0 && cfg.Aggregation.MaxEntries <= 0 {
+		errs = append(errs, errors.New("aggregation max_entries must be greater than 0"))
+	}
+	return errors.Join(append(errs, cfg.APIConfig.Validate())...)
 }
 
 func (cfg *Config) getK8sClient() (k8s.Interface, error) {
@@ -6,6 +6,7 @@ package k8seventsreceiver
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -39,6 +40,23 @@ func TestLoadConfig(t *testing.T) {
 				APIConfig: k8sconfig.APIConfig{
 					AuthType: k8sconfig.AuthTypeServiceAccount,
 				},
+				Filter: FilterConfig{
+					Include: EventMatchConfig{
+						Types: []string{"Warning"},
+					},
+					Exclude: EventMatchConfig{
+						Reasons:    []string{"BackOff"},
+						Namespaces: []string{"kube-system"},
+					},
+				},
+				Aggregation: AggregationConfig{
+					Window:     30 * time.Second,
+					MaxEntries: 500,
+				},
+				Enrichment: EnrichmentConfig{
+					Pods:  true,
+					Nodes: true,
+				},
 			},
 		},
 	}
@@ -57,3 +75,17 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestInvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	cfg := NewFactory().CreateDefaultConfig()
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "invalid_aggregation").String())
+	require.NoError(t, err)
+	require.NoError(t, sub.Unmarshal(cfg))
+
+	assert.ErrorContains(t, xconfmap.Validate(cfg), "aggregation max_entries must be greater than 0")
+}
@@ -0,0 +1,171 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	semconv "go.opentelemetry.io/otel/semconv/v1.27.0"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	k8s "k8s.io/client-go/kubernetes"
+	appslisters "k8s.io/client-go/listers/apps/v1"
+	batchlisters "k8s.io/client-go/listers/batch/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+)
+
+const (
+	podLabelPrefix  = "k8s.pod.label."
+	nodeLabelPrefix = "k8s.node.label."
+)
+
+// namespaceListers are the listers of the namespaced objects of a namespace, or of all the namespaces
+type namespaceListers struct {
+	pods        corelisters.PodLister
+	replicaSets appslisters.ReplicaSetLister
+	jobs        batchlisters.JobLister
+}
+
+// objectEnricher adds the labels and the owner workload of the involved pods and the labels of the
+// involved nodes to the events. The objects are cached by informers, which only keep their metadata.
+type objectEnricher struct {
+	factories  []informers.SharedInformerFactory
+	namespaces map[string]namespaceListers
+	nodes      corelisters.NodeLister
+}
+
+func newObjectEnricher(client k8s.Interface, cfg EnrichmentConfig, namespaces []string) *objectEnricher {
+	e := &objectEnricher{namespaces: map[string]namespaceListers{}}
+	if cfg.Pods {
+		if len(namespaces) == 0 {
+			namespaces = []string{metav1.NamespaceAll}
+		}
+		for _, ns := range namespaces {
+			factory := informers.NewSharedInformerFactoryWithOptions(client, 0,
+				informers.WithNamespace(ns), informers.WithTransform(stripObject))
+			e.namespaces[ns] = namespaceListers{
+				pods:        factory.Core().V1().Pods().Lister(),
+				replicaSets: factory.Apps().V1().ReplicaSets().Lister(),
+				jobs:        factory.Batch().V1().Jobs().Lister(),
+			}
+			e.factories = append(e.factories, factory)
+		}
+	}
+	if cfg.Nodes {
+		factory := informers.NewSharedInformerFactoryWithOptions(client, 0, informers.WithTransform(stripObject))
+		e.nodes = factory.Core().V1().Nodes().Lister()
+		e.factories = append(e.factories, factory)
+	}
+	return e
+}
+
+// start starts the informers, which run until the stop channel is closed.
+// Events received before the informers are synced are not enriched.
+func (e *objectEnricher) start(stopCh <-chan struct{}) {
+	for _, factory := range e.factories {
+		factory.Start(stopCh)
+	}
+}
+
+// shutdown waits for the informers to stop, once their stop channel is closed
+func (e *objectEnricher) shutdown() {
+	for _, factory := range e.factories {
+		factory.Shutdown()
+	}
+}
+
+// enrich adds the metadata of the object involved in the event to the resource attributes
+func (e *objectEnricher) enrich(ev *corev1.Event, attrs pcommon.Map) {
+	switch ev.InvolvedObject.Kind {
+	case "Pod":
+		e.enrichPod(ev.InvolvedObject.Namespace, ev.InvolvedObject.Name, attrs)
+	case "Node":
+		if e.nodes == nil {
+			return
+		}
+		node, err := e.nodes.Get(ev.InvolvedObject.Name)
+		if err != nil {
+			return
+		}
+		putLabels(attrs, nodeLabelPrefix, node.Labels)
+	}
+}
+
+func (e *objectEnricher) enrichPod(namespace, name string, attrs pcommon.Map) {
+	listers, ok := e.namespaces[namespace]
+	if !ok {
+		if listers, ok = e.namespaces[metav1.NamespaceAll]; !ok {
+			return
+		}
+	}
+	pod, err := listers.pods.Pods(namespace).Get(name)
+	if err != nil {
+		return
+	}
+
+	attrs.PutStr(string(semconv.K8SPodNameKey), pod.Name)
+	attrs.PutStr(string(semconv.K8SPodUIDKey), string(pod.UID))
+	putLabels(attrs, podLabelPrefix, pod.Labels)
+
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return
+	}
+	switch owner.Kind {
+	case "ReplicaSet":
+		attrs.PutStr(string(semconv.K8SReplicaSetNameKey), owner.Name)
+		if rs, err := listers.replicaSets.ReplicaSets(namespace).Get(owner.Name); err == nil {
+			if deployment := metav1.GetControllerOf(rs); deployment != nil && deployment.Kind == "Deployment" {
+				attrs.PutStr(string(semconv.K8SDeploymentNameKey), deployment.Name)
+			}
+		}
+	case "StatefulSet":
+		attrs.PutStr(string(semconv.K8SStatefulSetNameKey), owner.Name)
+	case "DaemonSet":
+		attrs.PutStr(string(semconv.K8SDaemonSetNameKey), owner.Name)
+	case "Job":
+		attrs.PutStr(string(semconv.K8SJobNameKey), owner.Name)
+		if job, err := listers.jobs.Jobs(namespace).Get(owner.Name); err == nil {
+			if cronJob := metav1.GetControllerOf(job); cronJob != nil && cronJob.Kind == "CronJob" {
+				attrs.PutStr(string(semconv.K8SCronJobNameKey), cronJob.Name)
+			}
+		}
+	}
+}
+
+func putLabels(attrs pcommon.Map, prefix string, labels map[string]string) {
+	for key, value := range labels {
+		attrs.PutStr(prefix+key, value)
+	}
+}
+
+// stripObject only keeps the metadata of the cached objects used for enrichment
+func stripObject(obj any) (any, error) {
+	var meta *metav1.ObjectMeta
+	switch o := obj.(type) {
+	case *corev1.Pod:
+		o.Spec = corev1.PodSpec{}
+		o.Status = corev1.PodStatus{}
+		meta = &o.ObjectMeta
+	case *corev1.Node:
+		o.Spec = corev1.NodeSpec{}
+		o.Status = corev1.NodeStatus{}
+		meta = &o.ObjectMeta
+	case *appsv1.ReplicaSet:
+		o.Spec = appsv1.ReplicaSetSpec{}
+		o.Status = appsv1.ReplicaSetStatus{}
+		meta = &o.ObjectMeta
+	case *batchv1.Job:
+		o.Spec = batchv1.JobSpec{}
+		o.Status = batchv1.JobStatus{}
+		meta = &o.ObjectMeta
+	default:
+		return obj, nil
+	}
+	meta.Annotations = nil
+	meta.ManagedFields = nil
+	return obj, nil
+}
@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func controllerRef(kind, name string) []v1.OwnerReference {
+	controller := true
+	return []v1.OwnerReference{{Kind: kind, Name: name, UID: types.UID(name), Controller: &controller}}
+}
+
+func TestObjectEnricher(t *testing.T) {
+	client := fake.NewClientset(
+		&corev1.Pod{ObjectMeta: v1.ObjectMeta{
+			Name:            "web-7d9f8-abcde",
+			Namespace:       "test",
+			UID:             "pod-uid-1",
+			Labels:          map[string]string{"app": "web"},
+			OwnerReferences: controllerRef("ReplicaSet", "web-7d9f8"),
+		}},
+		&appsv1.ReplicaSet{ObjectMeta: v1.ObjectMeta{
+			Name:            "web-7d9f8",
+			Namespace:       "test",
+			OwnerReferences: controllerRef("Deployment", "web"),
+		}},
+		&corev1.Pod{ObjectMeta: v1.ObjectMeta{
+			Name:            "backup-28001-xyz",
+			Namespace:       "test",
+			UID:             "pod-uid-2",
+			OwnerReferences: controllerRef("Job", "backup-28001"),
+		}},
+		&batchv1.Job{ObjectMeta: v1.ObjectMeta{
+			Name:            "backup-28001",
+			Namespace:       "test",
+			OwnerReferences: controllerRef("CronJob", "backup"),
+		}},
+		&corev1.Pod{ObjectMeta: v1.ObjectMeta{
+			Name:      "web-7d9f8-abcde",
+			Namespace: "other",
+			UID:       "pod-uid-3",
+		}},
+		&corev1.Node{ObjectMeta: v1.ObjectMeta{
+			Name:   "node-1",
+			Labels: map[string]string{"topology.kubernetes.io/zone": "eu-west-1a"},
+		}},
+	)
+
+	e := newObjectEnricher(client, EnrichmentConfig{Pods: true, Nodes: true}, []string{"test"})
+	stopCh := make(chan struct{})
+	e.start(stopCh)
+	defer func() {
+		close(stopCh)
+		e.shutdown()
+	}()
+	for _, factory := range e.factories {
+		for typ, synced := range factory.WaitForCacheSync(stopCh) {
+			assert.True(t, synced, "cache of %v not synced", typ)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		object   corev1.ObjectReference
+		expected map[string]any
+	}{
+		{
+			name:   "deployment_pod",
+			object: corev1.ObjectReference{Kind: "Pod", Namespace: "test", Name: "web-7d9f8-abcde"},
+			expected: map[string]any{
+				"k8s.pod.name":        "web-7d9f8-abcde",
+				"k8s.pod.uid":         "pod-uid-1",
+				"k8s.pod.label.app":   "web",
+				"k8s.replicaset.name": "web-7d9f8",
+				"k8s.deployment.name": "web",
+			},
+		},
+		{
+			name:   "cronjob_pod",
+			object: corev1.ObjectReference{Kind: "Pod", Namespace: "test", Name: "backup-28001-xyz"},
+			expected: map[string]any{
+				"k8s.pod.name":     "backup-28001-xyz",
+				"k8s.pod.uid":      "pod-uid-2",
+				"k8s.job.name":     "backup-28001",
+				"k8s.cronjob.name": "backup",
+			},
+		},
+		{
+			name:     "unknown_pod",
+			object:   corev1.ObjectReference{Kind: "Pod", Namespace: "test", Name: "deleted"},
+			expected: map[string]any{},
+		},
+		{
+			name:     "pod_of_unwatched_namespace",
+			object:   corev1.ObjectReference{Kind: "Pod", Namespace: "other", Name: "web-7d9f8-abcde"},
+			expected: map[string]any{},
+		},
+		{
+			name:   "node",
+			object: corev1.ObjectReference{Kind: "Node", Name: "node-1"},
+			expected: map[string]any{
+				"k8s.node.label.topology.kubernetes.io/zone": "eu-west-1a",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attrs := pcommon.NewMap()
+			e.enrich(&corev1.Event{InvolvedObject: tt.object}, attrs)
+			assert.Equal(t, tt.expected, attrs.AsRaw())
+		})
+	}
+}
+
+func TestStripObject(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{
+			Name:        "pod",
+			Labels:      map[string]string{"app": "web"},
+			Annotations: map[string]string{"note": "value"},
+		},
+		Spec: corev1.PodSpec{NodeName: "node-1"},
+	}
+	obj, err := stripObject(pod)
+	assert.NoError(t, err)
+	assert.Equal(t, &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{
+			Name:   "pod",
+			Labels: map[string]string{"app": "web"},
+		},
+	}, obj)
+}
@@ -14,6 +14,8 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver/internal/metadata"
 )
 
+const defaultAggregationMaxEntries = 1000
+
 // NewFactory creates a factory for k8s_cluster receiver.
 func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
@@ -27,6 +29,9 @@ func createDefaultConfig() component.Config {
 		APIConfig: k8sconfig.APIConfig{
 			AuthType: k8sconfig.AuthTypeServiceAccount,
 		},
+		Aggregation: AggregationConfig{
+			MaxEntries: defaultAggregationMaxEntries,
+		},
 	}
 }
 
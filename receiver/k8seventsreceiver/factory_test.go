@@ -27,6 +27,9 @@ func TestDefaultConfig(t *testing.T) {
 		APIConfig: k8sconfig.APIConfig{
 			AuthType: k8sconfig.AuthTypeServiceAccount,
 		},
+		Aggregation: AggregationConfig{
+			MaxEntries: defaultAggregationMaxEntries,
+		},
 	}, rCfg)
 }
 
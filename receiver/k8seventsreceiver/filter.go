@@ -0,0 +1,37 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// matchEvent returns whether an event matches the rule, i.e. all of its non-empty lists
+func matchEvent(rule EventMatchConfig, ev *corev1.Event) bool {
+	if len(rule.Types) > 0 && !slices.ContainsFunc(rule.Types, func(t string) bool { return strings.EqualFold(t, ev.Type) }) {
+		return false
+	}
+	if len(rule.Reasons) > 0 && !slices.Contains(rule.Reasons, ev.Reason) {
+		return false
+	}
+	if len(rule.Namespaces) > 0 && !slices.Contains(rule.Namespaces, ev.InvolvedObject.Namespace) {
+		return false
+	}
+	return true
+}
+
+func isEmptyRule(rule EventMatchConfig) bool {
+	return len(rule.Types) == 0 && len(rule.Reasons) == 0 && len(rule.Namespaces) == 0
+}
+
+// filterEvent returns whether an event is collected
+func filterEvent(cfg FilterConfig, ev *corev1.Event) bool {
+	if !matchEvent(cfg.Include, ev) {
+		return false
+	}
+	return isEmptyRule(cfg.Exclude) || !matchEvent(cfg.Exclude, ev)
+}
@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterEvent(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      FilterConfig
+		expected bool
+	}{
+		{
+			name:     "no_filter",
+			expected: true,
+		},
+		{
+			name: "include_type",
+			cfg: FilterConfig{
+				Include: EventMatchConfig{Types: []string{"warning"}},
+			},
+			expected: true,
+		},
+		{
+			name: "include_other_type",
+			cfg: FilterConfig{
+				Include: EventMatchConfig{Types: []string{"Normal"}},
+			},
+			expected: false,
+		},
+		{
+			name: "include_all_lists",
+			cfg: FilterConfig{
+				Include: EventMatchConfig{
+					Types:      []string{"Warning"},
+					Reasons:    []string{"BackOff", "FailedScheduling"},
+					Namespaces: []string{"test"},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "include_other_namespace",
+			cfg: FilterConfig{
+				Include: EventMatchConfig{
+					Reasons:    []string{"BackOff"},
+					Namespaces: []string{"kube-system"},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "exclude_reason",
+			cfg: FilterConfig{
+				Exclude: EventMatchConfig{Reasons: []string{"BackOff"}},
+			},
+			expected: false,
+		},
+		{
+			name: "exclude_other_namespace",
+			cfg: FilterConfig{
+				Exclude: EventMatchConfig{
+					Reasons:    []string{"BackOff"},
+					Namespaces: []string{"kube-system"},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "include_and_exclude",
+			cfg: FilterConfig{
+				Include: EventMatchConfig{Types: []string{"Warning"}},
+				Exclude: EventMatchConfig{Namespaces: []string{"test"}},
+			},
+			expected: false,
+		},
+	}
+
+	ev := getEvent("Warning")
+	ev.Reason = "BackOff"
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, filterEvent(tt.cfg, ev))
+		})
+	}
+}
@@ -7,10 +7,12 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/receiverhelper"
 	corev1 "k8s.io/api/core/v1"
@@ -32,6 +34,9 @@ type k8seventsReceiver struct {
 	cancel          context.CancelFunc
 	obsrecv         *receiverhelper.ObsReport
 	wg              sync.WaitGroup
+	aggregator      *eventAggregator
+	enricher        atomic.Pointer[objectEnricher]
+	flushWG         sync.WaitGroup
 }
 
 // newReceiver creates the Kubernetes events receiver with the given configuration.
@@ -51,13 +56,17 @@ func newReceiver(
 		return nil, err
 	}
 
-	return &k8seventsReceiver{
+	kr := &k8seventsReceiver{
 		settings:     set,
 		config:       config,
 		logsConsumer: consumer,
 		startTime:    time.Now(),
 		obsrecv:      obsrecv,
-	}, nil
+	}
+	if config.Aggregation.Window > 0 {
+		kr.aggregator = newEventAggregator(config.Aggregation)
+	}
+	return kr, nil
 }
 
 func (kr *k8seventsReceiver) Start(ctx context.Context, host component.Host) error {
@@ -68,6 +77,11 @@ func (kr *k8seventsReceiver) Start(ctx context.Context, host component.Host) err
 		return err
 	}
 
+	if kr.aggregator != nil {
+		kr.flushWG.Add(1)
+		go kr.flushPeriodically()
+	}
+
 	if kr.config.K8sLeaderElector != nil {
 		k8sLeaderElector := host.GetExtensions()[*kr.config.K8sLeaderElector]
 		if k8sLeaderElector == nil {
@@ -84,18 +98,13 @@ func (kr *k8seventsReceiver) Start(ctx context.Context, host component.Host) err
 		elector.SetCallBackFuncs(
 			func(_ context.Context) {
 				kr.settings.Logger.Info("Events Receiver started as leader")
-				if len(kr.config.Namespaces) == 0 {
-					kr.startWatch(corev1.NamespaceAll, k8sInterface)
-				} else {
-					for _, ns := range kr.config.Namespaces {
-						kr.startWatch(ns, k8sInterface)
-					}
-				}
+				kr.startWatches(k8sInterface)
 			},
 			// onStoppedLeading: stop watches, but DO NOT shut the whole receiver down
 			func() {
 				kr.settings.Logger.Info("no longer leader, stopping watches")
 				kr.stopWatches()
+				kr.stopEnricher()
 			},
 		)
 		return nil
@@ -103,17 +112,11 @@ func (kr *k8seventsReceiver) Start(ctx context.Context, host component.Host) err
 
 	// No leader election: start immediately.
 	kr.settings.Logger.Info("starting to watch namespaces for the events.")
-	if len(kr.config.Namespaces) == 0 {
-		kr.startWatch(corev1.NamespaceAll, k8sInterface)
-	} else {
-		for _, ns := range kr.config.Namespaces {
-			kr.startWatch(ns, k8sInterface)
-		}
-	}
+	kr.startWatches(k8sInterface)
 	return nil
 }
 
-func (kr *k8seventsReceiver) Shutdown(context.Context) error {
+func (kr *k8seventsReceiver) Shutdown(ctx context.Context) error {
 	// Stop informers and wait for them to exit.
 	kr.stopWatches()
 
@@ -121,9 +124,36 @@ func (kr *k8seventsReceiver) Shutdown(context.Context) error {
 		kr.cancel()
 		kr.cancel = nil
 	}
+
+	// Emit the events aggregated since the last flush, before the enricher is stopped.
+	kr.flushWG.Wait()
+	if kr.aggregator != nil {
+		kr.flush(ctx)
+	}
+	kr.stopEnricher()
 	return nil
 }
 
+// startWatches starts the informers of the involved objects, if enrichment is enabled,
+// and watches the configured namespaces for the events.
+func (kr *k8seventsReceiver) startWatches(client k8s.Interface) {
+	if kr.config.Enrichment.Pods || kr.config.Enrichment.Nodes {
+		stopperChan := make(chan struct{})
+		kr.stopperChanList = append(kr.stopperChanList, stopperChan)
+		enricher := newObjectEnricher(client, kr.config.Enrichment, kr.config.Namespaces)
+		enricher.start(stopperChan)
+		kr.enricher.Store(enricher)
+	}
+
+	if len(kr.config.Namespaces) == 0 {
+		kr.startWatch(corev1.NamespaceAll, client)
+	} else {
+		for _, ns := range kr.config.Namespaces {
+			kr.startWatch(ns, client)
+		}
+	}
+}
+
 // stopWatches closes all informer stop channels (idempotently) and waits for their goroutines to exit.
 func (kr *k8seventsReceiver) stopWatches() {
 	if len(kr.stopperChanList) == 0 {
@@ -142,6 +172,14 @@ func (kr *k8seventsReceiver) stopWatches() {
 	kr.stopperChanList = nil
 }
 
+// stopEnricher waits for the informers of the involved objects to exit, once stopWatches closed their
+// stop channel. Their caches are still used to enrich the events until then.
+func (kr *k8seventsReceiver) stopEnricher() {
+	if enricher := kr.enricher.Swap(nil); enricher != nil {
+		enricher.shutdown()
+	}
+}
+
 // Add the 'Event' handler and trigger the watch for a specific namespace.
 // For new and updated events, the code is relying on the following k8s code implementation:
 // https://github.com/kubernetes/kubernetes/blob/master/staging/src/k8s.io/client-go/tools/record/events_cache.go#L327
@@ -163,12 +201,50 @@ func (kr *k8seventsReceiver) startWatch(ns string, client k8s.Interface) {
 }
 
 func (kr *k8seventsReceiver) handleEvent(ev *corev1.Event) {
-	if kr.allowEvent(ev) {
-		ld := k8sEventToLogData(kr.settings.Logger, ev, kr.settings.BuildInfo.Version)
+	if !kr.allowEvent(ev) || !filterEvent(kr.config.Filter, ev) {
+		return
+	}
+	if kr.aggregator != nil && kr.aggregator.add(ev) {
+		return
+	}
+	kr.emit(kr.ctx, []*corev1.Event{ev})
+}
+
+// emit converts the events to logs, enriched with the metadata of the involved objects, and consumes them.
+func (kr *k8seventsReceiver) emit(ctx context.Context, events []*corev1.Event) {
+	ld := plog.NewLogs()
+	enricher := kr.enricher.Load()
+	for _, ev := range events {
+		eventLogs := k8sEventToLogData(kr.settings.Logger, ev, kr.settings.BuildInfo.Version)
+		if enricher != nil {
+			enricher.enrich(ev, eventLogs.ResourceLogs().At(0).Resource().Attributes())
+		}
+		eventLogs.ResourceLogs().MoveAndAppendTo(ld.ResourceLogs())
+	}
+
+	ctx = kr.obsrecv.StartLogsOp(ctx)
+	consumerErr := kr.logsConsumer.ConsumeLogs(ctx, ld)
+	kr.obsrecv.EndLogsOp(ctx, metadata.Type.String(), len(events), consumerErr)
+}
+
+// flushPeriodically emits the aggregated events at the end of every aggregation window.
+func (kr *k8seventsReceiver) flushPeriodically() {
+	defer kr.flushWG.Done()
+	ticker := time.NewTicker(kr.config.Aggregation.Window)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			kr.flush(kr.ctx)
+		case <-kr.ctx.Done():
+			return
+		}
+	}
+}
 
-		ctx := kr.obsrecv.StartLogsOp(kr.ctx)
-		consumerErr := kr.logsConsumer.ConsumeLogs(ctx, ld)
-		kr.obsrecv.EndLogsOp(ctx, metadata.Type.String(), 1, consumerErr)
+func (kr *k8seventsReceiver) flush(ctx context.Context) {
+	if events := kr.aggregator.flush(); len(events) > 0 {
+		kr.emit(ctx, events)
 	}
 }
 
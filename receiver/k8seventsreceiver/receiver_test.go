@@ -71,6 +71,96 @@ func TestHandleEvent(t *testing.T) {
 	assert.Equal(t, 1, sink.LogRecordCount())
 }
 
+func TestHandleEventFiltered(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Filter.Exclude.Types = []string{"Normal"}
+	sink := new(consumertest.LogsSink)
+	r, err := newReceiver(
+		receivertest.NewNopSettings(metadata.Type),
+		rCfg,
+		sink,
+	)
+	require.NoError(t, err)
+	recv := r.(*k8seventsReceiver)
+	recv.ctx = t.Context()
+	recv.handleEvent(getEvent("Normal"))
+	recv.handleEvent(getEvent("Warning"))
+
+	assert.Equal(t, 1, sink.LogRecordCount())
+}
+
+func TestAggregateEvents(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Aggregation.Window = time.Hour
+	rCfg.makeClient = func(k8sconfig.APIConfig) (k8s.Interface, error) {
+		return fake.NewClientset(), nil
+	}
+	sink := new(consumertest.LogsSink)
+	r, err := newReceiver(
+		receivertest.NewNopSettings(metadata.Type),
+		rCfg,
+		sink,
+	)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+	recv := r.(*k8seventsReceiver)
+
+	first := getEvent("Warning")
+	repeated := getEvent("Warning")
+	repeated.UID = types.UID("7c1d2e3f-0a1b")
+	repeated.Count = 1
+	recv.handleEvent(first)
+	recv.handleEvent(repeated)
+	assert.Equal(t, 0, sink.LogRecordCount())
+
+	// the aggregated events are emitted on shutdown
+	require.NoError(t, r.Shutdown(t.Context()))
+	require.Equal(t, 1, sink.LogRecordCount())
+	lr := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	count, ok := lr.Attributes().Get("k8s.event.count")
+	require.True(t, ok)
+	assert.Equal(t, int64(3), count.Int())
+}
+
+func TestAggregateEventsEnrichedOnShutdown(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Aggregation.Window = time.Hour
+	rCfg.Enrichment.Pods = true
+	rCfg.makeClient = func(k8sconfig.APIConfig) (k8s.Interface, error) {
+		return fake.NewClientset(&corev1.Pod{ObjectMeta: v1.ObjectMeta{
+			Name:      "test-34bcd-rn54",
+			Namespace: "test",
+			UID:       "059f3edc-b5a9",
+			Labels:    map[string]string{"app": "web"},
+		}}), nil
+	}
+	sink := new(consumertest.LogsSink)
+	r, err := newReceiver(
+		receivertest.NewNopSettings(metadata.Type),
+		rCfg,
+		sink,
+	)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+	recv := r.(*k8seventsReceiver)
+	require.Eventually(t, func() bool {
+		_, err := recv.enricher.Load().namespaces[v1.NamespaceAll].pods.Pods("test").Get("test-34bcd-rn54")
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	recv.handleEvent(getEvent("Warning"))
+	assert.Equal(t, 0, sink.LogRecordCount())
+
+	// the events of the last window are enriched before the enricher is stopped
+	require.NoError(t, r.Shutdown(t.Context()))
+	require.Equal(t, 1, sink.LogRecordCount())
+	attrs := sink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes()
+	label, ok := attrs.Get("k8s.pod.label.app")
+	require.True(t, ok)
+	assert.Equal(t, "web", label.Str())
+	assert.Nil(t, recv.enricher.Load())
+}
+
 func TestDropEventsOlderThanStartupTime(t *testing.T) {
 	rCfg := createDefaultConfig().(*Config)
 	sink := new(consumertest.LogsSink)
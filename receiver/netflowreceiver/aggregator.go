@@ -0,0 +1,164 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package netflowreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netflowreceiver"
+
+import (
+	"net/netip"
+	"strconv"
+	"sync"
+	"time"
+
+	protoproducer "github.com/netsampler/goflow2/v2/producer/proto"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netflowreceiver/internal/metadata"
+)
+
+// flowKey identifies the series a flow is rolled up to
+type flowKey struct {
+	samplerAddress    netip.Addr
+	sourcePrefix      netip.Prefix
+	destinationPrefix netip.Prefix
+	destinationPort   uint32
+	proto             uint32
+	inInterface       uint32
+	outInterface      uint32
+	overflow          bool
+}
+
+type flowCounters struct {
+	flows   int64
+	bytes   int64
+	packets int64
+}
+
+// flowAggregator rolls flows up to counters per source and destination prefix,
+// destination port and protocol, which are emitted as metrics on every flush
+type flowAggregator struct {
+	config         AggregationConfig
+	interfaceNames map[uint32]string
+
+	mu     sync.Mutex
+	series map[flowKey]*flowCounters
+	start  pcommon.Timestamp
+	mb     *metadata.MetricsBuilder
+}
+
+func newFlowAggregator(cfg Config, interfaceNames map[uint32]string, settings receiver.Settings) *flowAggregator {
+	return &flowAggregator{
+		config:         cfg.Aggregation,
+		interfaceNames: interfaceNames,
+		series:         map[flowKey]*flowCounters{},
+		start:          pcommon.NewTimestampFromTime(time.Now()),
+		mb:             metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
+	}
+}
+
+// add rolls a flow up to its series
+func (a *flowAggregator) add(pm *protoproducer.ProtoProducerMessage) {
+	srcAddr, _ := netip.AddrFromSlice(pm.SrcAddr)
+	dstAddr, _ := netip.AddrFromSlice(pm.DstAddr)
+	samplerAddr, _ := netip.AddrFromSlice(pm.SamplerAddress)
+
+	key := flowKey{
+		samplerAddress:    samplerAddr.Unmap(),
+		sourcePrefix:      a.prefix(srcAddr),
+		destinationPrefix: a.prefix(dstAddr),
+		destinationPort:   pm.DstPort,
+		proto:             pm.Proto,
+		inInterface:       pm.InIf,
+		outInterface:      pm.OutIf,
+	}
+
+	// Flows are sampled, the counters are scaled to estimate the actual traffic
+	scale := int64(1)
+	if pm.SamplingRate > 1 {
+		scale = int64(pm.SamplingRate)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	counters, ok := a.series[key]
+	if !ok {
+		if len(a.series) >= a.config.MaxSeries {
+			key = flowKey{samplerAddress: key.samplerAddress, overflow: true}
+			counters, ok = a.series[key]
+		}
+		if !ok {
+			counters = &flowCounters{}
+			a.series[key] = counters
+		}
+	}
+	counters.flows++
+	counters.bytes += int64(pm.Bytes) * scale
+	counters.packets += int64(pm.Packets) * scale
+}
+
+// prefix returns the prefix an address is rolled up to
+func (a *flowAggregator) prefix(addr netip.Addr) netip.Prefix {
+	if !addr.IsValid() {
+		return netip.Prefix{}
+	}
+	addr = addr.Unmap()
+	bits := a.config.IPv6PrefixLength
+	if addr.Is4() {
+		bits = a.config.IPv4PrefixLength
+	}
+	prefix, err := addr.Prefix(bits)
+	if err != nil {
+		return netip.Prefix{}
+	}
+	return prefix
+}
+
+// flush returns the metrics of the flows aggregated since the previous flush and resets the counters
+func (a *flowAggregator) flush() pmetric.Metrics {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+	for key, counters := range a.series {
+		var samplerAddress, sourcePrefix, destinationPrefix, transport, inInterface, outInterface string
+		if key.samplerAddress.IsValid() {
+			samplerAddress = key.samplerAddress.String()
+		}
+		if !key.overflow {
+			sourcePrefix = prefixString(key.sourcePrefix)
+			destinationPrefix = prefixString(key.destinationPrefix)
+			transport = getTransportName(key.proto)
+			inInterface = a.interfaceName(key.inInterface)
+			outInterface = a.interfaceName(key.outInterface)
+		}
+		port := int64(key.destinationPort)
+
+		a.mb.RecordFlowCountDataPoint(now, counters.flows, samplerAddress, sourcePrefix, destinationPrefix, port, transport, inInterface, outInterface)
+		a.mb.RecordFlowIoBytesDataPoint(now, counters.bytes, samplerAddress, sourcePrefix, destinationPrefix, port, transport, inInterface, outInterface)
+		a.mb.RecordFlowIoPacketsDataPoint(now, counters.packets, samplerAddress, sourcePrefix, destinationPrefix, port, transport, inInterface, outInterface)
+	}
+
+	metrics := a.mb.Emit(metadata.WithStartTimeOverride(a.start))
+	a.series = map[flowKey]*flowCounters{}
+	a.start = now
+	return metrics
+}
+
+// interfaceName returns the configured name of an interface, or its index
+func (a *flowAggregator) interfaceName(index uint32) string {
+	if index == 0 {
+		return ""
+	}
+	if name, ok := a.interfaceNames[index]; ok {
+		return name
+	}
+	return strconv.FormatUint(uint64(index), 10)
+}
+
+func prefixString(prefix netip.Prefix) string {
+	if !prefix.IsValid() {
+		return ""
+	}
+	return prefix.String()
+}
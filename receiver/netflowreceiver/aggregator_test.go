@@ -0,0 +1,153 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package netflowreceiver
+
+import (
+	"net/netip"
+	"testing"
+
+	protoproducer "github.com/netsampler/goflow2/v2/producer/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netflowreceiver/internal/metadata"
+)
+
+func newTestFlow(src, dst string, dstPort uint32, bytes, packets uint64) *protoproducer.ProtoProducerMessage {
+	pm := &protoproducer.ProtoProducerMessage{}
+	pm.SrcAddr = netip.MustParseAddr(src).AsSlice()
+	pm.DstAddr = netip.MustParseAddr(dst).AsSlice()
+	pm.SamplerAddress = netip.MustParseAddr("172.16.0.1").AsSlice()
+	pm.DstPort = dstPort
+	pm.Proto = 6
+	pm.InIf = 1
+	pm.OutIf = 2
+	pm.Bytes = bytes
+	pm.Packets = packets
+	return pm
+}
+
+type flowDataPoint struct {
+	metric     string
+	value      int64
+	attributes map[string]any
+}
+
+func flowDataPoints(metrics pmetric.Metrics) []flowDataPoint {
+	var dps []flowDataPoint
+	for _, rm := range metrics.ResourceMetrics().All() {
+		for _, sm := range rm.ScopeMetrics().All() {
+			for _, m := range sm.Metrics().All() {
+				for _, dp := range m.Sum().DataPoints().All() {
+					dps = append(dps, flowDataPoint{metric: m.Name(), value: dp.IntValue(), attributes: dp.Attributes().AsRaw()})
+				}
+			}
+		}
+	}
+	return dps
+}
+
+func TestFlowAggregator(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	aggregator := newFlowAggregator(*cfg, map[uint32]string{1: "wan"}, receivertest.NewNopSettings(metadata.Type))
+
+	aggregator.add(newTestFlow("10.0.0.1", "192.168.1.10", 443, 1000, 10))
+	aggregator.add(newTestFlow("10.0.0.2", "192.168.1.20", 443, 500, 5))
+	sampled := newTestFlow("2001:db8::1", "2001:db8:1::1", 53, 100, 1)
+	sampled.Proto = 17
+	sampled.SamplingRate = 10
+	aggregator.add(sampled)
+
+	metrics := aggregator.flush()
+	tcp := map[string]any{
+		"flow.sampler_address":    "172.16.0.1",
+		"flow.source.prefix":      "10.0.0.0/24",
+		"flow.destination.prefix": "192.168.1.0/24",
+		"destination.port":        int64(443),
+		"network.transport":       "tcp",
+		"flow.in_interface.name":  "wan",
+		"flow.out_interface.name": "2",
+	}
+	udp := map[string]any{
+		"flow.sampler_address":    "172.16.0.1",
+		"flow.source.prefix":      "2001:db8::/64",
+		"flow.destination.prefix": "2001:db8:1::/64",
+		"destination.port":        int64(53),
+		"network.transport":       "udp",
+		"flow.in_interface.name":  "wan",
+		"flow.out_interface.name": "2",
+	}
+	assert.ElementsMatch(t, []flowDataPoint{
+		{metric: "flow.count", value: 2, attributes: tcp},
+		{metric: "flow.io.bytes", value: 1500, attributes: tcp},
+		{metric: "flow.io.packets", value: 15, attributes: tcp},
+		{metric: "flow.count", value: 1, attributes: udp},
+		{metric: "flow.io.bytes", value: 1000, attributes: udp},
+		{metric: "flow.io.packets", value: 10, attributes: udp},
+	}, flowDataPoints(metrics))
+
+	dp := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0)
+	assert.Less(t, dp.StartTimestamp(), dp.Timestamp())
+	assert.Equal(t, pmetric.AggregationTemporalityDelta, metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().AggregationTemporality())
+
+	// The counters are reset on every flush
+	assert.Equal(t, 0, aggregator.flush().DataPointCount())
+}
+
+func TestFlowAggregatorMaxSeries(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Aggregation.MaxSeries = 1
+	aggregator := newFlowAggregator(*cfg, nil, receivertest.NewNopSettings(metadata.Type))
+
+	aggregator.add(newTestFlow("10.0.0.1", "192.168.1.10", 443, 1000, 10))
+	aggregator.add(newTestFlow("10.0.1.1", "192.168.1.10", 443, 200, 2))
+	aggregator.add(newTestFlow("10.0.2.1", "192.168.1.10", 80, 300, 3))
+
+	var overflow []flowDataPoint
+	for _, dp := range flowDataPoints(aggregator.flush()) {
+		if dp.attributes["flow.source.prefix"] == "" {
+			overflow = append(overflow, dp)
+		}
+	}
+	overflowAttributes := map[string]any{
+		"flow.sampler_address":    "172.16.0.1",
+		"flow.source.prefix":      "",
+		"flow.destination.prefix": "",
+		"destination.port":        int64(0),
+		"network.transport":       "",
+		"flow.in_interface.name":  "",
+		"flow.out_interface.name": "",
+	}
+	require.ElementsMatch(t, []flowDataPoint{
+		{metric: "flow.count", value: 2, attributes: overflowAttributes},
+		{metric: "flow.io.bytes", value: 500, attributes: overflowAttributes},
+		{metric: "flow.io.packets", value: 5, attributes: overflowAttributes},
+	}, overflow)
+}
+
+func TestExporterTemplates(t *testing.T) {
+	templates := newExporterTemplates(10)
+
+	first := templates.get("192.0.2.1:50000")
+	assert.Same(t, first, templates.get("192.0.2.1:50001"))
+	assert.Same(t, first, templates.get("[::ffff:192.0.2.1]:50002"))
+	assert.NotSame(t, first, templates.get("192.0.2.2:50000"))
+}
+
+func TestExporterTemplatesEviction(t *testing.T) {
+	templates := newExporterTemplates(2)
+
+	first := templates.get("192.0.2.1:50000")
+	second := templates.get("192.0.2.2:50000")
+	// Seeing the first exporter again makes the second one the least recently seen
+	assert.Same(t, first, templates.get("192.0.2.1:50000"))
+
+	templates.get("192.0.2.3:50000")
+	assert.Equal(t, 2, templates.lru.Len())
+	assert.Len(t, templates.templates, 2)
+	assert.Same(t, first, templates.get("192.0.2.1:50000"))
+	assert.NotSame(t, second, templates.get("192.0.2.2:50000"))
+}
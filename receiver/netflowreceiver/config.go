@@ -5,12 +5,17 @@ package netflowreceiver // import "github.com/open-telemetry/opentelemetry-colle
 
 import (
 	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netflowreceiver/internal/metadata"
 )
 
 // Config represents the receiver config settings within the collector's config.yaml
 type Config struct {
 	// The scheme defines the type of flow data that the listener will receive
-	// The scheme must be one of sflow, netflow, or flow
+	// The scheme must be one of sflow, netflow, or ipfix
 	Scheme string `mapstructure:"scheme"`
 
 	// The hostname or IP address that the listener will bind to
@@ -33,11 +38,39 @@ type Config struct {
 
 	// SendRaw determines whether to send raw flow messages instead of parsing them
 	SendRaw bool `mapstructure:"send_raw"`
+
+	// InterfaceNames maps interface indexes to names, e.g. "3": "ge-0/0/3"
+	InterfaceNames map[string]string `mapstructure:"interface_names"`
+
+	// MaxTemplateExporters is the maximum number of exporters whose Netflow v9 and IPFIX
+	// templates are cached. The templates of the least recently seen exporter are evicted first.
+	MaxTemplateExporters int `mapstructure:"max_template_exporters"`
+
+	// Aggregation configures how flows are rolled up into metrics
+	Aggregation AggregationConfig `mapstructure:"aggregation"`
+
+	metadata.MetricsBuilderConfig `mapstructure:",squash"`
+}
+
+// AggregationConfig configures the aggregation of flows into metrics
+type AggregationConfig struct {
+	// Interval at which the aggregated flows are emitted as metrics
+	Interval time.Duration `mapstructure:"interval"`
+
+	// The length of the prefixes that IPv4 source and destination addresses are rolled up to
+	IPv4PrefixLength int `mapstructure:"ipv4_prefix_length"`
+
+	// The length of the prefixes that IPv6 source and destination addresses are rolled up to
+	IPv6PrefixLength int `mapstructure:"ipv6_prefix_length"`
+
+	// The maximum number of series per interval, flows of additional series are
+	// counted in a series per sampler with no other attributes
+	MaxSeries int `mapstructure:"max_series"`
 }
 
 // Validate checks if the receiver configuration is valid
 func (cfg *Config) Validate() error {
-	validSchemes := [2]string{"sflow", "netflow"}
+	validSchemes := [3]string{"sflow", "netflow", "ipfix"}
 
 	validScheme := false
 	for _, scheme := range validSchemes {
@@ -47,7 +80,7 @@ func (cfg *Config) Validate() error {
 		}
 	}
 	if !validScheme {
-		return errors.New("scheme must be netflow or sflow")
+		return errors.New("scheme must be netflow, ipfix or sflow")
 	}
 
 	if cfg.Sockets <= 0 {
@@ -66,5 +99,42 @@ func (cfg *Config) Validate() error {
 		return errors.New("port must be greater than 0")
 	}
 
+	if cfg.MaxTemplateExporters <= 0 {
+		return errors.New("max_template_exporters must be greater than 0")
+	}
+
+	if _, err := parseInterfaceNames(cfg.InterfaceNames); err != nil {
+		return err
+	}
+
+	if cfg.Aggregation.Interval <= 0 {
+		return errors.New("aggregation interval must be greater than 0")
+	}
+
+	if cfg.Aggregation.IPv4PrefixLength < 0 || cfg.Aggregation.IPv4PrefixLength > 32 {
+		return errors.New("aggregation ipv4_prefix_length must be between 0 and 32")
+	}
+
+	if cfg.Aggregation.IPv6PrefixLength < 0 || cfg.Aggregation.IPv6PrefixLength > 128 {
+		return errors.New("aggregation ipv6_prefix_length must be between 0 and 128")
+	}
+
+	if cfg.Aggregation.MaxSeries <= 0 {
+		return errors.New("aggregation max_series must be greater than 0")
+	}
+
 	return nil
 }
+
+// parseInterfaceNames parses the keys of the interface names as interface indexes
+func parseInterfaceNames(names map[string]string) (map[uint32]string, error) {
+	parsed := make(map[uint32]string, len(names))
+	for key, name := range names {
+		index, err := strconv.ParseUint(key, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("interface_names key %q is not an interface index", key)
+		}
+		parsed[uint32(index)] = name
+	}
+	return parsed, nil
+}
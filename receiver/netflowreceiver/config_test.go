@@ -6,6 +6,7 @@ package netflowreceiver
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -33,42 +34,96 @@ func TestLoadConfig(t *testing.T) {
 		{
 			id: component.NewIDWithName(metadata.Type, "one_listener"),
 			expected: &Config{
-				Scheme:    "netflow",
-				Port:      2055,
-				Sockets:   1,
-				Workers:   1,
-				QueueSize: 1000,
+				Scheme:               "netflow",
+				Port:                 2055,
+				Sockets:              1,
+				Workers:              1,
+				QueueSize:            1000,
+				MaxTemplateExporters: 1000,
+				Aggregation: AggregationConfig{
+					Interval:         time.Minute,
+					IPv4PrefixLength: 24,
+					IPv6PrefixLength: 64,
+					MaxSeries:        10_000,
+				},
+				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
 			},
 		},
 		{
 			id: component.NewIDWithName(metadata.Type, "zero_queue"),
 			expected: &Config{
-				Scheme:    "netflow",
-				Port:      2055,
-				Sockets:   1,
-				Workers:   1,
-				QueueSize: 1000,
+				Scheme:               "netflow",
+				Port:                 2055,
+				Sockets:              1,
+				Workers:              1,
+				QueueSize:            1000,
+				MaxTemplateExporters: 1000,
+				Aggregation: AggregationConfig{
+					Interval:         time.Minute,
+					IPv4PrefixLength: 24,
+					IPv6PrefixLength: 64,
+					MaxSeries:        10_000,
+				},
+				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
 			},
 		},
 		{
 			id: component.NewIDWithName(metadata.Type, "sflow"),
 			expected: &Config{
-				Scheme:    "sflow",
-				Port:      6343,
-				Sockets:   1,
-				Workers:   1,
-				QueueSize: 1000,
+				Scheme:               "sflow",
+				Port:                 6343,
+				Sockets:              1,
+				Workers:              1,
+				QueueSize:            1000,
+				MaxTemplateExporters: 1000,
+				Aggregation: AggregationConfig{
+					Interval:         time.Minute,
+					IPv4PrefixLength: 24,
+					IPv6PrefixLength: 64,
+					MaxSeries:        10_000,
+				},
+				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
 			},
 		},
 		{
 			id: component.NewIDWithName(metadata.Type, "raw_logs"),
 			expected: &Config{
-				Scheme:    "netflow",
-				Port:      2055,
-				Sockets:   1,
-				Workers:   1,
-				QueueSize: 1000,
-				SendRaw:   true,
+				Scheme:               "netflow",
+				Port:                 2055,
+				Sockets:              1,
+				Workers:              1,
+				QueueSize:            1000,
+				SendRaw:              true,
+				MaxTemplateExporters: 1000,
+				Aggregation: AggregationConfig{
+					Interval:         time.Minute,
+					IPv4PrefixLength: 24,
+					IPv6PrefixLength: 64,
+					MaxSeries:        10_000,
+				},
+				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "ipfix_aggregation"),
+			expected: &Config{
+				Scheme:               "ipfix",
+				Port:                 4739,
+				Sockets:              1,
+				Workers:              2,
+				QueueSize:            1000,
+				MaxTemplateExporters: 100,
+				InterfaceNames: map[string]string{
+					"1": "ge-0/0/1",
+					"2": "ge-0/0/2",
+				},
+				Aggregation: AggregationConfig{
+					Interval:         30 * time.Second,
+					IPv4PrefixLength: 16,
+					IPv6PrefixLength: 48,
+					MaxSeries:        500,
+				},
+				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
 			},
 		},
 	}
@@ -100,7 +155,23 @@ func TestInvalidConfig(t *testing.T) {
 	}{
 		{
 			id:  component.NewIDWithName(metadata.Type, "invalid_schema"),
-			err: "scheme must be netflow or sflow",
+			err: "scheme must be netflow, ipfix or sflow",
+		},
+		{
+			id:  component.NewIDWithName(metadata.Type, "invalid_interface_names"),
+			err: `interface_names key "eth0" is not an interface index`,
+		},
+		{
+			id:  component.NewIDWithName(metadata.Type, "invalid_prefix_length"),
+			err: "aggregation ipv4_prefix_length must be between 0 and 32",
+		},
+		{
+			id:  component.NewIDWithName(metadata.Type, "zero_template_exporters"),
+			err: "max_template_exporters must be greater than 0",
+		},
+		{
+			id:  component.NewIDWithName(metadata.Type, "zero_interval"),
+			err: "aggregation interval must be greater than 0",
 		},
 		{
 			id:  component.NewIDWithName(metadata.Type, "invalid_port"),
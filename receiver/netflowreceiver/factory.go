@@ -5,11 +5,13 @@ package netflowreceiver // import "github.com/open-telemetry/opentelemetry-colle
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netflowreceiver/internal/metadata"
 )
 
@@ -20,6 +22,13 @@ const (
 	// that for a full queue of 1000 messages, the size in memory will be 9MB.
 	// Source: https://github.com/netsampler/goflow2/blob/v2.2.1/README.md#security-notes-and-assumptions
 	defaultQueueSize = 1_000
+
+	defaultMaxTemplateExporters = 1_000
+
+	defaultAggregationInterval = time.Minute
+	defaultIPv4PrefixLength    = 24
+	defaultIPv6PrefixLength    = 64
+	defaultMaxSeries           = 10_000
 )
 
 // NewFactory creates a factory for netflow receiver.
@@ -27,18 +36,27 @@ func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		metadata.Type,
 		createDefaultConfig,
-		receiver.WithLogs(createLogsReceiver, metadata.LogsStability))
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability),
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability))
 }
 
 // Config defines configuration for netflow receiver.
 // By default we listen for netflow traffic on port 2055
 func createDefaultConfig() component.Config {
 	return &Config{
-		Scheme:    "netflow",
-		Port:      2055,
-		Sockets:   defaultSockets,
-		Workers:   defaultWorkers,
-		QueueSize: defaultQueueSize,
+		Scheme:               "netflow",
+		Port:                 2055,
+		Sockets:              defaultSockets,
+		Workers:              defaultWorkers,
+		QueueSize:            defaultQueueSize,
+		MaxTemplateExporters: defaultMaxTemplateExporters,
+		Aggregation: AggregationConfig{
+			Interval:         defaultAggregationInterval,
+			IPv4PrefixLength: defaultIPv4PrefixLength,
+			IPv6PrefixLength: defaultIPv6PrefixLength,
+			MaxSeries:        defaultMaxSeries,
+		},
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
 	}
 }
 
@@ -46,12 +64,38 @@ func createDefaultConfig() component.Config {
 // We also create the UDP receiver, which is the piece of software that actually listens
 // for incoming netflow traffic on an UDP port.
 func createLogsReceiver(_ context.Context, params receiver.Settings, cfg component.Config, consumer consumer.Logs) (receiver.Logs, error) {
-	conf := *(cfg.(*Config))
+	conf := cfg.(*Config)
+
+	var err error
+	r := receivers.GetOrAdd(conf, func() (rcv component.Component) {
+		rcv, err = newNetflowReceiver(params, *conf)
+		return rcv
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.Unwrap().(*netflowReceiver).logConsumer = consumer
+	return r, nil
+}
 
-	nr, err := newNetflowLogsReceiver(params, conf, consumer)
+// createMetricsReceiver creates a netflow receiver that aggregates the flows into metrics.
+func createMetricsReceiver(_ context.Context, params receiver.Settings, cfg component.Config, consumer consumer.Metrics) (receiver.Metrics, error) {
+	conf := cfg.(*Config)
+
+	var err error
+	r := receivers.GetOrAdd(conf, func() (rcv component.Component) {
+		rcv, err = newNetflowReceiver(params, *conf)
+		return rcv
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return nr, nil
+	r.Unwrap().(*netflowReceiver).metricsConsumer = consumer
+	return r, nil
 }
+
+// receivers share a single UDP listener between the logs and metrics receivers
+// created from the same config, so that the flows are only decoded once.
+var receivers = sharedcomponent.NewSharedComponents()
@@ -0,0 +1,363 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+)
+
+var MetricsInfo = metricsInfo{
+	FlowCount: metricInfo{
+		Name: "flow.count",
+	},
+	FlowIoBytes: metricInfo{
+		Name: "flow.io.bytes",
+	},
+	FlowIoPackets: metricInfo{
+		Name: "flow.io.packets",
+	},
+}
+
+type metricsInfo struct {
+	FlowCount     metricInfo
+	FlowIoBytes   metricInfo
+	FlowIoPackets metricInfo
+}
+
+type metricInfo struct {
+	Name string
+}
+
+type metricFlowCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills flow.count metric with initial data.
+func (m *metricFlowCount) init() {
+	m.data.SetName("flow.count")
+	m.data.SetDescription("Number of flows received during the aggregation interval.")
+	m.data.SetUnit("{flow}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricFlowCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, flowSamplerAddressAttributeValue string, flowSourcePrefixAttributeValue string, flowDestinationPrefixAttributeValue string, destinationPortAttributeValue int64, networkTransportAttributeValue string, flowInInterfaceNameAttributeValue string, flowOutInterfaceNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("flow.sampler_address", flowSamplerAddressAttributeValue)
+	dp.Attributes().PutStr("flow.source.prefix", flowSourcePrefixAttributeValue)
+	dp.Attributes().PutStr("flow.destination.prefix", flowDestinationPrefixAttributeValue)
+	dp.Attributes().PutInt("destination.port", destinationPortAttributeValue)
+	dp.Attributes().PutStr("network.transport", networkTransportAttributeValue)
+	dp.Attributes().PutStr("flow.in_interface.name", flowInInterfaceNameAttributeValue)
+	dp.Attributes().PutStr("flow.out_interface.name", flowOutInterfaceNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricFlowCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricFlowCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricFlowCount(cfg MetricConfig) metricFlowCount {
+	m := metricFlowCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricFlowIoBytes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills flow.io.bytes metric with initial data.
+func (m *metricFlowIoBytes) init() {
+	m.data.SetName("flow.io.bytes")
+	m.data.SetDescription("Bytes transferred by the flows during the aggregation interval, scaled by the sampling rate.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricFlowIoBytes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, flowSamplerAddressAttributeValue string, flowSourcePrefixAttributeValue string, flowDestinationPrefixAttributeValue string, destinationPortAttributeValue int64, networkTransportAttributeValue string, flowInInterfaceNameAttributeValue string, flowOutInterfaceNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("flow.sampler_address", flowSamplerAddressAttributeValue)
+	dp.Attributes().PutStr("flow.source.prefix", flowSourcePrefixAttributeValue)
+	dp.Attributes().PutStr("flow.destination.prefix", flowDestinationPrefixAttributeValue)
+	dp.Attributes().PutInt("destination.port", destinationPortAttributeValue)
+	dp.Attributes().PutStr("network.transport", networkTransportAttributeValue)
+	dp.Attributes().PutStr("flow.in_interface.name", flowInInterfaceNameAttributeValue)
+	dp.Attributes().PutStr("flow.out_interface.name", flowOutInterfaceNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricFlowIoBytes) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricFlowIoBytes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricFlowIoBytes(cfg MetricConfig) metricFlowIoBytes {
+	m := metricFlowIoBytes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricFlowIoPackets struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills flow.io.packets metric with initial data.
+func (m *metricFlowIoPackets) init() {
+	m.data.SetName("flow.io.packets")
+	m.data.SetDescription("Packets transferred by the flows during the aggregation interval, scaled by the sampling rate.")
+	m.data.SetUnit("{packet}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricFlowIoPackets) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, flowSamplerAddressAttributeValue string, flowSourcePrefixAttributeValue string, flowDestinationPrefixAttributeValue string, destinationPortAttributeValue int64, networkTransportAttributeValue string, flowInInterfaceNameAttributeValue string, flowOutInterfaceNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("flow.sampler_address", flowSamplerAddressAttributeValue)
+	dp.Attributes().PutStr("flow.source.prefix", flowSourcePrefixAttributeValue)
+	dp.Attributes().PutStr("flow.destination.prefix", flowDestinationPrefixAttributeValue)
+	dp.Attributes().PutInt("destination.port", destinationPortAttributeValue)
+	dp.Attributes().PutStr("network.transport", networkTransportAttributeValue)
+	dp.Attributes().PutStr("flow.in_interface.name", flowInInterfaceNameAttributeValue)
+	dp.Attributes().PutStr("flow.out_interface.name", flowOutInterfaceNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricFlowIoPackets) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricFlowIoPackets) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricFlowIoPackets(cfg MetricConfig) metricFlowIoPackets {
+	m := metricFlowIoPackets{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+// MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
+// required to produce metric representation defined in metadata and user config.
+type MetricsBuilder struct {
+	config              MetricsBuilderConfig // config of the metrics builder.
+	startTime           pcommon.Timestamp    // start time that will be applied to all recorded data points.
+	metricsCapacity     int                  // maximum observed number of metrics per resource.
+	metricsBuffer       pmetric.Metrics      // accumulates metrics data before emitting.
+	buildInfo           component.BuildInfo  // contains version information.
+	metricFlowCount     metricFlowCount
+	metricFlowIoBytes   metricFlowIoBytes
+	metricFlowIoPackets metricFlowIoPackets
+}
+
+// MetricBuilderOption applies changes to default metrics builder.
+type MetricBuilderOption interface {
+	apply(*MetricsBuilder)
+}
+
+type metricBuilderOptionFunc func(mb *MetricsBuilder)
+
+func (mbof metricBuilderOptionFunc) apply(mb *MetricsBuilder) {
+	mbof(mb)
+}
+
+// WithStartTime sets startTime on the metrics builder.
+func WithStartTime(startTime pcommon.Timestamp) MetricBuilderOption {
+	return metricBuilderOptionFunc(func(mb *MetricsBuilder) {
+		mb.startTime = startTime
+	})
+}
+func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, options ...MetricBuilderOption) *MetricsBuilder {
+	mb := &MetricsBuilder{
+		config:              mbc,
+		startTime:           pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:       pmetric.NewMetrics(),
+		buildInfo:           settings.BuildInfo,
+		metricFlowCount:     newMetricFlowCount(mbc.Metrics.FlowCount),
+		metricFlowIoBytes:   newMetricFlowIoBytes(mbc.Metrics.FlowIoBytes),
+		metricFlowIoPackets: newMetricFlowIoPackets(mbc.Metrics.FlowIoPackets),
+	}
+
+	for _, op := range options {
+		op.apply(mb)
+	}
+	return mb
+}
+
+// updateCapacity updates max length of metrics and resource attributes that will be used for the slice capacity.
+func (mb *MetricsBuilder) updateCapacity(rm pmetric.ResourceMetrics) {
+	if mb.metricsCapacity < rm.ScopeMetrics().At(0).Metrics().Len() {
+		mb.metricsCapacity = rm.ScopeMetrics().At(0).Metrics().Len()
+	}
+}
+
+// ResourceMetricsOption applies changes to provided resource metrics.
+type ResourceMetricsOption interface {
+	apply(pmetric.ResourceMetrics)
+}
+
+type resourceMetricsOptionFunc func(pmetric.ResourceMetrics)
+
+func (rmof resourceMetricsOptionFunc) apply(rm pmetric.ResourceMetrics) {
+	rmof(rm)
+}
+
+// WithResource sets the provided resource on the emitted ResourceMetrics.
+// It's recommended to use ResourceBuilder to create the resource.
+func WithResource(res pcommon.Resource) ResourceMetricsOption {
+	return resourceMetricsOptionFunc(func(rm pmetric.ResourceMetrics) {
+		res.CopyTo(rm.Resource())
+	})
+}
+
+// WithStartTimeOverride overrides start time for all the resource metrics data points.
+// This option should be only used if different start time has to be set on metrics coming from different resources.
+func WithStartTimeOverride(start pcommon.Timestamp) ResourceMetricsOption {
+	return resourceMetricsOptionFunc(func(rm pmetric.ResourceMetrics) {
+		var dps pmetric.NumberDataPointSlice
+		metrics := rm.ScopeMetrics().At(0).Metrics()
+		for i := 0; i < metrics.Len(); i++ {
+			switch metrics.At(i).Type() {
+			case pmetric.MetricTypeGauge:
+				dps = metrics.At(i).Gauge().DataPoints()
+			case pmetric.MetricTypeSum:
+				dps = metrics.At(i).Sum().DataPoints()
+			}
+			for j := 0; j < dps.Len(); j++ {
+				dps.At(j).SetStartTimestamp(start)
+			}
+		}
+	})
+}
+
+// EmitForResource saves all the generated metrics under a new resource and updates the internal state to be ready for
+// recording another set of data points as part of another resource. This function can be helpful when one scraper
+// needs to emit metrics from several resources. Otherwise calling this function is not required,
+// just `Emit` function can be called instead.
+// Resource attributes should be provided as ResourceMetricsOption arguments.
+func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
+	rm := pmetric.NewResourceMetrics()
+	ils := rm.ScopeMetrics().AppendEmpty()
+	ils.Scope().SetName(ScopeName)
+	ils.Scope().SetVersion(mb.buildInfo.Version)
+	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
+	mb.metricFlowCount.emit(ils.Metrics())
+	mb.metricFlowIoBytes.emit(ils.Metrics())
+	mb.metricFlowIoPackets.emit(ils.Metrics())
+
+	for _, op := range options {
+		op.apply(rm)
+	}
+
+	if ils.Metrics().Len() > 0 {
+		mb.updateCapacity(rm)
+		rm.MoveTo(mb.metricsBuffer.ResourceMetrics().AppendEmpty())
+	}
+}
+
+// Emit returns all the metrics accumulated by the metrics builder and updates the internal state to be ready for
+// recording another set of metrics. This function will be responsible for applying all the transformations required to
+// produce metric representation defined in metadata and user config, e.g. delta or cumulative.
+func (mb *MetricsBuilder) Emit(options ...ResourceMetricsOption) pmetric.Metrics {
+	mb.EmitForResource(options...)
+	metrics := mb.metricsBuffer
+	mb.metricsBuffer = pmetric.NewMetrics()
+	return metrics
+}
+
+// RecordFlowCountDataPoint adds a data point to flow.count metric.
+func (mb *MetricsBuilder) RecordFlowCountDataPoint(ts pcommon.Timestamp, val int64, flowSamplerAddressAttributeValue string, flowSourcePrefixAttributeValue string, flowDestinationPrefixAttributeValue string, destinationPortAttributeValue int64, networkTransportAttributeValue string, flowInInterfaceNameAttributeValue string, flowOutInterfaceNameAttributeValue string) {
+	mb.metricFlowCount.recordDataPoint(mb.startTime, ts, val, flowSamplerAddressAttributeValue, flowSourcePrefixAttributeValue, flowDestinationPrefixAttributeValue, destinationPortAttributeValue, networkTransportAttributeValue, flowInInterfaceNameAttributeValue, flowOutInterfaceNameAttributeValue)
+}
+
+// RecordFlowIoBytesDataPoint adds a data point to flow.io.bytes metric.
+func (mb *MetricsBuilder) RecordFlowIoBytesDataPoint(ts pcommon.Timestamp, val int64, flowSamplerAddressAttributeValue string, flowSourcePrefixAttributeValue string, flowDestinationPrefixAttributeValue string, destinationPortAttributeValue int64, networkTransportAttributeValue string, flowInInterfaceNameAttributeValue string, flowOutInterfaceNameAttributeValue string) {
+	mb.metricFlowIoBytes.recordDataPoint(mb.startTime, ts, val, flowSamplerAddressAttributeValue, flowSourcePrefixAttributeValue, flowDestinationPrefixAttributeValue, destinationPortAttributeValue, networkTransportAttributeValue, flowInInterfaceNameAttributeValue, flowOutInterfaceNameAttributeValue)
+}
+
+// RecordFlowIoPacketsDataPoint adds a data point to flow.io.packets metric.
+func (mb *MetricsBuilder) RecordFlowIoPacketsDataPoint(ts pcommon.Timestamp, val int64, flowSamplerAddressAttributeValue string, flowSourcePrefixAttributeValue string, flowDestinationPrefixAttributeValue string, destinationPortAttributeValue int64, networkTransportAttributeValue string, flowInInterfaceNameAttributeValue string, flowOutInterfaceNameAttributeValue string) {
+	mb.metricFlowIoPackets.recordDataPoint(mb.startTime, ts, val, flowSamplerAddressAttributeValue, flowSourcePrefixAttributeValue, flowDestinationPrefixAttributeValue, destinationPortAttributeValue, networkTransportAttributeValue, flowInInterfaceNameAttributeValue, flowOutInterfaceNameAttributeValue)
+}
+
+// Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
+// and metrics builder should update its startTime and reset it's internal state accordingly.
+func (mb *MetricsBuilder) Reset(options ...MetricBuilderOption) {
+	mb.startTime = pcommon.NewTimestampFromTime(time.Now())
+	for _, op := range options {
+		op.apply(mb)
+	}
+}
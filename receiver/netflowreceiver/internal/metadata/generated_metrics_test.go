@@ -0,0 +1,205 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+type testDataSet int
+
+const (
+	testDataSetDefault testDataSet = iota
+	testDataSetAll
+	testDataSetNone
+)
+
+func TestMetricsBuilder(t *testing.T) {
+	tests := []struct {
+		name        string
+		metricsSet  testDataSet
+		resAttrsSet testDataSet
+		expectEmpty bool
+	}{
+		{
+			name: "default",
+		},
+		{
+			name:        "all_set",
+			metricsSet:  testDataSetAll,
+			resAttrsSet: testDataSetAll,
+		},
+		{
+			name:        "none_set",
+			metricsSet:  testDataSetNone,
+			resAttrsSet: testDataSetNone,
+			expectEmpty: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := pcommon.Timestamp(1_000_000_000)
+			ts := pcommon.Timestamp(1_000_001_000)
+			observedZapCore, observedLogs := observer.New(zap.WarnLevel)
+			settings := receivertest.NewNopSettings(receivertest.NopType)
+			settings.Logger = zap.New(observedZapCore)
+			mb := NewMetricsBuilder(loadMetricsBuilderConfig(t, tt.name), settings, WithStartTime(start))
+
+			expectedWarnings := 0
+
+			assert.Equal(t, expectedWarnings, observedLogs.Len())
+
+			defaultMetricsCount := 0
+			allMetricsCount := 0
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordFlowCountDataPoint(ts, 1, "flow.sampler_address-val", "flow.source.prefix-val", "flow.destination.prefix-val", 16, "network.transport-val", "flow.in_interface.name-val", "flow.out_interface.name-val")
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordFlowIoBytesDataPoint(ts, 1, "flow.sampler_address-val", "flow.source.prefix-val", "flow.destination.prefix-val", 16, "network.transport-val", "flow.in_interface.name-val", "flow.out_interface.name-val")
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordFlowIoPacketsDataPoint(ts, 1, "flow.sampler_address-val", "flow.source.prefix-val", "flow.destination.prefix-val", 16, "network.transport-val", "flow.in_interface.name-val", "flow.out_interface.name-val")
+
+			res := pcommon.NewResource()
+			metrics := mb.Emit(WithResource(res))
+
+			if tt.expectEmpty {
+				assert.Equal(t, 0, metrics.ResourceMetrics().Len())
+				return
+			}
+
+			assert.Equal(t, 1, metrics.ResourceMetrics().Len())
+			rm := metrics.ResourceMetrics().At(0)
+			assert.Equal(t, res, rm.Resource())
+			assert.Equal(t, 1, rm.ScopeMetrics().Len())
+			ms := rm.ScopeMetrics().At(0).Metrics()
+			if tt.metricsSet == testDataSetDefault {
+				assert.Equal(t, defaultMetricsCount, ms.Len())
+			}
+			if tt.metricsSet == testDataSetAll {
+				assert.Equal(t, allMetricsCount, ms.Len())
+			}
+			validatedMetrics := make(map[string]bool)
+			for i := 0; i < ms.Len(); i++ {
+				switch ms.At(i).Name() {
+				case "flow.count":
+					assert.False(t, validatedMetrics["flow.count"], "Found a duplicate in the metrics slice: flow.count")
+					validatedMetrics["flow.count"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of flows received during the aggregation interval.", ms.At(i).Description())
+					assert.Equal(t, "{flow}", ms.At(i).Unit())
+					assert.True(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityDelta, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("flow.sampler_address")
+					assert.True(t, ok)
+					assert.Equal(t, "flow.sampler_address-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("flow.source.prefix")
+					assert.True(t, ok)
+					assert.Equal(t, "flow.source.prefix-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("flow.destination.prefix")
+					assert.True(t, ok)
+					assert.Equal(t, "flow.destination.prefix-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("destination.port")
+					assert.True(t, ok)
+					assert.EqualValues(t, 16, attrVal.Int())
+					attrVal, ok = dp.Attributes().Get("network.transport")
+					assert.True(t, ok)
+					assert.Equal(t, "network.transport-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("flow.in_interface.name")
+					assert.True(t, ok)
+					assert.Equal(t, "flow.in_interface.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("flow.out_interface.name")
+					assert.True(t, ok)
+					assert.Equal(t, "flow.out_interface.name-val", attrVal.Str())
+				case "flow.io.bytes":
+					assert.False(t, validatedMetrics["flow.io.bytes"], "Found a duplicate in the metrics slice: flow.io.bytes")
+					validatedMetrics["flow.io.bytes"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Bytes transferred by the flows during the aggregation interval, scaled by the sampling rate.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.True(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityDelta, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("flow.sampler_address")
+					assert.True(t, ok)
+					assert.Equal(t, "flow.sampler_address-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("flow.source.prefix")
+					assert.True(t, ok)
+					assert.Equal(t, "flow.source.prefix-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("flow.destination.prefix")
+					assert.True(t, ok)
+					assert.Equal(t, "flow.destination.prefix-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("destination.port")
+					assert.True(t, ok)
+					assert.EqualValues(t, 16, attrVal.Int())
+					attrVal, ok = dp.Attributes().Get("network.transport")
+					assert.True(t, ok)
+					assert.Equal(t, "network.transport-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("flow.in_interface.name")
+					assert.True(t, ok)
+					assert.Equal(t, "flow.in_interface.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("flow.out_interface.name")
+					assert.True(t, ok)
+					assert.Equal(t, "flow.out_interface.name-val", attrVal.Str())
+				case "flow.io.packets":
+					assert.False(t, validatedMetrics["flow.io.packets"], "Found a duplicate in the metrics slice: flow.io.packets")
+					validatedMetrics["flow.io.packets"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Packets transferred by the flows during the aggregation interval, scaled by the sampling rate.", ms.At(i).Description())
+					assert.Equal(t, "{packet}", ms.At(i).Unit())
+					assert.True(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityDelta, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("flow.sampler_address")
+					assert.True(t, ok)
+					assert.Equal(t, "flow.sampler_address-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("flow.source.prefix")
+					assert.True(t, ok)
+					assert.Equal(t, "flow.source.prefix-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("flow.destination.prefix")
+					assert.True(t, ok)
+					assert.Equal(t, "flow.destination.prefix-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("destination.port")
+					assert.True(t, ok)
+					assert.EqualValues(t, 16, attrVal.Int())
+					attrVal, ok = dp.Attributes().Get("network.transport")
+					assert.True(t, ok)
+					assert.Equal(t, "network.transport-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("flow.in_interface.name")
+					assert.True(t, ok)
+					assert.Equal(t, "flow.in_interface.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("flow.out_interface.name")
+					assert.True(t, ok)
+					assert.Equal(t, "flow.out_interface.name-val", attrVal.Str())
+				}
+			}
+		})
+	}
+}
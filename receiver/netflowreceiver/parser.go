@@ -213,7 +213,7 @@ func getFlowTypeName(flowType int32) string {
 }
 
 // addMessageAttributes parses the message attributes and adds them to the log record
-func addMessageAttributes(m producer.ProducerMessage, r *plog.LogRecord) error {
+func addMessageAttributes(m producer.ProducerMessage, r *plog.LogRecord, interfaceNames map[uint32]string) error {
 	// we know msg is ProtoProducerMessage because that is the parent producer
 	pm, ok := m.(*protoproducer.ProtoProducerMessage)
 	if !ok {
@@ -254,5 +254,13 @@ func addMessageAttributes(m producer.ProducerMessage, r *plog.LogRecord) error {
 	r.Attributes().PutStr("flow.sampler_address", samplerAddr.String())
 	r.Attributes().PutInt("flow.tcp_flags", int64(pm.TcpFlags))
 
+	// Interface names are only known from the configured mappings
+	if name, ok := interfaceNames[pm.InIf]; ok && pm.InIf != 0 {
+		r.Attributes().PutStr("flow.in_interface.name", name)
+	}
+	if name, ok := interfaceNames[pm.OutIf]; ok && pm.OutIf != 0 {
+		r.Attributes().PutStr("flow.out_interface.name", name)
+	}
+
 	return nil
 }
@@ -62,7 +62,7 @@ func TestConvertToOtel(t *testing.T) {
 	}
 
 	record := plog.NewLogRecord()
-	err := addMessageAttributes(pm, &record)
+	err := addMessageAttributes(pm, &record, nil)
 	if err != nil {
 		t.Errorf("TestConvertToOtel() error = %v", err)
 		return
@@ -96,7 +96,7 @@ func TestEmptyConvertToOtel(t *testing.T) {
 	pm := &protoproducer.ProtoProducerMessage{}
 
 	record := plog.NewLogRecord()
-	err := addMessageAttributes(pm, &record)
+	err := addMessageAttributes(pm, &record, nil)
 	if err != nil {
 		t.Errorf("TestConvertToOtel() error = %v", err)
 		return
@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/netsampler/goflow2/v2/producer"
+	protoproducer "github.com/netsampler/goflow2/v2/producer/proto"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.uber.org/zap"
@@ -16,11 +17,14 @@ import (
 )
 
 // otelLogsProducerWrapper is a wrapper around a producer.ProducerInterface that sends the messages to a log consumer
+// and rolls them up in an aggregator
 type otelLogsProducerWrapper struct {
-	wrapped     producer.ProducerInterface
-	logConsumer consumer.Logs
-	logger      *zap.Logger
-	sendRaw     bool
+	wrapped        producer.ProducerInterface
+	logConsumer    consumer.Logs
+	logger         *zap.Logger
+	sendRaw        bool
+	interfaceNames map[uint32]string
+	aggregator     *flowAggregator
 }
 
 // Produce converts the message into a list log records and sends them to log consumer
@@ -39,6 +43,22 @@ func (o *otelLogsProducerWrapper) Produce(msg any, args *producer.ProduceArgs) (
 		return flowMessageSet, err
 	}
 
+	if o.aggregator != nil {
+		for _, msg := range flowMessageSet {
+			if pm, ok := msg.(*protoproducer.ProtoProducerMessage); ok {
+				o.aggregator.add(pm)
+			}
+		}
+	}
+
+	if len(flowMessageSet) == 0 {
+		o.logger.Info("received a packet with no flow messages from", zap.String("agent", args.SamplerAddress.String()))
+	}
+
+	if o.logConsumer == nil {
+		return flowMessageSet, nil
+	}
+
 	// Create the otel log structure to hold our messages
 	log := plog.NewLogs()
 	scopeLog := log.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
@@ -53,17 +73,13 @@ func (o *otelLogsProducerWrapper) Produce(msg any, args *producer.ProduceArgs) (
 			logRecord.Body().SetStr(fmt.Sprintf("%+v", msg))
 		} else {
 			// Parse the message and add the attributes to the log record
-			err = addMessageAttributes(msg, &logRecord)
+			err = addMessageAttributes(msg, &logRecord, o.interfaceNames)
 			if err != nil {
 				o.logger.Error("error adding message attributes", zap.Error(err))
 			}
 		}
 	}
 
-	if len(flowMessageSet) == 0 {
-		o.logger.Info("received a packet with no flow messages from", zap.String("agent", args.SamplerAddress.String()))
-	}
-
 	err = o.logConsumer.ConsumeLogs(context.Background(), log)
 	if err != nil {
 		return flowMessageSet, err
@@ -80,11 +96,13 @@ func (o *otelLogsProducerWrapper) Commit(flowMessageSet []producer.ProducerMessa
 	o.wrapped.Commit(flowMessageSet)
 }
 
-func newOtelLogsProducer(wrapped producer.ProducerInterface, logConsumer consumer.Logs, logger *zap.Logger, sendRaw bool) producer.ProducerInterface {
+func newOtelLogsProducer(wrapped producer.ProducerInterface, logConsumer consumer.Logs, logger *zap.Logger, sendRaw bool, interfaceNames map[uint32]string, aggregator *flowAggregator) producer.ProducerInterface {
 	return &otelLogsProducerWrapper{
-		wrapped:     wrapped,
-		logConsumer: logConsumer,
-		logger:      logger,
-		sendRaw:     sendRaw,
+		wrapped:        wrapped,
+		logConsumer:    logConsumer,
+		logger:         logger,
+		sendRaw:        sendRaw,
+		interfaceNames: interfaceNames,
+		aggregator:     aggregator,
 	}
 }
@@ -57,7 +57,7 @@ func TestProduce(t *testing.T) {
 	protoProducer, err := protoproducer.CreateProtoProducer(cfgm, protoproducer.CreateSamplingSystem)
 	require.NoError(t, err)
 
-	otelLogsProducer := newOtelLogsProducer(protoProducer, consumertest.NewNop(), zap.NewNop(), false)
+	otelLogsProducer := newOtelLogsProducer(protoProducer, consumertest.NewNop(), zap.NewNop(), false, nil, nil)
 	messages, err := otelLogsProducer.Produce(message, &producer.ProduceArgs{})
 	require.NoError(t, err)
 	require.NotNil(t, messages)
@@ -130,7 +130,7 @@ func TestProduceRaw(t *testing.T) {
 	require.NoError(t, err)
 
 	sink := &consumertest.LogsSink{}
-	otelLogsProducer := newOtelLogsProducer(protoProducer, sink, zap.NewNop(), true)
+	otelLogsProducer := newOtelLogsProducer(protoProducer, sink, zap.NewNop(), true, nil, nil)
 
 	messages, err := otelLogsProducer.Produce(message, &producer.ProduceArgs{})
 	require.NoError(t, err)
@@ -170,7 +170,7 @@ func TestProducerPanic(t *testing.T) {
 	mockConsumer := consumertest.NewNop()
 
 	// Wrap a panicProducer (instead of ProtoProducer) in the otelLogsProducerWrapper
-	wrapper := newOtelLogsProducer(&panicProducer{}, mockConsumer, logger, false)
+	wrapper := newOtelLogsProducer(&panicProducer{}, mockConsumer, logger, false, nil, nil)
 
 	// Call Produce which should recover from panic
 	messages, err := wrapper.Produce(nil, &producer.ProduceArgs{
@@ -5,9 +5,12 @@ package netflowreceiver // import "github.com/open-telemetry/opentelemetry-colle
 
 import (
 	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"net"
+	"sync"
+	"time"
 
 	"github.com/netsampler/goflow2/v2/decoders/netflow"
 	protoproducer "github.com/netsampler/goflow2/v2/producer/proto"
@@ -18,6 +21,9 @@ import (
 	"go.uber.org/zap"
 )
 
+// ipfixVersion is the version number in the header of IPFIX messages
+const ipfixVersion = 10
+
 var _ utils.ReceiverCallback = (*dropHandler)(nil)
 
 type dropHandler struct {
@@ -29,13 +35,25 @@ func (d dropHandler) Dropped(msg utils.Message) {
 }
 
 type netflowReceiver struct {
-	config      Config
-	logger      *zap.Logger
-	udpReceiver *utils.UDPReceiver
-	logConsumer consumer.Logs
+	config          Config
+	settings        receiver.Settings
+	logger          *zap.Logger
+	udpReceiver     *utils.UDPReceiver
+	logConsumer     consumer.Logs
+	metricsConsumer consumer.Metrics
+	interfaceNames  map[uint32]string
+	templates       *exporterTemplates
+	aggregator      *flowAggregator
+	cancel          context.CancelFunc
+	wg              sync.WaitGroup
 }
 
-func newNetflowLogsReceiver(params receiver.Settings, cfg Config, consumer consumer.Logs) (receiver.Logs, error) {
+func newNetflowReceiver(params receiver.Settings, cfg Config) (*netflowReceiver, error) {
+	interfaceNames, err := parseInterfaceNames(cfg.InterfaceNames)
+	if err != nil {
+		return nil, err
+	}
+
 	// UDP receiver configuration
 	udpCfg := &utils.UDPReceiverConfig{
 		Sockets:   cfg.Sockets,
@@ -52,16 +70,26 @@ func newNetflowLogsReceiver(params receiver.Settings, cfg Config, consumer consu
 	}
 
 	nr := &netflowReceiver{
-		logger:      params.Logger,
-		config:      cfg,
-		logConsumer: consumer,
-		udpReceiver: udpReceiver,
+		logger:         params.Logger,
+		settings:       params,
+		config:         cfg,
+		udpReceiver:    udpReceiver,
+		interfaceNames: interfaceNames,
+		templates:      newExporterTemplates(cfg.MaxTemplateExporters),
 	}
 
 	return nr, nil
 }
 
 func (nr *netflowReceiver) Start(_ context.Context, _ component.Host) error {
+	if nr.metricsConsumer != nil {
+		nr.aggregator = newFlowAggregator(nr.config, nr.interfaceNames, nr.settings)
+		ctx, cancel := context.WithCancel(context.Background())
+		nr.cancel = cancel
+		nr.wg.Add(1)
+		go nr.emitMetrics(ctx)
+	}
+
 	// The function that will decode packets
 	decodeFunc, err := nr.buildDecodeFunc()
 	if err != nil {
@@ -80,12 +108,16 @@ func (nr *netflowReceiver) Start(_ context.Context, _ component.Host) error {
 }
 
 func (nr *netflowReceiver) Shutdown(context.Context) error {
-	if nr.udpReceiver == nil {
-		return nil
+	// Stop the listener before the final flush of the aggregated flows, so that
+	// the flows decoded until then are included in it.
+	if nr.udpReceiver != nil {
+		if err := nr.udpReceiver.Stop(); err != nil {
+			nr.logger.Warn("Error stopping UDP receiver", zap.Error(err))
+		}
 	}
-	err := nr.udpReceiver.Stop()
-	if err != nil {
-		nr.logger.Warn("Error stopping UDP receiver", zap.Error(err))
+	if nr.cancel != nil {
+		nr.cancel()
+		nr.wg.Wait()
 	}
 	return nil
 }
@@ -108,10 +140,11 @@ func (nr *netflowReceiver) buildDecodeFunc() (utils.DecoderFunc, error) {
 
 	// the otel log producer converts those messages into OpenTelemetry logs
 	// it is a wrapper around the protobuf producer
-	otelLogsProducer := newOtelLogsProducer(protoProducer, nr.logConsumer, nr.logger, nr.config.SendRaw)
+	otelLogsProducer := newOtelLogsProducer(protoProducer, nr.logConsumer, nr.logger, nr.config.SendRaw, nr.interfaceNames, nr.aggregator)
 
 	cfgPipe := &utils.PipeConfig{
-		Producer: otelLogsProducer,
+		Producer:         otelLogsProducer,
+		NetFlowTemplater: nr.templates.get,
 	}
 
 	var p utils.FlowPipe
@@ -120,12 +153,53 @@ func (nr *netflowReceiver) buildDecodeFunc() (utils.DecoderFunc, error) {
 		p = utils.NewSFlowPipe(cfgPipe)
 	case "netflow":
 		p = utils.NewNetFlowPipe(cfgPipe)
+	case "ipfix":
+		return decodeIPFIXOnly(utils.NewNetFlowPipe(cfgPipe)), nil
 	default:
 		return nil, fmt.Errorf("scheme does not exist: %s", nr.config.Scheme)
 	}
 	return p.DecodeFlow, nil
 }
 
+// decodeIPFIXOnly wraps a netflow pipe so that it rejects the Netflow v5 and v9 packets
+func decodeIPFIXOnly(p utils.FlowPipe) utils.DecoderFunc {
+	return func(msg any) error {
+		if pkt, ok := msg.(*utils.Message); ok {
+			if len(pkt.Payload) < 2 || binary.BigEndian.Uint16(pkt.Payload) != ipfixVersion {
+				return fmt.Errorf("not an IPFIX packet from %s", pkt.Src.String())
+			}
+		}
+		return p.DecodeFlow(msg)
+	}
+}
+
+// emitMetrics sends the aggregated flows to the metrics consumer on every interval
+func (nr *netflowReceiver) emitMetrics(ctx context.Context) {
+	defer nr.wg.Done()
+	ticker := time.NewTicker(nr.config.Aggregation.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			nr.consumeMetrics()
+			return
+		case <-ticker.C:
+			nr.consumeMetrics()
+		}
+	}
+}
+
+func (nr *netflowReceiver) consumeMetrics() {
+	metrics := nr.aggregator.flush()
+	if metrics.DataPointCount() == 0 {
+		return
+	}
+	if err := nr.metricsConsumer.ConsumeMetrics(context.Background(), metrics); err != nil {
+		nr.logger.Error("failed to consume the aggregated flow metrics", zap.Error(err))
+	}
+}
+
 // handleErrors handles errors from the listener
 // We don't want the receiver to stop if there is an error processing a packet
 func (nr *netflowReceiver) handleErrors() {
@@ -4,12 +4,21 @@
 package netflowreceiver
 
 import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"net/netip"
 	"testing"
+	"time"
 
+	"github.com/netsampler/goflow2/v2/utils"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netflowreceiver/internal/metadata"
 )
 
@@ -20,5 +29,113 @@ func TestCreateValidDefaultReceiver(t *testing.T) {
 	receiver, err := factory.CreateLogs(t.Context(), set, cfg, consumertest.NewNop())
 	assert.NoError(t, err, "receiver creation failed")
 	assert.NotNil(t, receiver, "receiver creation failed")
-	assert.NotNil(t, receiver.(*netflowReceiver).udpReceiver)
+	assert.NotNil(t, receiver.(*sharedcomponent.SharedComponent).Unwrap().(*netflowReceiver).udpReceiver)
+}
+
+// newNetflowV5Packet returns a Netflow v5 packet with one record per flow
+func newNetflowV5Packet(flows ...[2]string) []byte {
+	packet := binary.BigEndian.AppendUint16(nil, 5)
+	packet = binary.BigEndian.AppendUint16(packet, uint16(len(flows)))
+	packet = append(packet, make([]byte, 20)...)
+	for _, flow := range flows {
+		src, dst := netip.MustParseAddr(flow[0]).As4(), netip.MustParseAddr(flow[1]).As4()
+		packet = append(packet, src[:]...)
+		packet = append(packet, dst[:]...)
+		packet = append(packet, make([]byte, 4)...)           // next hop
+		packet = binary.BigEndian.AppendUint16(packet, 1)     // input interface
+		packet = binary.BigEndian.AppendUint16(packet, 2)     // output interface
+		packet = binary.BigEndian.AppendUint32(packet, 10)    // packets
+		packet = binary.BigEndian.AppendUint32(packet, 1000)  // bytes
+		packet = append(packet, make([]byte, 8)...)           // first and last
+		packet = binary.BigEndian.AppendUint16(packet, 50000) // source port
+		packet = binary.BigEndian.AppendUint16(packet, 443)   // destination port
+		packet = append(packet, 0, 0, 6, 0)                   // pad, tcp flags, protocol, tos
+		packet = append(packet, make([]byte, 8)...)           // as numbers, masks and pad
+	}
+	return packet
+}
+
+func TestReceiveFlowsAsLogsAndMetrics(t *testing.T) {
+	listener, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := listener.LocalAddr().(*net.UDPAddr).Port
+	require.NoError(t, listener.Close())
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.Hostname = "127.0.0.1"
+	cfg.Port = port
+	cfg.InterfaceNames = map[string]string{"1": "wan"}
+	cfg.Aggregation.Interval = 50 * time.Millisecond
+	set := receivertest.NewNopSettings(metadata.Type)
+
+	logsSink := new(consumertest.LogsSink)
+	metricsSink := new(consumertest.MetricsSink)
+	logsReceiver, err := factory.CreateLogs(t.Context(), set, cfg, logsSink)
+	require.NoError(t, err)
+	metricsReceiver, err := factory.CreateMetrics(t.Context(), set, cfg, metricsSink)
+	require.NoError(t, err)
+	assert.Same(t, logsReceiver, metricsReceiver)
+
+	require.NoError(t, logsReceiver.Start(t.Context(), componenttest.NewNopHost()))
+	require.NoError(t, metricsReceiver.Start(t.Context(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, logsReceiver.Shutdown(t.Context()))
+		require.NoError(t, metricsReceiver.Shutdown(t.Context()))
+	}()
+
+	conn, err := net.Dial("udp", fmt.Sprintf("127.0.0.1:%d", port))
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = conn.Write(newNetflowV5Packet([2]string{"10.0.0.1", "192.168.1.10"}, [2]string{"10.0.0.2", "192.168.1.20"}))
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return logsSink.LogRecordCount() == 2 && metricsSink.DataPointCount() > 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	logRecord := logsSink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	inInterface, ok := logRecord.Attributes().Get("flow.in_interface.name")
+	require.True(t, ok)
+	assert.Equal(t, "wan", inInterface.Str())
+	_, ok = logRecord.Attributes().Get("flow.out_interface.name")
+	assert.False(t, ok)
+
+	dps := flowDataPoints(metricsSink.AllMetrics()[0])
+	require.Len(t, dps, 3)
+	for _, dp := range dps {
+		assert.Equal(t, "10.0.0.0/24", dp.attributes["flow.source.prefix"])
+		assert.Equal(t, "192.168.1.0/24", dp.attributes["flow.destination.prefix"])
+		assert.Equal(t, "wan", dp.attributes["flow.in_interface.name"])
+		switch dp.metric {
+		case "flow.count":
+			assert.Equal(t, int64(2), dp.value)
+		case "flow.io.bytes":
+			assert.Equal(t, int64(2000), dp.value)
+		case "flow.io.packets":
+			assert.Equal(t, int64(20), dp.value)
+		}
+	}
+}
+
+type decodedPipe struct {
+	decoded int
+}
+
+func (p *decodedPipe) DecodeFlow(any) error {
+	p.decoded++
+	return nil
+}
+
+func (*decodedPipe) Close() {}
+
+func TestDecodeIPFIXOnly(t *testing.T) {
+	pipe := &decodedPipe{}
+	decode := decodeIPFIXOnly(pipe)
+	src := netip.MustParseAddrPort("192.0.2.1:4739")
+
+	require.NoError(t, decode(&utils.Message{Src: src, Payload: []byte{0x00, 0x0a, 0x00, 0x10}}))
+	assert.EqualError(t, decode(&utils.Message{Src: src, Payload: newNetflowV5Packet()}), "not an IPFIX packet from 192.0.2.1:4739")
+	assert.EqualError(t, decode(&utils.Message{Src: src}), "not an IPFIX packet from 192.0.2.1:4739")
+	assert.Equal(t, 1, pipe.decoded)
 }
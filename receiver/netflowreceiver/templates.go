@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package netflowreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netflowreceiver"
+
+import (
+	"container/list"
+	"net/netip"
+	"sync"
+
+	"github.com/netsampler/goflow2/v2/decoders/netflow"
+)
+
+// exporterTemplates caches the Netflow v9 and IPFIX templates per exporter.
+// GoFlow2 keys the templates by the source address and port of the packets, so
+// templates would be lost whenever an exporter sends from a new source port,
+// e.g. after a restart. The template systems are shared by exporter address instead.
+//
+// The cache holds at most maxExporters template systems, the template system of
+// the least recently seen exporter is evicted first. An evicted exporter sends
+// its templates again periodically, so its flows are only dropped until then.
+type exporterTemplates struct {
+	mu           sync.Mutex
+	maxExporters int
+	templates    map[string]*list.Element
+	// lru orders the exporters from the most to the least recently seen.
+	lru *list.List
+}
+
+type exporterTemplatesEntry struct {
+	key       string
+	templates netflow.NetFlowTemplateSystem
+}
+
+func newExporterTemplates(maxExporters int) *exporterTemplates {
+	return &exporterTemplates{
+		maxExporters: maxExporters,
+		templates:    map[string]*list.Element{},
+		lru:          list.New(),
+	}
+}
+
+// get returns the template system of the exporter sending from the given address and port.
+// It is used as the GoFlow2 template system generator.
+func (t *exporterTemplates) get(key string) netflow.NetFlowTemplateSystem {
+	if addrPort, err := netip.ParseAddrPort(key); err == nil {
+		key = addrPort.Addr().Unmap().String()
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if elem, ok := t.templates[key]; ok {
+		t.lru.MoveToFront(elem)
+		return elem.Value.(*exporterTemplatesEntry).templates
+	}
+
+	for t.lru.Len() > 0 && t.lru.Len() >= t.maxExporters {
+		oldest := t.lru.Back()
+		t.lru.Remove(oldest)
+		delete(t.templates, oldest.Value.(*exporterTemplatesEntry).key)
+	}
+	entry := &exporterTemplatesEntry{key: key, templates: netflow.CreateTemplateSystem()}
+	t.templates[key] = t.lru.PushFront(entry)
+	return entry.templates
+}
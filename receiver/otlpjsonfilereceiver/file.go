@@ -5,6 +5,7 @@ package otlpjsonfilereceiver // import "github.com/open-telemetry/opentelemetry-
 
 import (
 	"context"
+	"errors"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
@@ -42,21 +43,60 @@ type Config struct {
 	fileconsumer.Config `mapstructure:",squash"`
 	StorageID           *component.ID `mapstructure:"storage"`
 	ReplayFile          bool          `mapstructure:"replay_file"`
+	Replay              ReplayConfig  `mapstructure:"replay"`
+}
+
+// ReplayConfig defines how the files are replayed in replay mode.
+type ReplayConfig struct {
+	// Enabled reads the files from the beginning and emits their records at their original pace,
+	// instead of following the files.
+	Enabled bool `mapstructure:"enabled"`
+	// Speed scales the original timing of the records, e.g. 2 replays the records twice as fast.
+	Speed float64 `mapstructure:"speed"`
+	// MaxRecordsPerSecond emits the records at this rate instead of their original timing, if greater than 0.
+	MaxRecordsPerSecond float64 `mapstructure:"max_records_per_second"`
+	// RewriteTimestamps shifts the timestamps of the records so that they are relative to the start of the replay.
+	RewriteTimestamps bool `mapstructure:"rewrite_timestamps"`
+	// Loops is the number of times the files are replayed, 0 replays them until the receiver is shut down.
+	Loops int `mapstructure:"loops"`
+}
+
+func (cfg *ReplayConfig) Validate() error {
+	var errs []error
+	if cfg.Speed <= 0 {
+		errs = append(errs, errors.New("replay speed must be greater than 0"))
+	}
+	if cfg.MaxRecordsPerSecond < 0 {
+		errs = append(errs, errors.New("replay max_records_per_second must not be negative"))
+	}
+	if cfg.Loops < 0 {
+		errs = append(errs, errors.New("replay loops must not be negative"))
+	}
+	return errors.Join(errs...)
 }
 
 func createDefaultConfig() component.Config {
 	return &Config{
 		Config: *fileconsumer.NewConfig(),
+		Replay: ReplayConfig{
+			Speed: 1,
+			Loops: 1,
+		},
 	}
 }
 
 type otlpjsonfilereceiver struct {
 	input     *fileconsumer.Manager
+	replayer  *replayer
 	id        component.ID
 	storageID *component.ID
 }
 
 func (f *otlpjsonfilereceiver) Start(ctx context.Context, host component.Host) error {
+	if f.replayer != nil {
+		f.replayer.start()
+		return nil
+	}
 	storageClient, err := adapter.GetStorageClient(ctx, host, f.storageID, f.id)
 	if err != nil {
 		return err
@@ -65,6 +105,10 @@ func (f *otlpjsonfilereceiver) Start(ctx context.Context, host component.Host) e
 }
 
 func (f *otlpjsonfilereceiver) Shutdown(_ context.Context) error {
+	if f.replayer != nil {
+		f.replayer.stop()
+		return nil
+	}
 	return f.input.Stop()
 }
 
@@ -78,36 +122,53 @@ func createLogsReceiver(_ context.Context, settings receiver.Settings, configura
 	if err != nil {
 		return nil, err
 	}
+	consumeLogs := func(ctx context.Context, l plog.Logs, attributes map[string]any) error {
+		ctx = obsrecv.StartLogsOp(ctx)
+		logRecordCount := l.LogRecordCount()
+		var err error
+		if logRecordCount != 0 {
+			// Appends token.Attributes
+			for i := 0; i < l.ResourceLogs().Len(); i++ {
+				resourceLog := l.ResourceLogs().At(i)
+				for j := 0; j < resourceLog.ScopeLogs().Len(); j++ {
+					scopeLog := resourceLog.ScopeLogs().At(j)
+					for k := 0; k < scopeLog.LogRecords().Len(); k++ {
+						LogRecords := scopeLog.LogRecords().At(k)
+						appendToMap(attributes, LogRecords.Attributes())
+					}
+				}
+			}
+			err = logs.ConsumeLogs(ctx, l)
+		}
+		obsrecv.EndLogsOp(ctx, metadata.Type.String(), logRecordCount, err)
+		return err
+	}
 	cfg := configuration.(*Config)
+	if cfg.Replay.Enabled {
+		r, err := newReplayer(cfg, settings.Logger, replaySignal[plog.Logs]{
+			unmarshal: logsUnmarshaler.UnmarshalLogs,
+			count:     plog.Logs.LogRecordCount,
+			timestamp: logsTimestamp,
+			shift:     shiftLogs,
+			consume:   consumeLogs,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &otlpjsonfilereceiver{replayer: r, id: settings.ID}, nil
+	}
 	opts := make([]fileconsumer.Option, 0)
 	if cfg.ReplayFile {
 		opts = append(opts, fileconsumer.WithNoTracking())
 	}
 	input, err := cfg.Build(settings.TelemetrySettings, func(ctx context.Context, tokens [][]byte, attributes map[string]any, _ int64, _ []int64) error {
 		for _, token := range tokens {
-			ctx = obsrecv.StartLogsOp(ctx)
-			var l plog.Logs
-			l, err = logsUnmarshaler.UnmarshalLogs(token)
+			l, err := logsUnmarshaler.UnmarshalLogs(token)
 			if err != nil {
-				obsrecv.EndLogsOp(ctx, metadata.Type.String(), 0, err)
-			} else {
-				logRecordCount := l.LogRecordCount()
-				if logRecordCount != 0 {
-					// Appends token.Attributes
-					for i := 0; i < l.ResourceLogs().Len(); i++ {
-						resourceLog := l.ResourceLogs().At(i)
-						for j := 0; j < resourceLog.ScopeLogs().Len(); j++ {
-							scopeLog := resourceLog.ScopeLogs().At(j)
-							for k := 0; k < scopeLog.LogRecords().Len(); k++ {
-								LogRecords := scopeLog.LogRecords().At(k)
-								appendToMap(attributes, LogRecords.Attributes())
-							}
-						}
-					}
-					err = logs.ConsumeLogs(ctx, l)
-				}
-				obsrecv.EndLogsOp(ctx, metadata.Type.String(), logRecordCount, err)
+				obsrecv.EndLogsOp(obsrecv.StartLogsOp(ctx), metadata.Type.String(), 0, err)
+				continue
 			}
+			_ = consumeLogs(ctx, l, attributes)
 		}
 		return nil
 	}, opts...)
@@ -128,35 +189,52 @@ func createMetricsReceiver(_ context.Context, settings receiver.Settings, config
 	if err != nil {
 		return nil, err
 	}
+	consumeMetrics := func(ctx context.Context, m pmetric.Metrics, attributes map[string]any) error {
+		ctx = obsrecv.StartMetricsOp(ctx)
+		var err error
+		if m.ResourceMetrics().Len() != 0 {
+			// Appends token.Attributes
+			for i := 0; i < m.ResourceMetrics().Len(); i++ {
+				resourceMetric := m.ResourceMetrics().At(i)
+				for j := 0; j < resourceMetric.ScopeMetrics().Len(); j++ {
+					ScopeMetric := resourceMetric.ScopeMetrics().At(j)
+					for k := 0; k < ScopeMetric.Metrics().Len(); k++ {
+						metric := ScopeMetric.Metrics().At(k)
+						appendToMap(attributes, metric.Metadata())
+					}
+				}
+			}
+			err = metrics.ConsumeMetrics(ctx, m)
+		}
+		obsrecv.EndMetricsOp(ctx, metadata.Type.String(), m.MetricCount(), err)
+		return err
+	}
 	cfg := configuration.(*Config)
+	if cfg.Replay.Enabled {
+		r, err := newReplayer(cfg, settings.Logger, replaySignal[pmetric.Metrics]{
+			unmarshal: metricsUnmarshaler.UnmarshalMetrics,
+			count:     pmetric.Metrics.DataPointCount,
+			timestamp: metricsTimestamp,
+			shift:     shiftMetrics,
+			consume:   consumeMetrics,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &otlpjsonfilereceiver{replayer: r, id: settings.ID}, nil
+	}
 	opts := make([]fileconsumer.Option, 0)
 	if cfg.ReplayFile {
 		opts = append(opts, fileconsumer.WithNoTracking())
 	}
 	input, err := cfg.Build(settings.TelemetrySettings, func(ctx context.Context, tokens [][]byte, attributes map[string]any, _ int64, _ []int64) error {
 		for _, token := range tokens {
-			ctx = obsrecv.StartMetricsOp(ctx)
-			var m pmetric.Metrics
-			m, err = metricsUnmarshaler.UnmarshalMetrics(token)
+			m, err := metricsUnmarshaler.UnmarshalMetrics(token)
 			if err != nil {
-				obsrecv.EndMetricsOp(ctx, metadata.Type.String(), 0, err)
-			} else {
-				if m.ResourceMetrics().Len() != 0 {
-					// Appends token.Attributes
-					for i := 0; i < m.ResourceMetrics().Len(); i++ {
-						resourceMetric := m.ResourceMetrics().At(i)
-						for j := 0; j < resourceMetric.ScopeMetrics().Len(); j++ {
-							ScopeMetric := resourceMetric.ScopeMetrics().At(j)
-							for k := 0; k < ScopeMetric.Metrics().Len(); k++ {
-								metric := ScopeMetric.Metrics().At(k)
-								appendToMap(attributes, metric.Metadata())
-							}
-						}
-					}
-					err = metrics.ConsumeMetrics(ctx, m)
-				}
-				obsrecv.EndMetricsOp(ctx, metadata.Type.String(), m.MetricCount(), err)
+				obsrecv.EndMetricsOp(obsrecv.StartMetricsOp(ctx), metadata.Type.String(), 0, err)
+				continue
 			}
+			_ = consumeMetrics(ctx, m, attributes)
 		}
 		return nil
 	}, opts...)
@@ -177,35 +255,52 @@ func createTracesReceiver(_ context.Context, settings receiver.Settings, configu
 	if err != nil {
 		return nil, err
 	}
+	consumeTraces := func(ctx context.Context, t ptrace.Traces, attributes map[string]any) error {
+		ctx = obsrecv.StartTracesOp(ctx)
+		var err error
+		if t.ResourceSpans().Len() != 0 {
+			// Appends token.Attributes
+			for i := 0; i < t.ResourceSpans().Len(); i++ {
+				resourceSpan := t.ResourceSpans().At(i)
+				for j := 0; j < resourceSpan.ScopeSpans().Len(); j++ {
+					scopeSpan := resourceSpan.ScopeSpans().At(j)
+					for k := 0; k < scopeSpan.Spans().Len(); k++ {
+						spans := scopeSpan.Spans().At(k)
+						appendToMap(attributes, spans.Attributes())
+					}
+				}
+			}
+			err = traces.ConsumeTraces(ctx, t)
+		}
+		obsrecv.EndTracesOp(ctx, metadata.Type.String(), t.SpanCount(), err)
+		return err
+	}
 	cfg := configuration.(*Config)
+	if cfg.Replay.Enabled {
+		r, err := newReplayer(cfg, settings.Logger, replaySignal[ptrace.Traces]{
+			unmarshal: tracesUnmarshaler.UnmarshalTraces,
+			count:     ptrace.Traces.SpanCount,
+			timestamp: tracesTimestamp,
+			shift:     shiftTraces,
+			consume:   consumeTraces,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &otlpjsonfilereceiver{replayer: r, id: settings.ID}, nil
+	}
 	opts := make([]fileconsumer.Option, 0)
 	if cfg.ReplayFile {
 		opts = append(opts, fileconsumer.WithNoTracking())
 	}
 	input, err := cfg.Build(settings.TelemetrySettings, func(ctx context.Context, tokens [][]byte, attributes map[string]any, _ int64, _ []int64) error {
 		for _, token := range tokens {
-			ctx = obsrecv.StartTracesOp(ctx)
-			var t ptrace.Traces
-			t, err = tracesUnmarshaler.UnmarshalTraces(token)
+			t, err := tracesUnmarshaler.UnmarshalTraces(token)
 			if err != nil {
-				obsrecv.EndTracesOp(ctx, metadata.Type.String(), 0, err)
-			} else {
-				if t.ResourceSpans().Len() != 0 {
-					// Appends token.Attributes
-					for i := 0; i < t.ResourceSpans().Len(); i++ {
-						resourceSpan := t.ResourceSpans().At(i)
-						for j := 0; j < resourceSpan.ScopeSpans().Len(); j++ {
-							scopeSpan := resourceSpan.ScopeSpans().At(j)
-							for k := 0; k < scopeSpan.Spans().Len(); k++ {
-								spans := scopeSpan.Spans().At(k)
-								appendToMap(attributes, spans.Attributes())
-							}
-						}
-					}
-					err = traces.ConsumeTraces(ctx, t)
-				}
-				obsrecv.EndTracesOp(ctx, metadata.Type.String(), t.SpanCount(), err)
+				obsrecv.EndTracesOp(obsrecv.StartTracesOp(ctx), metadata.Type.String(), 0, err)
+				continue
 			}
+			_ = consumeTraces(ctx, t, attributes)
 		}
 		return nil
 	}, opts...)
@@ -219,6 +314,22 @@ func createTracesReceiver(_ context.Context, settings receiver.Settings, configu
 func createProfilesReceiver(_ context.Context, settings receiver.Settings, configuration component.Config, profiles xconsumer.Profiles) (xreceiver.Profiles, error) {
 	profilesUnmarshaler := &pprofile.JSONUnmarshaler{}
 	cfg := configuration.(*Config)
+	if cfg.Replay.Enabled {
+		r, err := newReplayer(cfg, settings.Logger, replaySignal[pprofile.Profiles]{
+			unmarshal: profilesUnmarshaler.UnmarshalProfiles,
+			count:     pprofile.Profiles.SampleCount,
+			timestamp: profilesTimestamp,
+			shift:     shiftProfiles,
+			consume: func(ctx context.Context, p pprofile.Profiles, _ map[string]any) error {
+				// TODO Append token.Attributes
+				return profiles.ConsumeProfiles(ctx, p)
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &otlpjsonfilereceiver{replayer: r, id: settings.ID}, nil
+	}
 	opts := make([]fileconsumer.Option, 0)
 	if cfg.ReplayFile {
 		opts = append(opts, fileconsumer.WithNoTracking())
@@ -214,6 +214,10 @@ func testdataConfigYamlAsMap() *Config {
 				Exclude: []string{"/var/log/example.log"},
 			},
 		},
+		Replay: ReplayConfig{
+			Speed: 1,
+			Loops: 1,
+		},
 	}
 }
 
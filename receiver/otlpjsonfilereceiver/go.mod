@@ -18,6 +18,7 @@ require (
 
 require (
 	go.opentelemetry.io/collector/component/componenttest v0.141.0
+	go.opentelemetry.io/collector/confmap/xconfmap v0.141.0
 	go.opentelemetry.io/collector/consumer/consumertest v0.141.0
 	go.opentelemetry.io/collector/consumer/xconsumer v0.141.0
 	go.opentelemetry.io/collector/pdata/pprofile v0.141.0
@@ -0,0 +1,262 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpjsonfilereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/otlpjsonfilereceiver"
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/attrs"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/matcher"
+)
+
+// replaySignal adapts the replayer to the data of a signal.
+type replaySignal[T any] struct {
+	unmarshal func([]byte) (T, error)
+	// count returns the number of records of the data, which are rate limited
+	count func(T) int
+	// timestamp returns the earliest timestamp of the data, or 0 if it has none
+	timestamp func(T) pcommon.Timestamp
+	// shift adds a duration to all the timestamps of the data
+	shift   func(T, time.Duration)
+	consume func(context.Context, T, map[string]any) error
+}
+
+// replayer reads the files from the beginning, one line at a time, and emits their records
+// at their original pace or at a maximum rate.
+type replayer struct {
+	cfg        ReplayConfig
+	matcher    *matcher.Matcher
+	resolver   attrs.Resolver
+	maxLogSize int
+	logger     *zap.Logger
+	pacer      *pacer
+
+	// replayToken emits the data of a line, once it is due
+	replayToken func(ctx context.Context, token []byte, attributes map[string]any) error
+	// tokenTimestamp returns the earliest timestamp of the data of a line, or 0 if it has none
+	tokenTimestamp func(token []byte) pcommon.Timestamp
+	// offset is added to the timestamps of the records when rewriting them, it is computed once per loop
+	offset time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newReplayer[T any](cfg *Config, logger *zap.Logger, signal replaySignal[T]) (*replayer, error) {
+	m, err := matcher.New(cfg.Criteria)
+	if err != nil {
+		return nil, err
+	}
+	r := &replayer{
+		cfg:        cfg.Replay,
+		matcher:    m,
+		resolver:   cfg.Resolver,
+		maxLogSize: int(cfg.MaxLogSize),
+		logger:     logger,
+		pacer:      newPacer(cfg.Replay),
+	}
+	r.replayToken = func(ctx context.Context, token []byte, attributes map[string]any) error {
+		data, err := signal.unmarshal(token)
+		if err != nil {
+			return err
+		}
+		n := signal.count(data)
+		if n == 0 {
+			return nil
+		}
+		ts := signal.timestamp(data)
+		if err = sleep(ctx, r.pacer.delay(time.Now(), ts, n)); err != nil {
+			return err
+		}
+		if r.cfg.RewriteTimestamps && r.offset != 0 {
+			signal.shift(data, r.offset)
+		}
+		return signal.consume(ctx, data, attributes)
+	}
+	r.tokenTimestamp = func(token []byte) pcommon.Timestamp {
+		data, err := signal.unmarshal(token)
+		if err != nil {
+			return 0
+		}
+		return signal.timestamp(data)
+	}
+	return r, nil
+}
+
+func (r *replayer) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		r.run(ctx)
+	}()
+}
+
+func (r *replayer) stop() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+}
+
+func (r *replayer) run(ctx context.Context) {
+	for loop := 1; r.cfg.Loops == 0 || loop <= r.cfg.Loops; loop++ {
+		files, err := r.matcher.MatchFiles()
+		if err != nil {
+			r.logger.Warn("finding files", zap.Error(err))
+		}
+		if len(files) == 0 {
+			r.logger.Error("no files to replay, stopping the replay")
+			return
+		}
+
+		// Every loop replays the files as a new timeline.
+		r.pacer.reset()
+		if r.cfg.RewriteTimestamps {
+			r.offset = r.timestampOffset(ctx, files, time.Now())
+		}
+		for _, path := range files {
+			if err = r.replayFile(ctx, path); err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				r.logger.Error("failed to replay file", zap.String("path", path), zap.Error(err))
+			}
+		}
+		r.logger.Debug("replayed files", zap.Int("loop", loop), zap.Int("files", len(files)))
+	}
+	r.logger.Info("replay completed", zap.Int("loops", r.cfg.Loops))
+}
+
+// timestampOffset returns the duration between the start of the loop and the earliest timestamp of the files,
+// so that all the records are shifted by the same duration and keep their relative timing.
+func (r *replayer) timestampOffset(ctx context.Context, files []string, start time.Time) time.Duration {
+	var earliest pcommon.Timestamp
+	for _, path := range files {
+		file, err := os.Open(path)
+		if err != nil {
+			r.logger.Warn("failed to read file timestamps", zap.String("path", path), zap.Error(err))
+			continue
+		}
+		err = r.scanFile(ctx, file, func(_ int, token []byte) {
+			if ts := r.tokenTimestamp(token); ts != 0 && (earliest == 0 || ts < earliest) {
+				earliest = ts
+			}
+		})
+		file.Close()
+		if err != nil {
+			r.logger.Warn("failed to read file timestamps", zap.String("path", path), zap.Error(err))
+		}
+	}
+	if earliest == 0 {
+		return 0
+	}
+	return start.Sub(earliest.AsTime())
+}
+
+func (r *replayer) replayFile(ctx context.Context, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	attributes, err := r.resolver.Resolve(file)
+	if err != nil {
+		r.logger.Warn("resolving file attributes", zap.String("path", path), zap.Error(err))
+	}
+
+	return r.scanFile(ctx, file, func(line int, token []byte) {
+		if err := r.replayToken(ctx, token, attributes); err != nil && ctx.Err() == nil {
+			r.logger.Warn("failed to replay line", zap.String("path", path), zap.Int("line", line), zap.Error(err))
+		}
+	})
+}
+
+// scanFile calls fn with every non-empty line of the file, until the file is read or the context is done.
+func (r *replayer) scanFile(ctx context.Context, file *os.File, fn func(line int, token []byte)) error {
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, min(r.maxLogSize, bufio.MaxScanTokenSize)), r.maxLogSize)
+	for line := 1; scanner.Scan(); line++ {
+		token := bytes.TrimSpace(scanner.Bytes())
+		if len(token) == 0 {
+			continue
+		}
+		fn(line, token)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("reading file: %w", err)
+	}
+	return nil
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// pacer computes when the records are due, either from their original timing scaled by the speed,
+// or from the maximum rate of records.
+type pacer struct {
+	speed float64
+	rate  float64
+
+	// origin is the timestamp of the first record of the timeline, emitted at start
+	origin pcommon.Timestamp
+	start  time.Time
+	// next is the time the next records are due at the maximum rate
+	next time.Time
+}
+
+func newPacer(cfg ReplayConfig) *pacer {
+	return &pacer{speed: cfg.Speed, rate: cfg.MaxRecordsPerSecond}
+}
+
+// reset starts a new timeline.
+func (p *pacer) reset() {
+	p.origin = 0
+	p.start = time.Time{}
+	p.next = time.Time{}
+}
+
+// delay returns how long to wait from now before emitting n records with the given timestamp.
+// Records without timestamp, or older than the first record, are not delayed by the original timing.
+func (p *pacer) delay(now time.Time, ts pcommon.Timestamp, n int) time.Duration {
+	if p.rate > 0 {
+		d := max(p.next.Sub(now), 0)
+		p.next = now.Add(d + time.Duration(float64(n)/p.rate*float64(time.Second)))
+		return d
+	}
+	if ts == 0 {
+		return 0
+	}
+	if p.origin == 0 {
+		p.origin, p.start = ts, now
+		return 0
+	}
+	elapsed := time.Duration(float64(ts.AsTime().Sub(p.origin.AsTime())) / p.speed)
+	return max(p.start.Add(elapsed).Sub(now), 0)
+}
@@ -0,0 +1,247 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpjsonfilereceiver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/confmap/xconfmap"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/otlpjsonfilereceiver/internal/metadata"
+)
+
+func TestLoadReplayConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "replay").String())
+	require.NoError(t, err)
+	require.NoError(t, sub.Unmarshal(cfg))
+	require.NoError(t, xconfmap.Validate(cfg))
+	assert.Equal(t, ReplayConfig{
+		Enabled:           true,
+		Speed:             2.5,
+		RewriteTimestamps: true,
+		Loops:             0,
+	}, cfg.Replay)
+
+	cfg = NewFactory().CreateDefaultConfig().(*Config)
+	sub, err = cm.Sub(component.NewIDWithName(metadata.Type, "invalid_replay").String())
+	require.NoError(t, err)
+	require.NoError(t, sub.Unmarshal(cfg))
+	err = xconfmap.Validate(cfg)
+	assert.ErrorContains(t, err, "replay speed must be greater than 0")
+	assert.ErrorContains(t, err, "replay max_records_per_second must not be negative")
+}
+
+func TestPacerOriginalTiming(t *testing.T) {
+	p := newPacer(ReplayConfig{Speed: 2})
+	now := time.Unix(1000, 0)
+	origin := pcommon.NewTimestampFromTime(time.Unix(50, 0))
+
+	assert.Equal(t, time.Duration(0), p.delay(now, origin, 1))
+	// 10s later in the capture is due 5s later at twice the speed
+	assert.Equal(t, 5*time.Second, p.delay(now, origin+pcommon.Timestamp(10*time.Second), 1))
+	assert.Equal(t, 3*time.Second, p.delay(now.Add(2*time.Second), origin+pcommon.Timestamp(10*time.Second), 1))
+	// records without timestamp, or older than the first record, are not delayed
+	assert.Equal(t, time.Duration(0), p.delay(now, 0, 1))
+	assert.Equal(t, time.Duration(0), p.delay(now, origin-pcommon.Timestamp(time.Second), 1))
+
+	// a new timeline starts with the next record
+	p.reset()
+	assert.Equal(t, time.Duration(0), p.delay(now, origin+pcommon.Timestamp(10*time.Second), 1))
+}
+
+func TestPacerMaxRate(t *testing.T) {
+	p := newPacer(ReplayConfig{Speed: 1, MaxRecordsPerSecond: 10})
+	now := time.Unix(1000, 0)
+	ts := pcommon.NewTimestampFromTime(time.Unix(50, 0))
+
+	assert.Equal(t, time.Duration(0), p.delay(now, ts, 5))
+	// the original timing is ignored, the next records are due once the previous 5 records have been emitted
+	assert.Equal(t, 500*time.Millisecond, p.delay(now, ts+pcommon.Timestamp(time.Hour), 1))
+	assert.Equal(t, 400*time.Millisecond, p.delay(now.Add(200*time.Millisecond), ts, 1))
+	// the rate does not accumulate while no records are emitted
+	assert.Equal(t, time.Duration(0), p.delay(now.Add(time.Minute), ts, 1))
+}
+
+func TestShiftTimestamps(t *testing.T) {
+	base := pcommon.NewTimestampFromTime(time.Unix(100, 0))
+	shift := time.Hour
+	expected := pcommon.NewTimestampFromTime(time.Unix(100, 0).Add(shift))
+
+	ld := plog.NewLogs()
+	lrs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	lrs.AppendEmpty().SetObservedTimestamp(base + 10)
+	lr := lrs.AppendEmpty()
+	lr.SetTimestamp(base + 5)
+	lr.SetObservedTimestamp(base)
+	assert.Equal(t, base+5, logsTimestamp(ld))
+	shiftLogs(ld, shift)
+	assert.Equal(t, pcommon.Timestamp(0), lrs.At(0).Timestamp())
+	assert.Equal(t, expected+10, lrs.At(0).ObservedTimestamp())
+	assert.Equal(t, expected+5, lr.Timestamp())
+
+	td := ptrace.NewTraces()
+	span := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetStartTimestamp(base)
+	span.SetEndTimestamp(base + 10)
+	span.Events().AppendEmpty().SetTimestamp(base + 5)
+	assert.Equal(t, base, tracesTimestamp(td))
+	shiftTraces(td, shift)
+	assert.Equal(t, expected, span.StartTimestamp())
+	assert.Equal(t, expected+10, span.EndTimestamp())
+	assert.Equal(t, expected+5, span.Events().At(0).Timestamp())
+
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	sum := metrics.AppendEmpty().SetEmptySum().DataPoints().AppendEmpty()
+	sum.SetStartTimestamp(base)
+	sum.SetTimestamp(base + 20)
+	histogram := metrics.AppendEmpty().SetEmptyHistogram().DataPoints().AppendEmpty()
+	histogram.SetTimestamp(base + 10)
+	histogram.Exemplars().AppendEmpty().SetTimestamp(base + 5)
+	assert.Equal(t, base+10, metricsTimestamp(md))
+	shiftMetrics(md, shift)
+	assert.Equal(t, expected, sum.StartTimestamp())
+	assert.Equal(t, expected+20, sum.Timestamp())
+	assert.Equal(t, pcommon.Timestamp(0), histogram.StartTimestamp())
+	assert.Equal(t, expected+10, histogram.Timestamp())
+	assert.Equal(t, expected+5, histogram.Exemplars().At(0).Timestamp())
+}
+
+func TestReplayLogs(t *testing.T) {
+	tempFolder := t.TempDir()
+	marshaler := &plog.JSONMarshaler{}
+	var b []byte
+	for i := range 3 {
+		ld := plog.NewLogs()
+		lr := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+		lr.SetTimestamp(pcommon.NewTimestampFromTime(time.Unix(100, 0).Add(time.Duration(i) * 100 * time.Millisecond)))
+		lr.Body().SetInt(int64(i))
+		line, err := marshaler.MarshalLogs(ld)
+		require.NoError(t, err)
+		b = append(append(b, line...), '\n', '\n')
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(tempFolder, "logs.json"), b, 0o600))
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Include = []string{filepath.Join(tempFolder, "*")}
+	cfg.Replay = ReplayConfig{
+		Enabled:           true,
+		Speed:             2,
+		RewriteTimestamps: true,
+		Loops:             2,
+	}
+	sink := new(consumertest.LogsSink)
+	receiver, err := NewFactory().CreateLogs(t.Context(), receivertest.NewNopSettings(metadata.Type), cfg, sink)
+	require.NoError(t, err)
+
+	start := time.Now()
+	require.NoError(t, receiver.Start(t.Context(), componenttest.NewNopHost()))
+	require.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 6
+	}, 5*time.Second, 10*time.Millisecond)
+	// each loop takes 100ms at twice the original speed
+	assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+	require.NoError(t, receiver.Shutdown(t.Context()))
+
+	for i, ld := range sink.AllLogs() {
+		lr := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+		assert.Equal(t, int64(i%3), lr.Body().Int())
+		assert.Equal(t, "logs.json", lr.Attributes().AsRaw()["log.file.name"])
+		assert.WithinDuration(t, time.Now(), lr.Timestamp().AsTime(), 5*time.Second)
+	}
+}
+
+func TestReplayRewriteTimestamps(t *testing.T) {
+	tempFolder := t.TempDir()
+	marshaler := &plog.JSONMarshaler{}
+	var b []byte
+	// the lines are not in timestamp order, the earliest timestamp is on the second line
+	for _, offset := range []time.Duration{10 * time.Second, 0, 30 * time.Second} {
+		ld := plog.NewLogs()
+		lr := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+		lr.SetTimestamp(pcommon.NewTimestampFromTime(time.Unix(100, 0).Add(offset)))
+		line, err := marshaler.MarshalLogs(ld)
+		require.NoError(t, err)
+		b = append(append(b, line...), '\n')
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(tempFolder, "logs.json"), b, 0o600))
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Include = []string{filepath.Join(tempFolder, "*")}
+	cfg.Replay = ReplayConfig{
+		Enabled:             true,
+		Speed:               1,
+		MaxRecordsPerSecond: 100,
+		RewriteTimestamps:   true,
+		Loops:               1,
+	}
+	sink := new(consumertest.LogsSink)
+	receiver, err := NewFactory().CreateLogs(t.Context(), receivertest.NewNopSettings(metadata.Type), cfg, sink)
+	require.NoError(t, err)
+
+	start := time.Now()
+	require.NoError(t, receiver.Start(t.Context(), componenttest.NewNopHost()))
+	require.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 3
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, receiver.Shutdown(t.Context()))
+
+	var timestamps []pcommon.Timestamp
+	for _, ld := range sink.AllLogs() {
+		timestamps = append(timestamps, ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Timestamp())
+	}
+	// all the records are shifted by the same duration, even though the rate ignores their original timing
+	assert.WithinDuration(t, start, timestamps[1].AsTime(), time.Second)
+	assert.Equal(t, 10*time.Second, timestamps[0].AsTime().Sub(timestamps[1].AsTime()))
+	assert.Equal(t, 30*time.Second, timestamps[2].AsTime().Sub(timestamps[1].AsTime()))
+}
+
+func TestReplayShutdown(t *testing.T) {
+	tempFolder := t.TempDir()
+	marshaler := &ptrace.JSONMarshaler{}
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	b, err := marshaler.MarshalTraces(td)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(tempFolder, "traces.json"), append(b, '\n'), 0o600))
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Include = []string{filepath.Join(tempFolder, "*")}
+	cfg.Replay = ReplayConfig{
+		Enabled:             true,
+		Speed:               1,
+		MaxRecordsPerSecond: 20,
+	}
+	sink := new(consumertest.TracesSink)
+	receiver, err := NewFactory().CreateTraces(t.Context(), receivertest.NewNopSettings(metadata.Type), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, receiver.Start(t.Context(), componenttest.NewNopHost()))
+
+	// the file is replayed until the receiver is shut down, at 20 spans per second
+	require.Eventually(t, func() bool {
+		return sink.SpanCount() >= 3
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, receiver.Shutdown(t.Context()))
+	count := sink.SpanCount()
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, count, sink.SpanCount())
+}
@@ -0,0 +1,178 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpjsonfilereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/otlpjsonfilereceiver"
+
+import (
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// earliest returns the earliest of two timestamps, ignoring unset timestamps.
+func earliest(a, b pcommon.Timestamp) pcommon.Timestamp {
+	if a == 0 || (b != 0 && b < a) {
+		return b
+	}
+	return a
+}
+
+// shifted returns the timestamp shifted by d, unset timestamps stay unset.
+func shifted(ts pcommon.Timestamp, d time.Duration) pcommon.Timestamp {
+	if ts == 0 {
+		return 0
+	}
+	return pcommon.NewTimestampFromTime(ts.AsTime().Add(d))
+}
+
+func logsTimestamp(ld plog.Logs) pcommon.Timestamp {
+	var ts pcommon.Timestamp
+	for _, rl := range ld.ResourceLogs().All() {
+		for _, sl := range rl.ScopeLogs().All() {
+			for _, lr := range sl.LogRecords().All() {
+				if lr.Timestamp() != 0 {
+					ts = earliest(ts, lr.Timestamp())
+				} else {
+					ts = earliest(ts, lr.ObservedTimestamp())
+				}
+			}
+		}
+	}
+	return ts
+}
+
+func shiftLogs(ld plog.Logs, d time.Duration) {
+	for _, rl := range ld.ResourceLogs().All() {
+		for _, sl := range rl.ScopeLogs().All() {
+			for _, lr := range sl.LogRecords().All() {
+				lr.SetTimestamp(shifted(lr.Timestamp(), d))
+				lr.SetObservedTimestamp(shifted(lr.ObservedTimestamp(), d))
+			}
+		}
+	}
+}
+
+func tracesTimestamp(td ptrace.Traces) pcommon.Timestamp {
+	var ts pcommon.Timestamp
+	for _, rs := range td.ResourceSpans().All() {
+		for _, ss := range rs.ScopeSpans().All() {
+			for _, span := range ss.Spans().All() {
+				ts = earliest(ts, span.StartTimestamp())
+			}
+		}
+	}
+	return ts
+}
+
+func shiftTraces(td ptrace.Traces, d time.Duration) {
+	for _, rs := range td.ResourceSpans().All() {
+		for _, ss := range rs.ScopeSpans().All() {
+			for _, span := range ss.Spans().All() {
+				span.SetStartTimestamp(shifted(span.StartTimestamp(), d))
+				span.SetEndTimestamp(shifted(span.EndTimestamp(), d))
+				for _, event := range span.Events().All() {
+					event.SetTimestamp(shifted(event.Timestamp(), d))
+				}
+			}
+		}
+	}
+}
+
+// dataPoint is implemented by the data points of all the metric types.
+type dataPoint interface {
+	Timestamp() pcommon.Timestamp
+	SetTimestamp(pcommon.Timestamp)
+	StartTimestamp() pcommon.Timestamp
+	SetStartTimestamp(pcommon.Timestamp)
+}
+
+// exemplarDataPoint is implemented by the data points with exemplars.
+type exemplarDataPoint interface {
+	Exemplars() pmetric.ExemplarSlice
+}
+
+// rangeDataPoints calls f for every data point of the metrics.
+func rangeDataPoints(md pmetric.Metrics, f func(dataPoint)) {
+	for _, rm := range md.ResourceMetrics().All() {
+		for _, sm := range rm.ScopeMetrics().All() {
+			for _, m := range sm.Metrics().All() {
+				switch m.Type() {
+				case pmetric.MetricTypeGauge:
+					for _, dp := range m.Gauge().DataPoints().All() {
+						f(dp)
+					}
+				case pmetric.MetricTypeSum:
+					for _, dp := range m.Sum().DataPoints().All() {
+						f(dp)
+					}
+				case pmetric.MetricTypeHistogram:
+					for _, dp := range m.Histogram().DataPoints().All() {
+						f(dp)
+					}
+				case pmetric.MetricTypeExponentialHistogram:
+					for _, dp := range m.ExponentialHistogram().DataPoints().All() {
+						f(dp)
+					}
+				case pmetric.MetricTypeSummary:
+					for _, dp := range m.Summary().DataPoints().All() {
+						f(dp)
+					}
+				}
+			}
+		}
+	}
+}
+
+func metricsTimestamp(md pmetric.Metrics) pcommon.Timestamp {
+	var ts pcommon.Timestamp
+	rangeDataPoints(md, func(dp dataPoint) {
+		ts = earliest(ts, dp.Timestamp())
+	})
+	return ts
+}
+
+func shiftMetrics(md pmetric.Metrics, d time.Duration) {
+	rangeDataPoints(md, func(dp dataPoint) {
+		dp.SetTimestamp(shifted(dp.Timestamp(), d))
+		dp.SetStartTimestamp(shifted(dp.StartTimestamp(), d))
+		if edp, ok := dp.(exemplarDataPoint); ok {
+			for _, exemplar := range edp.Exemplars().All() {
+				exemplar.SetTimestamp(shifted(exemplar.Timestamp(), d))
+			}
+		}
+	})
+}
+
+func profilesTimestamp(pd pprofile.Profiles) pcommon.Timestamp {
+	var ts pcommon.Timestamp
+	for _, rp := range pd.ResourceProfiles().All() {
+		for _, sp := range rp.ScopeProfiles().All() {
+			for _, profile := range sp.Profiles().All() {
+				ts = earliest(ts, profile.Time())
+			}
+		}
+	}
+	return ts
+}
+
+func shiftProfiles(pd pprofile.Profiles, d time.Duration) {
+	for _, rp := range pd.ResourceProfiles().All() {
+		for _, sp := range rp.ScopeProfiles().All() {
+			for _, profile := range sp.Profiles().All() {
+				profile.SetTime(shifted(profile.Time(), d))
+				for _, sample := range profile.Samples().All() {
+					timestamps := sample.TimestampsUnixNano()
+					for i := 0; i < timestamps.Len(); i++ {
+						if timestamps.At(i) != 0 {
+							timestamps.SetAt(i, uint64(shifted(pcommon.Timestamp(timestamps.At(i)), d)))
+						}
+					}
+				}
+			}
+		}
+	}
+}
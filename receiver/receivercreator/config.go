@@ -93,6 +93,23 @@ type DiscoveryConfig struct {
 	Enabled            bool              `mapstructure:"enabled"`
 	IgnoreReceivers    []string          `mapstructure:"ignore_receivers"`
 	DefaultAnnotations map[string]string `mapstructure:"default_annotations"`
+	// Templates are named receiver templates that Pod annotations can reference with the
+	// `template` hint instead of configuring the receiver from scratch.
+	Templates map[string]DiscoveryTemplate `mapstructure:"templates"`
+
+	receiverTemplates map[string]receiverTemplate
+}
+
+// DiscoveryTemplate is a named receiver template for annotation based discovery.
+type DiscoveryTemplate struct {
+	// Receiver is the full name of the receiver to create (ie <receiver type>[/<name>]).
+	Receiver string `mapstructure:"receiver"`
+	// Config is the base configuration of the receiver. The configuration provided through
+	// the `config` hint is merged on top of it.
+	Config map[string]any `mapstructure:"config"`
+	// ResourceAttributes is a map of resource attributes to add to the created receiver's telemetry.
+	// It can contain expr expressions for endpoint env value expansion.
+	ResourceAttributes map[string]any `mapstructure:"resource_attributes"`
 }
 
 func (cfg *Config) Unmarshal(componentParser *confmap.Conf) error {
@@ -107,7 +124,7 @@ func (cfg *Config) Unmarshal(componentParser *confmap.Conf) error {
 
 	for endpointType := range cfg.ResourceAttributes {
 		switch endpointType {
-		case observer.ContainerType, observer.K8sServiceType, observer.K8sIngressType, observer.HostPortType, observer.K8sNodeType, observer.PodType, observer.PortType, observer.PodContainerType, observer.KafkaTopicType:
+		case observer.ContainerType, observer.K8sServiceType, observer.K8sIngressType, observer.HostPortType, observer.K8sNodeType, observer.PodType, observer.PortType, observer.PodContainerType, observer.KafkaTopicType, observer.ProcessType:
 		default:
 			return fmt.Errorf("resource attributes for unsupported endpoint type %q", endpointType)
 		}
@@ -148,5 +165,22 @@ func (cfg *Config) Unmarshal(componentParser *confmap.Conf) error {
 		cfg.receiverTemplates[subreceiverKey] = subreceiver
 	}
 
+	if len(cfg.Discovery.Templates) > 0 {
+		cfg.Discovery.receiverTemplates = make(map[string]receiverTemplate, len(cfg.Discovery.Templates))
+	}
+	for name, template := range cfg.Discovery.Templates {
+		subreceiver, err := newReceiverTemplate(template.Receiver, template.Config)
+		if err != nil {
+			return fmt.Errorf("discovery template %q receiver is invalid: %w", name, err)
+		}
+		for k, v := range template.ResourceAttributes {
+			if _, ok := v.(string); !ok {
+				return fmt.Errorf("unsupported `resource_attributes` %q value %v in discovery template %q", k, v, name)
+			}
+		}
+		subreceiver.ResourceAttributes = template.ResourceAttributes
+		cfg.Discovery.receiverTemplates[name] = subreceiver
+	}
+
 	return nil
 }
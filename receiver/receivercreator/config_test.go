@@ -121,9 +121,38 @@ func TestLoadConfig(t *testing.T) {
 					observer.K8sIngressType:   {"k8s.ingress.key": "k8s.ingress.value"},
 					observer.K8sNodeType:      {"k8s.node.key": "k8s.node.value"},
 					observer.KafkaTopicType:   {},
+					observer.ProcessType:      {"process.key": "process.value"},
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "discovery"),
+			expected: func() *Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.Discovery = DiscoveryConfig{
+					Enabled: true,
+					Templates: map[string]DiscoveryTemplate{
+						"redis-default": {
+							Receiver:           "redis/default",
+							Config:             map[string]any{"collection_interval": "20s"},
+							ResourceAttributes: map[string]any{"platform.template": "redis-default"},
+						},
+					},
+					receiverTemplates: map[string]receiverTemplate{
+						"redis-default": {
+							receiverConfig: receiverConfig{
+								id:         component.MustNewIDWithName("redis", "default"),
+								config:     userConfigMap{"collection_interval": "20s"},
+								endpointID: "endpoint.id",
+							},
+							ResourceAttributes: map[string]any{"platform.template": "redis-default"},
+							signals:            receiverSignals{metrics: true, logs: true, traces: true},
+						},
+					},
+				}
+				return cfg
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -167,6 +196,17 @@ func TestInvalidReceiverResourceAttributeValueType(t *testing.T) {
 	require.Nil(t, cfg)
 }
 
+func TestInvalidDiscoveryTemplate(t *testing.T) {
+	factories, err := otelcoltest.NopFactories()
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Receivers[metadata.Type] = factory
+	cfg, err := otelcoltest.LoadConfigAndValidate(filepath.Join("testdata", "invalid-discovery-template.yaml"), factories)
+	require.ErrorContains(t, err, "discovery template \"broken\" receiver is invalid")
+	require.Nil(t, cfg)
+}
+
 type nopWithEndpointConfig struct {
 	Endpoint string `mapstructure:"endpoint"`
 	IntField int    `mapstructure:"int_field"`
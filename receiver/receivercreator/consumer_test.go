@@ -30,6 +30,8 @@ func TestNewEnhancingConsumer(t *testing.T) {
 	require.NoError(t, err)
 	cntrEnv, err := containerEndpoint.Env()
 	require.NoError(t, err)
+	processEnv, err := processEndpoint.Env()
+	require.NoError(t, err)
 
 	cfg := createDefaultConfig().(*Config)
 	type args struct {
@@ -112,6 +114,29 @@ func TestNewEnhancingConsumer(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "process endpoint",
+			args: args{
+				resources:   cfg.ResourceAttributes,
+				env:         processEnv,
+				endpoint:    processEndpoint,
+				nextLogs:    nil,
+				nextMetrics: &consumertest.MetricsSink{},
+				nextTraces:  nil,
+			},
+			want: &enhancingConsumer{
+				logs:    nil,
+				metrics: &consumertest.MetricsSink{},
+				traces:  nil,
+				attrs: map[string]string{
+					"process.pid":             "1234",
+					"process.executable.name": "redis-server",
+					"process.executable.path": "/usr/bin/redis-server",
+					"process.command_line":    "/usr/bin/redis-server 127.0.0.1:6379",
+					"process.owner":           "redis",
+				},
+			},
+		},
 		{
 			// If the configured attribute value is empty it should not touch that
 			// attribute.
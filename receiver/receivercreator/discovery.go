@@ -25,11 +25,13 @@ const (
 	// hint suffix for metrics
 	otelMetricsHints = otelHints + ".metrics"
 	otelLogsHints    = otelHints + ".logs"
+	otelTracesHints  = otelHints + ".traces"
 
 	// hints definitions
 	discoveryEnabledHint = "enabled"
 	scraperHint          = "scraper"
 	configHint           = "config"
+	templateHint         = "template"
 
 	logsReceiver          = "filelog"
 	defaultLogPathPattern = "/var/log/pods/%s_%s_%s/%s/*.log"
@@ -40,6 +42,7 @@ type k8sHintsBuilder struct {
 	logger             *zap.Logger
 	ignoreReceivers    map[string]bool
 	defaultAnnotations map[string]string
+	templates          map[string]receiverTemplate
 }
 
 func createK8sHintsBuilder(config DiscoveryConfig, logger *zap.Logger) k8sHintsBuilder {
@@ -51,14 +54,15 @@ func createK8sHintsBuilder(config DiscoveryConfig, logger *zap.Logger) k8sHintsB
 		logger:             logger,
 		ignoreReceivers:    ignoreReceivers,
 		defaultAnnotations: config.DefaultAnnotations,
+		templates:          config.receiverTemplates,
 	}
 }
 
-// createReceiverTemplateFromHints creates a receiver configuration based on the provided hints.
+// createReceiverTemplatesFromHints creates receiver configurations based on the provided hints.
 // Hints are extracted from Pod's annotations.
-// Scraper configurations are only created for Port Endpoints.
+// Scraper and traces receiver configurations are only created for Port Endpoints.
 // Log receiver configurations are only created for Pod Container Endpoints.
-func (builder *k8sHintsBuilder) createReceiverTemplateFromHints(env observer.EndpointEnv) (*receiverTemplate, error) {
+func (builder *k8sHintsBuilder) createReceiverTemplatesFromHints(env observer.EndpointEnv) ([]receiverTemplate, error) {
 	var pod observer.Pod
 
 	endpointType := getStringEnv(env, "type")
@@ -82,14 +86,76 @@ func (builder *k8sHintsBuilder) createReceiverTemplateFromHints(env observer.End
 	}
 
 	annotations := mergeAnnotations(pod.Annotations, builder.defaultAnnotations)
+	var templates []receiverTemplate
 	switch endpointType {
 	case string(observer.PortType):
-		return builder.createScraper(annotations, env)
+		scraper, err := builder.createScraper(annotations, env)
+		if err != nil {
+			return nil, err
+		}
+		traces, err := builder.createTracesReceiver(annotations, env)
+		if err != nil {
+			return nil, err
+		}
+		templates = appendTemplates(templates, scraper, traces)
 	case string(observer.PodContainerType):
-		return builder.createLogsReceiver(annotations, env)
-	default:
+		logs, err := builder.createLogsReceiver(annotations, env)
+		if err != nil {
+			return nil, err
+		}
+		templates = appendTemplates(templates, logs)
+	}
+	return templates, nil
+}
+
+func appendTemplates(templates []receiverTemplate, toAppend ...*receiverTemplate) []receiverTemplate {
+	for _, t := range toAppend {
+		if t != nil {
+			templates = append(templates, *t)
+		}
+	}
+	return templates
+}
+
+// getTemplateFromHints returns the named template referenced by the template hint. It returns nil if
+// there is no such hint and an error if the hint references an unknown template.
+func (builder *k8sHintsBuilder) getTemplateFromHints(annotations map[string]string, hintBase, scopeSuffix string) (*receiverTemplate, error) {
+	name, found := getHintAnnotation(annotations, hintBase, templateHint, scopeSuffix)
+	if !found || name == "" {
 		return nil, nil
 	}
+	template, ok := builder.templates[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown discovery template %q", name)
+	}
+	return &template, nil
+}
+
+// newHintedReceiverTemplate creates the receiver template of a hinted receiver. The receiver is named after
+// the referenced discovery template, if any, and suffixed to be unique for the target endpoint.
+func newHintedReceiverTemplate(base *receiverTemplate, receiverType, suffix string, conf userConfigMap, signals receiverSignals) (*receiverTemplate, error) {
+	name := fmt.Sprintf("%v/%v", receiverType, suffix)
+	if base != nil && base.id.Name() != "" {
+		name = fmt.Sprintf("%v/%v/%v", receiverType, base.id.Name(), suffix)
+	}
+	recTemplate, err := newReceiverTemplate(name, conf)
+	if err != nil {
+		return nil, err
+	}
+	recTemplate.signals = signals
+	if base != nil {
+		recTemplate.ResourceAttributes = base.ResourceAttributes
+	}
+	return &recTemplate, nil
+}
+
+// mergeConfigs merges the user provided configuration on top of the base configuration.
+func mergeConfigs(base, user userConfigMap) (userConfigMap, error) {
+	conf := confmap.NewFromStringMap(base)
+	if err := conf.Merge(confmap.NewFromStringMap(user)); err != nil {
+		return nil, err
+	}
+	return conf.ToStringMap(), nil
 }
 
 func (builder *k8sHintsBuilder) createScraper(
@@ -112,10 +178,20 @@ func (builder *k8sHintsBuilder) createScraper(
 		return nil, nil
 	}
 
-	subreceiverKey, found := getHintAnnotation(annotations, otelMetricsHints, scraperHint, fmt.Sprint(port))
-	if !found || subreceiverKey == "" {
-		// no scraper hint detected
-		return nil, nil
+	base, err := builder.getTemplateFromHints(annotations, otelMetricsHints, fmt.Sprint(port))
+	if err != nil {
+		return nil, err
+	}
+	var subreceiverKey string
+	if base != nil {
+		subreceiverKey = base.id.Type().String()
+	} else {
+		var found bool
+		subreceiverKey, found = getHintAnnotation(annotations, otelMetricsHints, scraperHint, fmt.Sprint(port))
+		if !found || subreceiverKey == "" {
+			// no scraper hint detected
+			return nil, nil
+		}
 	}
 	if _, ok := builder.ignoreReceivers[subreceiverKey]; ok {
 		// scraper is ignored
@@ -124,26 +200,71 @@ func (builder *k8sHintsBuilder) createScraper(
 	builder.logger.Debug("handling added hinted receiver", zap.Any("subreceiverKey", subreceiverKey))
 
 	defaultEndpoint := getStringEnv(env, endpointConfigKey)
-	userConfMap, err := getScraperConfFromAnnotations(annotations, defaultEndpoint, fmt.Sprint(port), builder.logger)
+	userConfMap, err := getConfFromAnnotations(annotations, otelMetricsHints, defaultEndpoint, fmt.Sprint(port), builder.logger)
 	if err != nil {
 		return nil, fmt.Errorf("could not create receiver configuration: %v", zap.Error(err))
 	}
+	if base != nil {
+		if userConfMap, err = mergeConfigs(base.config, userConfMap); err != nil {
+			return nil, fmt.Errorf("could not merge template configuration: %w", err)
+		}
+	}
 
-	recTemplate, err := newReceiverTemplate(fmt.Sprintf("%v/%v_%v", subreceiverKey, pod.UID, port), userConfMap)
-	recTemplate.signals = receiverSignals{metrics: true, logs: false, traces: false}
-
-	return &recTemplate, err
+	return newHintedReceiverTemplate(base, subreceiverKey, fmt.Sprintf("%v_%v", pod.UID, port), userConfMap,
+		receiverSignals{metrics: true, logs: false, traces: false})
 }
 
-func (builder *k8sHintsBuilder) createLogsReceiver(
+// createTracesReceiver creates a traces receiver for a Port Endpoint. Unlike scrapers, traces receivers
+// can only be created from a named discovery template.
+func (builder *k8sHintsBuilder) createTracesReceiver(
 	annotations map[string]string,
 	env observer.EndpointEnv,
 ) (*receiverTemplate, error) {
-	if _, ok := builder.ignoreReceivers[logsReceiver]; ok {
+	var p observer.Port
+	if err := mapstructure.Decode(env, &p); err != nil {
+		return nil, fmt.Errorf("could not extract port event: %v", zap.Any("env", env))
+	}
+	if p.Port == 0 {
+		return nil, fmt.Errorf("could not extract port: %v", zap.Any("env", env))
+	}
+	scopeSuffix := fmt.Sprint(p.Port)
+
+	if !discoveryEnabled(annotations, otelTracesHints, scopeSuffix) {
+		return nil, nil
+	}
+
+	base, err := builder.getTemplateFromHints(annotations, otelTracesHints, scopeSuffix)
+	if err != nil {
+		return nil, err
+	}
+	if base == nil {
+		builder.logger.Debug("traces discovery requires a template hint", zap.String("port", scopeSuffix))
+		return nil, nil
+	}
+	subreceiverKey := base.id.Type().String()
+	if _, ok := builder.ignoreReceivers[subreceiverKey]; ok {
 		// receiver is ignored
 		return nil, nil
 	}
+	builder.logger.Debug("handling added hinted receiver", zap.Any("subreceiverKey", subreceiverKey))
+
+	defaultEndpoint := getStringEnv(env, endpointConfigKey)
+	userConfMap, err := getConfFromAnnotations(annotations, otelTracesHints, defaultEndpoint, scopeSuffix, builder.logger)
+	if err != nil {
+		return nil, fmt.Errorf("could not create receiver configuration: %v", zap.Error(err))
+	}
+	if userConfMap, err = mergeConfigs(base.config, userConfMap); err != nil {
+		return nil, fmt.Errorf("could not merge template configuration: %w", err)
+	}
 
+	return newHintedReceiverTemplate(base, subreceiverKey, fmt.Sprintf("%v_%v", p.Pod.UID, p.Port), userConfMap,
+		receiverSignals{metrics: false, logs: false, traces: true})
+}
+
+func (builder *k8sHintsBuilder) createLogsReceiver(
+	annotations map[string]string,
+	env observer.EndpointEnv,
+) (*receiverTemplate, error) {
 	var containerName string
 	var c observer.PodContainer
 	err := mapstructure.Decode(env, &c)
@@ -160,29 +281,55 @@ func (builder *k8sHintsBuilder) createLogsReceiver(
 		return nil, nil
 	}
 
+	base, err := builder.getTemplateFromHints(annotations, otelLogsHints, containerName)
+	if err != nil {
+		return nil, err
+	}
 	subreceiverKey := logsReceiver
+	if base != nil {
+		subreceiverKey = base.id.Type().String()
+	}
+	if _, ok := builder.ignoreReceivers[subreceiverKey]; ok {
+		// receiver is ignored
+		return nil, nil
+	}
 	builder.logger.Debug("handling added hinted receiver", zap.Any("subreceiverKey", subreceiverKey))
 
-	userConfMap := createLogsConfig(
-		annotations,
-		containerName,
-		pod.UID,
-		pod.Name,
-		pod.Namespace,
-		builder.logger)
-
-	recTemplate, err := newReceiverTemplate(fmt.Sprintf("%v/%v_%v", subreceiverKey, pod.UID, containerName), userConfMap)
-	recTemplate.signals = receiverSignals{metrics: false, logs: true, traces: false}
+	var userConfMap userConfigMap
+	if subreceiverKey == logsReceiver {
+		var templateConf userConfigMap
+		if base != nil {
+			templateConf = base.config
+		}
+		userConfMap = createLogsConfig(
+			annotations,
+			templateConf,
+			containerName,
+			pod.UID,
+			pod.Name,
+			pod.Namespace,
+			builder.logger)
+	} else {
+		// The filelog defaults don't apply to other receivers.
+		defaultEndpoint := getStringEnv(env, endpointConfigKey)
+		if userConfMap, err = getConfFromAnnotations(annotations, otelLogsHints, defaultEndpoint, containerName, builder.logger); err != nil {
+			return nil, fmt.Errorf("could not create receiver configuration: %v", zap.Error(err))
+		}
+		if userConfMap, err = mergeConfigs(base.config, userConfMap); err != nil {
+			return nil, fmt.Errorf("could not merge template configuration: %w", err)
+		}
+	}
 
-	return &recTemplate, err
+	return newHintedReceiverTemplate(base, subreceiverKey, fmt.Sprintf("%v_%v", pod.UID, containerName), userConfMap,
+		receiverSignals{metrics: false, logs: true, traces: false})
 }
 
-func getScraperConfFromAnnotations(
+func getConfFromAnnotations(
 	annotations map[string]string,
-	defaultEndpoint, scopeSuffix string,
+	hintBase, defaultEndpoint, scopeSuffix string,
 	logger *zap.Logger,
 ) (userConfigMap, error) {
-	configStr, found := getHintAnnotation(annotations, otelMetricsHints, configHint, scopeSuffix)
+	configStr, found := getHintAnnotation(annotations, hintBase, configHint, scopeSuffix)
 	if !found || configStr == "" {
 		// defaultEndpoint will be added properly later in observerHandler.startReceiver method
 		return userConfigMap{}, nil
@@ -221,6 +368,7 @@ func getScraperConfFromAnnotations(
 
 func createLogsConfig(
 	annotations map[string]string,
+	templateConf userConfigMap,
 	containerName, podUID, podName, namespace string,
 	logger *zap.Logger,
 ) userConfigMap {
@@ -234,6 +382,15 @@ func createLogsConfig(
 		"operators":         cont,
 	}
 
+	for k, v := range templateConf {
+		if k == "include" {
+			// path cannot be other than the one of the target container
+			logger.Warn("include setting cannot be set through discovery templates")
+			continue
+		}
+		defaultConfMap[k] = v
+	}
+
 	configStr, found := getHintAnnotation(annotations, otelLogsHints, configHint, scopeSuffix)
 	if !found || configStr == "" {
 		return defaultConfMap
@@ -218,19 +218,21 @@ endpoint: 1.2.3.4:6379`
 			builder := createK8sHintsBuilder(DiscoveryConfig{Enabled: true, IgnoreReceivers: test.ignoreReceivers}, logger)
 			env, err := test.inputEndpoint.Env()
 			require.NoError(t, err)
-			subreceiverTemplate, err := builder.createReceiverTemplateFromHints(env)
-			if subreceiverTemplate == nil {
-				require.Equal(t, receiverTemplate{}, test.expectedReceiver)
+			subreceiverTemplates, err := builder.createReceiverTemplatesFromHints(env)
+			if test.wantError {
+				require.Error(t, err)
 				return
 			}
-			if !test.wantError {
-				require.NoError(t, err)
-				require.Equal(t, subreceiverTemplate.config, test.expectedReceiver.config)
-				require.Equal(t, subreceiverTemplate.signals, test.expectedReceiver.signals)
-				require.Equal(t, subreceiverTemplate.id, test.expectedReceiver.id)
-			} else {
-				require.Error(t, err)
+			require.NoError(t, err)
+			if len(subreceiverTemplates) == 0 {
+				require.Equal(t, receiverTemplate{}, test.expectedReceiver)
+				return
 			}
+			require.Len(t, subreceiverTemplates, 1)
+			subreceiverTemplate := subreceiverTemplates[0]
+			require.Equal(t, subreceiverTemplate.config, test.expectedReceiver.config)
+			require.Equal(t, subreceiverTemplate.signals, test.expectedReceiver.signals)
+			require.Equal(t, subreceiverTemplate.id, test.expectedReceiver.id)
 		})
 	}
 }
@@ -588,18 +590,180 @@ include:
 				logger)
 			env, err := test.inputEndpoint.Env()
 			require.NoError(t, err)
-			subreceiverTemplate, err := builder.createReceiverTemplateFromHints(env)
-			if subreceiverTemplate == nil {
+			subreceiverTemplates, err := builder.createReceiverTemplatesFromHints(env)
+			if test.wantError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if len(subreceiverTemplates) == 0 {
 				require.Equal(t, receiverTemplate{}, test.expectedReceiver)
 				return
 			}
-			if !test.wantError {
-				require.NoError(t, err)
-				require.Equal(t, subreceiverTemplate.config, test.expectedReceiver.config)
-				require.Equal(t, subreceiverTemplate.signals, test.expectedReceiver.signals)
-				require.Equal(t, subreceiverTemplate.id, test.expectedReceiver.id)
-			} else {
-				require.Error(t, err)
+			require.Len(t, subreceiverTemplates, 1)
+			subreceiverTemplate := subreceiverTemplates[0]
+			require.Equal(t, subreceiverTemplate.config, test.expectedReceiver.config)
+			require.Equal(t, subreceiverTemplate.signals, test.expectedReceiver.signals)
+			require.Equal(t, subreceiverTemplate.id, test.expectedReceiver.id)
+		})
+	}
+}
+
+func TestK8sHintsBuilderTemplates(t *testing.T) {
+	logger := zaptest.NewLogger(t, zaptest.Level(zap.InfoLevel))
+
+	templates := map[string]receiverTemplate{}
+	for name, def := range map[string]struct {
+		receiver string
+		config   userConfigMap
+	}{
+		"redis-default": {receiver: "redis", config: userConfigMap{"collection_interval": "10s", "timeout": "5s"}},
+		"otlp-grpc":     {receiver: "otlp/grpc", config: userConfigMap{"protocols": map[string]any{"grpc": map[string]any{}}}},
+		"java-logs":     {receiver: "filelog", config: userConfigMap{"multiline": map[string]any{"line_start_pattern": "^\\d{4}"}, "include": "/etc/passwd"}},
+		"journald":      {receiver: "journald", config: userConfigMap{"units": []any{"kubelet"}}},
+	} {
+		template, err := newReceiverTemplate(def.receiver, def.config)
+		require.NoError(t, err)
+		template.ResourceAttributes = map[string]any{"platform.template": name}
+		templates[name] = template
+	}
+
+	port := func(annotations map[string]string) observer.Endpoint {
+		return observer.Endpoint{
+			ID:     "namespace/pod-2-UID/redis(6379)",
+			Target: "1.2.3.4:6379",
+			Details: &observer.Port{
+				Name:      "redis",
+				Pod:       observer.Pod{Name: "pod-2", Namespace: "default", UID: "pod-2-UID", Annotations: annotations},
+				Port:      6379,
+				Transport: observer.ProtocolTCP,
+			},
+		}
+	}
+	container := func(annotations map[string]string) observer.Endpoint {
+		return observer.Endpoint{
+			ID:     "namespace/pod-2-UID/redis",
+			Target: "1.2.3.4",
+			Details: &observer.PodContainer{
+				Name: "redis",
+				Pod:  observer.Pod{Name: "pod-2", Namespace: "default", UID: "pod-2-UID", Annotations: annotations},
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		inputEndpoint     observer.Endpoint
+		ignoreReceivers   []string
+		expectedIDs       []string
+		expectedConfigs   []userConfigMap
+		expectedSignals   []receiverSignals
+		expectedResAttrs  []map[string]any
+		expectedErrorText string
+	}{
+		"metrics_template_with_config_override": {
+			inputEndpoint: port(map[string]string{
+				otelMetricsHints + "/enabled":  "true",
+				otelMetricsHints + "/template": "redis-default",
+				otelMetricsHints + "/config":   "timeout: 30s",
+			}),
+			expectedIDs:      []string{"redis/pod-2-UID_6379"},
+			expectedConfigs:  []userConfigMap{{"collection_interval": "10s", "timeout": "30s"}},
+			expectedSignals:  []receiverSignals{{metrics: true}},
+			expectedResAttrs: []map[string]any{{"platform.template": "redis-default"}},
+		},
+		"metrics_template_takes_precedence_over_scraper": {
+			inputEndpoint: port(map[string]string{
+				otelMetricsHints + "/enabled":       "true",
+				otelMetricsHints + "/scraper":       "nginx",
+				otelMetricsHints + ".6379/template": "redis-default",
+			}),
+			expectedIDs:      []string{"redis/pod-2-UID_6379"},
+			expectedConfigs:  []userConfigMap{{"collection_interval": "10s", "timeout": "5s"}},
+			expectedSignals:  []receiverSignals{{metrics: true}},
+			expectedResAttrs: []map[string]any{{"platform.template": "redis-default"}},
+		},
+		"metrics_and_traces_on_the_same_port": {
+			inputEndpoint: port(map[string]string{
+				otelMetricsHints + "/enabled": "true",
+				otelMetricsHints + "/scraper": "redis",
+				otelTracesHints + "/enabled":  "true",
+				otelTracesHints + "/template": "otlp-grpc",
+				otelTracesHints + "/config":   "protocols:\n  grpc:\n    endpoint: '`endpoint`'",
+			}),
+			expectedIDs: []string{"redis/pod-2-UID_6379", "otlp/grpc/pod-2-UID_6379"},
+			expectedConfigs: []userConfigMap{
+				{},
+				{"protocols": map[string]any{"grpc": map[string]any{"endpoint": "`endpoint`"}}},
+			},
+			expectedSignals:  []receiverSignals{{metrics: true}, {traces: true}},
+			expectedResAttrs: []map[string]any{nil, {"platform.template": "otlp-grpc"}},
+		},
+		"traces_without_template": {
+			inputEndpoint: port(map[string]string{
+				otelTracesHints + "/enabled": "true",
+			}),
+		},
+		"traces_ignored": {
+			inputEndpoint: port(map[string]string{
+				otelTracesHints + "/enabled":  "true",
+				otelTracesHints + "/template": "otlp-grpc",
+			}),
+			ignoreReceivers: []string{"otlp"},
+		},
+		"unknown_template": {
+			inputEndpoint: port(map[string]string{
+				otelMetricsHints + "/enabled":  "true",
+				otelMetricsHints + "/template": "does-not-exist",
+			}),
+			expectedErrorText: `unknown discovery template "does-not-exist"`,
+		},
+		"filelog_template": {
+			inputEndpoint: container(map[string]string{
+				otelLogsHints + "/enabled":  "true",
+				otelLogsHints + "/template": "java-logs",
+				otelLogsHints + "/config":   "include_file_name: true",
+			}),
+			expectedIDs: []string{"filelog/pod-2-UID_redis"},
+			expectedConfigs: []userConfigMap{{
+				"include":           []string{"/var/log/pods/default_pod-2_pod-2-UID/redis/*.log"},
+				"include_file_path": true,
+				"include_file_name": true,
+				"operators":         []any{map[string]any{"id": "container-parser", "type": "container"}},
+				"multiline":         map[string]any{"line_start_pattern": "^\\d{4}"},
+			}},
+			expectedSignals:  []receiverSignals{{logs: true}},
+			expectedResAttrs: []map[string]any{{"platform.template": "java-logs"}},
+		},
+		"non_filelog_logs_template": {
+			inputEndpoint: container(map[string]string{
+				otelLogsHints + "/enabled":  "true",
+				otelLogsHints + "/template": "journald",
+			}),
+			expectedIDs:      []string{"journald/pod-2-UID_redis"},
+			expectedConfigs:  []userConfigMap{{"units": []any{"kubelet"}}},
+			expectedSignals:  []receiverSignals{{logs: true}},
+			expectedResAttrs: []map[string]any{{"platform.template": "journald"}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg := DiscoveryConfig{Enabled: true, IgnoreReceivers: test.ignoreReceivers, receiverTemplates: templates}
+			builder := createK8sHintsBuilder(cfg, logger)
+			env, err := test.inputEndpoint.Env()
+			require.NoError(t, err)
+			subreceiverTemplates, err := builder.createReceiverTemplatesFromHints(env)
+			if test.expectedErrorText != "" {
+				require.ErrorContains(t, err, test.expectedErrorText)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, subreceiverTemplates, len(test.expectedIDs))
+			for i, subreceiverTemplate := range subreceiverTemplates {
+				assert.Equal(t, test.expectedIDs[i], subreceiverTemplate.id.String())
+				assert.Equal(t, test.expectedConfigs[i], subreceiverTemplate.config)
+				assert.Equal(t, test.expectedSignals[i], subreceiverTemplate.signals)
+				assert.Equal(t, test.expectedResAttrs[i], subreceiverTemplate.ResourceAttributes)
 			}
 		})
 	}
@@ -674,7 +838,7 @@ nested_example:
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			conf, err := getScraperConfFromAnnotations(test.hintsAnn, test.defaultEndpoint, test.scopeSuffix, zaptest.NewLogger(t, zaptest.Level(zap.InfoLevel)))
+			conf, err := getConfFromAnnotations(test.hintsAnn, otelMetricsHints, test.defaultEndpoint, test.scopeSuffix, zaptest.NewLogger(t, zaptest.Level(zap.InfoLevel)))
 			if test.expectError {
 				assert.Error(t, err)
 			} else {
@@ -746,6 +910,7 @@ operators:
 				test.expectedConf,
 				createLogsConfig(
 					test.hintsAnn,
+					nil,
 					"my-container",
 					"my-uid",
 					"my-pod",
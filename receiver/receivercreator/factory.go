@@ -70,6 +70,13 @@ func createDefaultConfig() component.Config {
 				string(conventions.K8SNodeUIDKey):  "`uid`",
 			},
 			observer.KafkaTopicType: map[string]string{},
+			observer.ProcessType: map[string]string{
+				string(conventions.ProcessPIDKey):            "`pid`",
+				string(conventions.ProcessExecutableNameKey): "`process_name`",
+				string(conventions.ProcessExecutablePathKey): "`executable`",
+				string(conventions.ProcessCommandLineKey):    "`command`",
+				string(conventions.ProcessOwnerKey):          "`user`",
+			},
 		},
 		receiverTemplates: map[string]receiverTemplate{},
 	}
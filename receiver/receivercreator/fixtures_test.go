@@ -162,6 +162,22 @@ var kafkaTopicsEndpoint = observer.Endpoint{
 	Details: &observer.KafkaTopic{},
 }
 
+var processEndpoint = observer.Endpoint{
+	ID:     "process-1",
+	Target: "127.0.0.1:6379",
+	Details: &observer.Process{
+		PID:         1234,
+		Name:        "redis-server",
+		Executable:  "/usr/bin/redis-server",
+		Command:     "/usr/bin/redis-server 127.0.0.1:6379",
+		Args:        []string{"/usr/bin/redis-server 127.0.0.1:6379"},
+		User:        "redis",
+		Cgroup:      "/system.slice/redis-server.service",
+		SystemdUnit: "redis-server.service",
+		Ports:       []uint16{6379},
+	},
+}
+
 var unsupportedEndpoint = observer.Endpoint{
 	ID:      "endpoint-1",
 	Target:  "localhost:1234",
@@ -4,6 +4,9 @@
 package receivercreator // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator"
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"sync"
 
@@ -32,6 +35,8 @@ type observerHandler struct {
 	params receiver.Settings
 	// receiversByEndpointID is a map of endpoint IDs to a receiver instance.
 	receiversByEndpointID receiverMap
+	// configHashes holds the hash of the effective configuration of each started receiver.
+	configHashes map[component.Component]string
 	// nextLogsConsumer is the receiver_creator's own consumer
 	nextLogsConsumer consumer.Logs
 	// nextMetricsConsumer is the receiver_creator's own consumer
@@ -74,35 +79,8 @@ func (obs *observerHandler) OnAdd(added []observer.Endpoint) {
 	defer obs.Unlock()
 
 	for _, e := range added {
-		var env observer.EndpointEnv
-		var err error
-		if env, err = e.Env(); err != nil {
-			obs.params.Logger.Error("unable to convert endpoint to environment map", zap.String("endpoint", string(e.ID)), zap.Error(err))
-			continue
-		}
-
-		if obs.config.Discovery.Enabled {
-			builder := createK8sHintsBuilder(obs.config.Discovery, obs.params.Logger)
-			subreceiverTemplate, err := builder.createReceiverTemplateFromHints(env)
-			if err != nil {
-				obs.params.Logger.Error("could not extract configurations from K8s hints' annotations", zap.Error(err))
-				break
-			}
-			if subreceiverTemplate != nil {
-				obs.params.Logger.Debug("adding K8s hinted receiver", zap.Any("subreceiver", subreceiverTemplate))
-				obs.startReceiver(*subreceiverTemplate, env, e)
-				continue
-			}
-		}
-
-		for _, template := range obs.config.receiverTemplates {
-			if matches, err := template.rule.eval(env); err != nil {
-				obs.params.Logger.Error("failed matching rule", zap.String("rule", template.Rule), zap.Error(err))
-				continue
-			} else if !matches {
-				continue
-			}
-			obs.startReceiver(template, env, e)
+		for _, r := range obs.resolveReceivers(e) {
+			obs.startReceiver(r)
 		}
 	}
 }
@@ -124,25 +102,105 @@ func (obs *observerHandler) OnRemove(removed []observer.Endpoint) {
 		}
 
 		for _, rcvr := range obs.receiversByEndpointID.Get(e.ID) {
-			obs.params.Logger.Info("stopping receiver", zap.Reflect("receiver", rcvr), zap.String("endpoint_id", string(e.ID)))
+			obs.stopReceiver(rcvr, e.ID)
+		}
+		obs.receiversByEndpointID.RemoveAll(e.ID)
+	}
+}
 
-			if err := obs.runner.shutdown(rcvr); err != nil {
-				obs.params.Logger.Error("failed to stop receiver", zap.Reflect("receiver", rcvr), zap.Error(err))
+// OnChange responds to endpoint change notifications. Receivers whose effective configuration
+// didn't change keep running, the others are restarted.
+func (obs *observerHandler) OnChange(changed []observer.Endpoint) {
+	obs.Lock()
+	defer obs.Unlock()
+
+	for _, e := range changed {
+		running := map[string][]component.Component{}
+		for _, rcvr := range obs.receiversByEndpointID.Get(e.ID) {
+			hash := obs.configHashes[rcvr]
+			running[hash] = append(running[hash], rcvr)
+		}
+		obs.receiversByEndpointID.RemoveAll(e.ID)
+
+		var toStart []resolvedReceiver
+		for _, r := range obs.resolveReceivers(e) {
+			if rcvrs := running[r.hash]; r.hash != "" && len(rcvrs) > 0 {
+				obs.params.Logger.Debug("receiver configuration unchanged, keeping it running",
+					zap.String("name", r.config.id.String()),
+					zap.String("endpoint_id", string(e.ID)))
+				obs.receiversByEndpointID.Put(e.ID, rcvrs[0])
+				running[r.hash] = rcvrs[1:]
 				continue
 			}
+			toStart = append(toStart, r)
+		}
+
+		// The superseded receivers are stopped before their replacements are started,
+		// so that they don't run concurrently, e.g. listening on the same port.
+		for _, rcvrs := range running {
+			for _, rcvr := range rcvrs {
+				obs.stopReceiver(rcvr, e.ID)
+			}
+		}
+		for _, r := range toStart {
+			obs.startReceiver(r)
 		}
-		obs.receiversByEndpointID.RemoveAll(e.ID)
 	}
 }
 
-// OnChange responds to endpoint change notifications.
-func (obs *observerHandler) OnChange(changed []observer.Endpoint) {
-	// TODO: optimize to only restart if effective config has changed.
-	obs.OnRemove(changed)
-	obs.OnAdd(changed)
+// resolvedReceiver is a receiver instance resolved from a template for a given endpoint.
+type resolvedReceiver struct {
+	config           receiverConfig
+	discoveredConfig userConfigMap
+	consumer         *enhancingConsumer
+	endpoint         observer.Endpoint
+	// hash identifies the effective configuration of the receiver.
+	hash string
+}
+
+// resolveReceivers resolves the receivers to run for the given endpoint, either from the K8s hints
+// or from the configured receiver templates whose rule matches the endpoint.
+func (obs *observerHandler) resolveReceivers(e observer.Endpoint) []resolvedReceiver {
+	env, err := e.Env()
+	if err != nil {
+		obs.params.Logger.Error("unable to convert endpoint to environment map", zap.String("endpoint", string(e.ID)), zap.Error(err))
+		return nil
+	}
+
+	var resolved []resolvedReceiver
+	if obs.config.Discovery.Enabled {
+		builder := createK8sHintsBuilder(obs.config.Discovery, obs.params.Logger)
+		subreceiverTemplates, err := builder.createReceiverTemplatesFromHints(env)
+		if err != nil {
+			obs.params.Logger.Error("could not extract configurations from K8s hints' annotations", zap.Error(err))
+			return nil
+		}
+		if len(subreceiverTemplates) > 0 {
+			for _, subreceiverTemplate := range subreceiverTemplates {
+				obs.params.Logger.Debug("adding K8s hinted receiver", zap.Any("subreceiver", subreceiverTemplate))
+				if r, ok := obs.resolveReceiver(subreceiverTemplate, env, e); ok {
+					resolved = append(resolved, r)
+				}
+			}
+			return resolved
+		}
+	}
+
+	for _, template := range obs.config.receiverTemplates {
+		if matches, err := template.rule.eval(env); err != nil {
+			obs.params.Logger.Error("failed matching rule", zap.String("rule", template.Rule), zap.Error(err))
+			continue
+		} else if !matches {
+			continue
+		}
+		if r, ok := obs.resolveReceiver(template, env, e); ok {
+			resolved = append(resolved, r)
+		}
+	}
+	return resolved
 }
 
-func (obs *observerHandler) startReceiver(template receiverTemplate, env observer.EndpointEnv, e observer.Endpoint) {
+func (obs *observerHandler) resolveReceiver(template receiverTemplate, env observer.EndpointEnv, e observer.Endpoint) (resolvedReceiver, bool) {
 	obs.params.Logger.Debug("expanding the following template config",
 		zap.String("name", template.id.String()),
 		zap.String("endpoint", e.Target),
@@ -151,7 +209,7 @@ func (obs *observerHandler) startReceiver(template receiverTemplate, env observe
 	resolvedConfig, err := expandConfig(template.config, env)
 	if err != nil {
 		obs.params.Logger.Error("unable to resolve template config", zap.String("receiver", template.id.String()), zap.Error(err))
-		return
+		return resolvedReceiver{}, false
 	}
 
 	discoveredCfg := userConfigMap{}
@@ -167,7 +225,7 @@ func (obs *observerHandler) startReceiver(template receiverTemplate, env observe
 	discoveredConfig, err := expandConfig(discoveredCfg, env)
 	if err != nil {
 		obs.params.Logger.Error("unable to resolve discovered config", zap.String("receiver", template.id.String()), zap.Error(err))
-		return
+		return resolvedReceiver{}, false
 	}
 
 	resAttrs := map[string]string{}
@@ -193,36 +251,72 @@ func (obs *observerHandler) startReceiver(template receiverTemplate, env observe
 		obs.nextTracesConsumer,
 	); err != nil {
 		obs.params.Logger.Error("failed creating resource enhancer", zap.String("receiver", template.id.String()), zap.Error(err))
-		return
+		return resolvedReceiver{}, false
 	}
 
 	filterConsumerSignals(consumer, template.signals)
 
 	// short-circuit if no consumers are set
 	if consumer.metrics == nil && consumer.logs == nil && consumer.traces == nil {
-		return
+		return resolvedReceiver{}, false
 	}
 
-	obs.params.Logger.Info("starting receiver",
-		zap.String("name", template.id.String()),
-		zap.String("endpoint", e.Target),
-		zap.String("endpoint_id", string(e.ID)),
-		zap.Any("config", resolvedConfig))
-
-	var receiver component.Component
-	if receiver, err = obs.runner.start(
-		receiverConfig{
+	r := resolvedReceiver{
+		config: receiverConfig{
 			id:         template.id,
 			config:     resolvedConfig,
 			endpointID: e.ID,
 		},
-		discoveredConfig,
-		consumer,
-	); err != nil {
-		obs.params.Logger.Error("failed to start receiver", zap.String("receiver", template.id.String()), zap.Error(err))
+		discoveredConfig: discoveredConfig,
+		consumer:         consumer,
+		endpoint:         e,
+	}
+	r.hash = configHash(r)
+	return r, true
+}
+
+// configHash returns a hash of everything that determines how the receiver runs. An empty
+// string is returned if the configuration can't be hashed.
+func configHash(r resolvedReceiver) string {
+	h := sha256.New()
+	if err := json.NewEncoder(h).Encode([]any{
+		r.config.id.String(),
+		r.config.config,
+		r.discoveredConfig,
+		r.consumer.attrs,
+		[]bool{r.consumer.metrics != nil, r.consumer.logs != nil, r.consumer.traces != nil},
+	}); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (obs *observerHandler) startReceiver(r resolvedReceiver) {
+	obs.params.Logger.Info("starting receiver",
+		zap.String("name", r.config.id.String()),
+		zap.String("endpoint", r.endpoint.Target),
+		zap.String("endpoint_id", string(r.endpoint.ID)),
+		zap.Any("config", r.config.config))
+
+	receiver, err := obs.runner.start(r.config, r.discoveredConfig, r.consumer)
+	if err != nil {
+		obs.params.Logger.Error("failed to start receiver", zap.String("receiver", r.config.id.String()), zap.Error(err))
 		return
 	}
-	obs.receiversByEndpointID.Put(e.ID, receiver)
+	obs.receiversByEndpointID.Put(r.endpoint.ID, receiver)
+	if obs.configHashes == nil {
+		obs.configHashes = map[component.Component]string{}
+	}
+	obs.configHashes[receiver] = r.hash
+}
+
+func (obs *observerHandler) stopReceiver(rcvr component.Component, id observer.EndpointID) {
+	obs.params.Logger.Info("stopping receiver", zap.Reflect("receiver", rcvr), zap.String("endpoint_id", string(id)))
+
+	delete(obs.configHashes, rcvr)
+	if err := obs.runner.shutdown(rcvr); err != nil {
+		obs.params.Logger.Error("failed to stop receiver", zap.Reflect("receiver", rcvr), zap.Error(err))
+	}
 }
 
 func filterConsumerSignals(consumer *enhancingConsumer, signals receiverSignals) {
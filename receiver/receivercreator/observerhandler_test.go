@@ -4,6 +4,7 @@
 package receivercreator
 
 import (
+	"maps"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -552,7 +553,7 @@ func TestOnChange(t *testing.T) {
 	cfg := createDefaultConfig().(*Config)
 	rcvrCfg := receiverConfig{
 		id:         component.MustNewIDWithName("with_endpoint", "some.name"),
-		config:     userConfigMap{"endpoint": "some.endpoint"},
+		config:     userConfigMap{"int_field": "`port`"},
 		endpointID: portEndpoint.ID,
 	}
 	cfg.receiverTemplates = map[string]receiverTemplate{
@@ -571,10 +572,27 @@ func TestOnChange(t *testing.T) {
 	require.NotNil(t, origRcvr)
 	require.NoError(t, r.lastError)
 
+	// The effective config didn't change so the receiver keeps running.
 	handler.OnChange([]observer.Endpoint{portEndpoint})
 
+	require.NoError(t, r.lastError)
+	assert.Nil(t, r.shutdownComponent)
+	assert.Same(t, origRcvr, r.startedComponent)
+	assert.Equal(t, 1, handler.receiversByEndpointID.Size())
+	assert.Same(t, origRcvr, handler.receiversByEndpointID.Get("port-1")[0])
+
+	// The port is part of the resolved config so the receiver is restarted.
+	changedEndpoint := portEndpoint
+	changedPort := *portEndpoint.Details.(*observer.Port)
+	changedPort.Port = 4321
+	changedEndpoint.Details = &changedPort
+	r.calls = nil
+	handler.OnChange([]observer.Endpoint{changedEndpoint})
+
 	require.NoError(t, r.lastError)
 	assert.Same(t, origRcvr, r.shutdownComponent)
+	// The previous receiver is stopped before its replacement is started.
+	assert.Equal(t, []string{"shutdown", "start"}, r.calls)
 
 	newRcvr := r.startedComponent
 	require.NotSame(t, origRcvr, newRcvr)
@@ -582,6 +600,61 @@ func TestOnChange(t *testing.T) {
 
 	assert.Equal(t, 1, handler.receiversByEndpointID.Size())
 	assert.Same(t, newRcvr, handler.receiversByEndpointID.Get("port-1")[0])
+	assert.Len(t, handler.configHashes, 1)
+}
+
+func TestOnChangeWithHints(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Discovery.Enabled = true
+
+	handler, r := newObserverHandler(t, cfg, nil, consumertest.NewNop(), nil)
+	handler.OnAdd([]observer.Endpoint{portEndpointWithHints})
+
+	origRcvr := r.startedComponent
+	require.NotNil(t, origRcvr)
+	require.NoError(t, r.lastError)
+
+	withAnnotations := func(annotations map[string]string) observer.Endpoint {
+		e := portEndpointWithHints
+		port := *portEndpointWithHints.Details.(*observer.Port)
+		port.Pod.Annotations = annotations
+		e.Details = &port
+		return e
+	}
+
+	// An unrelated annotation doesn't restart the receiver.
+	annotations := maps.Clone(portEndpointWithHints.Details.(*observer.Port).Pod.Annotations)
+	annotations["unrelated"] = "value"
+	handler.OnChange([]observer.Endpoint{withAnnotations(annotations)})
+
+	assert.Nil(t, r.shutdownComponent)
+	assert.Same(t, origRcvr, r.startedComponent)
+	assert.Same(t, origRcvr, handler.receiversByEndpointID.Get(portEndpointWithHints.ID)[0])
+
+	// A config hint change restarts the receiver in place.
+	annotations[otelMetricsHints+"/config"] = "int_field: 30"
+	handler.OnChange([]observer.Endpoint{withAnnotations(annotations)})
+
+	require.NoError(t, r.lastError)
+	assert.Same(t, origRcvr, r.shutdownComponent)
+	newRcvr := r.startedComponent
+	require.NotSame(t, origRcvr, newRcvr)
+	assert.Equal(t, 1, handler.receiversByEndpointID.Size())
+	assert.Same(t, newRcvr, handler.receiversByEndpointID.Get(portEndpointWithHints.ID)[0])
+
+	wr, ok := newRcvr.(*wrappedReceiver)
+	require.True(t, ok)
+	rcvr, ok := wr.metrics.(*nopWithEndpointReceiver)
+	require.True(t, ok)
+	assert.Equal(t, &nopWithEndpointConfig{IntField: 30, Endpoint: "1.2.3.4:6379"}, rcvr.cfg)
+
+	// Disabling discovery stops the receiver.
+	annotations[otelMetricsHints+"/enabled"] = "false"
+	handler.OnChange([]observer.Endpoint{withAnnotations(annotations)})
+
+	assert.Same(t, newRcvr, r.shutdownComponent)
+	assert.Equal(t, 0, handler.receiversByEndpointID.Size())
+	assert.Empty(t, handler.configHashes)
 }
 
 type mockRunner struct {
@@ -589,6 +662,8 @@ type mockRunner struct {
 	startedComponent  component.Component
 	shutdownComponent component.Component
 	lastError         error
+	// calls records the order of the start and shutdown calls
+	calls []string
 }
 
 func (r *mockRunner) start(
@@ -596,11 +671,13 @@ func (r *mockRunner) start(
 	discoveredConfig userConfigMap,
 	consumer *enhancingConsumer,
 ) (component.Component, error) {
+	r.calls = append(r.calls, "start")
 	r.startedComponent, r.lastError = r.receiverRunner.start(receiver, discoveredConfig, consumer)
 	return r.startedComponent, r.lastError
 }
 
 func (r *mockRunner) shutdown(rcvr component.Component) error {
+	r.calls = append(r.calls, "shutdown")
 	r.shutdownComponent = rcvr
 	r.lastError = r.receiverRunner.shutdown(rcvr)
 	return r.lastError
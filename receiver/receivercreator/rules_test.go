@@ -35,6 +35,8 @@ func Test_ruleEval(t *testing.T) {
 		{"relocated type builtin", args{`type == "k8s.node" && typeOf("some string") == "string"`, k8sNodeEndpoint}, true, false},
 		{"pod container", args{`type == "pod.container" and container_image matches "redis"`, podContainerEndpointWithHints}, true, false},
 		{"kafka topics", args{`type == "kafka.topics"`, kafkaTopicsEndpoint}, true, false},
+		{"systemd unit", args{`type == "process" && systemd_unit == "redis-server.service"`, processEndpoint}, true, false},
+		{"process executable", args{`type == "process" && executable endsWith "/redis-server" && 6379 in ports`, processEndpoint}, true, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,226 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package snmptrapreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmptrapreceiver"
+
+import (
+	"cmp"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/gosnmp/gosnmp"
+	"go.opentelemetry.io/collector/config/configopaque"
+)
+
+// Config Defaults
+const (
+	defaultEndpoint      = "localhost:162"
+	defaultSecurityLevel = "no_auth_no_priv"
+	defaultAuthType      = "MD5"
+	defaultPrivacyType   = "DES"
+)
+
+// Security levels
+const (
+	noAuthNoPriv = "no_auth_no_priv"
+	authNoPriv   = "auth_no_priv"
+	authPriv     = "auth_priv"
+)
+
+var (
+	// Config errors
+	errEmptyEndpoint        = errors.New("endpoint must be specified")
+	errEmptyUser            = errors.New("user must be specified")
+	errBadSecurityLevel     = errors.New("security_level must be either no_auth_no_priv, auth_no_priv, or auth_priv")
+	errBadAuthType          = errors.New("auth_type must be either MD5, SHA, SHA224, SHA256, SHA384, SHA512")
+	errEmptyAuthPassword    = errors.New("auth_password must be specified when security_level is auth_no_priv or auth_priv")
+	errBadPrivacyType       = errors.New("privacy_type must be either DES, AES, AES192, AES192C, AES256, AES256C")
+	errEmptyPrivacyPassword = errors.New("privacy_password must be specified when security_level is auth_priv")
+	errBadEngineID          = errors.New("engine_id must be a hex string of 5 to 32 bytes")
+)
+
+// Config defines the configuration for the SNMP trap receiver.
+type Config struct {
+	// Endpoint is the UDP address to listen for traps and informs on.
+	// Default: localhost:162
+	Endpoint string `mapstructure:"endpoint"`
+
+	// Communities are the community strings accepted for SNMP v1 and v2c traps and informs.
+	// If empty, traps and informs are accepted for any community.
+	Communities []configopaque.String `mapstructure:"communities"`
+
+	// Users are the USM users accepted for SNMP v3 traps and informs.
+	// If empty, SNMP v3 traps and informs are dropped.
+	Users []UserConfig `mapstructure:"users"`
+
+	// EngineID is the authoritative engine ID of the receiver as a hex string.
+	// It is required to receive SNMP v3 informs, for which the receiver is the authoritative engine.
+	EngineID string `mapstructure:"engine_id"`
+
+	// MIBDirectories are the directories of MIB files used to resolve OIDs to names.
+	// The well known OIDs of the SNMPv2-SMI and SNMPv2-MIB modules are always resolved.
+	MIBDirectories []string `mapstructure:"mib_directories"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// UserConfig defines the credentials of a USM user for SNMP v3.
+type UserConfig struct {
+	// User is the USM user name.
+	User string `mapstructure:"user"`
+
+	// SecurityLevel is the minimum security level of the traps and informs of the user.
+	// Valid options: “no_auth_no_priv”, “auth_no_priv”, “auth_priv”
+	// Default: "no_auth_no_priv"
+	SecurityLevel string `mapstructure:"security_level"`
+
+	// AuthType is the authentication protocol of the user.
+	// Valid options: “MD5”, “SHA”, “SHA224”, “SHA256”, “SHA384”, “SHA512”
+	// Default: "MD5"
+	AuthType string `mapstructure:"auth_type"`
+
+	// AuthPassword is the authentication password of the user.
+	AuthPassword configopaque.String `mapstructure:"auth_password"`
+
+	// PrivacyType is the privacy protocol of the user.
+	// Valid options: “DES”, “AES”, “AES192”, “AES192C”, “AES256”, “AES256C”
+	// Default: "DES"
+	PrivacyType string `mapstructure:"privacy_type"`
+
+	// PrivacyPassword is the privacy password of the user.
+	PrivacyPassword configopaque.String `mapstructure:"privacy_password"`
+}
+
+// Validate validates the given config, returning an error specifying any issues with the config.
+func (cfg *Config) Validate() error {
+	var combinedErr error
+
+	if cfg.Endpoint == "" {
+		combinedErr = errors.Join(combinedErr, errEmptyEndpoint)
+	}
+
+	users := map[string]struct{}{}
+	for i, user := range cfg.Users {
+		if err := user.validate(); err != nil {
+			combinedErr = errors.Join(combinedErr, fmt.Errorf("users[%d]: %w", i, err))
+		}
+		if _, ok := users[user.User]; ok {
+			combinedErr = errors.Join(combinedErr, fmt.Errorf("users[%d]: user %q is configured more than once", i, user.User))
+		}
+		users[user.User] = struct{}{}
+	}
+
+	if cfg.EngineID != "" {
+		if _, err := parseEngineID(cfg.EngineID); err != nil {
+			combinedErr = errors.Join(combinedErr, err)
+		}
+	}
+
+	for _, dir := range cfg.MIBDirectories {
+		info, err := os.Stat(dir)
+		if err != nil {
+			combinedErr = errors.Join(combinedErr, fmt.Errorf("invalid mib_directories entry: %w", err))
+			continue
+		}
+		if !info.IsDir() {
+			combinedErr = errors.Join(combinedErr, fmt.Errorf("invalid mib_directories entry: %q is not a directory", dir))
+		}
+	}
+
+	return combinedErr
+}
+
+func (cfg *UserConfig) validate() error {
+	var combinedErr error
+
+	if cfg.User == "" {
+		combinedErr = errors.Join(combinedErr, errEmptyUser)
+	}
+
+	switch cmp.Or(strings.ToLower(cfg.SecurityLevel), defaultSecurityLevel) {
+	case noAuthNoPriv:
+	case authNoPriv:
+		combinedErr = errors.Join(combinedErr, cfg.validateAuth())
+	case authPriv:
+		combinedErr = errors.Join(combinedErr, cfg.validateAuth(), cfg.validatePrivacy())
+	default:
+		combinedErr = errors.Join(combinedErr, errBadSecurityLevel)
+	}
+
+	return combinedErr
+}
+
+func (cfg *UserConfig) validateAuth() error {
+	var combinedErr error
+	if _, ok := cfg.authProtocol(); !ok {
+		combinedErr = errors.Join(combinedErr, errBadAuthType)
+	}
+	if cfg.AuthPassword == "" {
+		combinedErr = errors.Join(combinedErr, errEmptyAuthPassword)
+	}
+	return combinedErr
+}
+
+func (cfg *UserConfig) validatePrivacy() error {
+	var combinedErr error
+	if _, ok := cfg.privacyProtocol(); !ok {
+		combinedErr = errors.Join(combinedErr, errBadPrivacyType)
+	}
+	if cfg.PrivacyPassword == "" {
+		combinedErr = errors.Join(combinedErr, errEmptyPrivacyPassword)
+	}
+	return combinedErr
+}
+
+var authProtocols = map[string]gosnmp.SnmpV3AuthProtocol{
+	"MD5":    gosnmp.MD5,
+	"SHA":    gosnmp.SHA,
+	"SHA224": gosnmp.SHA224,
+	"SHA256": gosnmp.SHA256,
+	"SHA384": gosnmp.SHA384,
+	"SHA512": gosnmp.SHA512,
+}
+
+var privacyProtocols = map[string]gosnmp.SnmpV3PrivProtocol{
+	"DES":     gosnmp.DES,
+	"AES":     gosnmp.AES,
+	"AES192":  gosnmp.AES192,
+	"AES192C": gosnmp.AES192C,
+	"AES256":  gosnmp.AES256,
+	"AES256C": gosnmp.AES256C,
+}
+
+// msgFlags returns the minimum security level of the user as message flags
+func (cfg *UserConfig) msgFlags() gosnmp.SnmpV3MsgFlags {
+	switch strings.ToLower(cfg.SecurityLevel) {
+	case authNoPriv:
+		return gosnmp.AuthNoPriv
+	case authPriv:
+		return gosnmp.AuthPriv
+	default:
+		return gosnmp.NoAuthNoPriv
+	}
+}
+
+func (cfg *UserConfig) authProtocol() (gosnmp.SnmpV3AuthProtocol, bool) {
+	protocol, ok := authProtocols[cmp.Or(strings.ToUpper(cfg.AuthType), defaultAuthType)]
+	return protocol, ok
+}
+
+func (cfg *UserConfig) privacyProtocol() (gosnmp.SnmpV3PrivProtocol, bool) {
+	protocol, ok := privacyProtocols[cmp.Or(strings.ToUpper(cfg.PrivacyType), defaultPrivacyType)]
+	return protocol, ok
+}
+
+// parseEngineID decodes an engine ID given as a hex string, optionally prefixed with 0x
+func parseEngineID(engineID string) (string, error) {
+	decoded, err := hex.DecodeString(strings.TrimPrefix(strings.ToLower(engineID), "0x"))
+	if err != nil || len(decoded) < 5 || len(decoded) > 32 {
+		return "", errBadEngineID
+	}
+	return string(decoded), nil
+}
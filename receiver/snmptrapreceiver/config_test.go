@@ -0,0 +1,119 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package snmptrapreceiver
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/confmap/xconfmap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmptrapreceiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id       component.ID
+		expected component.Config
+	}{
+		{
+			id:       component.NewID(metadata.Type),
+			expected: createDefaultConfig(),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "customname"),
+			expected: &Config{
+				Endpoint:       "0.0.0.0:1162",
+				Communities:    []configopaque.String{"public", "private"},
+				EngineID:       "80001f888055bfb2a6d19b7e65",
+				MIBDirectories: []string{"testdata/mibs"},
+				Users: []UserConfig{
+					{
+						User:            "alice",
+						SecurityLevel:   "auth_priv",
+						AuthType:        "SHA256",
+						AuthPassword:    "alicepassword",
+						PrivacyType:     "AES",
+						PrivacyPassword: "aliceprivacy",
+					},
+					{
+						User:          "bob",
+						SecurityLevel: "auth_no_priv",
+						AuthType:      "MD5",
+						AuthPassword:  "bobpassword",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cfg := NewFactory().CreateDefaultConfig()
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			assert.NoError(t, xconfmap.Validate(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestInvalidConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		errs []string
+	}{
+		{
+			name: "invalid_user",
+			errs: []string{
+				errEmptyUser.Error(),
+				errEmptyAuthPassword.Error(),
+				errBadPrivacyType.Error(),
+			},
+		},
+		{
+			name: "duplicate_user",
+			errs: []string{`users[1]: user "alice" is configured more than once`},
+		},
+		{
+			name: "invalid_engine_id",
+			errs: []string{errBadEngineID.Error()},
+		},
+		{
+			name: "invalid_mib_directory",
+			errs: []string{"invalid mib_directories entry"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := NewFactory().CreateDefaultConfig()
+			sub, err := cm.Sub(component.NewIDWithName(metadata.Type, tt.name).String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			err = xconfmap.Validate(cfg)
+			for _, expected := range tt.errs {
+				assert.ErrorContains(t, err, expected)
+			}
+		})
+	}
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ""
+	assert.ErrorIs(t, cfg.Validate(), errEmptyEndpoint)
+}
@@ -0,0 +1,16 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"go.opentelemetry.io/collector/component"
+)
+
+var (
+	Type      = component.MustNewType("snmptrap")
+	ScopeName = "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmptrapreceiver"
+)
+
+const (
+	LogsStability = component.StabilityLevelDevelopment
+)
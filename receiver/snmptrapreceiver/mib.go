@@ -0,0 +1,331 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package snmptrapreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmptrapreceiver"
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// wellKnownOIDs are the OIDs of the SNMPv2-SMI and SNMPv2-MIB modules, which are always resolved
+var wellKnownOIDs = map[string]string{
+	"1":                     "iso",
+	"1.3":                   "org",
+	"1.3.6":                 "dod",
+	"1.3.6.1":               "internet",
+	"1.3.6.1.1":             "directory",
+	"1.3.6.1.2":             "mgmt",
+	"1.3.6.1.2.1":           "mib-2",
+	"1.3.6.1.2.1.1":         "system",
+	"1.3.6.1.2.1.1.1":       "sysDescr",
+	"1.3.6.1.2.1.1.2":       "sysObjectID",
+	"1.3.6.1.2.1.1.3":       "sysUpTime",
+	"1.3.6.1.2.1.1.4":       "sysContact",
+	"1.3.6.1.2.1.1.5":       "sysName",
+	"1.3.6.1.2.1.1.6":       "sysLocation",
+	"1.3.6.1.2.1.10":        "transmission",
+	"1.3.6.1.3":             "experimental",
+	"1.3.6.1.4":             "private",
+	"1.3.6.1.4.1":           "enterprises",
+	"1.3.6.1.5":             "security",
+	"1.3.6.1.6":             "snmpV2",
+	"1.3.6.1.6.1":           "snmpDomains",
+	"1.3.6.1.6.2":           "snmpProxys",
+	"1.3.6.1.6.3":           "snmpModules",
+	"1.3.6.1.6.3.1":         "snmpMIB",
+	"1.3.6.1.6.3.1.1":       "snmpMIBObjects",
+	"1.3.6.1.6.3.1.1.4":     "snmpTrap",
+	"1.3.6.1.6.3.1.1.4.1":   "snmpTrapOID",
+	"1.3.6.1.6.3.1.1.4.3":   "snmpTrapEnterprise",
+	"1.3.6.1.6.3.1.1.5":     "snmpTraps",
+	"1.3.6.1.6.3.1.1.5.1":   "coldStart",
+	"1.3.6.1.6.3.1.1.5.2":   "warmStart",
+	"1.3.6.1.6.3.1.1.5.3":   "linkDown",
+	"1.3.6.1.6.3.1.1.5.4":   "linkUp",
+	"1.3.6.1.6.3.1.1.5.5":   "authenticationFailure",
+	"1.3.6.1.6.3.1.1.5.6":   "egpNeighborLoss",
+	"1.3.6.1.6.3.18.1.3":    "snmpTrapAddress",
+	"1.3.6.1.6.3.18.1.4":    "snmpTrapCommunity",
+	"1.3.6.1.6.3.1.1.6.1":   "snmpSetSerialNo",
+	"1.3.6.1.6.3.1.2.2.1.1": "snmpInTraps",
+}
+
+// objectMacros are the macros whose values are object identifiers assigned as `::= { parent ... }`
+var objectMacros = map[string]struct{}{
+	"OBJECT-TYPE":        {},
+	"OBJECT-IDENTITY":    {},
+	"MODULE-IDENTITY":    {},
+	"NOTIFICATION-TYPE":  {},
+	"OBJECT-GROUP":       {},
+	"NOTIFICATION-GROUP": {},
+	"MODULE-COMPLIANCE":  {},
+	"AGENT-CAPABILITIES": {},
+}
+
+// oidDefinition is an object identifier assignment relative to a named parent
+type oidDefinition struct {
+	parent string
+	subIDs []string
+}
+
+// mibResolver resolves OIDs to the names of the objects defined in MIB modules
+type mibResolver struct {
+	names map[string]string
+}
+
+// newMIBResolver loads the MIB files of the given directories.
+// Objects whose parent cannot be resolved are ignored.
+func newMIBResolver(dirs []string) (*mibResolver, error) {
+	definitions := map[string]oidDefinition{}
+	for _, dir := range dirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read MIB directory: %w", err)
+		}
+		for _, entry := range entries {
+			if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+				continue
+			}
+			content, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read MIB file: %w", err)
+			}
+			for name, definition := range parseMIB(string(content)) {
+				if _, ok := definitions[name]; !ok {
+					definitions[name] = definition
+				}
+			}
+		}
+	}
+
+	oids := map[string]string{}
+	for oid, name := range wellKnownOIDs {
+		oids[name] = oid
+	}
+	var resolve func(name string, visiting map[string]struct{}) (string, bool)
+	resolve = func(name string, visiting map[string]struct{}) (string, bool) {
+		if oid, ok := oids[name]; ok {
+			return oid, true
+		}
+		definition, ok := definitions[name]
+		if !ok {
+			return "", false
+		}
+		if _, ok := visiting[name]; ok {
+			return "", false
+		}
+		visiting[name] = struct{}{}
+		parent, ok := resolve(definition.parent, visiting)
+		if !ok {
+			return "", false
+		}
+		oid := strings.Join(append([]string{parent}, definition.subIDs...), ".")
+		oids[name] = oid
+		return oid, true
+	}
+
+	r := &mibResolver{names: map[string]string{}}
+	for oid, name := range wellKnownOIDs {
+		r.names[oid] = name
+	}
+	// Names are resolved in order so that the result is stable when several objects share an OID
+	for _, name := range slices.Sorted(maps.Keys(definitions)) {
+		if oid, ok := resolve(name, map[string]struct{}{}); ok {
+			if _, exists := r.names[oid]; !exists {
+				r.names[oid] = name
+			}
+		}
+	}
+	return r, nil
+}
+
+// resolve returns the name of the closest defined ancestor of an OID followed by the remaining sub-identifiers,
+// e.g. ifIndex.2, or the OID itself when no ancestor is defined
+func (r *mibResolver) resolve(oid string) string {
+	oid = strings.TrimPrefix(oid, ".")
+	for prefix := oid; prefix != ""; {
+		if name, ok := r.names[prefix]; ok {
+			return name + oid[len(prefix):]
+		}
+		i := strings.LastIndexByte(prefix, '.')
+		if i < 0 {
+			break
+		}
+		prefix = prefix[:i]
+	}
+	return oid
+}
+
+// parseMIB returns the object identifier assignments of a MIB module.
+// Only the assignments are parsed, the rest of the module is ignored.
+func parseMIB(content string) map[string]oidDefinition {
+	tokens := tokenizeMIB(content)
+	definitions := map[string]oidDefinition{}
+	for i := 0; i+1 < len(tokens); i++ {
+		name := tokens[i]
+		if !isValueReference(name) {
+			continue
+		}
+
+		switch {
+		case tokens[i+1] == "OBJECT" && i+2 < len(tokens) && tokens[i+2] == "IDENTIFIER":
+			i = parseAssignment(tokens, i+3, name, definitions)
+		case isObjectMacro(tokens[i+1]):
+			i = parseAssignment(tokens, i+2, name, definitions)
+		case tokens[i+1] == "TRAP-TYPE":
+			i = parseTrapType(tokens, i+2, name, definitions)
+		}
+	}
+	return definitions
+}
+
+func isObjectMacro(token string) bool {
+	_, ok := objectMacros[token]
+	return ok
+}
+
+// isValueReference returns whether a token is a value reference, which start with a lowercase letter in ASN.1
+func isValueReference(token string) bool {
+	return token != "" && unicode.IsLower(rune(token[0]))
+}
+
+// parseAssignment parses the `::= { parent sub-identifiers }` assignment following the macro at position i
+// and returns the position of its last token
+func parseAssignment(tokens []string, i int, name string, definitions map[string]oidDefinition) int {
+	i = skipTo(tokens, i, "::=")
+	if i+1 >= len(tokens) || tokens[i+1] != "{" {
+		return i
+	}
+	end := skipTo(tokens, i+1, "}")
+	components := parseOIDComponents(tokens[i+2 : min(end, len(tokens))])
+	if len(components) < 2 {
+		return end
+	}
+
+	parent := components[0].name
+	if components[0].number != "" {
+		// Assignments starting with a number are only valid from the root, e.g. { 1 3 6 }
+		if components[0].number != "1" {
+			return end
+		}
+		parent = wellKnownOIDs["1"]
+	}
+	var subIDs []string
+	for _, component := range components[1:] {
+		if component.number == "" {
+			return end
+		}
+		subIDs = append(subIDs, component.number)
+		if component.name != "" {
+			// Named components such as org(3) define intermediate objects
+			if _, ok := definitions[component.name]; !ok {
+				definitions[component.name] = oidDefinition{parent: parent, subIDs: slices.Clone(subIDs)}
+			}
+		}
+	}
+	definitions[name] = oidDefinition{parent: parent, subIDs: subIDs}
+	return end
+}
+
+// parseTrapType parses a SMIv1 TRAP-TYPE macro, whose OID is the enterprise followed by 0 and the trap number
+func parseTrapType(tokens []string, i int, name string, definitions map[string]oidDefinition) int {
+	var enterprise string
+	for ; i < len(tokens) && tokens[i] != "::="; i++ {
+		if tokens[i] == "ENTERPRISE" && i+1 < len(tokens) {
+			enterprise = tokens[i+1]
+		}
+	}
+	if i+1 >= len(tokens) || enterprise == "" {
+		return i
+	}
+	if _, err := strconv.ParseUint(tokens[i+1], 10, 32); err != nil {
+		return i
+	}
+	definitions[name] = oidDefinition{parent: enterprise, subIDs: []string{"0", tokens[i+1]}}
+	return i + 1
+}
+
+type oidComponent struct {
+	name   string
+	number string
+}
+
+// parseOIDComponents parses the components of an object identifier value, e.g. `iso org(3) 6`
+func parseOIDComponents(tokens []string) []oidComponent {
+	var components []oidComponent
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+		if _, err := strconv.ParseUint(token, 10, 32); err == nil {
+			components = append(components, oidComponent{number: token})
+			continue
+		}
+		component := oidComponent{name: token}
+		if i+3 < len(tokens) && tokens[i+1] == "(" && tokens[i+3] == ")" {
+			component.number = tokens[i+2]
+			i += 3
+		}
+		components = append(components, component)
+	}
+	return components
+}
+
+func skipTo(tokens []string, i int, token string) int {
+	for i < len(tokens) && tokens[i] != token {
+		i++
+	}
+	return i
+}
+
+// tokenizeMIB splits a MIB module into tokens, dropping comments and quoted strings
+func tokenizeMIB(content string) []string {
+	var tokens []string
+	for i := 0; i < len(content); {
+		c := content[i]
+		switch {
+		case unicode.IsSpace(rune(c)):
+			i++
+		case strings.HasPrefix(content[i:], "--"):
+			// Comments end at the end of the line or at the next "--"
+			end := i + 2
+			for end < len(content) && content[end] != '\n' && !strings.HasPrefix(content[end:], "--") {
+				end++
+			}
+			i = min(end+2, len(content))
+			if end < len(content) && content[end] == '\n' {
+				i = end + 1
+			}
+		case c == '"':
+			end := strings.IndexByte(content[i+1:], '"')
+			if end < 0 {
+				return tokens
+			}
+			tokens = append(tokens, `""`)
+			i += end + 2
+		case strings.HasPrefix(content[i:], "::="):
+			tokens = append(tokens, "::=")
+			i += 3
+		case strings.ContainsRune("{}(),;|[]", rune(c)):
+			tokens = append(tokens, string(c))
+			i++
+		default:
+			end := i
+			for end < len(content) && !unicode.IsSpace(rune(content[end])) && !strings.ContainsRune(`{}(),;|[]"`, rune(content[end])) &&
+				!strings.HasPrefix(content[end:], "--") && !strings.HasPrefix(content[end:], "::=") {
+				end++
+			}
+			if end == i {
+				end++
+			}
+			tokens = append(tokens, content[i:end])
+			i = end
+		}
+	}
+	return tokens
+}
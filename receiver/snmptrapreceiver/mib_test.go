@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package snmptrapreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMIBResolver(t *testing.T) {
+	resolver, err := newMIBResolver([]string{"testdata/mibs"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		oid      string
+		expected string
+	}{
+		{oid: ".1.3.6.1.4.1.99999", expected: "acme"},
+		{oid: "1.3.6.1.4.1.99999.1.0.1", expected: "acmeAlarmRaised"},
+		{oid: "1.3.6.1.4.1.99999.1.1.1.1.2.7", expected: "acmeAlarmSeverity.7"},
+		{oid: "1.3.6.1.4.1.99999.1.1.1.1.3", expected: "acmeAlarmText"},
+		{oid: "1.3.6.1.4.1.99999.2.0.3", expected: "acmeFanFailure"},
+		{oid: "1.3.6.1.4.1.99999.3.1", expected: "acme.3.1"},
+		{oid: "1.3.6.1.4.1.9.9.41", expected: "enterprises.9.9.41"},
+		{oid: "1.3.6.1.6.3.1.1.5.4", expected: "linkUp"},
+		{oid: "1.3.6.1.2.1.1.3.0", expected: "sysUpTime.0"},
+		{oid: "2.5.4.3", expected: "2.5.4.3"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, resolver.resolve(tt.oid), tt.oid)
+	}
+}
+
+func TestMIBResolverWithoutMIBs(t *testing.T) {
+	resolver, err := newMIBResolver(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "enterprises.99999.1.0.1", resolver.resolve("1.3.6.1.4.1.99999.1.0.1"))
+	assert.Equal(t, "coldStart", resolver.resolve("1.3.6.1.6.3.1.1.5.1"))
+}
+
+func TestParseMIB(t *testing.T) {
+	definitions := parseMIB(`
+TEST-MIB DEFINITIONS ::= BEGIN
+-- ignored OBJECT IDENTIFIER ::= { iso 8 }
+-- comments end at the next dashes -- test OBJECT IDENTIFIER ::= { iso 9 }
+internet OBJECT IDENTIFIER ::= { iso org(3) dod(6) 1 }
+numbered OBJECT IDENTIFIER ::= { 1 3 6 1 99 }
+described OBJECT-IDENTITY
+    STATUS  current
+    DESCRIPTION "An object -- with a dash ::= { numbered 2 }"
+    ::= { numbered 1 }
+END
+`)
+	assert.Equal(t, map[string]oidDefinition{
+		"test":      {parent: "iso", subIDs: []string{"9"}},
+		"org":       {parent: "iso", subIDs: []string{"3"}},
+		"dod":       {parent: "iso", subIDs: []string{"3", "6"}},
+		"internet":  {parent: "iso", subIDs: []string{"3", "6", "1"}},
+		"numbered":  {parent: "iso", subIDs: []string{"3", "6", "1", "99"}},
+		"described": {parent: "numbered", subIDs: []string{"1"}},
+	}, definitions)
+}
+
+func TestMIBResolverInvalidDirectory(t *testing.T) {
+	_, err := newMIBResolver([]string{"testdata/missing"})
+	assert.ErrorContains(t, err, "failed to read MIB directory")
+}
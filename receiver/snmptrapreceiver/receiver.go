@@ -0,0 +1,331 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package snmptrapreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmptrapreceiver"
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gosnmp/gosnmp"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmptrapreceiver/internal/metadata"
+)
+
+// Attributes of the log records
+const (
+	attributeVersion          = "snmp.version"
+	attributePDUType          = "snmp.pdu_type"
+	attributeTrapOID          = "snmp.trap.oid"
+	attributeTrapName         = "snmp.trap.name"
+	attributeUser             = "snmp.user"
+	attributeAgentAddress     = "snmp.agent.address"
+	attributeEnterpriseOID    = "snmp.enterprise.oid"
+	attributeNetworkPeerAddr  = "network.peer.address"
+	attributeNetworkPeerPort  = "network.peer.port"
+	snmpTrapOID               = "1.3.6.1.6.3.1.1.4.1.0"
+	sysUpTime                 = "1.3.6.1.2.1.1.3.0"
+	snmpTraps                 = "1.3.6.1.6.3.1.1.5"
+	enterpriseSpecificTrapNum = 6
+)
+
+type snmpTrapReceiver struct {
+	config      *Config
+	settings    receiver.Settings
+	logger      *zap.Logger
+	consumer    consumer.Logs
+	obsrecv     *receiverhelper.ObsReport
+	communities map[string]struct{}
+	users       map[string]gosnmp.SnmpV3MsgFlags
+	resolver    *mibResolver
+	listener    *gosnmp.TrapListener
+	wg          sync.WaitGroup
+}
+
+func newSNMPTrapReceiver(params receiver.Settings, cfg *Config, consumer consumer.Logs) (*snmpTrapReceiver, error) {
+	obsrecv, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+		ReceiverID:             params.ID,
+		Transport:              "udp",
+		ReceiverCreateSettings: params,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	communities := map[string]struct{}{}
+	for _, community := range cfg.Communities {
+		communities[string(community)] = struct{}{}
+	}
+	users := map[string]gosnmp.SnmpV3MsgFlags{}
+	for _, user := range cfg.Users {
+		users[user.User] = user.msgFlags()
+	}
+
+	return &snmpTrapReceiver{
+		config:      cfg,
+		settings:    params,
+		logger:      params.Logger,
+		consumer:    consumer,
+		obsrecv:     obsrecv,
+		communities: communities,
+		users:       users,
+	}, nil
+}
+
+// Start loads the MIB files and starts listening for traps and informs
+func (r *snmpTrapReceiver) Start(_ context.Context, _ component.Host) error {
+	resolver, err := newMIBResolver(r.config.MIBDirectories)
+	if err != nil {
+		return err
+	}
+	r.resolver = resolver
+
+	params, err := r.trapParams()
+	if err != nil {
+		return err
+	}
+
+	r.listener = gosnmp.NewTrapListener()
+	r.listener.Params = params
+	r.listener.OnNewTrap = r.handleTrap
+
+	errs := make(chan error, 1)
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		errs <- r.listener.Listen("udp://" + r.config.Endpoint)
+	}()
+
+	// Wait for the listener so that it is not closed before it starts listening
+	select {
+	case <-r.listener.Listening():
+		return nil
+	case err := <-errs:
+		r.listener = nil
+		return fmt.Errorf("failed to listen on %s: %w", r.config.Endpoint, err)
+	}
+}
+
+// trapParams returns the parameters of the trap listener, which hold the credentials of the SNMP v3 users
+func (r *snmpTrapReceiver) trapParams() (*gosnmp.GoSNMP, error) {
+	var engineID string
+	if r.config.EngineID != "" {
+		var err error
+		if engineID, err = parseEngineID(r.config.EngineID); err != nil {
+			return nil, err
+		}
+	}
+
+	// The table is created even without users so that SNMP v3 traps and informs of unknown users are dropped
+	table := gosnmp.NewSnmpV3SecurityParametersTable(gosnmp.Logger{})
+	for _, user := range r.config.Users {
+		securityParameters := &gosnmp.UsmSecurityParameters{
+			UserName:               user.User,
+			AuthoritativeEngineID:  engineID,
+			AuthenticationProtocol: gosnmp.NoAuth,
+			PrivacyProtocol:        gosnmp.NoPriv,
+		}
+		flags := user.msgFlags()
+		if flags&gosnmp.AuthNoPriv != 0 {
+			securityParameters.AuthenticationProtocol, _ = user.authProtocol()
+			securityParameters.AuthenticationPassphrase = string(user.AuthPassword)
+		}
+		if flags&gosnmp.AuthPriv == gosnmp.AuthPriv {
+			securityParameters.PrivacyProtocol, _ = user.privacyProtocol()
+			securityParameters.PrivacyPassphrase = string(user.PrivacyPassword)
+		}
+		if err := table.Add(user.User, securityParameters); err != nil {
+			return nil, fmt.Errorf("invalid credentials for user %q: %w", user.User, err)
+		}
+	}
+
+	return &gosnmp.GoSNMP{
+		Version:       gosnmp.Version3,
+		SecurityModel: gosnmp.UserSecurityModel,
+		MsgFlags:      gosnmp.NoAuthNoPriv,
+		// The security parameters hold the engine ID reported to the senders of SNMP v3 informs
+		SecurityParameters: &gosnmp.UsmSecurityParameters{
+			AuthoritativeEngineID:  engineID,
+			AuthenticationProtocol: gosnmp.NoAuth,
+			PrivacyProtocol:        gosnmp.NoPriv,
+		},
+		TrapSecurityParametersTable: table,
+	}, nil
+}
+
+// Shutdown stops listening for traps and informs
+func (r *snmpTrapReceiver) Shutdown(context.Context) error {
+	if r.listener == nil {
+		return nil
+	}
+	r.listener.Close()
+	r.wg.Wait()
+	return nil
+}
+
+// handleTrap converts the traps and informs accepted by the listener to logs.
+// The listener responds to informs once the trap is handled.
+func (r *snmpTrapReceiver) handleTrap(packet *gosnmp.SnmpPacket, addr *net.UDPAddr) {
+	if !r.accept(packet, addr) {
+		return
+	}
+
+	ctx := r.obsrecv.StartLogsOp(context.Background())
+	logs := r.toLogs(packet, addr)
+	err := r.consumer.ConsumeLogs(ctx, logs)
+	r.obsrecv.EndLogsOp(ctx, metadata.Type.String(), logs.LogRecordCount(), err)
+	if err != nil {
+		r.logger.Error("Failed to consume SNMP trap", zap.Error(err))
+	}
+}
+
+// accept returns whether the trap is an accepted trap or inform of a configured community or user
+func (r *snmpTrapReceiver) accept(packet *gosnmp.SnmpPacket, addr *net.UDPAddr) bool {
+	switch packet.PDUType {
+	case gosnmp.Trap, gosnmp.SNMPv2Trap, gosnmp.InformRequest:
+	default:
+		r.logger.Debug("Dropping SNMP message which is not a trap or an inform", zap.Stringer("pdu_type", packet.PDUType), zap.Stringer("source", addr))
+		return false
+	}
+
+	if packet.Version != gosnmp.Version3 {
+		if _, ok := r.communities[packet.Community]; !ok && len(r.communities) > 0 {
+			r.logger.Debug("Dropping SNMP trap of an unknown community", zap.Stringer("source", addr))
+			return false
+		}
+		return true
+	}
+
+	securityParameters, ok := packet.SecurityParameters.(*gosnmp.UsmSecurityParameters)
+	if !ok {
+		return false
+	}
+	// The listener only authenticates the traps of configured users, with the security level of the packet
+	securityLevel, ok := r.users[securityParameters.UserName]
+	if !ok || packet.MsgFlags&gosnmp.AuthPriv < securityLevel {
+		r.logger.Debug("Dropping SNMP v3 trap below the security level of its user", zap.String("user", securityParameters.UserName), zap.Stringer("source", addr))
+		return false
+	}
+	return true
+}
+
+// toLogs converts a trap or inform to a log record whose attributes are the variable bindings
+func (r *snmpTrapReceiver) toLogs(packet *gosnmp.SnmpPacket, addr *net.UDPAddr) plog.Logs {
+	logs := plog.NewLogs()
+	scopeLogs := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
+	scopeLogs.Scope().SetName(metadata.ScopeName)
+	logRecord := scopeLogs.LogRecords().AppendEmpty()
+	now := pcommon.NewTimestampFromTime(time.Now())
+	logRecord.SetObservedTimestamp(now)
+	logRecord.SetTimestamp(now)
+
+	attributes := logRecord.Attributes()
+	attributes.PutStr(attributeVersion, "v"+packet.Version.String())
+	pduType := "trap"
+	if packet.PDUType == gosnmp.InformRequest {
+		pduType = "inform"
+	}
+	attributes.PutStr(attributePDUType, pduType)
+	if securityParameters, ok := packet.SecurityParameters.(*gosnmp.UsmSecurityParameters); ok && packet.Version == gosnmp.Version3 {
+		attributes.PutStr(attributeUser, securityParameters.UserName)
+	}
+	if addr != nil {
+		attributes.PutStr(attributeNetworkPeerAddr, addr.IP.String())
+		attributes.PutInt(attributeNetworkPeerPort, int64(addr.Port))
+	}
+
+	var trapOID string
+	if packet.PDUType == gosnmp.Trap {
+		// SNMP v1 traps are converted to SNMP v2 notifications as per RFC 3584
+		enterprise := strings.TrimPrefix(packet.Enterprise, ".")
+		attributes.PutStr(attributeEnterpriseOID, enterprise)
+		attributes.PutStr(attributeAgentAddress, packet.AgentAddress)
+		attributes.PutInt(r.resolver.resolve(sysUpTime), int64(packet.Timestamp))
+		if packet.GenericTrap == enterpriseSpecificTrapNum {
+			trapOID = fmt.Sprintf("%s.0.%d", enterprise, packet.SpecificTrap)
+		} else {
+			trapOID = fmt.Sprintf("%s.%d", snmpTraps, packet.GenericTrap+1)
+		}
+	}
+
+	for _, variable := range packet.Variables {
+		oid := strings.TrimPrefix(variable.Name, ".")
+		if oid == snmpTrapOID {
+			if value, ok := variable.Value.(string); ok {
+				trapOID = strings.TrimPrefix(value, ".")
+			}
+			continue
+		}
+		putVariable(attributes.PutEmpty(r.resolver.resolve(oid)), variable)
+	}
+
+	if trapOID != "" {
+		trapName := r.resolver.resolve(trapOID)
+		attributes.PutStr(attributeTrapOID, trapOID)
+		attributes.PutStr(attributeTrapName, trapName)
+		logRecord.Body().SetStr(trapName)
+	}
+	return logs
+}
+
+// putVariable sets the value of a variable binding
+func putVariable(value pcommon.Value, variable gosnmp.SnmpPDU) {
+	switch variable.Type {
+	case gosnmp.OctetString:
+		b, _ := variable.Value.([]byte)
+		if isPrintable(b) {
+			value.SetStr(string(b))
+		} else {
+			value.SetEmptyBytes().FromRaw(b)
+		}
+	case gosnmp.ObjectIdentifier, gosnmp.IPAddress:
+		s, _ := variable.Value.(string)
+		value.SetStr(strings.TrimPrefix(s, "."))
+	case gosnmp.Integer, gosnmp.Counter32, gosnmp.Gauge32, gosnmp.TimeTicks, gosnmp.Uinteger32, gosnmp.Counter64:
+		n := gosnmp.ToBigInt(variable.Value)
+		if n.IsInt64() {
+			value.SetInt(n.Int64())
+		} else {
+			value.SetStr(n.String())
+		}
+	case gosnmp.OpaqueFloat:
+		f, _ := variable.Value.(float32)
+		value.SetDouble(float64(f))
+	case gosnmp.OpaqueDouble:
+		f, _ := variable.Value.(float64)
+		value.SetDouble(f)
+	case gosnmp.Boolean:
+		b, _ := variable.Value.(bool)
+		value.SetBool(b)
+	case gosnmp.Null, gosnmp.NoSuchObject, gosnmp.NoSuchInstance, gosnmp.EndOfMibView:
+		// The value is left empty
+	default:
+		value.SetStr(fmt.Sprint(variable.Value))
+	}
+}
+
+// isPrintable returns whether an octet string is printable text, rather than binary data such as a MAC address
+func isPrintable(b []byte) bool {
+	if !utf8.Valid(b) {
+		return false
+	}
+	for _, r := range string(b) {
+		if unicode.IsControl(r) && r != '\t' && r != '\n' && r != '\r' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,314 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package snmptrapreceiver
+
+import (
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gosnmp/gosnmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmptrapreceiver/internal/metadata"
+)
+
+const (
+	testEngineID       = "80001f888055bfb2a6d19b7e65"
+	testSenderEngineID = "\x80\x00\x1f\x88\x80\x01\x02\x03\x04"
+	acmeAlarmRaised    = ".1.3.6.1.4.1.99999.1.0.1"
+	acmeAlarmSeverity  = ".1.3.6.1.4.1.99999.1.1.1.1.2.7"
+	acmeAlarmText      = ".1.3.6.1.4.1.99999.1.1.1.1.3.7"
+)
+
+func newTestReceiver(t *testing.T) (string, *consumertest.LogsSink) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = testutil.GetAvailableLocalNetworkAddress(t, "udp")
+	cfg.Communities = []configopaque.String{"public"}
+	cfg.EngineID = testEngineID
+	cfg.MIBDirectories = []string{"testdata/mibs"}
+	cfg.Users = []UserConfig{
+		{
+			User:            "alice",
+			SecurityLevel:   authPriv,
+			AuthType:        "SHA256",
+			AuthPassword:    "alicepassword",
+			PrivacyType:     "AES",
+			PrivacyPassword: "aliceprivacy",
+		},
+	}
+	require.NoError(t, cfg.Validate())
+
+	sink := new(consumertest.LogsSink)
+	rcv, err := NewFactory().CreateLogs(t.Context(), receivertest.NewNopSettings(metadata.Type), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, rcv.Start(t.Context(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		require.NoError(t, rcv.Shutdown(t.Context()))
+	})
+	return cfg.Endpoint, sink
+}
+
+func newTestSender(t *testing.T, endpoint string, sender *gosnmp.GoSNMP) *gosnmp.GoSNMP {
+	host, port, err := net.SplitHostPort(endpoint)
+	require.NoError(t, err)
+	portNumber, err := strconv.ParseUint(port, 10, 16)
+	require.NoError(t, err)
+
+	sender.Target = host
+	sender.Port = uint16(portNumber)
+	sender.Timeout = time.Second
+	sender.Retries = 1
+	require.NoError(t, sender.Connect())
+	t.Cleanup(func() {
+		sender.Conn.Close()
+	})
+	return sender
+}
+
+func alarmVariables() []gosnmp.SnmpPDU {
+	return []gosnmp.SnmpPDU{
+		{Name: ".1.3.6.1.2.1.1.3.0", Type: gosnmp.TimeTicks, Value: uint32(4200)},
+		{Name: ".1.3.6.1.6.3.1.1.4.1.0", Type: gosnmp.ObjectIdentifier, Value: acmeAlarmRaised},
+		{Name: acmeAlarmSeverity, Type: gosnmp.Integer, Value: 1},
+		{Name: acmeAlarmText, Type: gosnmp.OctetString, Value: "fan failure"},
+	}
+}
+
+func waitForLog(t *testing.T, sink *consumertest.LogsSink, count int) plog.LogRecord {
+	require.Eventually(t, func() bool {
+		return sink.LogRecordCount() >= count
+	}, 5*time.Second, 10*time.Millisecond)
+	logs := sink.AllLogs()
+	last := logs[len(logs)-1]
+	return last.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+}
+
+func TestReceiveV2cTrapAndInform(t *testing.T) {
+	endpoint, sink := newTestReceiver(t)
+	sender := newTestSender(t, endpoint, &gosnmp.GoSNMP{
+		Version:   gosnmp.Version2c,
+		Community: "public",
+	})
+
+	_, err := sender.SendTrap(gosnmp.SnmpTrap{Variables: alarmVariables()})
+	require.NoError(t, err)
+	logRecord := waitForLog(t, sink, 1)
+
+	assert.Equal(t, "acmeAlarmRaised", logRecord.Body().Str())
+	attributes := logRecord.Attributes().AsRaw()
+	assert.Equal(t, "v2c", attributes[attributeVersion])
+	assert.Equal(t, "trap", attributes[attributePDUType])
+	assert.Equal(t, "1.3.6.1.4.1.99999.1.0.1", attributes[attributeTrapOID])
+	assert.Equal(t, "acmeAlarmRaised", attributes[attributeTrapName])
+	assert.Equal(t, "127.0.0.1", attributes[attributeNetworkPeerAddr])
+	assert.Equal(t, int64(4200), attributes["sysUpTime.0"])
+	assert.Equal(t, int64(1), attributes["acmeAlarmSeverity.7"])
+	assert.Equal(t, "fan failure", attributes["acmeAlarmText.7"])
+	assert.NotContains(t, attributes, "snmpTrapOID.0")
+	assert.NotContains(t, attributes, attributeUser)
+	assert.NotZero(t, logRecord.ObservedTimestamp())
+
+	// Informs are acknowledged by the receiver
+	_, err = sender.SendTrap(gosnmp.SnmpTrap{Variables: alarmVariables(), IsInform: true})
+	require.NoError(t, err)
+	logRecord = waitForLog(t, sink, 2)
+	assert.Equal(t, "inform", logRecord.Attributes().AsRaw()[attributePDUType])
+}
+
+func TestReceiveV1Trap(t *testing.T) {
+	endpoint, sink := newTestReceiver(t)
+	sender := newTestSender(t, endpoint, &gosnmp.GoSNMP{
+		Version:   gosnmp.Version1,
+		Community: "public",
+	})
+
+	_, err := sender.SendTrap(gosnmp.SnmpTrap{
+		Variables:    []gosnmp.SnmpPDU{{Name: acmeAlarmText, Type: gosnmp.OctetString, Value: "fan failure"}},
+		Enterprise:   ".1.3.6.1.4.1.99999.2",
+		AgentAddress: "192.0.2.10",
+		GenericTrap:  6,
+		SpecificTrap: 3,
+		Timestamp:    300,
+	})
+	require.NoError(t, err)
+	logRecord := waitForLog(t, sink, 1)
+
+	assert.Equal(t, "acmeFanFailure", logRecord.Body().Str())
+	attributes := logRecord.Attributes().AsRaw()
+	assert.Equal(t, "v1", attributes[attributeVersion])
+	assert.Equal(t, "1.3.6.1.4.1.99999.2.0.3", attributes[attributeTrapOID])
+	assert.Equal(t, "1.3.6.1.4.1.99999.2", attributes[attributeEnterpriseOID])
+	assert.Equal(t, "192.0.2.10", attributes[attributeAgentAddress])
+	assert.Equal(t, int64(300), attributes["sysUpTime.0"])
+	assert.Equal(t, "fan failure", attributes["acmeAlarmText.7"])
+
+	// Generic traps are mapped to the SNMPv2 notifications
+	_, err = sender.SendTrap(gosnmp.SnmpTrap{
+		Enterprise:   ".1.3.6.1.4.1.99999.2",
+		AgentAddress: "192.0.2.10",
+		GenericTrap:  2,
+	})
+	require.NoError(t, err)
+	logRecord = waitForLog(t, sink, 2)
+	assert.Equal(t, "linkDown", logRecord.Body().Str())
+	assert.Equal(t, "1.3.6.1.6.3.1.1.5.3", logRecord.Attributes().AsRaw()[attributeTrapOID])
+}
+
+func TestReceiveV3TrapAndInform(t *testing.T) {
+	endpoint, sink := newTestReceiver(t)
+	securityParameters := &gosnmp.UsmSecurityParameters{
+		UserName:                 "alice",
+		AuthenticationProtocol:   gosnmp.SHA256,
+		AuthenticationPassphrase: "alicepassword",
+		PrivacyProtocol:          gosnmp.AES,
+		PrivacyPassphrase:        "aliceprivacy",
+		AuthoritativeEngineBoots: 1,
+		AuthoritativeEngineTime:  1,
+		AuthoritativeEngineID:    testSenderEngineID,
+	}
+	sender := newTestSender(t, endpoint, &gosnmp.GoSNMP{
+		Version:            gosnmp.Version3,
+		SecurityModel:      gosnmp.UserSecurityModel,
+		MsgFlags:           gosnmp.AuthPriv,
+		SecurityParameters: securityParameters,
+	})
+
+	_, err := sender.SendTrap(gosnmp.SnmpTrap{Variables: alarmVariables()})
+	require.NoError(t, err)
+	logRecord := waitForLog(t, sink, 1)
+
+	attributes := logRecord.Attributes().AsRaw()
+	assert.Equal(t, "v3", attributes[attributeVersion])
+	assert.Equal(t, "alice", attributes[attributeUser])
+	assert.Equal(t, "acmeAlarmRaised", attributes[attributeTrapName])
+	assert.Equal(t, "fan failure", attributes["acmeAlarmText.7"])
+
+	// Informs are sent with the engine ID of the receiver, which is the authoritative engine
+	engineID, err := parseEngineID(testEngineID)
+	require.NoError(t, err)
+	informSecurityParameters := securityParameters.Copy().(*gosnmp.UsmSecurityParameters)
+	informSecurityParameters.AuthoritativeEngineID = engineID
+	informSender := newTestSender(t, endpoint, &gosnmp.GoSNMP{
+		Version:            gosnmp.Version3,
+		SecurityModel:      gosnmp.UserSecurityModel,
+		MsgFlags:           gosnmp.AuthPriv,
+		SecurityParameters: informSecurityParameters,
+	})
+	_, err = informSender.SendTrap(gosnmp.SnmpTrap{Variables: alarmVariables(), IsInform: true})
+	require.NoError(t, err)
+	logRecord = waitForLog(t, sink, 2)
+	assert.Equal(t, "inform", logRecord.Attributes().AsRaw()[attributePDUType])
+}
+
+func TestDropUnauthorizedTraps(t *testing.T) {
+	endpoint, sink := newTestReceiver(t)
+
+	unknownCommunity := newTestSender(t, endpoint, &gosnmp.GoSNMP{
+		Version:   gosnmp.Version2c,
+		Community: "private",
+	})
+	_, err := unknownCommunity.SendTrap(gosnmp.SnmpTrap{Variables: alarmVariables()})
+	require.NoError(t, err)
+
+	unknownUser := newTestSender(t, endpoint, &gosnmp.GoSNMP{
+		Version:       gosnmp.Version3,
+		SecurityModel: gosnmp.UserSecurityModel,
+		MsgFlags:      gosnmp.AuthNoPriv,
+		SecurityParameters: &gosnmp.UsmSecurityParameters{
+			UserName:                 "mallory",
+			AuthenticationProtocol:   gosnmp.SHA256,
+			AuthenticationPassphrase: "mallorypassword",
+			AuthoritativeEngineID:    testSenderEngineID,
+		},
+	})
+	_, err = unknownUser.SendTrap(gosnmp.SnmpTrap{Variables: alarmVariables()})
+	require.NoError(t, err)
+
+	// The user requires privacy
+	noPrivacy := newTestSender(t, endpoint, &gosnmp.GoSNMP{
+		Version:       gosnmp.Version3,
+		SecurityModel: gosnmp.UserSecurityModel,
+		MsgFlags:      gosnmp.AuthNoPriv,
+		SecurityParameters: &gosnmp.UsmSecurityParameters{
+			UserName:                 "alice",
+			AuthenticationProtocol:   gosnmp.SHA256,
+			AuthenticationPassphrase: "alicepassword",
+			AuthoritativeEngineID:    testSenderEngineID,
+		},
+	})
+	_, err = noPrivacy.SendTrap(gosnmp.SnmpTrap{Variables: alarmVariables()})
+	require.NoError(t, err)
+
+	// A trap of a known community is sent last, the traps are handled in order
+	known := newTestSender(t, endpoint, &gosnmp.GoSNMP{
+		Version:   gosnmp.Version2c,
+		Community: "public",
+	})
+	_, err = known.SendTrap(gosnmp.SnmpTrap{Variables: alarmVariables()})
+	require.NoError(t, err)
+
+	waitForLog(t, sink, 1)
+	assert.Equal(t, 1, sink.LogRecordCount())
+}
+
+func TestPutVariable(t *testing.T) {
+	tests := []struct {
+		name     string
+		variable gosnmp.SnmpPDU
+		expected any
+	}{
+		{
+			name:     "text",
+			variable: gosnmp.SnmpPDU{Type: gosnmp.OctetString, Value: []byte("eth0")},
+			expected: "eth0",
+		},
+		{
+			name:     "binary",
+			variable: gosnmp.SnmpPDU{Type: gosnmp.OctetString, Value: []byte{0x00, 0x1b, 0x21, 0x3c, 0x4d, 0x5e}},
+			expected: []byte{0x00, 0x1b, 0x21, 0x3c, 0x4d, 0x5e},
+		},
+		{
+			name:     "oid",
+			variable: gosnmp.SnmpPDU{Type: gosnmp.ObjectIdentifier, Value: ".1.3.6.1.4.1.99999"},
+			expected: "1.3.6.1.4.1.99999",
+		},
+		{
+			name:     "ip address",
+			variable: gosnmp.SnmpPDU{Type: gosnmp.IPAddress, Value: "192.0.2.1"},
+			expected: "192.0.2.1",
+		},
+		{
+			name:     "counter64 above int64",
+			variable: gosnmp.SnmpPDU{Type: gosnmp.Counter64, Value: uint64(1 << 63)},
+			expected: "9223372036854775808",
+		},
+		{
+			name:     "gauge",
+			variable: gosnmp.SnmpPDU{Type: gosnmp.Gauge32, Value: uint(42)},
+			expected: int64(42),
+		},
+		{
+			name:     "null",
+			variable: gosnmp.SnmpPDU{Type: gosnmp.Null},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attributes := plog.NewLogRecord().Attributes()
+			putVariable(attributes.PutEmpty("value"), tt.variable)
+			assert.Equal(t, tt.expected, attributes.AsRaw()["value"])
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/statsdreceiver/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/statsdreceiver/protocol"
 )
@@ -33,6 +34,7 @@ func NewFactory() receiver.Factory {
 		metadata.Type,
 		createDefaultConfig,
 		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability),
 	)
 }
 
@@ -56,6 +58,40 @@ func createMetricsReceiver(
 	cfg component.Config,
 	consumer consumer.Metrics,
 ) (receiver.Metrics, error) {
+	var err error
 	c := cfg.(*Config)
-	return newReceiver(params, *c, consumer)
+	r := receivers.GetOrAdd(cfg, func() (rcv component.Component) {
+		rcv, err = newReceiver(params, *c)
+		return rcv
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.Unwrap().(*statsdReceiver).nextMetricsConsumer = consumer
+	return r, nil
 }
+
+func createLogsReceiver(
+	_ context.Context,
+	params receiver.Settings,
+	cfg component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	var err error
+	c := cfg.(*Config)
+	r := receivers.GetOrAdd(cfg, func() (rcv component.Component) {
+		rcv, err = newReceiver(params, *c)
+		return rcv
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.Unwrap().(*statsdReceiver).nextLogsConsumer = consumer
+	return r, nil
+}
+
+// receivers share a single StatsD receiver between the metrics and logs
+// pipelines that use the same configuration, so that both listen on one socket.
+var receivers = sharedcomponent.NewSharedComponents()
@@ -30,3 +30,15 @@ func TestCreateReceiver(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, tReceiver, "receiver creation failed")
 }
+
+func TestCreateLogsReceiver(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.NetAddr.Endpoint = "localhost:0" // Endpoint is required, not going to be used here.
+
+	params := receivertest.NewNopSettings(metadata.Type)
+	mReceiver, err := createMetricsReceiver(t.Context(), params, cfg, consumertest.NewNop())
+	assert.NoError(t, err)
+	lReceiver, err := createLogsReceiver(t.Context(), params, cfg, consumertest.NewNop())
+	assert.NoError(t, err)
+	assert.Same(t, mReceiver, lReceiver, "metrics and logs receivers must share the same socket")
+}
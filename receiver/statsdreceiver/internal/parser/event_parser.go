@@ -0,0 +1,295 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package parser // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/statsdreceiver/internal/parser"
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	semconv "go.opentelemetry.io/otel/semconv/v1.22.0"
+)
+
+// DogStatsD events and service checks, see
+// https://docs.datadoghq.com/developers/dogstatsd/datagram_shell/?tab=events
+// https://docs.datadoghq.com/developers/dogstatsd/datagram_shell/?tab=servicechecks
+const (
+	eventPrefix        = "_e{"
+	serviceCheckPrefix = "_sc|"
+
+	eventName        = "dogstatsd.event"
+	serviceCheckName = "dogstatsd.service_check"
+
+	attrEventTitle          = "dogstatsd.event.title"
+	attrEventPriority       = "dogstatsd.event.priority"
+	attrEventAlertType      = "dogstatsd.event.alert_type"
+	attrEventAggregationKey = "dogstatsd.event.aggregation_key"
+	attrEventSourceTypeName = "dogstatsd.event.source_type_name"
+	attrServiceCheckName    = "dogstatsd.service_check.name"
+	attrServiceCheckStatus  = "dogstatsd.service_check.status"
+
+	defaultEventPriority  = "normal"
+	defaultEventAlertType = "info"
+)
+
+var (
+	errEmptyEventTitle       = errors.New("empty event title")
+	errEmptyServiceCheckName = errors.New("empty service check name")
+)
+
+var eventPriorities = map[string]bool{
+	"normal": true,
+	"low":    true,
+}
+
+var eventAlertTypeSeverities = map[string]plog.SeverityNumber{
+	"success": plog.SeverityNumberInfo,
+	"info":    plog.SeverityNumberInfo,
+	"warning": plog.SeverityNumberWarn,
+	"error":   plog.SeverityNumberError,
+}
+
+type serviceCheckStatus struct {
+	text     string
+	severity plog.SeverityNumber
+}
+
+var serviceCheckStatuses = []serviceCheckStatus{
+	{text: "OK", severity: plog.SeverityNumberInfo},
+	{text: "WARNING", severity: plog.SeverityNumberWarn},
+	{text: "CRITICAL", severity: plog.SeverityNumberError},
+	{text: "UNKNOWN", severity: plog.SeverityNumberUnspecified},
+}
+
+type logRecords struct {
+	addr    net.Addr
+	records plog.LogRecordSlice
+}
+
+func isEventOrServiceCheck(line string) bool {
+	return strings.HasPrefix(line, eventPrefix) || strings.HasPrefix(line, serviceCheckPrefix)
+}
+
+// aggregateLogRecord parses a DogStatsD event or service check and keeps it
+// until the next call to GetLogs.
+func (p *StatsDParser) aggregateLogRecord(line string, addr net.Addr) error {
+	lr := plog.NewLogRecord()
+	var err error
+	if strings.HasPrefix(line, eventPrefix) {
+		err = parseEvent(line, p.enableSimpleTags, lr)
+	} else {
+		err = parseServiceCheck(line, p.enableSimpleTags, lr)
+	}
+	if err != nil {
+		return err
+	}
+	lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(timeNowFunc()))
+	if lr.Timestamp() == 0 {
+		lr.SetTimestamp(lr.ObservedTimestamp())
+	}
+
+	addrKey := p.netAddrKey(addr)
+	records, ok := p.logRecordsByAddress[addrKey]
+	if !ok {
+		records = &logRecords{addr: addr, records: plog.NewLogRecordSlice()}
+		p.logRecordsByAddress[addrKey] = records
+	}
+	lr.MoveTo(records.records.AppendEmpty())
+	return nil
+}
+
+// GetLogs gets the events and service checks received since the last call and resets them.
+func (p *StatsDParser) GetLogs() []BatchLogs {
+	batchLogs := make([]BatchLogs, 0, len(p.logRecordsByAddress))
+	for _, records := range p.logRecordsByAddress {
+		batch := BatchLogs{
+			Info: client.Info{
+				Addr: records.addr,
+			},
+			Logs: plog.NewLogs(),
+		}
+		sl := batch.Logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
+		p.setVersionAndNameScope(sl.Scope())
+		records.records.MoveAndAppendTo(sl.LogRecords())
+		batchLogs = append(batchLogs, batch)
+	}
+	p.logRecordsByAddress = make(map[netAddr]*logRecords)
+	return batchLogs
+}
+
+// parseEvent parses a DogStatsD event of the form
+// _e{<TITLE_LENGTH>,<TEXT_LENGTH>}:<TITLE>|<TEXT>|d:<TIMESTAMP>|h:<HOSTNAME>|p:<PRIORITY>|t:<ALERT_TYPE>|#<TAGS>
+func parseEvent(line string, enableSimpleTags bool, dest plog.LogRecord) error {
+	lengths, rest, found := strings.Cut(strings.TrimPrefix(line, eventPrefix), "}:")
+	if !found {
+		return fmt.Errorf("invalid event format: %s", line)
+	}
+	titleLenStr, textLenStr, found := strings.Cut(lengths, ",")
+	if !found {
+		return fmt.Errorf("invalid event lengths: %s", lengths)
+	}
+	titleLen, err := strconv.Atoi(titleLenStr)
+	if err != nil || titleLen < 0 {
+		return fmt.Errorf("invalid event lengths: %s", lengths)
+	}
+	textLen, err := strconv.Atoi(textLenStr)
+	if err != nil || textLen < 0 {
+		return fmt.Errorf("invalid event lengths: %s", lengths)
+	}
+	if len(rest) < titleLen+1+textLen || rest[titleLen] != '|' {
+		return fmt.Errorf("event title and text do not match their lengths: %s", line)
+	}
+	title := rest[:titleLen]
+	text := rest[titleLen+1 : titleLen+1+textLen]
+	rest = rest[titleLen+1+textLen:]
+	if title == "" {
+		return errEmptyEventTitle
+	}
+
+	dest.SetEventName(eventName)
+	dest.Body().SetStr(unescapeNewlines(text))
+	attrs := dest.Attributes()
+	attrs.PutStr(attrEventTitle, unescapeNewlines(title))
+	priority := defaultEventPriority
+	alertType := defaultEventAlertType
+
+	if rest != "" {
+		if rest[0] != '|' {
+			return fmt.Errorf("event title and text do not match their lengths: %s", line)
+		}
+		rest = rest[1:]
+	}
+	var part string
+	part, rest, _ = strings.Cut(rest, "|")
+	for ; part != ""; part, rest, _ = strings.Cut(rest, "|") {
+		switch {
+		case strings.HasPrefix(part, "d:"):
+			if err := setTimestamp(strings.TrimPrefix(part, "d:"), dest); err != nil {
+				return err
+			}
+		case strings.HasPrefix(part, "h:"):
+			putNonEmpty(attrs, string(semconv.HostNameKey), strings.TrimPrefix(part, "h:"))
+		case strings.HasPrefix(part, "k:"):
+			putNonEmpty(attrs, attrEventAggregationKey, strings.TrimPrefix(part, "k:"))
+		case strings.HasPrefix(part, "s:"):
+			putNonEmpty(attrs, attrEventSourceTypeName, strings.TrimPrefix(part, "s:"))
+		case strings.HasPrefix(part, "p:"):
+			priority = strings.TrimPrefix(part, "p:")
+			if !eventPriorities[priority] {
+				return fmt.Errorf("invalid event priority: %s", priority)
+			}
+		case strings.HasPrefix(part, "t:"):
+			alertType = strings.TrimPrefix(part, "t:")
+			if _, ok := eventAlertTypeSeverities[alertType]; !ok {
+				return fmt.Errorf("invalid event alert type: %s", alertType)
+			}
+		case strings.HasPrefix(part, "c:"):
+			// As per DogStatD protocol v1.2
+			putNonEmpty(attrs, string(semconv.ContainerIDKey), strings.TrimPrefix(part, "c:"))
+		case strings.HasPrefix(part, "#"):
+			if err := putTags(strings.TrimPrefix(part, "#"), enableSimpleTags, attrs); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unrecognized event part: %s", part)
+		}
+	}
+
+	attrs.PutStr(attrEventPriority, priority)
+	attrs.PutStr(attrEventAlertType, alertType)
+	dest.SetSeverityText(alertType)
+	dest.SetSeverityNumber(eventAlertTypeSeverities[alertType])
+	return nil
+}
+
+// parseServiceCheck parses a DogStatsD service check of the form
+// _sc|<NAME>|<STATUS>|d:<TIMESTAMP>|h:<HOSTNAME>|#<TAGS>|m:<MESSAGE>
+func parseServiceCheck(line string, enableSimpleTags bool, dest plog.LogRecord) error {
+	name, rest, found := strings.Cut(strings.TrimPrefix(line, serviceCheckPrefix), "|")
+	if !found {
+		return fmt.Errorf("invalid service check format: %s", line)
+	}
+	if name == "" {
+		return errEmptyServiceCheckName
+	}
+	statusStr, rest, _ := strings.Cut(rest, "|")
+	status, err := strconv.Atoi(statusStr)
+	if err != nil || status < 0 || status >= len(serviceCheckStatuses) {
+		return fmt.Errorf("invalid service check status: %s", statusStr)
+	}
+
+	dest.SetEventName(serviceCheckName)
+	dest.SetSeverityText(serviceCheckStatuses[status].text)
+	dest.SetSeverityNumber(serviceCheckStatuses[status].severity)
+	attrs := dest.Attributes()
+	attrs.PutStr(attrServiceCheckName, name)
+	attrs.PutInt(attrServiceCheckStatus, int64(status))
+
+	var part string
+	part, rest, _ = strings.Cut(rest, "|")
+	for ; part != ""; part, rest, _ = strings.Cut(rest, "|") {
+		switch {
+		case strings.HasPrefix(part, "d:"):
+			if err := setTimestamp(strings.TrimPrefix(part, "d:"), dest); err != nil {
+				return err
+			}
+		case strings.HasPrefix(part, "h:"):
+			putNonEmpty(attrs, string(semconv.HostNameKey), strings.TrimPrefix(part, "h:"))
+		case strings.HasPrefix(part, "c:"):
+			// As per DogStatD protocol v1.2
+			putNonEmpty(attrs, string(semconv.ContainerIDKey), strings.TrimPrefix(part, "c:"))
+		case strings.HasPrefix(part, "#"):
+			if err := putTags(strings.TrimPrefix(part, "#"), enableSimpleTags, attrs); err != nil {
+				return err
+			}
+		case strings.HasPrefix(part, "m:"):
+			// The message is always the last field and may contain '|'.
+			message := strings.TrimPrefix(part, "m:")
+			if rest != "" {
+				message += "|" + rest
+			}
+			dest.Body().SetStr(strings.ReplaceAll(unescapeNewlines(message), `m\:`, "m:"))
+			return nil
+		default:
+			return fmt.Errorf("unrecognized service check part: %s", part)
+		}
+	}
+	return nil
+}
+
+func setTimestamp(timestampStr string, dest plog.LogRecord) error {
+	timestampSeconds, err := strconv.ParseUint(timestampStr, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid timestamp: %s", timestampStr)
+	}
+	dest.SetTimestamp(pcommon.Timestamp(timestampSeconds * 1e9))
+	return nil
+}
+
+func putTags(tagsStr string, enableSimpleTags bool, attrs pcommon.Map) error {
+	tags, err := parseTags(tagsStr, enableSimpleTags)
+	if err != nil {
+		return err
+	}
+	for _, kv := range tags {
+		attrs.PutStr(string(kv.Key), kv.Value.AsString())
+	}
+	return nil
+}
+
+func putNonEmpty(attrs pcommon.Map, key, value string) {
+	if value != "" {
+		attrs.PutStr(key, value)
+	}
+}
+
+// unescapeNewlines restores the line breaks that DogStatsD clients escape as "\n".
+func unescapeNewlines(s string) string {
+	return strings.ReplaceAll(s, `\n`, "\n")
+}
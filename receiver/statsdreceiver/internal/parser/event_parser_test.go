@@ -0,0 +1,274 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func Test_ParseEvent(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		wantBody      string
+		wantAttrs     map[string]any
+		wantSeverity  plog.SeverityNumber
+		wantTimestamp pcommon.Timestamp
+		err           error
+	}{
+		{
+			name:     "title and text",
+			input:    "_e{6,13}:deploy|checkout v1.2",
+			wantBody: "checkout v1.2",
+			wantAttrs: map[string]any{
+				"dogstatsd.event.title":      "deploy",
+				"dogstatsd.event.priority":   "normal",
+				"dogstatsd.event.alert_type": "info",
+			},
+			wantSeverity: plog.SeverityNumberInfo,
+		},
+		{
+			name:     "all fields",
+			input:    "_e{6,18}:deploy|line one\\nline two|d:1700000000|h:web-1|k:deploys|p:low|s:jenkins|t:error|#env:prod,team:payments|c:abc123",
+			wantBody: "line one\nline two",
+			wantAttrs: map[string]any{
+				"dogstatsd.event.title":            "deploy",
+				"dogstatsd.event.priority":         "low",
+				"dogstatsd.event.alert_type":       "error",
+				"dogstatsd.event.aggregation_key":  "deploys",
+				"dogstatsd.event.source_type_name": "jenkins",
+				"host.name":                        "web-1",
+				"container.id":                     "abc123",
+				"env":                              "prod",
+				"team":                             "payments",
+			},
+			wantSeverity:  plog.SeverityNumberError,
+			wantTimestamp: pcommon.Timestamp(1700000000 * time.Second),
+		},
+		{
+			name:     "text containing separators",
+			input:    "_e{5,8}:title|a|b:c|#d|t:warning",
+			wantBody: "a|b:c|#d",
+			wantAttrs: map[string]any{
+				"dogstatsd.event.title":      "title",
+				"dogstatsd.event.priority":   "normal",
+				"dogstatsd.event.alert_type": "warning",
+			},
+			wantSeverity: plog.SeverityNumberWarn,
+		},
+		{
+			name:  "invalid lengths",
+			input: "_e{a,1}:title|t",
+			err:   errors.New("invalid event lengths: a,1"),
+		},
+		{
+			name:  "missing lengths",
+			input: "_e{5}:title|t",
+			err:   errors.New("invalid event lengths: 5"),
+		},
+		{
+			name:  "lengths do not match",
+			input: "_e{3,1}:title|t",
+			err:   errors.New("event title and text do not match their lengths: _e{3,1}:title|t"),
+		},
+		{
+			name:  "empty title",
+			input: "_e{0,4}:|text",
+			err:   errEmptyEventTitle,
+		},
+		{
+			name:  "invalid priority",
+			input: "_e{5,4}:title|text|p:high",
+			err:   errors.New("invalid event priority: high"),
+		},
+		{
+			name:  "invalid alert type",
+			input: "_e{5,4}:title|text|t:fatal",
+			err:   errors.New("invalid event alert type: fatal"),
+		},
+		{
+			name:  "invalid timestamp",
+			input: "_e{5,4}:title|text|d:yesterday",
+			err:   errors.New("invalid timestamp: yesterday"),
+		},
+		{
+			name:  "unrecognized part",
+			input: "_e{5,4}:title|text|x:y",
+			err:   errors.New("unrecognized event part: x:y"),
+		},
+		{
+			name:  "simple tag not enabled",
+			input: "_e{5,4}:title|text|#canary",
+			err:   errors.New("invalid tag format: \"canary\""),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lr := plog.NewLogRecord()
+			err := parseEvent(tt.input, false, lr)
+			if tt.err != nil {
+				assert.Equal(t, tt.err, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "dogstatsd.event", lr.EventName())
+			assert.Equal(t, tt.wantBody, lr.Body().Str())
+			assert.Equal(t, tt.wantAttrs, lr.Attributes().AsRaw())
+			assert.Equal(t, tt.wantSeverity, lr.SeverityNumber())
+			assert.Equal(t, tt.wantAttrs["dogstatsd.event.alert_type"], lr.SeverityText())
+			assert.Equal(t, tt.wantTimestamp, lr.Timestamp())
+		})
+	}
+}
+
+func Test_ParseServiceCheck(t *testing.T) {
+	tests := []struct {
+		name             string
+		input            string
+		wantBody         string
+		wantAttrs        map[string]any
+		wantSeverity     plog.SeverityNumber
+		wantSeverityText string
+		wantTimestamp    pcommon.Timestamp
+		err              error
+	}{
+		{
+			name:  "name and status",
+			input: "_sc|checkout.health|0",
+			wantAttrs: map[string]any{
+				"dogstatsd.service_check.name":   "checkout.health",
+				"dogstatsd.service_check.status": int64(0),
+			},
+			wantSeverity:     plog.SeverityNumberInfo,
+			wantSeverityText: "OK",
+		},
+		{
+			name:     "all fields",
+			input:    "_sc|checkout.health|2|d:1700000000|h:web-1|#env:prod|c:abc123|m:db down|retrying\\nm\\: 3 attempts",
+			wantBody: "db down|retrying\nm: 3 attempts",
+			wantAttrs: map[string]any{
+				"dogstatsd.service_check.name":   "checkout.health",
+				"dogstatsd.service_check.status": int64(2),
+				"host.name":                      "web-1",
+				"container.id":                   "abc123",
+				"env":                            "prod",
+			},
+			wantSeverity:     plog.SeverityNumberError,
+			wantSeverityText: "CRITICAL",
+			wantTimestamp:    pcommon.Timestamp(1700000000 * time.Second),
+		},
+		{
+			name:  "unknown status",
+			input: "_sc|checkout.health|3",
+			wantAttrs: map[string]any{
+				"dogstatsd.service_check.name":   "checkout.health",
+				"dogstatsd.service_check.status": int64(3),
+			},
+			wantSeverity:     plog.SeverityNumberUnspecified,
+			wantSeverityText: "UNKNOWN",
+		},
+		{
+			name:  "missing status",
+			input: "_sc|checkout.health",
+			err:   errors.New("invalid service check format: _sc|checkout.health"),
+		},
+		{
+			name:  "empty name",
+			input: "_sc||0",
+			err:   errEmptyServiceCheckName,
+		},
+		{
+			name:  "invalid status",
+			input: "_sc|checkout.health|4",
+			err:   errors.New("invalid service check status: 4"),
+		},
+		{
+			name:  "unrecognized part",
+			input: "_sc|checkout.health|1|p:low",
+			err:   errors.New("unrecognized service check part: p:low"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lr := plog.NewLogRecord()
+			err := parseServiceCheck(tt.input, false, lr)
+			if tt.err != nil {
+				assert.Equal(t, tt.err, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "dogstatsd.service_check", lr.EventName())
+			assert.Equal(t, tt.wantBody, lr.Body().AsString())
+			assert.Equal(t, tt.wantAttrs, lr.Attributes().AsRaw())
+			assert.Equal(t, tt.wantSeverity, lr.SeverityNumber())
+			assert.Equal(t, tt.wantSeverityText, lr.SeverityText())
+			assert.Equal(t, tt.wantTimestamp, lr.Timestamp())
+		})
+	}
+}
+
+func TestStatsDParser_AggregateEventsAndServiceChecks(t *testing.T) {
+	timeNowFunc = func() time.Time {
+		return time.Unix(1711000000, 0)
+	}
+	defer func() { timeNowFunc = time.Now }()
+
+	p := &StatsDParser{BuildInfo: component.BuildInfo{Version: "v1.2.3"}}
+	require.NoError(t, p.Initialize(false, true, false, false, nil))
+
+	addr1, _ := net.ResolveUDPAddr("udp", "1.2.3.4:5678")
+	addr2, _ := net.ResolveUDPAddr("udp", "5.6.7.8:5678")
+	require.NoError(t, p.Aggregate("_e{6,4}:deploy|done|#canary", addr1))
+	require.NoError(t, p.Aggregate("_sc|checkout.health|1|d:1700000000", addr1))
+	require.NoError(t, p.Aggregate("test.metric:1|c", addr1))
+	require.NoError(t, p.Aggregate("_sc|cart.health|0", addr2))
+	assert.Error(t, p.Aggregate("_sc|cart.health|9", addr2))
+
+	batches := p.GetLogs()
+	require.Len(t, batches, 2)
+	byAddr := map[string]plog.Logs{}
+	for _, batch := range batches {
+		byAddr[batch.Info.Addr.String()] = batch.Logs
+	}
+
+	ld := byAddr[addr1.String()]
+	require.Equal(t, 1, ld.ResourceLogs().Len())
+	sl := ld.ResourceLogs().At(0).ScopeLogs().At(0)
+	assert.Equal(t, receiverName, sl.Scope().Name())
+	assert.Equal(t, "v1.2.3", sl.Scope().Version())
+	require.Equal(t, 2, sl.LogRecords().Len())
+
+	event := sl.LogRecords().At(0)
+	assert.Equal(t, "done", event.Body().Str())
+	canary, ok := event.Attributes().Get("canary")
+	assert.True(t, ok)
+	assert.Empty(t, canary.Str())
+	// Without a timestamp field the log record is timestamped when it is received.
+	assert.Equal(t, pcommon.Timestamp(1711000000*time.Second), event.Timestamp())
+	assert.Equal(t, pcommon.Timestamp(1711000000*time.Second), event.ObservedTimestamp())
+
+	serviceCheck := sl.LogRecords().At(1)
+	assert.Equal(t, "WARNING", serviceCheck.SeverityText())
+	assert.Equal(t, pcommon.Timestamp(1700000000*time.Second), serviceCheck.Timestamp())
+	assert.Equal(t, pcommon.Timestamp(1711000000*time.Second), serviceCheck.ObservedTimestamp())
+
+	assert.Equal(t, 1, byAddr[addr2.String()].LogRecordCount())
+
+	// Events and service checks are not reported as metrics, and are reset once flushed.
+	metrics := p.GetMetrics()
+	require.Len(t, metrics, 1)
+	assert.Equal(t, 1, metrics[0].Metrics.DataPointCount())
+	assert.Empty(t, p.GetLogs())
+}
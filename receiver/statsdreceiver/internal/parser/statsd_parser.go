@@ -57,6 +57,7 @@ var defaultObserverCategory = ObserverCategory{
 // StatsDParser supports the Parse method for parsing StatsD messages with Tags.
 type StatsDParser struct {
 	instrumentsByAddress    map[netAddr]*instruments
+	logRecordsByAddress     map[netAddr]*logRecords
 	enableMetricType        bool
 	enableSimpleTags        bool
 	isMonotonicCounter      bool
@@ -203,6 +204,7 @@ func (p *StatsDParser) resetState(when time.Time) {
 
 func (p *StatsDParser) Initialize(enableMetricType, enableSimpleTags, isMonotonicCounter, enableIPOnlyAggregation bool, sendTimerHistogram []protocol.TimerHistogramMapping) error {
 	p.resetState(timeNowFunc())
+	p.logRecordsByAddress = make(map[netAddr]*logRecords)
 
 	p.histogramEvents = defaultObserverCategory
 	p.timerEvents = defaultObserverCategory
@@ -325,6 +327,13 @@ func (p *StatsDParser) setVersionAndNameScope(ilm pcommon.InstrumentationScope)
 	ilm.SetName(receiverName)
 }
 
+func (p *StatsDParser) netAddrKey(addr net.Addr) netAddr {
+	if p.enableIPOnlyAggregation {
+		return newIPOnlyNetAddr(addr)
+	}
+	return newNetAddr(addr)
+}
+
 var timeNowFunc = time.Now
 
 func (p *StatsDParser) observerCategoryFor(t MetricType) ObserverCategory {
@@ -340,16 +349,16 @@ func (p *StatsDParser) observerCategoryFor(t MetricType) ObserverCategory {
 
 // Aggregate for each metric line.
 func (p *StatsDParser) Aggregate(line string, addr net.Addr) error {
+	if isEventOrServiceCheck(line) {
+		return p.aggregateLogRecord(line, addr)
+	}
+
 	parsedMetric, err := parseMessageToMetric(line, p.enableMetricType, p.enableSimpleTags)
 	if err != nil {
 		return err
 	}
 
-	addrKey := newNetAddr(addr)
-	if p.enableIPOnlyAggregation {
-		addrKey = newIPOnlyNetAddr(addr)
-	}
-
+	addrKey := p.netAddrKey(addr)
 	instrument, ok := p.instrumentsByAddress[addrKey]
 	if !ok {
 		instrument = newInstruments(addr)
@@ -502,28 +511,11 @@ func parseMessageToMetric(line string, enableMetricType, enableSimpleTags bool)
 		case strings.HasPrefix(part, "#"):
 			tagsStr := strings.TrimPrefix(part, "#")
 
-			// handle an empty tag set
-			// where the tags part was still sent (some clients do this)
-			if tagsStr == "" {
-				continue
-			}
-
-			var tagSet string
-			tagSet, tagsStr, _ = strings.Cut(tagsStr, ",")
-			for ; tagSet != ""; tagSet, tagsStr, _ = strings.Cut(tagsStr, ",") {
-				k, v, _ := strings.Cut(tagSet, ":")
-				if k == "" {
-					return result, fmt.Errorf("invalid tag format: %q", tagSet)
-				}
-
-				// support both simple tags (w/o value) and dimension tags (w/ value).
-				// dogstatsd notably allows simple tags.
-				if v == "" && !enableSimpleTags {
-					return result, fmt.Errorf("invalid tag format: %q", tagSet)
-				}
-
-				kvs = append(kvs, attribute.String(k, v))
+			tags, err := parseTags(tagsStr, enableSimpleTags)
+			if err != nil {
+				return result, err
 			}
+			kvs = append(kvs, tags...)
 		case strings.HasPrefix(part, "c:"):
 			// As per DogStatD protocol v1.2:
 			// https://docs.datadoghq.com/developers/dogstatsd/datagram_shell/?tab=metrics#dogstatsd-protocol-v12
@@ -570,6 +562,34 @@ func parseMessageToMetric(line string, enableMetricType, enableSimpleTags bool)
 	return result, nil
 }
 
+// parseTags parses the comma separated tags of a DogStatsD datagram.
+func parseTags(tagsStr string, enableSimpleTags bool) ([]attribute.KeyValue, error) {
+	// handle an empty tag set
+	// where the tags part was still sent (some clients do this)
+	if tagsStr == "" {
+		return nil, nil
+	}
+
+	var kvs []attribute.KeyValue
+	var tagSet string
+	tagSet, tagsStr, _ = strings.Cut(tagsStr, ",")
+	for ; tagSet != ""; tagSet, tagsStr, _ = strings.Cut(tagsStr, ",") {
+		k, v, _ := strings.Cut(tagSet, ":")
+		if k == "" {
+			return nil, fmt.Errorf("invalid tag format: %q", tagSet)
+		}
+
+		// support both simple tags (w/o value) and dimension tags (w/ value).
+		// dogstatsd notably allows simple tags.
+		if v == "" && !enableSimpleTags {
+			return nil, fmt.Errorf("invalid tag format: %q", tagSet)
+		}
+
+		kvs = append(kvs, attribute.String(k, v))
+	}
+	return kvs, nil
+}
+
 type netAddr struct {
 	Network string
 	String  string
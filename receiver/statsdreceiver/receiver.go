@@ -25,27 +25,32 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/statsdreceiver/internal/transport"
 )
 
-var _ receiver.Metrics = (*statsdReceiver)(nil)
+var (
+	_ receiver.Metrics = (*statsdReceiver)(nil)
+	_ receiver.Logs    = (*statsdReceiver)(nil)
+)
 
-// statsdReceiver implements the receiver.Metrics for StatsD protocol.
+// statsdReceiver implements the receiver.Metrics for StatsD protocol, and the
+// receiver.Logs for DogStatsD events and service checks.
 type statsdReceiver struct {
 	settings receiver.Settings
 	config   *Config
 
-	server       transport.Server
-	reporter     *reporter
-	obsrecv      *receiverhelper.ObsReport
-	parser       parser.Parser
-	nextConsumer consumer.Metrics
-	cancel       context.CancelFunc
+	server              transport.Server
+	reporter            *reporter
+	obsrecv             *receiverhelper.ObsReport
+	parser              parser.Parser
+	nextMetricsConsumer consumer.Metrics
+	nextLogsConsumer    consumer.Logs
+	cancel              context.CancelFunc
 }
 
-// newReceiver creates the StatsD receiver with the given parameters.
+// newReceiver creates the StatsD receiver with the given parameters. The next
+// consumers are set by the factory for each signal the receiver is used for.
 func newReceiver(
 	set receiver.Settings,
 	config Config,
-	nextConsumer consumer.Metrics,
-) (receiver.Metrics, error) {
+) (*statsdReceiver, error) {
 	trans := transport.NewTransport(strings.ToLower(string(config.NetAddr.Transport)))
 
 	if config.NetAddr.Endpoint == "" {
@@ -72,11 +77,10 @@ func newReceiver(
 	}
 
 	r := &statsdReceiver{
-		settings:     set,
-		config:       &config,
-		nextConsumer: nextConsumer,
-		obsrecv:      obsrecv,
-		reporter:     rep,
+		settings: set,
+		config:   &config,
+		obsrecv:  obsrecv,
+		reporter: rep,
 		parser: &parser.StatsDParser{
 			BuildInfo: set.BuildInfo,
 		},
@@ -118,8 +122,14 @@ func (r *statsdReceiver) Start(ctx context.Context, host component.Host) error {
 	if err != nil {
 		return err
 	}
+	// The transport servers require a metrics consumer, which is not set when the
+	// receiver is only used for DogStatsD events and service checks.
+	nextMetricsConsumer := r.nextMetricsConsumer
+	if nextMetricsConsumer == nil {
+		nextMetricsConsumer, _ = consumer.NewMetrics(func(context.Context, pmetric.Metrics) error { return nil })
+	}
 	go func() {
-		if err := r.server.ListenAndServe(r.nextConsumer, r.reporter, transferChan); err != nil {
+		if err := r.server.ListenAndServe(nextMetricsConsumer, r.reporter, transferChan); err != nil {
 			if !errors.Is(err, net.ErrClosed) {
 				componentstatus.ReportStatus(host, componentstatus.NewFatalErrorEvent(err))
 			}
@@ -131,15 +141,30 @@ func (r *statsdReceiver) Start(ctx context.Context, host component.Host) error {
 			select {
 			case <-ticker.C:
 				batchMetrics := r.parser.GetMetrics()
-				for _, batch := range batchMetrics {
-					batchCtx := client.NewContext(ctx, batch.Info)
-					numPoints := batch.Metrics.DataPointCount()
-					flushCtx := r.obsrecv.StartMetricsOp(batchCtx)
-					err := r.Flush(flushCtx, batch.Metrics, r.nextConsumer)
-					if err != nil {
-						r.reporter.OnDebugf("Error flushing metrics", zap.Error(err))
+				if r.nextMetricsConsumer != nil {
+					for _, batch := range batchMetrics {
+						batchCtx := client.NewContext(ctx, batch.Info)
+						numPoints := batch.Metrics.DataPointCount()
+						flushCtx := r.obsrecv.StartMetricsOp(batchCtx)
+						err := r.Flush(flushCtx, batch.Metrics, r.nextMetricsConsumer)
+						if err != nil {
+							r.reporter.OnDebugf("Error flushing metrics", zap.Error(err))
+						}
+						r.obsrecv.EndMetricsOp(flushCtx, metadata.Type.String(), numPoints, err)
+					}
+				}
+				batchLogs := r.parser.GetLogs()
+				if r.nextLogsConsumer != nil {
+					for _, batch := range batchLogs {
+						batchCtx := client.NewContext(ctx, batch.Info)
+						numRecords := batch.Logs.LogRecordCount()
+						flushCtx := r.obsrecv.StartLogsOp(batchCtx)
+						err := r.nextLogsConsumer.ConsumeLogs(flushCtx, batch.Logs)
+						if err != nil {
+							r.reporter.OnDebugf("Error flushing logs", zap.Error(err))
+						}
+						r.obsrecv.EndLogsOp(flushCtx, metadata.Type.String(), numRecords, err)
 					}
-					r.obsrecv.EndMetricsOp(flushCtx, metadata.Type.String(), numPoints, err)
 				}
 			case metric := <-transferChan:
 				err := r.parser.Aggregate(metric.Raw, metric.Addr)
@@ -5,6 +5,7 @@ package statsdreceiver
 
 import (
 	"errors"
+	"net"
 	"runtime"
 	"testing"
 	"time"
@@ -49,8 +50,9 @@ func Test_statsdreceiver_Start(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			receiver, err := newReceiver(receivertest.NewNopSettings(metadata.Type), tt.args.config, tt.args.nextConsumer)
+			receiver, err := newReceiver(receivertest.NewNopSettings(metadata.Type), tt.args.config)
 			require.NoError(t, err)
+			receiver.nextMetricsConsumer = tt.args.nextConsumer
 			err = receiver.Start(t.Context(), componenttest.NewNopHost())
 			assert.Equal(t, tt.wantErr, err)
 
@@ -62,10 +64,9 @@ func Test_statsdreceiver_Start(t *testing.T) {
 func TestStatsdReceiver_ShutdownBeforeStart(t *testing.T) {
 	ctx := t.Context()
 	cfg := createDefaultConfig().(*Config)
-	nextConsumer := consumertest.NewNop()
-	rcv, err := newReceiver(receivertest.NewNopSettings(metadata.Type), *cfg, nextConsumer)
+	r, err := newReceiver(receivertest.NewNopSettings(metadata.Type), *cfg)
 	assert.NoError(t, err)
-	r := rcv.(*statsdReceiver)
+	r.nextMetricsConsumer = consumertest.NewNop()
 	assert.NoError(t, r.Shutdown(ctx))
 }
 
@@ -73,9 +74,9 @@ func TestStatsdReceiver_Flush(t *testing.T) {
 	ctx := t.Context()
 	cfg := createDefaultConfig().(*Config)
 	nextConsumer := consumertest.NewNop()
-	rcv, err := newReceiver(receivertest.NewNopSettings(metadata.Type), *cfg, nextConsumer)
+	r, err := newReceiver(receivertest.NewNopSettings(metadata.Type), *cfg)
 	assert.NoError(t, err)
-	r := rcv.(*statsdReceiver)
+	r.nextMetricsConsumer = nextConsumer
 	metrics := pmetric.NewMetrics()
 	assert.NoError(t, r.Flush(ctx, metrics, nextConsumer))
 	assert.NoError(t, r.Start(ctx, componenttest.NewNopHost()))
@@ -136,9 +137,9 @@ func Test_statsdreceiver_EndToEnd(t *testing.T) {
 			}
 			cfg.NetAddr.Endpoint = tt.addr
 			sink := new(consumertest.MetricsSink)
-			rcv, err := newReceiver(receivertest.NewNopSettings(metadata.Type), *cfg, sink)
+			r, err := newReceiver(receivertest.NewNopSettings(metadata.Type), *cfg)
 			require.NoError(t, err)
-			r := rcv.(*statsdReceiver)
+			r.nextMetricsConsumer = sink
 
 			require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
 			defer func() {
@@ -183,3 +184,69 @@ func Test_statsdreceiver_EndToEnd(t *testing.T) {
 		})
 	}
 }
+
+func Test_statsdreceiver_EndToEndLogs(t *testing.T) {
+	addr := testutil.GetAvailableLocalNetworkAddress(t, "udp")
+	cfg := createDefaultConfig().(*Config)
+	cfg.NetAddr.Endpoint = addr
+	cfg.AggregationInterval = 1 * time.Second
+
+	metricsSink := new(consumertest.MetricsSink)
+	logsSink := new(consumertest.LogsSink)
+	r, err := newReceiver(receivertest.NewNopSettings(metadata.Type), *cfg)
+	require.NoError(t, err)
+	r.nextMetricsConsumer = metricsSink
+	r.nextLogsConsumer = logsSink
+
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+	defer func() {
+		assert.NoError(t, r.Shutdown(t.Context()))
+	}()
+
+	conn, err := net.Dial("udp", addr)
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = conn.Write([]byte("_e{6,13}:deploy|version 1.2.3|t:success|#env:prod\n_sc|checkout.health|2|m:database down\ntest.metric:42|c\n"))
+	require.NoError(t, err)
+
+	require.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.Equal(c, 2, logsSink.LogRecordCount())
+		assert.Equal(c, 1, metricsSink.DataPointCount())
+	}, 10*time.Second, 100*time.Millisecond)
+
+	var bodies []string
+	for _, ld := range logsSink.AllLogs() {
+		for _, lr := range ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().All() {
+			bodies = append(bodies, lr.Body().Str())
+		}
+	}
+	assert.Equal(t, []string{"version 1.2.3", "database down"}, bodies)
+}
+
+func Test_statsdreceiver_LogsOnly(t *testing.T) {
+	addr := testutil.GetAvailableLocalNetworkAddress(t, "udp")
+	cfg := createDefaultConfig().(*Config)
+	cfg.NetAddr.Endpoint = addr
+	cfg.AggregationInterval = 1 * time.Second
+
+	logsSink := new(consumertest.LogsSink)
+	r, err := newReceiver(receivertest.NewNopSettings(metadata.Type), *cfg)
+	require.NoError(t, err)
+	r.nextLogsConsumer = logsSink
+
+	// The transport server fails before listening without a metrics consumer
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+	defer func() {
+		assert.NoError(t, r.Shutdown(t.Context()))
+	}()
+
+	conn, err := net.Dial("udp", addr)
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = conn.Write([]byte("_e{6,13}:deploy|version 1.2.3\ntest.metric:42|c\n"))
+	require.NoError(t, err)
+
+	require.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.Equal(c, 1, logsSink.LogRecordCount())
+	}, 10*time.Second, 100*time.Millisecond)
+}
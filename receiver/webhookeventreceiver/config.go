@@ -5,10 +5,13 @@ package webhookeventreceiver // import "github.com/open-telemetry/opentelemetry-
 
 import (
 	"errors"
+	"fmt"
 	"regexp"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
 	"go.uber.org/multierr"
 )
 
@@ -18,6 +21,12 @@ var (
 	errWriteTimeoutExceedsMaxValue = errors.New("the duration specified for write_timeout exceeds the maximum allowed value of 10s")
 	errRequiredHeader              = errors.New("both key and value are required to assign a required_header")
 	errHeaderAttributeRegexCompile = errors.New("regex for header_attribute_regex failed to compile")
+	errMissingSignatureSecret      = errors.New("a secret is required to verify signatures")
+	errMissingSignatureHeader      = errors.New("either a preset or a header is required to verify signatures")
+	errSignaturePresetOverride     = errors.New("header, algorithm, encoding, prefix, timestamp_header and payload cannot be set together with a preset")
+	errSignaturePayloadBody        = errors.New("the signature payload must contain " + payloadBodyPlaceholder)
+	errSignaturePayloadTimestamp   = errors.New("a timestamp_header is required when the signature payload contains " + payloadTimestampPlaceholder)
+	errNegativeTimestampTolerance  = errors.New("timestamp_tolerance cannot be negative")
 )
 
 // Config defines configuration for the Generic Webhook receiver.
@@ -32,6 +41,7 @@ type Config struct {
 	SplitLogsAtJSONBoundary    bool                     `mapstructure:"split_logs_at_json_boundary"`   // optional setting to split logs at JSON object boundaries
 	ConvertHeadersToAttributes bool                     `mapstructure:"convert_headers_to_attributes"` // optional to convert all headers to attributes
 	HeaderAttributeRegex       string                   `mapstructure:"header_attribute_regex"`        // optional to convert headers matching a regex to log attributes
+	Signature                  SignatureConfig          `mapstructure:"signature"`                     // optional setting to verify the HMAC signature of all requests
 }
 
 type RequiredHeader struct {
@@ -39,6 +49,76 @@ type RequiredHeader struct {
 	Value string `mapstructure:"value"`
 }
 
+// SignatureConfig configures the verification of HMAC signed requests.
+// Requests without a valid signature are rejected with a 401 response.
+type SignatureConfig struct {
+	// Preset selects the signature scheme of a known provider: github, stripe, slack or pagerduty.
+	// Presets also record the provider and its event headers as log attributes.
+	Preset string `mapstructure:"preset"`
+	// Secret is the shared secret used to compute the HMAC.
+	Secret configopaque.String `mapstructure:"secret"`
+	// Header is the request header carrying the signature.
+	Header string `mapstructure:"header"`
+	// Algorithm is the HMAC hash algorithm: sha1, sha256 or sha512. Default is sha256.
+	Algorithm string `mapstructure:"algorithm"`
+	// Encoding is the encoding of the signature: hex or base64. Default is hex.
+	Encoding string `mapstructure:"encoding"`
+	// Prefix is stripped from the signature, e.g. "sha256=".
+	Prefix string `mapstructure:"prefix"`
+	// TimestampHeader is the request header carrying the unix timestamp of the request.
+	TimestampHeader string `mapstructure:"timestamp_header"`
+	// TimestampTolerance is the maximum age of a signed request. Zero disables the check. Default is 5m.
+	TimestampTolerance time.Duration `mapstructure:"timestamp_tolerance"`
+	// Payload is the template of the signed payload. {timestamp} and {body} are replaced
+	// with the request timestamp and body. Default is {body}.
+	Payload string `mapstructure:"payload"`
+}
+
+func (cfg *SignatureConfig) enabled() bool {
+	return cfg.Preset != "" || cfg.Secret != "" || cfg.Header != ""
+}
+
+func (cfg *SignatureConfig) validate() error {
+	if !cfg.enabled() {
+		return nil
+	}
+
+	var errs error
+	if cfg.Secret == "" {
+		errs = multierr.Append(errs, errMissingSignatureSecret)
+	}
+	if cfg.TimestampTolerance < 0 {
+		errs = multierr.Append(errs, errNegativeTimestampTolerance)
+	}
+
+	if cfg.Preset != "" {
+		if _, ok := signaturePresets[cfg.Preset]; !ok {
+			errs = multierr.Append(errs, fmt.Errorf("unknown signature preset %q", cfg.Preset))
+		}
+		if cfg.Header != "" || cfg.Algorithm != "" || cfg.Encoding != "" || cfg.Prefix != "" || cfg.TimestampHeader != "" || cfg.Payload != "" {
+			errs = multierr.Append(errs, errSignaturePresetOverride)
+		}
+		return errs
+	}
+
+	if cfg.Header == "" {
+		errs = multierr.Append(errs, errMissingSignatureHeader)
+	}
+	if _, ok := hashAlgorithms[cfg.Algorithm]; cfg.Algorithm != "" && !ok {
+		errs = multierr.Append(errs, fmt.Errorf("unsupported signature algorithm %q", cfg.Algorithm))
+	}
+	if _, ok := signatureEncodings[cfg.Encoding]; cfg.Encoding != "" && !ok {
+		errs = multierr.Append(errs, fmt.Errorf("unsupported signature encoding %q", cfg.Encoding))
+	}
+	if cfg.Payload != "" && !strings.Contains(cfg.Payload, payloadBodyPlaceholder) {
+		errs = multierr.Append(errs, errSignaturePayloadBody)
+	}
+	if strings.Contains(cfg.Payload, payloadTimestampPlaceholder) && cfg.TimestampHeader == "" {
+		errs = multierr.Append(errs, errSignaturePayloadTimestamp)
+	}
+	return errs
+}
+
 func (cfg *Config) Validate() error {
 	var errs error
 
@@ -94,6 +174,10 @@ func (cfg *Config) Validate() error {
 		}
 	}
 
+	if err := cfg.Signature.validate(); err != nil {
+		errs = multierr.Append(errs, err)
+	}
+
 	return errs
 }
 
@@ -5,8 +5,10 @@ package webhookeventreceiver
 
 import (
 	"bufio"
+	"errors"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
@@ -103,6 +105,139 @@ func TestValidateConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "Valid signature preset",
+			conf: Config{
+				ServerConfig: confighttp.ServerConfig{
+					Endpoint: "localhost:0",
+				},
+				Signature: SignatureConfig{
+					Preset: "github",
+					Secret: "secret",
+				},
+			},
+		},
+		{
+			desc:   "Signature without secret",
+			expect: errMissingSignatureSecret,
+			conf: Config{
+				ServerConfig: confighttp.ServerConfig{
+					Endpoint: "localhost:0",
+				},
+				Signature: SignatureConfig{
+					Preset: "github",
+				},
+			},
+		},
+		{
+			desc:   "Unknown signature preset",
+			expect: errors.New(`unknown signature preset "bitbucket"`),
+			conf: Config{
+				ServerConfig: confighttp.ServerConfig{
+					Endpoint: "localhost:0",
+				},
+				Signature: SignatureConfig{
+					Preset: "bitbucket",
+					Secret: "secret",
+				},
+			},
+		},
+		{
+			desc:   "Signature preset with custom header",
+			expect: errSignaturePresetOverride,
+			conf: Config{
+				ServerConfig: confighttp.ServerConfig{
+					Endpoint: "localhost:0",
+				},
+				Signature: SignatureConfig{
+					Preset: "slack",
+					Secret: "secret",
+					Header: "X-Signature",
+				},
+			},
+		},
+		{
+			desc:   "Signature without header",
+			expect: errMissingSignatureHeader,
+			conf: Config{
+				ServerConfig: confighttp.ServerConfig{
+					Endpoint: "localhost:0",
+				},
+				Signature: SignatureConfig{
+					Secret: "secret",
+				},
+			},
+		},
+		{
+			desc:   "Unsupported signature algorithm",
+			expect: errors.New(`unsupported signature algorithm "md5"`),
+			conf: Config{
+				ServerConfig: confighttp.ServerConfig{
+					Endpoint: "localhost:0",
+				},
+				Signature: SignatureConfig{
+					Secret:    "secret",
+					Header:    "X-Signature",
+					Algorithm: "md5",
+				},
+			},
+		},
+		{
+			desc:   "Unsupported signature encoding",
+			expect: errors.New(`unsupported signature encoding "base32"`),
+			conf: Config{
+				ServerConfig: confighttp.ServerConfig{
+					Endpoint: "localhost:0",
+				},
+				Signature: SignatureConfig{
+					Secret:   "secret",
+					Header:   "X-Signature",
+					Encoding: "base32",
+				},
+			},
+		},
+		{
+			desc:   "Signature payload without body",
+			expect: errSignaturePayloadBody,
+			conf: Config{
+				ServerConfig: confighttp.ServerConfig{
+					Endpoint: "localhost:0",
+				},
+				Signature: SignatureConfig{
+					Secret:  "secret",
+					Header:  "X-Signature",
+					Payload: "payload",
+				},
+			},
+		},
+		{
+			desc:   "Signature payload with timestamp but no timestamp header",
+			expect: errSignaturePayloadTimestamp,
+			conf: Config{
+				ServerConfig: confighttp.ServerConfig{
+					Endpoint: "localhost:0",
+				},
+				Signature: SignatureConfig{
+					Secret:  "secret",
+					Header:  "X-Signature",
+					Payload: "{timestamp}.{body}",
+				},
+			},
+		},
+		{
+			desc:   "Negative timestamp tolerance",
+			expect: errNegativeTimestampTolerance,
+			conf: Config{
+				ServerConfig: confighttp.ServerConfig{
+					Endpoint: "localhost:0",
+				},
+				Signature: SignatureConfig{
+					Preset:             "stripe",
+					Secret:             "secret",
+					TimestampTolerance: -time.Second,
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -5,6 +5,7 @@ package webhookeventreceiver // import "github.com/open-telemetry/opentelemetry-
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
@@ -26,6 +27,9 @@ const (
 	defaultPath               = "/events"
 	defaultHealthPath         = "/health_check"
 	defaultMaxRequestBodySize = 100 * 1024 // 100KB
+	defaultSignatureAlgorithm = "sha256"
+	defaultSignatureEncoding  = "hex"
+	defaultTimestampTolerance = 5 * time.Minute
 )
 
 // NewFactory creates a factory for Generic Webhook Receiver.
@@ -50,6 +54,9 @@ func createDefaultConfig() component.Config {
 		ConvertHeadersToAttributes: false, // optional, off by default
 		SplitLogsAtNewLine:         false,
 		SplitLogsAtJSONBoundary:    false,
+		Signature: SignatureConfig{
+			TimestampTolerance: defaultTimestampTolerance,
+		},
 	}
 }
 
@@ -5,9 +5,11 @@ package webhookeventreceiver // import "github.com/open-telemetry/opentelemetry-
 
 import (
 	"bufio"
+	"bytes"
 	"compress/gzip"
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"regexp"
@@ -46,6 +48,7 @@ type eventReceiver struct {
 	gzipPool            *sync.Pool
 	includeHeadersRegex *regexp.Regexp
 	maxRequestBodySize  int // Computed max token size for scanner (minimum 64KB)
+	signature           *signatureVerifier
 }
 
 func newLogsReceiver(params receiver.Settings, cfg Config, consumer consumer.Logs) (receiver.Logs, error) {
@@ -86,6 +89,7 @@ func newLogsReceiver(params receiver.Settings, cfg Config, consumer consumer.Log
 		gzipPool:            &sync.Pool{New: func() any { return new(gzip.Reader) }},
 		includeHeadersRegex: includeHeaderRegex,
 		maxRequestBodySize:  int(cfg.MaxRequestBodySize),
+		signature:           newSignatureVerifier(cfg.Signature),
 	}
 
 	return er, nil
@@ -189,6 +193,26 @@ func (er *eventReceiver) handleReq(w http.ResponseWriter, r *http.Request, _ htt
 	}
 
 	bodyReader := r.Body
+	// signatures are computed over the raw request body, so it has to be read before it is decoded.
+	if er.signature != nil {
+		body, err := io.ReadAll(io.LimitReader(r.Body, int64(er.maxRequestBodySize)+1))
+		_ = r.Body.Close()
+		if err == nil && len(body) > er.maxRequestBodySize {
+			err = fmt.Errorf("%w: limit is %d bytes", errRequestBodyTooLarge, er.maxRequestBodySize)
+		}
+		if err != nil {
+			er.failBadReq(ctx, w, http.StatusBadRequest, err)
+			er.obsrecv.EndLogsOp(ctx, metadata.Type.String(), 0, err)
+			return
+		}
+		if err := er.signature.verify(r.Header, body); err != nil {
+			er.failBadReq(ctx, w, http.StatusUnauthorized, err)
+			er.obsrecv.EndLogsOp(ctx, metadata.Type.String(), 0, err)
+			return
+		}
+		bodyReader = io.NopCloser(bytes.NewReader(body))
+	}
+
 	// gzip encoded case
 	if encoding == "gzip" || encoding == "x-gzip" {
 		reader := er.gzipPool.Get().(*gzip.Reader)
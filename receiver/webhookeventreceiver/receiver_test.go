@@ -219,6 +219,37 @@ func TestHandleReq(t *testing.T) {
 	}
 }
 
+func TestHandleSignedReq(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "localhost:0"
+	cfg.Signature.Preset = "github"
+	cfg.Signature.Secret = testSecret
+
+	sink := new(consumertest.LogsSink)
+	receiver, err := newLogsReceiver(receivertest.NewNopSettings(metadata.Type), *cfg, sink)
+	require.NoError(t, err, "Failed to create receiver")
+
+	r := receiver.(*eventReceiver)
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()), "Failed to start receiver")
+	defer func() {
+		require.NoError(t, r.Shutdown(t.Context()), "Failed to shutdown receiver")
+	}()
+
+	req := httptest.NewRequest(http.MethodPost, "http://localhost/events", strings.NewReader(testBody))
+	req.Header.Set("X-Hub-Signature-256", "sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17")
+	req.Header.Set("X-GitHub-Event", "push")
+	w := httptest.NewRecorder()
+	r.handleReq(w, req, httprouter.ParamsFromContext(t.Context()))
+
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+	require.Equal(t, 1, sink.LogRecordCount())
+	logRecord := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	require.Equal(t, testBody, logRecord.Body().Str())
+	eventType, ok := logRecord.Attributes().Get("webhook.event_type")
+	require.True(t, ok)
+	require.Equal(t, "push", eventType.Str())
+}
+
 // failure in its many forms
 func TestFailedReq(t *testing.T) {
 	cfg := createDefaultConfig().(*Config)
@@ -227,6 +258,10 @@ func TestFailedReq(t *testing.T) {
 	headerCfg.Endpoint = "localhost:0"
 	headerCfg.RequiredHeader.Key = "key-present"
 	headerCfg.RequiredHeader.Value = "value-present"
+	signatureCfg := createDefaultConfig().(*Config)
+	signatureCfg.Endpoint = "localhost:0"
+	signatureCfg.Signature.Preset = "github"
+	signatureCfg.Signature.Secret = testSecret
 
 	tests := []struct {
 		desc   string
@@ -276,6 +311,36 @@ func TestFailedReq(t *testing.T) {
 			}(),
 			status: http.StatusUnauthorized,
 		},
+		{
+			desc:   "Unsigned request",
+			cfg:    *signatureCfg,
+			req:    httptest.NewRequest(http.MethodPost, "http://localhost/events", strings.NewReader(testBody)),
+			status: http.StatusUnauthorized,
+		},
+		{
+			desc: "Invalid signature",
+			cfg:  *signatureCfg,
+			req: func() *http.Request {
+				req := httptest.NewRequest(http.MethodPost, "http://localhost/events", strings.NewReader("tampered"))
+				req.Header.Set("X-Hub-Signature-256", "sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17")
+				return req
+			}(),
+			status: http.StatusUnauthorized,
+		},
+		{
+			desc: "Signed request body exceeds max size",
+			cfg: func() Config {
+				c := *signatureCfg
+				c.MaxRequestBodySize = 4
+				return c
+			}(),
+			req: func() *http.Request {
+				req := httptest.NewRequest(http.MethodPost, "http://localhost/events", strings.NewReader(testBody))
+				req.Header.Set("X-Hub-Signature-256", "sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17")
+				return req
+			}(),
+			status: http.StatusBadRequest,
+		},
 		{
 			desc: "Request body exceeds max size",
 			cfg: func() Config {
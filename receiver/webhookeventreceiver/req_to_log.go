@@ -71,6 +71,9 @@ func (er *eventReceiver) reqToLog(sc *bufio.Scanner,
 			if er.includeHeadersRegex != nil {
 				appendHeaders(headers, logRecord, er.includeHeadersRegex)
 			}
+			if er.signature != nil {
+				er.signature.appendEventAttributes(headers, logRecord)
+			}
 		}
 	}
 
@@ -0,0 +1,289 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhookeventreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/webhookeventreceiver"
+
+import (
+	"crypto/hmac"
+	"crypto/sha1" // #nosec G505 -- some webhook providers still sign payloads with HMAC-SHA1
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+const (
+	payloadTimestampPlaceholder = "{timestamp}"
+	payloadBodyPlaceholder      = "{body}"
+
+	attrWebhookProvider   = "webhook.provider"
+	attrWebhookEventType  = "webhook.event_type"
+	attrWebhookDeliveryID = "webhook.delivery_id"
+)
+
+var (
+	errMissingSignature   = errors.New("request was missing the signature header")
+	errMissingTimestamp   = errors.New("request was missing the signature timestamp")
+	errInvalidTimestamp   = errors.New("request signature timestamp is invalid")
+	errTimestampTolerance = errors.New("request signature timestamp is outside of the allowed tolerance")
+	errInvalidSignature   = errors.New("request signature does not match")
+)
+
+var hashAlgorithms = map[string]func() hash.Hash{
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+	"sha512": sha512.New,
+}
+
+var signatureEncodings = map[string]func(string) ([]byte, error){
+	"hex":    hex.DecodeString,
+	"base64": base64.StdEncoding.DecodeString,
+}
+
+// signaturePreset describes how a webhook provider signs its requests.
+type signaturePreset struct {
+	header          string
+	algorithm       string
+	encoding        string
+	prefix          string
+	timestampHeader string
+	payload         string
+	// parseHeader extracts the timestamp and the signatures from the signature header
+	// for providers that send both in the same header.
+	parseHeader func(value, prefix string) (timestamp string, signatures []string)
+	// eventHeaders maps request headers to the log record attributes they are recorded as.
+	eventHeaders map[string]string
+}
+
+var signaturePresets = map[string]signaturePreset{
+	// https://docs.github.com/en/webhooks/using-webhooks/validating-webhook-deliveries
+	"github": {
+		header:    "X-Hub-Signature-256",
+		algorithm: "sha256",
+		encoding:  "hex",
+		prefix:    "sha256=",
+		payload:   payloadBodyPlaceholder,
+		eventHeaders: map[string]string{
+			"X-GitHub-Event":    attrWebhookEventType,
+			"X-GitHub-Delivery": attrWebhookDeliveryID,
+		},
+	},
+	// https://docs.stripe.com/webhooks#verify-manually
+	"stripe": {
+		header:      "Stripe-Signature",
+		algorithm:   "sha256",
+		encoding:    "hex",
+		prefix:      "v1=",
+		payload:     payloadTimestampPlaceholder + "." + payloadBodyPlaceholder,
+		parseHeader: parseKeyValueSignatureHeader,
+	},
+	// https://api.slack.com/authentication/verifying-requests-from-slack
+	"slack": {
+		header:          "X-Slack-Signature",
+		algorithm:       "sha256",
+		encoding:        "hex",
+		prefix:          "v0=",
+		timestampHeader: "X-Slack-Request-Timestamp",
+		payload:         "v0:" + payloadTimestampPlaceholder + ":" + payloadBodyPlaceholder,
+	},
+	// https://developer.pagerduty.com/docs/webhooks-overview#webhook-signatures
+	"pagerduty": {
+		header:    "X-PagerDuty-Signature",
+		algorithm: "sha256",
+		encoding:  "hex",
+		prefix:    "v1=",
+		payload:   payloadBodyPlaceholder,
+		eventHeaders: map[string]string{
+			"X-Webhook-Id": attrWebhookDeliveryID,
+		},
+	},
+}
+
+// signatureVerifier verifies the HMAC signature of incoming requests.
+type signatureVerifier struct {
+	provider        string
+	secret          []byte
+	hash            func() hash.Hash
+	decode          func(string) ([]byte, error)
+	header          string
+	prefix          string
+	timestampHeader string
+	tolerance       time.Duration
+	payload         string
+	parseHeader     func(value, prefix string) (timestamp string, signatures []string)
+	eventHeaders    map[string]string
+	now             func() time.Time
+}
+
+// newSignatureVerifier returns nil if signature verification is not configured.
+// The config is expected to have been validated.
+func newSignatureVerifier(cfg SignatureConfig) *signatureVerifier {
+	if !cfg.enabled() {
+		return nil
+	}
+	preset := signaturePreset{
+		header:          cfg.Header,
+		algorithm:       cfg.Algorithm,
+		encoding:        cfg.Encoding,
+		prefix:          cfg.Prefix,
+		timestampHeader: cfg.TimestampHeader,
+		payload:         cfg.Payload,
+	}
+	if cfg.Preset != "" {
+		preset = signaturePresets[cfg.Preset]
+	}
+	if preset.algorithm == "" {
+		preset.algorithm = defaultSignatureAlgorithm
+	}
+	if preset.encoding == "" {
+		preset.encoding = defaultSignatureEncoding
+	}
+	if preset.payload == "" {
+		preset.payload = payloadBodyPlaceholder
+	}
+	if preset.parseHeader == nil {
+		preset.parseHeader = parseListSignatureHeader
+	}
+	return &signatureVerifier{
+		provider:        cfg.Preset,
+		secret:          []byte(cfg.Secret),
+		hash:            hashAlgorithms[preset.algorithm],
+		decode:          signatureEncodings[preset.encoding],
+		header:          preset.header,
+		prefix:          preset.prefix,
+		timestampHeader: preset.timestampHeader,
+		tolerance:       cfg.TimestampTolerance,
+		payload:         preset.payload,
+		parseHeader:     preset.parseHeader,
+		eventHeaders:    preset.eventHeaders,
+		now:             time.Now,
+	}
+}
+
+// verify checks that the request carries a valid signature of body.
+func (v *signatureVerifier) verify(headers http.Header, body []byte) error {
+	headerValue := headers.Get(v.header)
+	if headerValue == "" {
+		return errMissingSignature
+	}
+	timestamp, signatures := v.parseHeader(headerValue, v.prefix)
+	if v.timestampHeader != "" {
+		timestamp = headers.Get(v.timestampHeader)
+	}
+	if len(signatures) == 0 {
+		return errMissingSignature
+	}
+
+	if strings.Contains(v.payload, payloadTimestampPlaceholder) {
+		if timestamp == "" {
+			return errMissingTimestamp
+		}
+		if err := v.checkTimestamp(timestamp); err != nil {
+			return err
+		}
+	}
+
+	expected := v.sign(timestamp, body)
+	for _, signature := range signatures {
+		decoded, err := v.decode(signature)
+		if err != nil {
+			continue
+		}
+		if hmac.Equal(decoded, expected) {
+			return nil
+		}
+	}
+	return errInvalidSignature
+}
+
+func (v *signatureVerifier) checkTimestamp(timestamp string) error {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errInvalidTimestamp
+	}
+	if v.tolerance <= 0 {
+		return nil
+	}
+	age := v.now().Sub(time.Unix(seconds, 0))
+	if age > v.tolerance || age < -v.tolerance {
+		return errTimestampTolerance
+	}
+	return nil
+}
+
+// sign computes the HMAC of the signed payload built from the payload template.
+func (v *signatureVerifier) sign(timestamp string, body []byte) []byte {
+	mac := hmac.New(v.hash, v.secret)
+	rest := v.payload
+	for rest != "" {
+		start := strings.IndexByte(rest, '{')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(rest[start:], '}')
+		if end < 0 {
+			break
+		}
+		end += start + 1
+		_, _ = mac.Write([]byte(rest[:start]))
+		switch rest[start:end] {
+		case payloadTimestampPlaceholder:
+			_, _ = mac.Write([]byte(timestamp))
+		case payloadBodyPlaceholder:
+			_, _ = mac.Write(body)
+		default:
+			_, _ = mac.Write([]byte(rest[start:end]))
+		}
+		rest = rest[end:]
+	}
+	_, _ = mac.Write([]byte(rest))
+	return mac.Sum(nil)
+}
+
+// appendEventAttributes records the provider and its event headers on the log record.
+func (v *signatureVerifier) appendEventAttributes(headers http.Header, l plog.LogRecord) {
+	if v.provider != "" {
+		l.Attributes().PutStr(attrWebhookProvider, v.provider)
+	}
+	for header, attr := range v.eventHeaders {
+		if value := headers.Get(header); value != "" {
+			l.Attributes().PutStr(attr, value)
+		}
+	}
+}
+
+// parseListSignatureHeader parses a comma separated list of signatures, e.g. "sha256=abc" or "v1=abc,v1=def".
+func parseListSignatureHeader(value, prefix string) (string, []string) {
+	var signatures []string
+	for part := range strings.SplitSeq(value, ",") {
+		part = strings.TrimSpace(part)
+		if signature, ok := strings.CutPrefix(part, prefix); ok && signature != "" {
+			signatures = append(signatures, signature)
+		}
+	}
+	return "", signatures
+}
+
+// parseKeyValueSignatureHeader parses a header carrying the timestamp next to the signatures,
+// e.g. "t=1492774577,v1=abc,v0=def".
+func parseKeyValueSignatureHeader(value, prefix string) (string, []string) {
+	var timestamp string
+	var signatures []string
+	for part := range strings.SplitSeq(value, ",") {
+		part = strings.TrimSpace(part)
+		if t, ok := strings.CutPrefix(part, "t="); ok {
+			timestamp = t
+		} else if signature, ok := strings.CutPrefix(part, prefix); ok && signature != "" {
+			signatures = append(signatures, signature)
+		}
+	}
+	return timestamp, signatures
+}
@@ -0,0 +1,191 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhookeventreceiver
+
+import (
+	"crypto/hmac"
+	"crypto/sha1" // #nosec G505 -- used to test HMAC-SHA1 signatures
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"hash"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+const (
+	testSecret    = "It's a Secret to Everybody"
+	testBody      = "Hello, World!"
+	testTimestamp = int64(1700000000)
+)
+
+func testHMAC(h func() hash.Hash, payload string) []byte {
+	mac := hmac.New(h, []byte(testSecret))
+	_, _ = mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
+
+func TestSignatureVerifier(t *testing.T) {
+	timestamp := strconv.FormatInt(testTimestamp, 10)
+	staleTimestamp := strconv.FormatInt(testTimestamp-600, 10)
+	bodySignature := hex.EncodeToString(testHMAC(sha256.New, testBody))
+
+	tests := []struct {
+		desc    string
+		cfg     SignatureConfig
+		headers map[string]string
+		err     error
+	}{
+		{
+			desc: "github",
+			cfg:  SignatureConfig{Preset: "github"},
+			headers: map[string]string{
+				// Example from https://docs.github.com/en/webhooks/using-webhooks/validating-webhook-deliveries
+				"X-Hub-Signature-256": "sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17",
+			},
+		},
+		{
+			desc: "github with wrong signature",
+			cfg:  SignatureConfig{Preset: "github"},
+			headers: map[string]string{
+				"X-Hub-Signature-256": "sha256=" + hex.EncodeToString(testHMAC(sha256.New, "Goodbye, World!")),
+			},
+			err: errInvalidSignature,
+		},
+		{
+			desc: "github without prefix",
+			cfg:  SignatureConfig{Preset: "github"},
+			headers: map[string]string{
+				"X-Hub-Signature-256": bodySignature,
+			},
+			err: errMissingSignature,
+		},
+		{
+			desc: "unsigned request",
+			cfg:  SignatureConfig{Preset: "github"},
+			err:  errMissingSignature,
+		},
+		{
+			desc: "stripe",
+			cfg:  SignatureConfig{Preset: "stripe", TimestampTolerance: 5 * time.Minute},
+			headers: map[string]string{
+				"Stripe-Signature": "t=" + timestamp + ",v1=deadbeef,v1=" + hex.EncodeToString(testHMAC(sha256.New, timestamp+"."+testBody)) + ",v0=cafe",
+			},
+		},
+		{
+			desc: "stripe without timestamp",
+			cfg:  SignatureConfig{Preset: "stripe", TimestampTolerance: 5 * time.Minute},
+			headers: map[string]string{
+				"Stripe-Signature": "v1=" + hex.EncodeToString(testHMAC(sha256.New, "."+testBody)),
+			},
+			err: errMissingTimestamp,
+		},
+		{
+			desc: "stripe replayed",
+			cfg:  SignatureConfig{Preset: "stripe", TimestampTolerance: 5 * time.Minute},
+			headers: map[string]string{
+				"Stripe-Signature": "t=" + staleTimestamp + ",v1=" + hex.EncodeToString(testHMAC(sha256.New, staleTimestamp+"."+testBody)),
+			},
+			err: errTimestampTolerance,
+		},
+		{
+			desc: "stripe replayed without tolerance",
+			cfg:  SignatureConfig{Preset: "stripe"},
+			headers: map[string]string{
+				"Stripe-Signature": "t=" + staleTimestamp + ",v1=" + hex.EncodeToString(testHMAC(sha256.New, staleTimestamp+"."+testBody)),
+			},
+		},
+		{
+			desc: "slack",
+			cfg:  SignatureConfig{Preset: "slack", TimestampTolerance: 5 * time.Minute},
+			headers: map[string]string{
+				"X-Slack-Signature":         "v0=" + hex.EncodeToString(testHMAC(sha256.New, "v0:"+timestamp+":"+testBody)),
+				"X-Slack-Request-Timestamp": timestamp,
+			},
+		},
+		{
+			desc: "slack with invalid timestamp",
+			cfg:  SignatureConfig{Preset: "slack", TimestampTolerance: 5 * time.Minute},
+			headers: map[string]string{
+				"X-Slack-Signature":         "v0=" + hex.EncodeToString(testHMAC(sha256.New, "v0:now:"+testBody)),
+				"X-Slack-Request-Timestamp": "now",
+			},
+			err: errInvalidTimestamp,
+		},
+		{
+			desc: "pagerduty with rotated secrets",
+			cfg:  SignatureConfig{Preset: "pagerduty"},
+			headers: map[string]string{
+				"X-PagerDuty-Signature": "v1=0123abcd, v1=" + bodySignature,
+			},
+		},
+		{
+			desc: "custom base64 sha1",
+			cfg: SignatureConfig{
+				Header:          "X-Signature",
+				Algorithm:       "sha1",
+				Encoding:        "base64",
+				TimestampHeader: "X-Timestamp",
+				Payload:         "{timestamp}|{body}",
+			},
+			headers: map[string]string{
+				"X-Signature": base64.StdEncoding.EncodeToString(testHMAC(sha1.New, timestamp+"|"+testBody)),
+				"X-Timestamp": timestamp,
+			},
+		},
+		{
+			desc: "custom with invalid encoding",
+			cfg:  SignatureConfig{Header: "X-Signature", Encoding: "base64"},
+			headers: map[string]string{
+				"X-Signature": bodySignature,
+			},
+			err: errInvalidSignature,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			test.cfg.Secret = testSecret
+			require.NoError(t, test.cfg.validate())
+			v := newSignatureVerifier(test.cfg)
+			v.now = func() time.Time { return time.Unix(testTimestamp+30, 0) }
+
+			headers := http.Header{}
+			for k, val := range test.headers {
+				headers.Set(k, val)
+			}
+			require.Equal(t, test.err, v.verify(headers, []byte(testBody)))
+		})
+	}
+}
+
+func TestSignatureVerifierDisabled(t *testing.T) {
+	require.Nil(t, newSignatureVerifier(SignatureConfig{TimestampTolerance: time.Minute}))
+}
+
+func TestSignatureEventAttributes(t *testing.T) {
+	v := newSignatureVerifier(SignatureConfig{Preset: "github", Secret: testSecret})
+	headers := http.Header{}
+	headers.Set("X-GitHub-Event", "push")
+	headers.Set("X-GitHub-Delivery", "72d3162e-cc78-11e3-81ab-4c9367dc0958")
+
+	l := plog.NewLogRecord()
+	v.appendEventAttributes(headers, l)
+	assert.Equal(t, map[string]any{
+		"webhook.provider":    "github",
+		"webhook.event_type":  "push",
+		"webhook.delivery_id": "72d3162e-cc78-11e3-81ab-4c9367dc0958",
+	}, l.Attributes().AsRaw())
+
+	custom := newSignatureVerifier(SignatureConfig{Header: "X-Signature", Secret: testSecret})
+	l = plog.NewLogRecord()
+	custom.appendEventAttributes(headers, l)
+	assert.Equal(t, 0, l.Attributes().Len())
+}